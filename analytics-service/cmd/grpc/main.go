@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	_ "time/tzdata" // The runtime image has no zoneinfo, forecasts use the month in the user's time zone
 
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/run"
 )
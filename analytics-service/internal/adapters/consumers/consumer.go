@@ -3,9 +3,11 @@ package consumers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/IBM/sarama"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/kafka"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
 )
@@ -20,74 +22,40 @@ func NewAnalyticsConsumer(analyticsService *service.AnalyticsService) *Analytics
 	}
 }
 
-func (cons *AnalyticsConsumer) Setup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (cons *AnalyticsConsumer) Cleanup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (cons *AnalyticsConsumer) ConsumeClaim(s sarama.ConsumerGroupSession, c sarama.ConsumerGroupClaim) error {
-	l := log.FromContext(s.Context())
-
-	for {
-		select {
-		case msg, ok := <-c.Messages():
-			if !ok {
-				l.Info("Consumer channel closed")
-				return nil
-			}
-
-			l.Infof("Message received: topic=%s, partition=%d, offset=%d, key=%s",
-				msg.Topic, msg.Partition, msg.Offset, string(msg.Key))
-
-			// Key содержит userId
-			userUID := string(msg.Key)
-			if userUID == "" {
-				l.Errorf("Empty user_uid in message key")
-				s.MarkMessage(msg, "")
-				s.Commit()
-				continue
-			}
-
-			// Value содержит действие (обычно "update")
-			var kafkaMsg models.KafkaAnalyticsMessage
-			if err := json.Unmarshal(msg.Value, &kafkaMsg); err != nil {
-				// Если не удалось распарсить JSON, пробуем использовать просто строку
-				l.Infof("Could not parse message as JSON, using key as userUID: %v", err)
-				kafkaMsg.UserUID = userUID
-				kafkaMsg.Action = string(msg.Value)
-			}
-
-			// Если userUID не в теле, берем из ключа
-			if kafkaMsg.UserUID == "" {
-				kafkaMsg.UserUID = userUID
-			}
+// Process обрабатывает одно событие аналитики. Подтверждение, повторы и DLQ - на kafka.RetryingHandler
+func (cons *AnalyticsConsumer) Process(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	l := log.FromContext(ctx)
 
-			l.Infof("Processing analytics for user: %s, action: %s",
-				kafkaMsg.UserUID, kafkaMsg.Action)
+	// Key содержит userId
+	userUID := string(msg.Key)
+	if userUID == "" {
+		return kafka.Permanent(errors.New("empty user_uid in message key"))
+	}
 
-			// Обрабатываем событие аналитики
-			if err := cons.service.ProcessAnalyticsEvent(s.Context(), kafkaMsg.UserUID); err != nil {
-				l.Errorf("Failed to process analytics event: %v", err)
-				// Все равно помечаем как обработанное, чтобы избежать повторной обработки
-				s.MarkMessage(msg, "")
-				s.Commit()
-				continue
-			}
+	// Value содержит действие (обычно "update")
+	var kafkaMsg models.KafkaAnalyticsMessage
+	if err := json.Unmarshal(msg.Value, &kafkaMsg); err != nil {
+		// Если не удалось распарсить JSON, пробуем использовать просто строку
+		l.Infof("Could not parse message as JSON, using key as userUID: %v", err)
+		kafkaMsg.UserUID = userUID
+		kafkaMsg.Action = string(msg.Value)
+	}
 
-			l.Infof("Successfully processed analytics for user: %s", kafkaMsg.UserUID)
+	// Если userUID не в теле, берем из ключа
+	if kafkaMsg.UserUID == "" {
+		kafkaMsg.UserUID = userUID
+	}
 
-			// Помечаем сообщение как обработанное
-			s.MarkMessage(msg, "")
-			s.Commit()
+	l.Infof("Processing analytics for user: %s, action: %s",
+		kafkaMsg.UserUID, kafkaMsg.Action)
 
-		case <-s.Context().Done():
-			l.Info("Consumer context done")
-			return nil
-		}
+	// Обрабатываем событие аналитики
+	if err := cons.service.ProcessAnalyticsEvent(ctx, kafkaMsg); err != nil {
+		return err
 	}
+
+	l.Infof("Successfully processed analytics for user: %s", kafkaMsg.UserUID)
+	return nil
 }
 
 func StartConsuming(ctx context.Context, consumer sarama.ConsumerGroup, topics []string, handler sarama.ConsumerGroupHandler) {
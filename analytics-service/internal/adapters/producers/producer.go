@@ -0,0 +1,58 @@
+package producers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/google/uuid"
+)
+
+type Producer interface {
+	Produce(ctx context.Context, key, message []byte) error
+	Close() error
+}
+
+const msgIdHeader = "msg-id"
+
+type notificationSender struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func NewKafkaProducer(producer sarama.SyncProducer, topic string) Producer {
+	return &notificationSender{
+		topic:    topic,
+		producer: producer,
+	}
+}
+
+func (p notificationSender) Produce(ctx context.Context, key, message []byte) error {
+	l := log.FromContext(ctx)
+
+	msg := &sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.ByteEncoder(key),
+		Value: sarama.ByteEncoder(message),
+		Headers: []sarama.RecordHeader{
+			{
+				Key:   []byte(msgIdHeader),
+				Value: []byte(uuid.New().String()),
+			},
+		},
+	}
+
+	partition, offset, err := p.producer.SendMessage(msg)
+	if err != nil {
+		l.Errorf("Failed to send message to Kafka topic '%s': %v", p.topic, err)
+		return fmt.Errorf("failed to send message to Kafka topic '%s': %w", p.topic, err)
+	}
+
+	l.Infof("Message successfully sent, partition = %d, offset = %d", partition, offset)
+	return nil
+}
+
+func (p notificationSender) Close() error {
+	return p.producer.Close()
+}
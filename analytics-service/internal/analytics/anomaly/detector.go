@@ -0,0 +1,218 @@
+// Package anomaly ищет необычные операции, сравнивая их с историей операций пользователя
+// по робастной статистике: медиане и медианному абсолютному отклонению (MAD)
+package anomaly
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+const (
+	// madScale приводит MAD к стандартному отклонению нормального распределения
+	madScale = 0.6745
+	// minRatio - во сколько раз сумма должна превышать медиану, чтобы считаться необычной.
+	// Не дает отмечать операции при очень плотном разбросе сумм
+	minRatio = 1.5
+	// zeroSpreadRatio - порог превышения медианы, если все прошлые суммы одинаковые
+	zeroSpreadRatio = 2
+	// minTitleSamples - сколько операций с тем же названием нужно для сравнения по получателю
+	minTitleSamples = 3
+	// newCategoryMinHistory - сколько операций должно быть у пользователя, чтобы первая операция
+	// в категории считалась необычной, а не заполнением нового аккаунта
+	newCategoryMinHistory = 10
+	// amountEpsilon - точность сравнения сумм для дублей
+	amountEpsilon = 0.005
+)
+
+// Config - пороги детектора
+type Config struct {
+	Threshold       float64       // Робастная z-оценка, выше которой сумма считается необычной
+	MinSamples      int           // Сколько операций категории нужно для сравнения сумм
+	DuplicateWindow time.Duration // Насколько близко по времени внесения операции считаются дублями
+}
+
+// Transaction - операция, проверяемая детектором, и операции истории
+type Transaction struct {
+	ID         int64
+	CategoryID int32
+	Type       string
+	Amount     float64
+	Title      string
+	Date       time.Time
+	CreatedAt  int64 // Время внесения, unix
+}
+
+// Finding - найденная аномалия
+type Finding struct {
+	Kind        string  // models.Anomaly*
+	Typical     float64 // Медиана сумм, с которыми сравнивалась операция
+	Score       float64 // Робастная z-оценка, 0 - если разброс сумм нулевой
+	Samples     int     // Сколько операций участвовало в сравнении
+	SameDayKind bool    // Сравнение только с операциями в будни или только в выходные, как у операции
+	DuplicateOf int64
+}
+
+// Detect проверяет операцию по истории пользователя. Сама операция в истории пропускается.
+// Суммы проверяются только у расходов: крупный доход не повод для тревоги
+func Detect(tx Transaction, history []Transaction, cfg Config) []Finding {
+	var findings []Finding
+
+	if f, ok := duplicate(tx, history, cfg.DuplicateWindow); ok {
+		findings = append(findings, f)
+	}
+
+	if f, ok := newCategory(tx, history); ok {
+		// Сравнивать суммы в новой категории не с чем
+		return append(findings, f)
+	}
+
+	if tx.Type != "expense" {
+		return findings
+	}
+
+	// Сравнение с тем же получателем точнее, чем с категорией, поэтому при совпадении оно заменяет его
+	if f, ok := largeForTitle(tx, history, cfg.Threshold); ok {
+		return append(findings, f)
+	}
+	if f, ok := largeAmount(tx, history, cfg); ok {
+		findings = append(findings, f)
+	}
+
+	return findings
+}
+
+// duplicate ищет более раннюю операцию с той же суммой и названием, внесенную в пределах окна.
+// Операции без названия сравниваются по категории
+func duplicate(tx Transaction, history []Transaction, window time.Duration) (Finding, bool) {
+	title := models.NormalizeTitle(tx.Title)
+	windowSec := int64(window / time.Second)
+
+	for _, h := range history {
+		// Из пары дублей отмечается только более поздняя операция
+		if h.ID >= tx.ID || h.Type != tx.Type || math.Abs(h.Amount-tx.Amount) > amountEpsilon {
+			continue
+		}
+		if d := tx.CreatedAt - h.CreatedAt; d < -windowSec || d > windowSec {
+			continue
+		}
+		if models.NormalizeTitle(h.Title) != title || (title == "" && h.CategoryID != tx.CategoryID) {
+			continue
+		}
+		return Finding{Kind: models.AnomalyDuplicate, DuplicateOf: h.ID}, true
+	}
+
+	return Finding{}, false
+}
+
+// newCategory проверяет, что до операции в ее категории ничего не вносилось. Учитываются только более
+// ранние операции, поэтому при импорте пачки в новую категорию отмечается одна первая операция
+func newCategory(tx Transaction, history []Transaction) (Finding, bool) {
+	others := 0
+	for _, h := range history {
+		if h.ID >= tx.ID {
+			continue
+		}
+		if h.CategoryID == tx.CategoryID {
+			return Finding{}, false
+		}
+		others++
+	}
+
+	if others < newCategoryMinHistory {
+		return Finding{}, false
+	}
+	return Finding{Kind: models.AnomalyNewCategory, Samples: others}, true
+}
+
+// largeAmount сравнивает сумму с расходами категории. Если операций в тот же тип дня (будни или выходные)
+// достаточно, сравнение идет только с ними: в выходные траты на те же категории обычно крупнее
+func largeAmount(tx Transaction, history []Transaction, cfg Config) (Finding, bool) {
+	weekend := isWeekend(tx.Date)
+
+	var all, sameDay []float64
+	for _, h := range history {
+		if h.ID == tx.ID || h.Type != tx.Type || h.CategoryID != tx.CategoryID {
+			continue
+		}
+		all = append(all, h.Amount)
+		if isWeekend(h.Date) == weekend {
+			sameDay = append(sameDay, h.Amount)
+		}
+	}
+
+	samples, sameDayKind := all, false
+	if len(sameDay) >= cfg.MinSamples {
+		samples, sameDayKind = sameDay, true
+	}
+	if len(samples) < cfg.MinSamples {
+		return Finding{}, false
+	}
+
+	f, ok := outlier(tx.Amount, samples, cfg.Threshold)
+	f.Kind = models.AnomalyLargeAmount
+	f.SameDayKind = sameDayKind
+	return f, ok
+}
+
+func largeForTitle(tx Transaction, history []Transaction, threshold float64) (Finding, bool) {
+	title := models.NormalizeTitle(tx.Title)
+	if title == "" {
+		return Finding{}, false
+	}
+
+	var samples []float64
+	for _, h := range history {
+		if h.ID == tx.ID || h.Type != tx.Type || models.NormalizeTitle(h.Title) != title {
+			continue
+		}
+		samples = append(samples, h.Amount)
+	}
+	if len(samples) < minTitleSamples {
+		return Finding{}, false
+	}
+
+	f, ok := outlier(tx.Amount, samples, threshold)
+	f.Kind = models.AnomalyLargeForTitle
+	return f, ok
+}
+
+// outlier проверяет, что amount необычно велик относительно samples по робастной z-оценке
+func outlier(amount float64, samples []float64, threshold float64) (Finding, bool) {
+	med := median(samples)
+	f := Finding{Typical: med, Samples: len(samples)}
+
+	if med <= 0 {
+		return f, false
+	}
+
+	deviations := make([]float64, len(samples))
+	for i, v := range samples {
+		deviations[i] = math.Abs(v - med)
+	}
+	mad := median(deviations)
+
+	if mad == 0 {
+		return f, amount > zeroSpreadRatio*med
+	}
+
+	f.Score = madScale * (amount - med) / mad
+	return f, f.Score > threshold && amount > minRatio*med
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
@@ -0,0 +1,282 @@
+// Package budget распределяет доход пользователя по категориям расходов на месяц
+package budget
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+const (
+	// defaultSavingsShare - цель по сбережениям, если пользователь ее не задал
+	defaultSavingsShare = 0.1
+	// Доли дохода в стратегии 50/30/20
+	needsShare   = 0.5
+	wantsShare   = 0.3
+	savingsShare = 0.2
+)
+
+// classes - группы категорий по коду. Неизвестные категории считаются желаниями
+var classes = map[string]string{
+	"housing":       models.BudgetClassFixed,
+	"utilities":     models.BudgetClassFixed,
+	"loan_payments": models.BudgetClassFixed,
+	"communication": models.BudgetClassFixed,
+	"groceries":     models.BudgetClassNeeds,
+	"transport":     models.BudgetClassNeeds,
+	"health":        models.BudgetClassNeeds,
+	"education":     models.BudgetClassNeeds,
+}
+
+// Classify возвращает группу категории в плане бюджета
+func Classify(code string) string {
+	if class, ok := classes[code]; ok {
+		return class
+	}
+	return models.BudgetClassWants
+}
+
+// Category - категория, которой распределяется бюджет
+type Category struct {
+	Code    string
+	Average float64 // Средние расходы в месяц
+	Debt    float64 // Платежи по долгам в месяц, учитываются в обязательной категории
+	Min     float64 // Рекомендуемый диапазон в рублях, Max 0 - для категории нет правила
+	Max     float64
+}
+
+// Input - доход, цель по сбережениям и категории для плана
+type Input struct {
+	Strategy      string
+	Income        float64
+	SavingsTarget float64 // 0 - 10% дохода, в стратегии 50/30/20 не меньше 20%
+	Categories    []Category
+}
+
+// Item - бюджет категории и объяснение, откуда взялась сумма
+type Item struct {
+	Class  string
+	Amount float64
+	Reason string
+}
+
+// Plan - бюджеты в порядке Input.Categories и итоги по группам
+type Plan struct {
+	Items            []Item
+	Fixed            float64
+	Needs            float64
+	Wants            float64
+	SavingsTarget    float64
+	Savings          float64
+	SavingsTargetMet bool
+	Unallocated      float64
+}
+
+// Build распределяет доход по категориям. Обязательные платежи не сокращаются: в бюджет
+// идут средние расходы, а по кредитам - не меньше ежемесячных платежей по долгам. Остальные категории
+// начинаются со средних расходов, ограниченных рекомендуемым максимумом, и по стратегии сокращаются
+// не ниже рекомендуемого минимума, пока в доход не поместится цель по сбережениям:
+//   - balanced - все необязательные категории сокращаются пропорционально запасу над минимумом;
+//   - 50_30_20 - нужды вместе с обязательными платежами укладываются в 50% дохода, желания - в 30%,
+//     на сбережения остается не меньше 20%. Цель больше 20% уменьшает сначала долю желаний;
+//   - zero_based - категории начинаются с минимума, свободные деньги сначала получают нужды,
+//     затем желания, а все, что останется, уходит в сбережения.
+//
+// Если цель не помещается даже в минимумы, SavingsTargetMet равен false
+func Build(in Input) Plan {
+	n := len(in.Categories)
+	plan := Plan{Items: make([]Item, n)}
+	amounts := make([]float64, n)
+	floors := make([]float64, n)
+	ceilings := make([]float64, n)
+
+	var fixed float64
+	var needs, wants []int
+	for i, c := range in.Categories {
+		class := Classify(c.Code)
+		plan.Items[i].Class = class
+
+		if class == models.BudgetClassFixed {
+			amounts[i] = math.Ceil(math.Max(c.Average, c.Debt))
+			fixed += amounts[i]
+			continue
+		}
+
+		ceilings[i] = c.Average
+		if c.Max > 0 {
+			ceilings[i] = math.Min(ceilings[i], c.Max)
+		}
+		floors[i] = math.Min(c.Min, ceilings[i])
+		amounts[i] = ceilings[i]
+		if class == models.BudgetClassNeeds {
+			needs = append(needs, i)
+		} else {
+			wants = append(wants, i)
+		}
+	}
+	flexible := make([]int, 0, len(needs)+len(wants))
+	flexible = append(flexible, needs...)
+	flexible = append(flexible, wants...)
+
+	target := in.SavingsTarget
+	if target <= 0 {
+		target = in.Income * defaultSavingsShare
+	}
+
+	switch in.Strategy {
+	case models.BudgetStrategy503020:
+		target = math.Max(target, in.Income*savingsShare)
+		wantsCap := in.Income*wantsShare - (target - in.Income*savingsShare)
+		needsCap := in.Income*needsShare - fixed + math.Min(wantsCap, 0)
+		reduce(amounts, floors, wants, sum(amounts, wants)-math.Max(wantsCap, 0))
+		reduce(amounts, floors, needs, sum(amounts, needs)-math.Max(needsCap, 0))
+	case models.BudgetStrategyZeroBased:
+		for _, i := range flexible {
+			amounts[i] = floors[i]
+		}
+		available := in.Income - fixed - target - sum(amounts, flexible)
+		if available < 0 {
+			// Даже минимумы не помещаются: сначала обнуляются желания, затем нужды
+			zeros := make([]float64, n)
+			left := reduce(amounts, zeros, wants, -available)
+			reduce(amounts, zeros, needs, left)
+		} else {
+			left := raise(amounts, ceilings, needs, available)
+			raise(amounts, ceilings, wants, left)
+		}
+	default:
+		reduce(amounts, floors, flexible, sum(amounts, flexible)-(in.Income-fixed-target))
+	}
+
+	for i, c := range in.Categories {
+		item := &plan.Items[i]
+		switch item.Class {
+		case models.BudgetClassFixed:
+			item.Amount = amounts[i]
+			plan.Fixed += item.Amount
+		case models.BudgetClassNeeds:
+			item.Amount = roundDown(amounts[i])
+			plan.Needs += item.Amount
+		default:
+			item.Amount = roundDown(amounts[i])
+			plan.Wants += item.Amount
+		}
+		item.Reason = reason(in.Strategy, c, *item)
+	}
+
+	left := in.Income - plan.Fixed - plan.Needs - plan.Wants
+	plan.SavingsTarget = round2(target)
+	if in.Strategy == models.BudgetStrategyZeroBased {
+		plan.Savings = left
+	} else {
+		plan.Savings = math.Min(target, left)
+		plan.Unallocated = left - plan.Savings
+	}
+	plan.Savings = round2(plan.Savings)
+	plan.Unallocated = round2(plan.Unallocated)
+	plan.SavingsTargetMet = plan.Savings >= plan.SavingsTarget
+
+	return plan
+}
+
+// reduce уменьшает суммы категорий на excess пропорционально запасу над floors.
+// Возвращает часть excess, которую не удалось снять
+func reduce(amounts, floors []float64, idx []int, excess float64) float64 {
+	if excess <= 0 {
+		return 0
+	}
+
+	var room float64
+	for _, i := range idx {
+		room += amounts[i] - floors[i]
+	}
+	if room <= 0 {
+		return excess
+	}
+
+	k := math.Min(1, excess/room)
+	for _, i := range idx {
+		amounts[i] -= (amounts[i] - floors[i]) * k
+	}
+
+	return math.Max(0, excess-room)
+}
+
+// raise увеличивает суммы категорий на budget пропорционально запасу до ceilings.
+// Возвращает нераспределенную часть budget
+func raise(amounts, ceilings []float64, idx []int, budget float64) float64 {
+	if budget <= 0 {
+		return budget
+	}
+
+	var room float64
+	for _, i := range idx {
+		room += ceilings[i] - amounts[i]
+	}
+	if room <= 0 {
+		return budget
+	}
+
+	k := math.Min(1, budget/room)
+	for _, i := range idx {
+		amounts[i] += (ceilings[i] - amounts[i]) * k
+	}
+
+	return math.Max(0, budget-room)
+}
+
+// reason объясняет сумму бюджета категории
+func reason(strategy string, c Category, item Item) string {
+	if item.Class == models.BudgetClassFixed {
+		if c.Debt > c.Average {
+			return fmt.Sprintf("Обязательный платеж: ежемесячные платежи по долгам %.0f ₽", c.Debt)
+		}
+		return "Обязательный платеж по средним расходам, не сокращается"
+	}
+
+	if item.Amount >= roundDown(c.Average) {
+		return "По средним расходам за прошлые месяцы"
+	}
+	if c.Max > 0 && c.Average > c.Max && item.Amount >= roundDown(c.Max) {
+		return fmt.Sprintf("Снижено до рекомендуемого максимума %.0f ₽", c.Max)
+	}
+
+	// Расходы выше рекомендуемого максимума сначала ограничиваются им, сокращение считается от него
+	from, cut := "средних расходов", c.Average-item.Amount
+	if c.Max > 0 && c.Average > c.Max {
+		from, cut = "рекомендуемого максимума", c.Max-item.Amount
+	}
+	switch strategy {
+	case models.BudgetStrategy503020:
+		if item.Class == models.BudgetClassNeeds {
+			return fmt.Sprintf("Снижено на %.0f ₽ от %s, чтобы нужды с обязательными платежами уложились в 50%% дохода", cut, from)
+		}
+		return fmt.Sprintf("Снижено на %.0f ₽ от %s, чтобы желания уложились в 30%% дохода", cut, from)
+	case models.BudgetStrategyZeroBased:
+		return fmt.Sprintf("Снижено на %.0f ₽ от %s: свободные деньги сначала распределены на нужды и сбережения", cut, from)
+	default:
+		return fmt.Sprintf("Снижено на %.0f ₽ от %s, чтобы выполнить цель по сбережениям", cut, from)
+	}
+}
+
+// roundDown округляет бюджет вниз до 100 ₽, небольшие суммы - до 10 ₽
+func roundDown(amount float64) float64 {
+	step := 100.0
+	if amount < 1000 {
+		step = 10
+	}
+	return math.Floor(amount/step) * step
+}
+
+func sum(amounts []float64, idx []int) float64 {
+	var total float64
+	for _, i := range idx {
+		total += amounts[i]
+	}
+	return total
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,182 @@
+package budget
+
+import (
+	"testing"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          Input
+		amounts     []float64 // В порядке Input.Categories
+		savings     float64
+		unallocated float64
+		targetMet   bool
+	}{
+		{
+			name: "fixed cost above income is not cut",
+			in: Input{
+				Strategy: models.BudgetStrategyBalanced,
+				Income:   50000,
+				Categories: []Category{
+					{Code: "housing", Average: 60000},
+					{Code: "loan_payments", Average: 5000, Debt: 8000},
+					{Code: "groceries", Average: 10000, Min: 5000, Max: 15000},
+				},
+			},
+			amounts:   []float64{60000, 8000, 5000},
+			savings:   -23000,
+			targetMet: false,
+		},
+		{
+			name: "balanced cuts flexible categories proportionally",
+			in: Input{
+				Strategy:      models.BudgetStrategyBalanced,
+				Income:        100000,
+				SavingsTarget: 30000,
+				Categories: []Category{
+					{Code: "housing", Average: 40000},
+					{Code: "groceries", Average: 20000, Min: 15000, Max: 25000},
+					{Code: "entertainment", Average: 20000, Min: 10000, Max: 15000},
+				},
+			},
+			amounts:   []float64{40000, 17500, 12500},
+			savings:   30000,
+			targetMet: true,
+		},
+		{
+			name: "savings target does not fit into minimums",
+			in: Input{
+				Strategy:      models.BudgetStrategyBalanced,
+				Income:        100000,
+				SavingsTarget: 40000,
+				Categories: []Category{
+					{Code: "housing", Average: 40000},
+					{Code: "groceries", Average: 20000, Min: 15000, Max: 25000},
+					{Code: "entertainment", Average: 20000, Min: 10000, Max: 15000},
+				},
+			},
+			amounts:   []float64{40000, 15000, 10000},
+			savings:   35000,
+			targetMet: false,
+		},
+		{
+			name: "balanced leaves money below the averages unallocated",
+			in: Input{
+				Strategy: models.BudgetStrategyBalanced,
+				Income:   100000,
+				Categories: []Category{
+					{Code: "housing", Average: 30000},
+					{Code: "groceries", Average: 15000, Min: 10000, Max: 20000},
+				},
+			},
+			amounts:     []float64{30000, 15000},
+			savings:     10000,
+			unallocated: 45000,
+			targetMet:   true,
+		},
+		{
+			name: "50/30/20 with target above 20% shrinks wants first",
+			in: Input{
+				Strategy:      models.BudgetStrategy503020,
+				Income:        100000,
+				SavingsTarget: 30000,
+				Categories: []Category{
+					{Code: "housing", Average: 30000},
+					{Code: "groceries", Average: 25000, Min: 10000, Max: 30000},
+					{Code: "entertainment", Average: 25000, Min: 5000, Max: 30000},
+					{Code: "restaurants", Average: 10000},
+				},
+			},
+			amounts:   []float64{30000, 20000, 15000, 5000},
+			savings:   30000,
+			targetMet: true,
+		},
+		{
+			name: "50/30/20 raises a lower target to 20%",
+			in: Input{
+				Strategy:      models.BudgetStrategy503020,
+				Income:        100000,
+				SavingsTarget: 5000,
+				Categories: []Category{
+					{Code: "housing", Average: 30000},
+					{Code: "groceries", Average: 30000, Min: 10000, Max: 30000},
+					{Code: "entertainment", Average: 20000},
+				},
+			},
+			amounts:     []float64{30000, 20000, 20000},
+			savings:     20000,
+			unallocated: 10000,
+			targetMet:   true,
+		},
+		{
+			name: "zero-based leftover goes to savings",
+			in: Input{
+				Strategy:      models.BudgetStrategyZeroBased,
+				Income:        100000,
+				SavingsTarget: 10000,
+				Categories: []Category{
+					{Code: "housing", Average: 30000},
+					{Code: "groceries", Average: 15000, Min: 10000, Max: 20000},
+					{Code: "entertainment", Average: 8000, Min: 5000, Max: 15000},
+				},
+			},
+			amounts:   []float64{30000, 15000, 8000},
+			savings:   47000,
+			targetMet: true,
+		},
+		{
+			name: "zero-based drops wants before needs when minimums do not fit",
+			in: Input{
+				Strategy:      models.BudgetStrategyZeroBased,
+				Income:        50000,
+				SavingsTarget: 10000,
+				Categories: []Category{
+					{Code: "housing", Average: 30000},
+					{Code: "groceries", Average: 15000, Min: 10000, Max: 20000},
+					{Code: "entertainment", Average: 8000, Min: 5000, Max: 15000},
+				},
+			},
+			amounts:   []float64{30000, 10000, 0},
+			savings:   10000,
+			targetMet: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := Build(tt.in)
+
+			if len(plan.Items) != len(tt.amounts) {
+				t.Fatalf("got %d items, want %d", len(plan.Items), len(tt.amounts))
+			}
+			var total float64
+			for i, item := range plan.Items {
+				if item.Amount != tt.amounts[i] {
+					t.Errorf("%s: amount = %v, want %v", tt.in.Categories[i].Code, item.Amount, tt.amounts[i])
+				}
+				if item.Class != Classify(tt.in.Categories[i].Code) {
+					t.Errorf("%s: class = %q, want %q", tt.in.Categories[i].Code, item.Class, Classify(tt.in.Categories[i].Code))
+				}
+				if item.Reason == "" {
+					t.Errorf("%s: reason is empty", tt.in.Categories[i].Code)
+				}
+				total += item.Amount
+			}
+			if got := plan.Fixed + plan.Needs + plan.Wants; got != total {
+				t.Errorf("group totals = %v, want %v", got, total)
+			}
+			if plan.Savings != tt.savings {
+				t.Errorf("savings = %v, want %v", plan.Savings, tt.savings)
+			}
+			if plan.Unallocated != tt.unallocated {
+				t.Errorf("unallocated = %v, want %v", plan.Unallocated, tt.unallocated)
+			}
+			if plan.SavingsTargetMet != tt.targetMet {
+				t.Errorf("savings target met = %v, want %v", plan.SavingsTargetMet, tt.targetMet)
+			}
+		})
+	}
+}
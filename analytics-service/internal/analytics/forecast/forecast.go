@@ -0,0 +1,99 @@
+// Package forecast проецирует расходы на конец месяца по темпу трат с начала месяца
+// и по тому, сколько тратилось в оставшиеся дни прошлых месяцев
+package forecast
+
+import "math"
+
+// intervalZ - квантиль нормального распределения для 80% интервала прогноза
+const intervalZ = 1.2816
+
+// noHistorySpread - разброс оставшихся расходов относительно прогноза по темпу, если прошлых месяцев нет
+const noHistorySpread = 0.5
+
+// Input - расходы категории, по которым строится прогноз
+type Input struct {
+	SpentToDate float64 // Расходы с начала месяца по сегодня включительно
+	Scheduled   float64 // Уже внесенные расходы на оставшиеся дни месяца
+	DaysElapsed int     // Прошло дней месяца, включая сегодня
+	DaysInMonth int
+	// PastRemaining - расходы прошлых месяцев после того же числа. Регулярные платежи в конце месяца,
+	// например аренда, попадают сюда, даже если в этом месяце еще не внесены
+	PastRemaining []float64
+}
+
+// Projection - ожидаемые расходы за месяц и их стандартное отклонение
+type Projection struct {
+	Expected float64
+	StdDev   float64
+}
+
+// Project оценивает расходы за весь месяц. Оставшаяся часть - смесь темпа трат и среднего прошлых
+// месяцев: чем больше дней прошло, тем больше вес темпа. Уже внесенные будущие расходы - нижняя граница
+func Project(in Input) Projection {
+	remainingDays := in.DaysInMonth - in.DaysElapsed
+	if remainingDays <= 0 || in.DaysElapsed <= 0 {
+		return Projection{Expected: in.SpentToDate + in.Scheduled}
+	}
+
+	pace := in.SpentToDate / float64(in.DaysElapsed) * float64(remainingDays)
+
+	remaining, spread := pace, pace*noHistorySpread
+	if len(in.PastRemaining) > 0 {
+		mean, sd := meanStdDev(in.PastRemaining)
+		w := float64(in.DaysElapsed) / float64(in.DaysInMonth)
+		remaining = w*pace + (1-w)*mean
+		// Расхождение темпа и истории - тоже неопределенность
+		spread = math.Max(sd, math.Abs(pace-mean)/2)
+	}
+
+	return Projection{
+		Expected: in.SpentToDate + math.Max(in.Scheduled, remaining),
+		StdDev:   spread,
+	}
+}
+
+// Interval возвращает 80% интервал прогноза, не ниже уже потраченного и внесенного
+func (p Projection) Interval(floor float64) (low, high float64) {
+	low = math.Max(floor, p.Expected-intervalZ*p.StdDev)
+	high = math.Max(floor, p.Expected+intervalZ*p.StdDev)
+	return low, high
+}
+
+// BreachProbability возвращает вероятность того, что расходы превысят limit
+func (p Projection) BreachProbability(limit float64) float64 {
+	if p.StdDev == 0 {
+		if p.Expected > limit {
+			return 1
+		}
+		return 0
+	}
+
+	z := (limit - p.Expected) / p.StdDev
+	return 0.5 * math.Erfc(z/math.Sqrt2)
+}
+
+// Sum складывает прогнозы независимых категорий
+func Sum(projections []Projection) Projection {
+	var total Projection
+	var variance float64
+	for _, p := range projections {
+		total.Expected += p.Expected
+		variance += p.StdDev * p.StdDev
+	}
+	total.StdDev = math.Sqrt(variance)
+	return total
+}
+
+func meanStdDev(values []float64) (float64, float64) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
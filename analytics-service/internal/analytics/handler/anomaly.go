@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAnomaliesLimit = 20
+	maxAnomaliesLimit     = 200
+)
+
+// GetAnomalies возвращает необычные операции пользователя с объяснениями
+func (h *AnalyticsHandler) GetAnomalies(ctx context.Context, req *pb.GetAnomaliesReq) (*pb.GetAnomaliesResp, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultAnomaliesLimit
+	case limit > maxAnomaliesLimit:
+		limit = maxAnomaliesLimit
+	}
+
+	anomalies, err := h.service.GetAnomalies(ctx, req.UserUid, limit)
+	if err != nil {
+		log.FromContext(ctx).Errorf("Failed to get anomalies: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get anomalies")
+	}
+
+	resp := &pb.GetAnomaliesResp{
+		Anomalies: make([]*pb.Anomaly, 0, len(anomalies)),
+	}
+	for _, a := range anomalies {
+		resp.Anomalies = append(resp.Anomalies, &pb.Anomaly{
+			TransactionId:   a.TransactionID,
+			Kind:            a.Kind,
+			CategoryId:      a.CategoryID,
+			CategoryName:    a.CategoryName,
+			Type:            a.Type,
+			Title:           a.Title,
+			Amount:          a.Amount,
+			TransactionDate: a.TransactionDate,
+			Typical:         a.Typical,
+			Score:           a.Score,
+			DuplicateOf:     a.DuplicateOf,
+			Explanation:     a.Explanation,
+			DetectedAt:      a.DetectedAt,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/service"
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GenerateBudgetPlan предлагает бюджеты по категориям на следующий месяц
+func (h *AnalyticsHandler) GenerateBudgetPlan(ctx context.Context, req *pb.GenerateBudgetPlanReq) (*pb.BudgetPlan, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+	if req.SavingsTarget < 0 {
+		return nil, status.Error(codes.InvalidArgument, "savings_target must not be negative")
+	}
+
+	plan, err := h.service.GenerateBudgetPlan(ctx, req.UserUid, req.Strategy, req.SavingsTarget)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidBudgetPlan):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrNoIncome), errors.Is(err, service.ErrNoSpendingHistory):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		log.FromContext(ctx).Errorf("Failed to generate budget plan: %v", err)
+		return nil, calculationError(err, "failed to generate budget plan")
+	}
+
+	resp := &pb.BudgetPlan{
+		Id:               plan.ID,
+		UserUid:          plan.UserUID,
+		Month:            plan.Month,
+		Strategy:         plan.Strategy,
+		Income:           plan.Income,
+		IncomeSource:     plan.IncomeSource,
+		SavingsTarget:    plan.SavingsTarget,
+		Savings:          plan.Savings,
+		SavingsTargetMet: plan.SavingsTargetMet,
+		Fixed:            plan.Fixed,
+		Needs:            plan.Needs,
+		Wants:            plan.Wants,
+		Unallocated:      plan.Unallocated,
+		HistoryMonths:    int32(plan.HistoryMonths),
+		Items:            make([]*pb.BudgetPlanItem, 0, len(plan.Items)),
+		RulesVersion:     plan.RulesVersion,
+		CalculatedAt:     plan.CalculatedAt,
+		ExpiresAt:        plan.ExpiresAt,
+	}
+	for _, item := range plan.Items {
+		resp.Items = append(resp.Items, &pb.BudgetPlanItem{
+			CategoryId:      item.CategoryID,
+			CategoryCode:    item.CategoryCode,
+			CategoryName:    item.CategoryName,
+			Class:           item.Class,
+			Amount:          item.Amount,
+			AverageSpending: item.AverageSpending,
+			RecommendedMin:  item.RecommendedMin,
+			RecommendedMax:  item.RecommendedMax,
+			Reason:          item.Reason,
+		})
+	}
+
+	return resp, nil
+}
+
+// AcceptBudgetPlan создает бюджеты в funds-service из последнего предложенного плана
+func (h *AnalyticsHandler) AcceptBudgetPlan(ctx context.Context, req *pb.AcceptBudgetPlanReq) (*pb.AcceptBudgetPlanResp, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+	if req.PlanId == "" {
+		return nil, status.Error(codes.InvalidArgument, "plan_id is required")
+	}
+
+	accepted, err := h.service.AcceptBudgetPlan(ctx, req.UserUid, req.PlanId)
+	if err != nil {
+		if errors.Is(err, service.ErrBudgetPlanNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		// Категория из плана могла быть архивирована, пока план ждал принятия
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		log.FromContext(ctx).Errorf("Failed to accept budget plan: %v", err)
+		return nil, calculationError(err, "failed to accept budget plan")
+	}
+
+	return &pb.AcceptBudgetPlanResp{
+		PlanId:  accepted.PlanID,
+		Month:   accepted.Month,
+		Budgets: plannedBudgetsToProto(accepted.Budgets),
+		Kept:    plannedBudgetsToProto(accepted.Kept),
+	}, nil
+}
+
+func plannedBudgetsToProto(budgets []models.Budget) []*pb.PlannedBudget {
+	result := make([]*pb.PlannedBudget, 0, len(budgets))
+	for _, b := range budgets {
+		result = append(result, &pb.PlannedBudget{
+			Id:           b.ID,
+			CategoryId:   b.CategoryID,
+			CategoryCode: b.CategoryCode,
+			CategoryName: b.CategoryName,
+			Month:        b.Month,
+			Amount:       b.Amount,
+			Source:       b.Source,
+		})
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/kafka"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultReplayLimit = 100
+	maxReplayLimit     = 1000
+)
+
+// ReplayDeadLetters отправляет сообщения из dead-letter топиков обратно в исходные топики
+func (h *AnalyticsHandler) ReplayDeadLetters(ctx context.Context, req *pb.ReplayDeadLettersReq) (*pb.ReplayDeadLettersResp, error) {
+	limit := int(req.Limit)
+	switch {
+	case limit < 0 || limit > maxReplayLimit:
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", maxReplayLimit)
+	case limit == 0:
+		limit = defaultReplayLimit
+	}
+
+	replayed, err := h.service.ReplayDeadLetters(ctx, req.Topic, limit)
+	if errors.Is(err, kafka.ErrUnknownDLQTopic) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		log.FromContext(ctx).Errorf("Failed to replay dead letters: %v", err)
+		return nil, status.Error(codes.Internal, "failed to replay dead letters")
+	}
+
+	return &pb.ReplayDeadLettersResp{Replayed: int32(replayed)}, nil
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetForecast возвращает прогноз расходов пользователя на конец текущего месяца
+func (h *AnalyticsHandler) GetForecast(ctx context.Context, req *pb.GetForecastReq) (*pb.GetForecastResp, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+
+	f, err := h.service.GetForecast(ctx, req.UserUid)
+	if err != nil {
+		log.FromContext(ctx).Errorf("Failed to calculate forecast: %v", err)
+		return nil, calculationError(err, "failed to calculate forecast")
+	}
+
+	resp := &pb.GetForecastResp{
+		UserUid:                 f.UserUID,
+		Month:                   f.Month,
+		DaysElapsed:             int32(f.DaysElapsed),
+		DaysInMonth:             int32(f.DaysInMonth),
+		HistoryMonths:           int32(f.HistoryMonths),
+		Salary:                  f.Salary,
+		SpentToDate:             f.SpentToDate,
+		Forecast:                f.Forecast,
+		Low:                     f.Low,
+		High:                    f.High,
+		BudgetBreachProbability: f.BudgetBreachProbability,
+		LikelyBudgetBreach:      f.LikelyBudgetBreach,
+		Categories:              make([]*pb.CategoryForecast, 0, len(f.Categories)),
+		RulesVersion:            f.RulesVersion,
+		CalculatedAt:            f.CalculatedAt,
+	}
+	for _, c := range f.Categories {
+		resp.Categories = append(resp.Categories, &pb.CategoryForecast{
+			CategoryCode:      c.CategoryCode,
+			CategoryName:      c.CategoryName,
+			SpentToDate:       c.SpentToDate,
+			Scheduled:         c.Scheduled,
+			Forecast:          c.Forecast,
+			Low:               c.Low,
+			High:              c.High,
+			RecommendedMax:    c.RecommendedMax,
+			Budget:            c.Budget,
+			BreachProbability: c.BreachProbability,
+			LikelyBreach:      c.LikelyBreach,
+		})
+	}
+
+	return resp, nil
+}
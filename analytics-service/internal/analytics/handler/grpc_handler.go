@@ -5,7 +5,9 @@ import (
 
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/service"
 	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	fundspb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/funds_service"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -33,17 +35,53 @@ func (h *AnalyticsHandler) GetRecommendations(ctx context.Context, req *pb.GetRe
 
 	l.Infof("GetRecommendations called for user: %s", req.UserUid)
 
-	// Получаем рекомендации из Redis
-	result, err := h.service.GetRecommendations(ctx, req.UserUid)
-	if err != nil {
-		l.Errorf("Failed to get recommendations: %v", err)
-		return nil, status.Error(codes.NotFound, "recommendations not found, please wait for calculation")
+	var period *fundspb.Period
+	if req.Period.GetKind() != "" {
+		period = &fundspb.Period{
+			Kind:     req.Period.Kind,
+			Offset:   req.Period.Offset,
+			DateFrom: req.Period.DateFrom,
+			DateTo:   req.Period.DateTo,
+			Days:     req.Period.Days,
+		}
 	}
 
-	// Конвертируем в proto формат
+	var result *models.AnalyticsResult
+	if req.LedgerId != 0 {
+		// Рекомендации по общему бюджету считаются на лету
+		var err error
+		result, err = h.service.GetLedgerRecommendations(ctx, req.UserUid, req.LedgerId, period)
+		if err != nil {
+			l.Errorf("Failed to get ledger recommendations: %v", err)
+			return nil, calculationError(err, "failed to calculate ledger recommendations")
+		}
+	} else if period != nil {
+		// Рекомендации за выбранный период считаются на лету
+		var err error
+		result, err = h.service.GetPeriodRecommendations(ctx, req.UserUid, period)
+		if err != nil {
+			l.Errorf("Failed to get period recommendations: %v", err)
+			return nil, calculationError(err, "failed to calculate recommendations")
+		}
+	} else {
+		// Получаем рекомендации из Redis
+		var err error
+		result, err = h.service.GetRecommendations(ctx, req.UserUid)
+		if err != nil {
+			l.Errorf("Failed to get recommendations: %v", err)
+			return nil, status.Error(codes.NotFound, "recommendations not found, please wait for calculation")
+		}
+	}
+
+	return resultToProto(result), nil
+}
+
+// resultToProto конвертирует результат аналитики в proto формат
+func resultToProto(result *models.AnalyticsResult) *pb.GetRecommendationsResp {
 	var pbRecommendations []*pb.CategoryRecommendation
 	for _, r := range result.Recommendations {
 		pbRecommendations = append(pbRecommendations, &pb.CategoryRecommendation{
+			CategoryCode:     r.CategoryCode,
 			CategoryName:     r.CategoryName,
 			ActualAmount:     r.ActualAmount,
 			ActualPercentage: r.ActualPercentage,
@@ -55,7 +93,7 @@ func (h *AnalyticsHandler) GetRecommendations(ctx context.Context, req *pb.GetRe
 		})
 	}
 
-	return &pb.GetRecommendationsResp{
+	resp := &pb.GetRecommendationsResp{
 		UserUid:         result.UserUID,
 		Salary:          result.Salary,
 		SalaryBracket:   result.SalaryBracket,
@@ -68,5 +106,47 @@ func (h *AnalyticsHandler) GetRecommendations(ctx context.Context, req *pb.GetRe
 		OverallMessage:  result.OverallMessage,
 		Recommendations: pbRecommendations,
 		CalculatedAt:    result.CalculatedAt,
-	}, nil
+		LedgerId:        result.LedgerID,
+		PeriodFrom:      result.PeriodFrom,
+		PeriodTo:        result.PeriodTo,
+		RulesVersion:    result.RulesVersion,
+		IncomeSource:    result.IncomeSource,
+	}
+
+	if result.Debt != nil {
+		resp.Debt = &pb.DebtLoad{
+			TotalOwed:         result.Debt.TotalOwed,
+			MonthlyPayments:   result.Debt.MonthlyPayments,
+			DebtToIncomeRatio: result.Debt.DebtToIncomeRatio,
+			Status:            result.Debt.Status,
+			Message:           result.Debt.Message,
+			DebtFreeDate:      result.Debt.DebtFreeDate,
+		}
+	}
+
+	if result.Income != nil {
+		resp.Income = &pb.IncomeAnalysis{
+			ProfileSalary:       result.Income.ProfileSalary,
+			EffectiveIncome:     result.Income.EffectiveIncome,
+			Months:              int32(result.Income.Months),
+			StabilityScore:      result.Income.StabilityScore,
+			AverageExpenses:     result.Income.AverageExpenses,
+			SavingsRate:         result.Income.SavingsRate,
+			Balance:             result.Income.Balance,
+			EmergencyFundMonths: result.Income.EmergencyFundMonths,
+			ExpenseVolatility:   result.Income.ExpenseVolatility,
+		}
+	}
+
+	return resp
+}
+
+// calculationError пробрасывает ошибки запроса из funds-service, остальные ошибки расчета скрывает за msg
+func calculationError(err error, msg string) error {
+	switch code := status.Code(err); code {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied:
+		return status.Error(code, err.Error())
+	default:
+		return status.Error(codes.Internal, msg)
+	}
 }
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetHealthScore возвращает оценку финансового здоровья пользователя с разбивкой и историей
+func (h *AnalyticsHandler) GetHealthScore(ctx context.Context, req *pb.GetHealthScoreReq) (*pb.GetHealthScoreResp, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+	months, err := historyMonths(req.Months)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.GetHealthScore(ctx, req.UserUid, months)
+	if err != nil {
+		log.FromContext(ctx).Errorf("Failed to get health score: %v", err)
+		return nil, calculationError(err, "failed to get health score")
+	}
+
+	resp := &pb.GetHealthScoreResp{
+		UserUid:      result.UserUID,
+		Score:        result.Score,
+		Components:   make([]*pb.HealthComponent, 0, len(result.Components)),
+		Actions:      make([]*pb.HealthAction, 0, len(result.Actions)),
+		History:      make([]*pb.HealthScorePoint, 0, len(result.History)),
+		CalculatedAt: result.CalculatedAt,
+	}
+	for _, c := range result.Components {
+		resp.Components = append(resp.Components, &pb.HealthComponent{
+			Code:        c.Code,
+			Available:   c.Available,
+			Value:       c.Value,
+			Score:       c.Score,
+			Weight:      c.Weight,
+			Explanation: c.Explanation,
+		})
+	}
+	for _, a := range result.Actions {
+		resp.Actions = append(resp.Actions, &pb.HealthAction{
+			Component: a.Component,
+			Gain:      a.Gain,
+			Message:   a.Message,
+		})
+	}
+	for _, p := range result.History {
+		resp.History = append(resp.History, &pb.HealthScorePoint{
+			Month:        p.Month,
+			Score:        p.Score,
+			CalculatedAt: p.CalculatedAt,
+		})
+	}
+
+	return resp, nil
+}
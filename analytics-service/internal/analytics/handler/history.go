@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultHistoryMonths = 6
+	maxHistoryMonths     = 24
+)
+
+// GetRecommendationHistory возвращает сохраненные рекомендации пользователя по месяцам
+func (h *AnalyticsHandler) GetRecommendationHistory(ctx context.Context, req *pb.GetRecommendationHistoryReq) (*pb.GetRecommendationHistoryResp, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+	months, err := historyMonths(req.Months)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := h.service.GetRecommendationHistory(ctx, req.UserUid, months)
+	if err != nil {
+		log.FromContext(ctx).Errorf("Failed to get recommendations history: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get recommendations history")
+	}
+
+	resp := &pb.GetRecommendationHistoryResp{
+		Snapshots: make([]*pb.RecommendationSnapshot, 0, len(snapshots)),
+	}
+	for _, snapshot := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, &pb.RecommendationSnapshot{
+			Month:           snapshot.Month,
+			Recommendations: resultToProto(snapshot.Result),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetCategoryTrend возвращает динамику доли расходов по категориям
+func (h *AnalyticsHandler) GetCategoryTrend(ctx context.Context, req *pb.GetCategoryTrendReq) (*pb.GetCategoryTrendResp, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+	months, err := historyMonths(req.Months)
+	if err != nil {
+		return nil, err
+	}
+
+	trends, err := h.service.GetCategoryTrend(ctx, req.UserUid, months, req.CategoryCode)
+	if err != nil {
+		log.FromContext(ctx).Errorf("Failed to get category trend: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get category trend")
+	}
+
+	resp := &pb.GetCategoryTrendResp{
+		Trends: make([]*pb.CategoryTrend, 0, len(trends)),
+	}
+	for _, trend := range trends {
+		pbTrend := &pb.CategoryTrend{
+			CategoryCode: trend.CategoryCode,
+			CategoryName: trend.CategoryName,
+			Direction:    trend.Direction,
+			Change:       trend.Change,
+		}
+		for _, p := range trend.Points {
+			pbTrend.Points = append(pbTrend.Points, &pb.CategoryTrendPoint{
+				Month:            p.Month,
+				ActualAmount:     p.ActualAmount,
+				ActualPercentage: p.ActualPercentage,
+				RecommendedMin:   p.RecommendedMin,
+				RecommendedMax:   p.RecommendedMax,
+				Status:           p.Status,
+			})
+		}
+		resp.Trends = append(resp.Trends, pbTrend)
+	}
+
+	return resp, nil
+}
+
+// historyMonths проверяет глубину истории, 0 означает значение по умолчанию
+func historyMonths(months int32) (int, error) {
+	switch {
+	case months == 0:
+		return defaultHistoryMonths, nil
+	case months < 0 || months > maxHistoryMonths:
+		return 0, status.Errorf(codes.InvalidArgument, "months must be between 1 and %d", maxHistoryMonths)
+	default:
+		return int(months), nil
+	}
+}
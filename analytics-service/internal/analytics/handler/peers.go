@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/service"
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPeerComparison сравнивает расходы пользователя по категориям с похожими пользователями
+func (h *AnalyticsHandler) GetPeerComparison(ctx context.Context, req *pb.GetPeerComparisonReq) (*pb.GetPeerComparisonResp, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+
+	result, err := h.service.GetPeerComparison(ctx, req.UserUid)
+	if err != nil {
+		if errors.Is(err, service.ErrNoPeerCohort) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.FromContext(ctx).Errorf("Failed to compare with peers: %v", err)
+		return nil, calculationError(err, "failed to compare with peers")
+	}
+
+	resp := &pb.GetPeerComparisonResp{
+		UserUid:            result.UserUID,
+		Level:              result.Level,
+		SalaryBracket:      result.SalaryBracket,
+		AgeBand:            result.AgeBand,
+		WorkSphereId:       result.WorkSphereID,
+		CohortSize:         int32(result.CohortSize),
+		Categories:         make([]*pb.PeerCategoryComparison, 0, len(result.Categories)),
+		PeriodTo:           result.PeriodTo,
+		CohortCalculatedAt: result.CohortCalculatedAt,
+	}
+	for _, c := range result.Categories {
+		resp.Categories = append(resp.Categories, &pb.PeerCategoryComparison{
+			CategoryCode:  c.CategoryCode,
+			CategoryName:  c.CategoryName,
+			Amount:        c.Amount,
+			CohortMedian:  c.CohortMedian,
+			SpendersShare: c.SpendersShare,
+			Percentile:    c.Percentile,
+			Message:       c.Message,
+		})
+	}
+
+	return resp, nil
+}
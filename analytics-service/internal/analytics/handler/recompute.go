@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetRecomputeMetrics возвращает счетчики отложенных пересчетов аналитики
+func (h *AnalyticsHandler) GetRecomputeMetrics(ctx context.Context, _ *pb.GetRecomputeMetricsReq) (*pb.RecomputeMetrics, error) {
+	metrics, err := h.service.GetRecomputeMetrics(ctx)
+	if err != nil {
+		log.FromContext(ctx).Errorf("Failed to get recompute metrics: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get recompute metrics")
+	}
+
+	return &pb.RecomputeMetrics{
+		Events:         metrics.Events,
+		Coalesced:      metrics.Coalesced,
+		Recomputations: metrics.Recomputations,
+		Failures:       metrics.Failures,
+		Pending:        metrics.Pending,
+		Processing:     metrics.Processing,
+		Dropped:        metrics.Dropped,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/repository"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/rules"
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetRecommendationRules возвращает правила рекомендаций указанной версии
+func (h *AnalyticsHandler) GetRecommendationRules(ctx context.Context, req *pb.GetRecommendationRulesReq) (*pb.RecommendationRules, error) {
+	if req.Version < 0 {
+		return nil, status.Error(codes.InvalidArgument, "version must not be negative")
+	}
+
+	ruleSet, err := h.service.GetRules(ctx, req.Version)
+	if err != nil {
+		return nil, rulesError(ctx, err)
+	}
+
+	return ruleSetToProto(ruleSet), nil
+}
+
+// UpdateRecommendationRules сохраняет новую версию правил рекомендаций
+func (h *AnalyticsHandler) UpdateRecommendationRules(ctx context.Context, req *pb.UpdateRecommendationRulesReq) (*pb.RecommendationRules, error) {
+	if req.UpdatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "updated_by is required")
+	}
+	if req.Thresholds == nil {
+		return nil, status.Error(codes.InvalidArgument, "thresholds are required")
+	}
+
+	ruleSet := &models.RuleSet{
+		Thresholds: models.RuleThresholds{
+			CriticalMultiplier:  req.Thresholds.CriticalMultiplier,
+			WarningMultiplier:   req.Thresholds.WarningMultiplier,
+			ExcellentMultiplier: req.Thresholds.ExcellentMultiplier,
+		},
+		Brackets: make([]models.SalaryBracket, 0, len(req.Brackets)),
+	}
+	for _, b := range req.Brackets {
+		bracket := models.SalaryBracket{
+			MinSalary:  b.MinSalary,
+			MaxSalary:  b.MaxSalary,
+			Categories: make([]models.CategoryRange, 0, len(b.Categories)),
+		}
+		for _, c := range b.Categories {
+			bracket.Categories = append(bracket.Categories, models.CategoryRange{
+				Code:    c.CategoryCode,
+				MinPerc: c.MinPercent,
+				MaxPerc: c.MaxPercent,
+			})
+		}
+		ruleSet.Brackets = append(ruleSet.Brackets, bracket)
+	}
+
+	updated, err := h.service.UpdateRules(ctx, ruleSet, req.BaseVersion, req.UpdatedBy)
+	if err != nil {
+		return nil, rulesError(ctx, err)
+	}
+
+	return ruleSetToProto(updated), nil
+}
+
+func ruleSetToProto(ruleSet *models.RuleSet) *pb.RecommendationRules {
+	resp := &pb.RecommendationRules{
+		Version:   ruleSet.Version,
+		UpdatedAt: ruleSet.UpdatedAt,
+		UpdatedBy: ruleSet.UpdatedBy,
+		Thresholds: &pb.RuleThresholds{
+			CriticalMultiplier:  ruleSet.Thresholds.CriticalMultiplier,
+			WarningMultiplier:   ruleSet.Thresholds.WarningMultiplier,
+			ExcellentMultiplier: ruleSet.Thresholds.ExcellentMultiplier,
+		},
+	}
+
+	for _, b := range ruleSet.Brackets {
+		bracket := &pb.SalaryBracket{
+			MinSalary: b.MinSalary,
+			MaxSalary: b.MaxSalary,
+		}
+		for _, c := range b.Categories {
+			bracket.Categories = append(bracket.Categories, &pb.RuleCategoryRange{
+				CategoryCode: c.Code,
+				MinPercent:   c.MinPerc,
+				MaxPercent:   c.MaxPerc,
+			})
+		}
+		resp.Brackets = append(resp.Brackets, bracket)
+	}
+
+	return resp
+}
+
+// rulesError сопоставляет ошибки хранилища правил с кодами gRPC
+func rulesError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidRuleSet):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, rules.ErrRuleSetNotFound):
+		return status.Error(codes.NotFound, "rule set version not found")
+	case errors.Is(err, repository.ErrRuleSetVersionConflict):
+		return status.Error(codes.Aborted, "rules were changed by someone else, reload them and retry")
+	default:
+		log.FromContext(ctx).Errorf("Failed to process recommendation rules: %v", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/service"
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateScenario показывает, как изменятся рекомендации, оценка здоровья и прогноз после изменений
+// зарплаты и расходов, ничего не сохраняя
+func (h *AnalyticsHandler) SimulateScenario(ctx context.Context, req *pb.SimulateScenarioReq) (*pb.SimulateScenarioResp, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+	if req.Salary < 0 {
+		return nil, status.Error(codes.InvalidArgument, "salary must not be negative")
+	}
+	if req.Salary == 0 && len(req.Categories) == 0 && len(req.RecurringExpenses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "scenario must change salary, categories or recurring expenses")
+	}
+
+	scenario := models.Scenario{
+		Salary:            req.Salary,
+		Categories:        make([]models.CategoryAdjustment, 0, len(req.Categories)),
+		RecurringExpenses: make([]models.RecurringExpense, 0, len(req.RecurringExpenses)),
+	}
+	for _, c := range req.Categories {
+		if c.CategoryCode == "" {
+			return nil, status.Error(codes.InvalidArgument, "category_code is required")
+		}
+		if c.PercentChange < -100 {
+			return nil, status.Error(codes.InvalidArgument, "percent_change must not be less than -100")
+		}
+		scenario.Categories = append(scenario.Categories, models.CategoryAdjustment{
+			CategoryCode:  c.CategoryCode,
+			PercentChange: c.PercentChange,
+			AmountChange:  c.AmountChange,
+		})
+	}
+	for _, e := range req.RecurringExpenses {
+		if e.CategoryCode == "" {
+			return nil, status.Error(codes.InvalidArgument, "recurring expense category_code is required")
+		}
+		if e.MonthlyAmount <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "recurring expense monthly_amount must be positive")
+		}
+		scenario.RecurringExpenses = append(scenario.RecurringExpenses, models.RecurringExpense{
+			CategoryCode:  e.CategoryCode,
+			Title:         e.Title,
+			MonthlyAmount: e.MonthlyAmount,
+		})
+	}
+
+	result, err := h.service.SimulateScenario(ctx, req.UserUid, scenario)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidScenario) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.FromContext(ctx).Errorf("Failed to simulate scenario: %v", err)
+		return nil, calculationError(err, "failed to simulate scenario")
+	}
+
+	resp := &pb.SimulateScenarioResp{
+		UserUid:                          result.UserUID,
+		CurrentSalary:                    result.CurrentSalary,
+		SimulatedSalary:                  result.SimulatedSalary,
+		CurrentSalaryBracket:             result.CurrentSalaryBracket,
+		SimulatedSalaryBracket:           result.SimulatedSalaryBracket,
+		SalaryBracketChanged:             result.SalaryBracketChanged,
+		CurrentOverallStatus:             result.CurrentOverallStatus,
+		SimulatedOverallStatus:           result.SimulatedOverallStatus,
+		CurrentHealthScore:               result.CurrentHealthScore,
+		SimulatedHealthScore:             result.SimulatedHealthScore,
+		CurrentForecast:                  result.CurrentForecast,
+		SimulatedForecast:                result.SimulatedForecast,
+		CurrentBudgetBreachProbability:   result.CurrentBudgetBreachProbability,
+		SimulatedBudgetBreachProbability: result.SimulatedBudgetBreachProbability,
+		Categories:                       make([]*pb.CategoryScenarioDiff, 0, len(result.Categories)),
+		Simulated:                        resultToProto(result.Simulated),
+		CalculatedAt:                     result.CalculatedAt,
+	}
+	for _, c := range result.Categories {
+		resp.Categories = append(resp.Categories, &pb.CategoryScenarioDiff{
+			CategoryCode:        c.CategoryCode,
+			CategoryName:        c.CategoryName,
+			CurrentAmount:       c.CurrentAmount,
+			SimulatedAmount:     c.SimulatedAmount,
+			CurrentPercentage:   c.CurrentPercentage,
+			SimulatedPercentage: c.SimulatedPercentage,
+			CurrentStatus:       c.CurrentStatus,
+			SimulatedStatus:     c.SimulatedStatus,
+			RecommendedMin:      c.RecommendedMin,
+			RecommendedMax:      c.RecommendedMax,
+			CurrentForecast:     c.CurrentForecast,
+			SimulatedForecast:   c.SimulatedForecast,
+		})
+	}
+
+	return resp, nil
+}
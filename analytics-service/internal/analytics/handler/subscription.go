@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDetectedSubscriptions возвращает найденные в расходах пользователя подписки
+func (h *AnalyticsHandler) GetDetectedSubscriptions(ctx context.Context, req *pb.GetDetectedSubscriptionsReq) (*pb.GetDetectedSubscriptionsResp, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+
+	result, err := h.service.GetDetectedSubscriptions(ctx, req.UserUid)
+	if err != nil {
+		log.FromContext(ctx).Errorf("Failed to detect subscriptions: %v", err)
+		return nil, calculationError(err, "failed to detect subscriptions")
+	}
+
+	resp := &pb.GetDetectedSubscriptionsResp{
+		UserUid:          result.UserUID,
+		Subscriptions:    make([]*pb.Subscription, 0, len(result.Subscriptions)),
+		TotalMonthlyCost: result.TotalMonthlyCost,
+		CalculatedAt:     result.CalculatedAt,
+	}
+	for _, sub := range result.Subscriptions {
+		pbSub := &pb.Subscription{
+			Title:        sub.Title,
+			CategoryId:   sub.CategoryID,
+			CategoryName: sub.CategoryName,
+			Period:       sub.Period,
+			Amount:       sub.Amount,
+			MonthlyCost:  sub.MonthlyCost,
+			Charges:      int32(sub.Charges),
+			FirstCharge:  sub.FirstCharge,
+			LastCharge:   sub.LastCharge,
+			NextCharge:   sub.NextCharge,
+			Status:       sub.Status,
+		}
+		for _, c := range sub.PriceChanges {
+			pbSub.PriceChanges = append(pbSub.PriceChanges, &pb.PriceChange{
+				Date:      c.Date,
+				OldAmount: c.OldAmount,
+				NewAmount: c.NewAmount,
+			})
+		}
+		resp.Subscriptions = append(resp.Subscriptions, pbSub)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,207 @@
+// Package health сводит показатели финансов пользователя в одну оценку 0..100
+package health
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// Границы показателей: на лучшей оценка компонента 100, на худшей - 0, между ними линейно
+const (
+	savingsRateBest     = 20.0 // Откладывать 20% дохода
+	debtRatioBest       = 15.0 // Платежи по долгам до 15% дохода
+	debtRatioWorst      = 50.0
+	volatilityBest      = 0.1 // Расходы меняются от месяца к месяцу не больше чем на 10%
+	volatilityWorst     = 0.6
+	emergencyMonthsBest = 6.0 // Баланс покрывает полгода расходов
+)
+
+const (
+	maxActions = 3
+	// minVolatilityMonths - по одному месяцу разброс расходов не оценить
+	minVolatilityMonths = 2
+	// warningAdherence - категория с небольшим превышением засчитывается наполовину
+	warningAdherence = 0.5
+)
+
+// Weights - веса компонентов. Компоненты без данных не учитываются, веса остальных нормируются
+type Weights struct {
+	SavingsRate     float64
+	BudgetAdherence float64
+	DebtToIncome    float64
+	Volatility      float64
+	EmergencyFund   float64
+}
+
+// component - компонент оценки и действие, которое его улучшит
+type component struct {
+	models.HealthComponent
+	action string
+}
+
+// Score считает оценку по результату аналитики. Компоненты возвращаются в постоянном порядке,
+// действия - по убыванию прироста оценки, который дало бы улучшение компонента до 100
+func Score(result *models.AnalyticsResult, w Weights) (float64, []models.HealthComponent, []models.HealthAction) {
+	all := []component{
+		savingsRate(result, w.SavingsRate),
+		budgetAdherence(result, w.BudgetAdherence),
+		debtToIncome(result, w.DebtToIncome),
+		volatility(result, w.Volatility),
+		emergencyFund(result, w.EmergencyFund),
+	}
+
+	var totalWeight, weighted float64
+	for _, c := range all {
+		if c.Available {
+			totalWeight += c.Weight
+			weighted += c.Weight * c.Score
+		}
+	}
+
+	components := make([]models.HealthComponent, 0, len(all))
+	var actions []models.HealthAction
+	for _, c := range all {
+		if !c.Available || totalWeight == 0 {
+			c.Weight = 0
+			components = append(components, c.HealthComponent)
+			continue
+		}
+
+		c.Weight = c.Weight / totalWeight
+		if gain := c.Weight * (100 - c.Score); gain > 0 && c.action != "" {
+			actions = append(actions, models.HealthAction{
+				Component: c.Code,
+				Gain:      round2(gain),
+				Message:   c.action,
+			})
+		}
+
+		c.Weight = round2(c.Weight)
+		c.Score = round2(c.Score)
+		c.Value = round2(c.Value)
+		components = append(components, c.HealthComponent)
+	}
+
+	sort.SliceStable(actions, func(i, j int) bool {
+		return actions[i].Gain > actions[j].Gain
+	})
+	if len(actions) > maxActions {
+		actions = actions[:maxActions]
+	}
+
+	if totalWeight == 0 {
+		return 0, components, nil
+	}
+	return round2(weighted / totalWeight), components, actions
+}
+
+func savingsRate(result *models.AnalyticsResult, weight float64) component {
+	c := component{HealthComponent: models.HealthComponent{Code: models.HealthSavingsRate, Weight: weight}}
+	if result.Income == nil || result.Income.EffectiveIncome <= 0 {
+		c.Explanation = "Нет операций дохода за прошлые месяцы."
+		return c
+	}
+
+	c.Available = true
+	c.Value = result.Income.SavingsRate
+	c.Score = linear(c.Value, 0, savingsRateBest)
+	if c.Value < 0 {
+		c.Explanation = fmt.Sprintf("Расходы превышают доход на %.1f%%.", -c.Value)
+	} else {
+		c.Explanation = fmt.Sprintf("Вы откладываете %.1f%% дохода, цель - %.0f%%.", c.Value, savingsRateBest)
+	}
+	c.action = "Откладывайте часть дохода сразу после его получения, цель - 20%."
+	return c
+}
+
+// budgetAdherence - доля категорий в пределах рекомендаций
+func budgetAdherence(result *models.AnalyticsResult, weight float64) component {
+	c := component{HealthComponent: models.HealthComponent{Code: models.HealthBudgetAdherence, Weight: weight}}
+	if len(result.Recommendations) == 0 {
+		c.Explanation = "Нет расходов в категориях с рекомендациями."
+		return c
+	}
+
+	var within float64
+	var worst *models.CategoryRecommendation
+	for i, r := range result.Recommendations {
+		switch r.Status {
+		case models.StatusExcellent, models.StatusNormal:
+			within++
+		case models.StatusWarning:
+			within += warningAdherence
+		}
+		if r.Status != models.StatusWarning && r.Status != models.StatusCritical {
+			continue
+		}
+		if worst == nil || r.Deviation > worst.Deviation {
+			worst = &result.Recommendations[i]
+		}
+	}
+
+	c.Available = true
+	c.Value = within / float64(len(result.Recommendations)) * 100
+	c.Score = c.Value
+	c.Explanation = fmt.Sprintf("Расходы в пределах рекомендаций в %.0f%% категорий.", c.Value)
+	if worst != nil {
+		c.action = fmt.Sprintf("Сократите расходы на «%s»: они превышают рекомендуемую долю на %.1f%%.", worst.CategoryName, worst.Deviation)
+	}
+	return c
+}
+
+func debtToIncome(result *models.AnalyticsResult, weight float64) component {
+	c := component{HealthComponent: models.HealthComponent{Code: models.HealthDebtToIncome, Weight: weight}}
+	if result.Debt == nil || result.Salary <= 0 {
+		c.Explanation = "Не удалось получить долговую нагрузку."
+		return c
+	}
+
+	c.Available = true
+	c.Value = result.Debt.DebtToIncomeRatio
+	c.Score = linear(-c.Value, -debtRatioWorst, -debtRatioBest)
+	c.Explanation = fmt.Sprintf("Платежи по долгам составляют %.1f%% дохода, норма - до %.0f%%.", c.Value, debtRatioBest)
+	c.action = "Не берите новых долгов и направляйте свободные деньги на досрочное погашение."
+	return c
+}
+
+func volatility(result *models.AnalyticsResult, weight float64) component {
+	c := component{HealthComponent: models.HealthComponent{Code: models.HealthSpendingVolatility, Weight: weight}}
+	if result.Income == nil || result.Income.Months < minVolatilityMonths || result.Income.AverageExpenses <= 0 {
+		c.Explanation = "Недостаточно месяцев с расходами, чтобы оценить их разброс."
+		return c
+	}
+
+	c.Available = true
+	c.Value = result.Income.ExpenseVolatility
+	c.Score = linear(-c.Value, -volatilityWorst, -volatilityBest)
+	c.Explanation = fmt.Sprintf("Расходы отклоняются от среднего в среднем на %.0f%% в месяц.", c.Value*100)
+	c.action = "Распределите крупные покупки по месяцам и планируйте их заранее."
+	return c
+}
+
+func emergencyFund(result *models.AnalyticsResult, weight float64) component {
+	c := component{HealthComponent: models.HealthComponent{Code: models.HealthEmergencyFund, Weight: weight}}
+	if result.Income == nil || result.Income.AverageExpenses <= 0 {
+		c.Explanation = "Нет расходов за прошлые месяцы, чтобы оценить финансовую подушку."
+		return c
+	}
+
+	c.Available = true
+	c.Value = result.Income.EmergencyFundMonths
+	c.Score = linear(c.Value, 0, emergencyMonthsBest)
+	c.Explanation = fmt.Sprintf("Баланса хватит на %.1f мес. расходов, цель - %.0f.", c.Value, emergencyMonthsBest)
+	c.action = "Соберите финансовую подушку на 6 месяцев расходов."
+	return c
+}
+
+// linear переводит значение в оценку 0..100: worst и ниже - 0, best и выше - 100
+func linear(value, worst, best float64) float64 {
+	return math.Min(100, math.Max(0, (value-worst)/(best-worst)*100))
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,116 @@
+// Package income оценивает фактический месячный доход по операциям дохода за прошлые месяцы
+package income
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// clipZ - робастная z-оценка, за которой доход месяца считается выбросом, например премией
+	clipZ = 3.5
+	// madScale приводит MAD к стандартному отклонению нормального распределения
+	madScale = 0.6745
+	// minSpread - минимальный разброс относительно медианы. При одинаковой зарплате MAD нулевой,
+	// и без него повышение зарплаты считалось бы выбросом
+	minSpread = 0.1
+)
+
+// Month - доходы и расходы за полный месяц
+type Month struct {
+	Income  float64
+	Expense float64
+}
+
+// Summary - оценка дохода по прошлым месяцам
+type Summary struct {
+	Effective   float64 // Средний месячный доход, выбросы ограничены
+	Months      int     // Число учтенных месяцев
+	Stability   float64 // 0..100, 100 - доход не меняется от месяца к месяцу
+	AvgExpenses float64 // Средние расходы за те же месяцы
+	// ExpenseVolatility - коэффициент вариации расходов по месяцам, 0 - расходы не меняются
+	ExpenseVolatility float64
+}
+
+// Analyze оценивает доход по полным месяцам от старых к новым. Месяцы до первого дохода не учитываются:
+// пользователь мог еще не вести учет. Доход месяца, сильно отличающийся от медианы, ограничивается,
+// чтобы разовые премии и пропуски не сдвигали среднее
+func Analyze(months []Month) Summary {
+	start := len(months)
+	for i, m := range months {
+		if m.Income > 0 {
+			start = i
+			break
+		}
+	}
+	months = months[start:]
+	if len(months) == 0 {
+		return Summary{}
+	}
+
+	incomes := make([]float64, len(months))
+	expenses := make([]float64, len(months))
+	for i, m := range months {
+		incomes[i] = m.Income
+		expenses[i] = m.Expense
+	}
+
+	med := median(incomes)
+	deviations := make([]float64, len(incomes))
+	for i, v := range incomes {
+		deviations[i] = math.Abs(v - med)
+	}
+	spread := math.Max(median(deviations), minSpread*med) / madScale
+	low, high := med-clipZ*spread, med+clipZ*spread
+
+	var clipped float64
+	for _, v := range incomes {
+		clipped += math.Min(math.Max(v, low), high)
+	}
+
+	avgExpenses, expenseCV := meanCV(expenses)
+	summary := Summary{
+		Effective:         clipped / float64(len(incomes)),
+		Months:            len(incomes),
+		AvgExpenses:       avgExpenses,
+		ExpenseVolatility: expenseCV,
+	}
+
+	// По одному месяцу о стабильности судить нельзя
+	if len(incomes) >= 2 {
+		if mean, cv := meanCV(incomes); mean > 0 {
+			summary.Stability = math.Max(0, 1-cv) * 100
+		}
+	}
+
+	return summary
+}
+
+// meanCV возвращает среднее и коэффициент вариации, 0 - если среднее не положительное
+func meanCV(values []float64) (float64, float64) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	if mean <= 0 {
+		return mean, 0
+	}
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(variance/float64(len(values))) / mean
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
@@ -0,0 +1,197 @@
+// Package peers собирает обезличенные распределения расходов групп похожих пользователей:
+// с тем же диапазоном зарплаты, возрастом и сферой работы
+package peers
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// quantileStep - шаг хранимых процентилей. Минимум и максимум не хранятся, чтобы по ним
+// нельзя было узнать расходы отдельного пользователя
+const quantileStep = 5
+
+// medianIndex - индекс 50-го процентиля среди хранимых
+const medianIndex = 50/quantileStep - 1
+
+// Config - ограничения, защищающие данные отдельных пользователей
+type Config struct {
+	MinCohortSize int // Группы меньше не сохраняются
+	MinSpenders   int // Категории, в которых тратило меньше пользователей, не публикуются
+}
+
+// Member - пользователь с расходами по категориям
+type Member struct {
+	SalaryBracket string
+	AgeBand       string
+	WorkSphereID  int64
+	Spending      []models.PeerCategorySpending
+}
+
+// Cohorts возвращает группы пользователя от самой узкой к самой широкой. Без возраста или сферы
+// работы группы, которые их учитывают, пропускаются
+func Cohorts(salaryBracket, ageBand string, workSphereID int64) []models.PeerCohort {
+	var result []models.PeerCohort
+	if ageBand != "" && workSphereID != 0 {
+		result = append(result, models.PeerCohort{
+			Level:         models.PeerLevelSalaryAgeSphere,
+			SalaryBracket: salaryBracket,
+			AgeBand:       ageBand,
+			WorkSphereID:  workSphereID,
+		})
+	}
+	if ageBand != "" {
+		result = append(result, models.PeerCohort{
+			Level:         models.PeerLevelSalaryAge,
+			SalaryBracket: salaryBracket,
+			AgeBand:       ageBand,
+		})
+	}
+	return append(result, models.PeerCohort{
+		Level:         models.PeerLevelSalary,
+		SalaryBracket: salaryBracket,
+	})
+}
+
+// Aggregate раскладывает пользователей по группам всех уровней и возвращает группы,
+// в которых не меньше MinCohortSize человек, упорядоченные по ключу
+func Aggregate(members []Member, cfg Config, calculatedAt int64) []models.PeerCohort {
+	cohorts := make(map[string]*models.PeerCohort)
+	groups := make(map[string][]*Member)
+	for i := range members {
+		m := &members[i]
+		for _, c := range Cohorts(m.SalaryBracket, m.AgeBand, m.WorkSphereID) {
+			key := c.Key()
+			if _, ok := cohorts[key]; !ok {
+				cohorts[key] = &c
+			}
+			groups[key] = append(groups[key], m)
+		}
+	}
+
+	result := make([]models.PeerCohort, 0, len(cohorts))
+	for key, c := range cohorts {
+		group := groups[key]
+		if len(group) < cfg.MinCohortSize {
+			continue
+		}
+
+		c.Size = len(group)
+		c.Categories = categoryStats(group, cfg.MinSpenders)
+		c.CalculatedAt = calculatedAt
+		result = append(result, *c)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key() < result[j].Key()
+	})
+	return result
+}
+
+// categoryStats считает процентили расходов группы по категориям. Пользователи без расходов
+// в категории учитываются с нулем, иначе распределение описывало бы только тративших
+func categoryStats(group []*Member, minSpenders int) []models.PeerCategoryStats {
+	amounts := make(map[string][]float64)
+	names := make(map[string]string)
+	for _, m := range group {
+		for _, s := range m.Spending {
+			if s.Amount <= 0 {
+				continue
+			}
+			amounts[s.Code] = append(amounts[s.Code], s.Amount)
+			names[s.Code] = s.Name
+		}
+	}
+
+	result := make([]models.PeerCategoryStats, 0, len(amounts))
+	for code, values := range amounts {
+		spenders := len(values)
+		if spenders < minSpenders {
+			continue
+		}
+
+		values = append(values, make([]float64, len(group)-spenders)...)
+		result = append(result, models.PeerCategoryStats{
+			Code:      code,
+			Name:      names[code],
+			Spenders:  spenders,
+			Quantiles: Quantiles(values),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Code < result[j].Code
+	})
+	return result
+}
+
+// Quantiles возвращает процентили 5, 10, ..., 95 с линейной интерполяцией между значениями
+func Quantiles(values []float64) []float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	result := make([]float64, 0, 100/quantileStep-1)
+	for p := quantileStep; p < 100; p += quantileStep {
+		pos := float64(p) / 100 * float64(len(sorted)-1)
+		lo := int(math.Floor(pos))
+		v := sorted[lo]
+		if lo+1 < len(sorted) {
+			v += (sorted[lo+1] - sorted[lo]) * (pos - float64(lo))
+		}
+		result = append(result, math.Round(v*100)/100)
+	}
+	return result
+}
+
+// Median возвращает медиану по процентилям
+func Median(quantiles []float64) float64 {
+	if len(quantiles) <= medianIndex {
+		return 0
+	}
+	return quantiles[medianIndex]
+}
+
+// Rank оценивает по процентилям, какой процент группы потратил меньше value. Между процентилями
+// значение интерполируется, выше 95-го процентиля точнее 95 оценить нельзя
+func Rank(quantiles []float64, value float64) float64 {
+	if value <= 0 {
+		return 0
+	}
+
+	// Расходы не бывают отрицательными, поэтому нулевой процентиль считается нулем
+	var prevLevel, prevValue float64
+	for i, q := range quantiles {
+		level := float64((i + 1) * quantileStep)
+		if q < value {
+			prevLevel, prevValue = level, q
+			continue
+		}
+		if q > prevValue {
+			return prevLevel + (level-prevLevel)*(value-prevValue)/(q-prevValue)
+		}
+		return prevLevel
+	}
+	return prevLevel
+}
+
+// AgeBand возвращает возрастную группу, пустую строку - если возраст не указан
+func AgeBand(age int32) string {
+	switch {
+	case age <= 0:
+		return ""
+	case age < 25:
+		return "<25"
+	case age < 35:
+		return "25-34"
+	case age < 45:
+		return "35-44"
+	case age < 55:
+		return "45-54"
+	case age < 65:
+		return "55-64"
+	default:
+		return "65+"
+	}
+}
@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"github.com/redis/rueidis"
+)
+
+const (
+	aggregatesKeyPrefix       = "analytics:aggregates:"
+	aggregatesStateKeyPrefix  = "analytics:aggregates:state:"
+	aggregatesEventsKeyPrefix = "analytics:aggregates:events:"
+	categoriesKey             = "analytics:categories"
+
+	aggregatesStateFrom    = "from"
+	aggregatesStateBuiltAt = "built_at"
+	aggregatesStateSeq     = "seq"
+
+	// aggregatesEventsKeep - сколько последних примененных событий помнится для пропуска повторов.
+	// Повтор более старого события, например из DLQ, исправит только пересборка агрегатов
+	aggregatesEventsKeep = 1000
+)
+
+// applySpendingDeltasScript прибавляет изменения расходов к суммам дней и категорий. Дни раньше
+// начала накопления пропускаются, пока агрегаты не собраны, изменения не применяются. Номер
+// изменения растет в любом случае, чтобы идущая параллельно пересборка не затерла событие.
+// Идентификатор события запоминается вместе с изменениями, повторно доставленное событие
+// пропускается целиком. Возвращает 0, если событие уже применено
+var applySpendingDeltasScript = rueidis.NewLuaScript(`
+local event = ARGV[2]
+if event ~= '' and redis.call('ZSCORE', KEYS[3], event) then
+	return 0
+end
+
+local from = redis.call('HGET', KEYS[2], 'from')
+if from then
+	for i = 4, #ARGV, 3 do
+		if ARGV[i] >= from then
+			local field = ARGV[i] .. ':' .. ARGV[i + 1]
+			local total = tonumber(redis.call('HINCRBYFLOAT', KEYS[1], field, ARGV[i + 2]))
+			if math.abs(total) < 0.005 then
+				redis.call('HDEL', KEYS[1], field)
+			end
+		end
+	end
+end
+
+local seq = redis.call('HINCRBY', KEYS[2], 'seq', 1)
+if event ~= '' then
+	redis.call('ZADD', KEYS[3], seq, event)
+	redis.call('ZREMRANGEBYRANK', KEYS[3], 0, -tonumber(ARGV[3]) - 1)
+	redis.call('PEXPIRE', KEYS[3], ARGV[1])
+end
+redis.call('PEXPIRE', KEYS[1], ARGV[1])
+redis.call('PEXPIRE', KEYS[2], ARGV[1])
+return 1
+`)
+
+// replaceSpendingAggregatesScript заменяет агрегаты пересобранными, если с начала пересборки
+// не пришло ни одного изменения. Возвращает 1, если агрегаты заменены
+var replaceSpendingAggregatesScript = rueidis.NewLuaScript(`
+local seq = tonumber(redis.call('HGET', KEYS[2], 'seq')) or 0
+if seq ~= tonumber(ARGV[1]) then
+	return 0
+end
+
+redis.call('DEL', KEYS[1])
+for i = 5, #ARGV, 2 do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+end
+redis.call('HSET', KEYS[2], 'from', ARGV[2], 'built_at', ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+redis.call('PEXPIRE', KEYS[2], ARGV[4])
+return 1
+`)
+
+// ApplySpendingDeltas применяет изменения расходов пользователя из события к накопленным суммам. ttl продлевает
+// хранение агрегатов: у неактивного пользователя они соберутся заново при следующем расчете. Событие
+// с уже примененным eventID пропускается и возвращается false, пустой eventID не проверяется
+func (r *RedisRepository) ApplySpendingDeltas(ctx context.Context, userUID, eventID string, deltas []models.SpendingDelta, ttl time.Duration) (bool, error) {
+	args := make([]string, 0, 3+3*len(deltas))
+	args = append(args, strconv.FormatInt(ttl.Milliseconds(), 10), eventID, strconv.Itoa(aggregatesEventsKeep))
+	for _, d := range deltas {
+		args = append(args, d.Date, strconv.Itoa(int(d.CategoryID)), strconv.FormatFloat(d.Amount, 'f', -1, 64))
+	}
+
+	applied, err := applySpendingDeltasScript.Exec(ctx, r.client,
+		[]string{aggregatesKeyPrefix + userUID, aggregatesStateKeyPrefix + userUID, aggregatesEventsKeyPrefix + userUID},
+		args,
+	).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("failed to apply spending deltas: %w", err)
+	}
+
+	return applied == 1, nil
+}
+
+// ResetSpendingAggregates помечает агрегаты пользователя устаревшими, они пересоберутся при следующем расчете
+func (r *RedisRepository) ResetSpendingAggregates(ctx context.Context, userUID string) error {
+	key := aggregatesStateKeyPrefix + userUID
+	for _, resp := range r.client.DoMulti(ctx,
+		r.client.B().Hdel().Key(key).Field(aggregatesStateBuiltAt).Build(),
+		r.client.B().Hincrby().Key(key).Field(aggregatesStateSeq).Increment(1).Build(),
+	) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("failed to reset spending aggregates: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSpendingAggregates возвращает накопленные расходы пользователя. Если агрегаты не собраны, BuiltAt равен 0
+func (r *RedisRepository) GetSpendingAggregates(ctx context.Context, userUID string) (*models.SpendingAggregates, error) {
+	resps := r.client.DoMulti(ctx,
+		r.client.B().Hgetall().Key(aggregatesStateKeyPrefix+userUID).Build(),
+		r.client.B().Hgetall().Key(aggregatesKeyPrefix+userUID).Build(),
+	)
+
+	state, err := resps[0].AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spending aggregates state: %w", err)
+	}
+	totals, err := resps[1].AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spending aggregates: %w", err)
+	}
+
+	aggregates := &models.SpendingAggregates{
+		From:   state[aggregatesStateFrom],
+		Totals: make(map[string]map[int32]float64),
+	}
+	if v, ok := state[aggregatesStateBuiltAt]; ok {
+		if aggregates.BuiltAt, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid spending aggregates build time %q: %w", v, err)
+		}
+	}
+	if v, ok := state[aggregatesStateSeq]; ok {
+		if aggregates.Seq, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid spending aggregates sequence %q: %w", v, err)
+		}
+	}
+
+	for field, value := range totals {
+		date, category, ok := strings.Cut(field, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid spending aggregate field %q", field)
+		}
+		categoryID, err := strconv.ParseInt(category, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spending aggregate field %q: %w", field, err)
+		}
+		total, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spending aggregate %q: %w", value, err)
+		}
+
+		if aggregates.Totals[date] == nil {
+			aggregates.Totals[date] = make(map[int32]float64)
+		}
+		aggregates.Totals[date][int32(categoryID)] = total
+	}
+
+	return aggregates, nil
+}
+
+// ReplaceSpendingAggregates сохраняет пересобранные агрегаты, если после чтения номера изменения Seq
+// не было новых событий. Возвращает false, если агрегаты не заменены
+func (r *RedisRepository) ReplaceSpendingAggregates(ctx context.Context, userUID string, aggregates *models.SpendingAggregates, ttl time.Duration) (bool, error) {
+	args := []string{
+		strconv.FormatInt(aggregates.Seq, 10),
+		aggregates.From,
+		strconv.FormatInt(aggregates.BuiltAt, 10),
+		strconv.FormatInt(ttl.Milliseconds(), 10),
+	}
+	for date, categories := range aggregates.Totals {
+		for categoryID, total := range categories {
+			args = append(args, date+":"+strconv.Itoa(int(categoryID)), strconv.FormatFloat(total, 'f', -1, 64))
+		}
+	}
+
+	replaced, err := replaceSpendingAggregatesScript.Exec(ctx, r.client,
+		[]string{aggregatesKeyPrefix + userUID, aggregatesStateKeyPrefix + userUID},
+		args,
+	).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("failed to replace spending aggregates: %w", err)
+	}
+
+	return replaced == 1, nil
+}
+
+// SaveCategories сохраняет коды и названия категорий
+func (r *RedisRepository) SaveCategories(ctx context.Context, categories []models.CategoryInfo) error {
+	if len(categories) == 0 {
+		return nil
+	}
+
+	cmd := r.client.B().Hset().Key(categoriesKey).FieldValue()
+	for _, c := range categories {
+		data, err := json.Marshal(c)
+		if err != nil {
+			return fmt.Errorf("failed to marshal category: %w", err)
+		}
+		cmd = cmd.FieldValue(strconv.Itoa(int(c.ID)), rueidis.BinaryString(data))
+	}
+
+	if err := r.client.Do(ctx, cmd.Build()).Error(); err != nil {
+		return fmt.Errorf("failed to save categories: %w", err)
+	}
+
+	return nil
+}
+
+// GetCategories возвращает сохраненные категории, неизвестных категорий в результате нет
+func (r *RedisRepository) GetCategories(ctx context.Context, ids []int32) (map[int32]models.CategoryInfo, error) {
+	result := make(map[int32]models.CategoryInfo, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	fields := make([]string, 0, len(ids))
+	for _, id := range ids {
+		fields = append(fields, strconv.Itoa(int(id)))
+	}
+
+	values, err := r.client.Do(ctx, r.client.B().Hmget().Key(categoriesKey).Field(fields...).Build()).ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	for _, value := range values {
+		data, err := value.AsBytes()
+		if err != nil {
+			if rueidis.IsRedisNil(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read category: %w", err)
+		}
+
+		var category models.CategoryInfo
+		if err := json.Unmarshal(data, &category); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal category: %w", err)
+		}
+		result[category.ID] = category
+	}
+
+	return result, nil
+}
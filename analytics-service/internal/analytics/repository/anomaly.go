@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"github.com/redis/rueidis"
+)
+
+const (
+	anomaliesKeyPrefix      = "analytics:anomalies:"
+	anomaliesIndexKeyPrefix = "analytics:anomalies:index:"
+)
+
+// SaveAnomalies заменяет аномалии операции: повторная проверка измененной операции перезаписывает прежние
+// отметки, а пустой список их удаляет. У пользователя хранятся отметки не более чем keep последних операций
+func (r *RedisRepository) SaveAnomalies(ctx context.Context, userUID string, transactionID int64, anomalies []models.Anomaly, keep int) error {
+	key := anomaliesKeyPrefix + userUID
+	indexKey := anomaliesIndexKeyPrefix + userUID
+	field := strconv.FormatInt(transactionID, 10)
+
+	if len(anomalies) == 0 {
+		for _, resp := range r.client.DoMulti(ctx,
+			r.client.B().Hdel().Key(key).Field(field).Build(),
+			r.client.B().Zrem().Key(indexKey).Member(field).Build(),
+		) {
+			if err := resp.Error(); err != nil {
+				return fmt.Errorf("failed to delete anomalies from Redis: %w", err)
+			}
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(anomalies)
+	if err != nil {
+		return fmt.Errorf("failed to marshal anomalies: %w", err)
+	}
+
+	for _, resp := range r.client.DoMulti(ctx,
+		r.client.B().Hset().Key(key).FieldValue().FieldValue(field, rueidis.BinaryString(data)).Build(),
+		r.client.B().Zadd().Key(indexKey).ScoreMember().ScoreMember(float64(anomalies[0].DetectedAt), field).Build(),
+	) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("failed to save anomalies to Redis: %w", err)
+		}
+	}
+
+	// Самые старые отметки сверх лимита удаляются из хэша до того, как их уберут из индекса
+	stop := int64(-(keep + 1))
+	expired, err := r.client.Do(ctx, r.client.B().Zrange().Key(indexKey).Min("0").Max(strconv.FormatInt(stop, 10)).Build()).AsStrSlice()
+	if err != nil {
+		return fmt.Errorf("failed to get expired anomalies: %w", err)
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	for _, resp := range r.client.DoMulti(ctx,
+		r.client.B().Hdel().Key(key).Field(expired...).Build(),
+		r.client.B().Zremrangebyrank().Key(indexKey).Start(0).Stop(stop).Build(),
+	) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("failed to trim anomalies in Redis: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetAnomalies возвращает аномалии не более чем limit последних отмеченных операций, начиная с новых
+func (r *RedisRepository) GetAnomalies(ctx context.Context, userUID string, limit int) ([]models.Anomaly, error) {
+	key := anomaliesKeyPrefix + userUID
+	indexKey := anomaliesIndexKeyPrefix + userUID
+
+	fields, err := r.client.Do(ctx, r.client.B().Zrange().Key(indexKey).Min("0").Max(strconv.Itoa(limit-1)).Rev().Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anomalies index: %w", err)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	values, err := r.client.Do(ctx, r.client.B().Hmget().Key(key).Field(fields...).Build()).ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anomalies: %w", err)
+	}
+
+	var result []models.Anomaly
+	for _, value := range values {
+		data, err := value.AsBytes()
+		if err != nil {
+			if rueidis.IsRedisNil(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read anomalies: %w", err)
+		}
+
+		var anomalies []models.Anomaly
+		if err := json.Unmarshal(data, &anomalies); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal anomalies: %w", err)
+		}
+		result = append(result, anomalies...)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"github.com/redis/rueidis"
+)
+
+const budgetPlanKeyPrefix = "analytics:budget_plan:"
+
+// SaveBudgetPlan сохраняет предложенный план бюджета до принятия. Новый план заменяет предыдущий
+func (r *RedisRepository) SaveBudgetPlan(ctx context.Context, userUID string, plan *models.BudgetPlan, ttl time.Duration) error {
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return fmt.Errorf("failed to marshal budget plan: %w", err)
+	}
+
+	cmd := r.client.B().Set().Key(budgetPlanKeyPrefix + userUID).Value(rueidis.BinaryString(data)).ExSeconds(int64(ttl.Seconds())).Build()
+	if err := r.client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("failed to save budget plan to Redis: %w", err)
+	}
+
+	return nil
+}
+
+// GetBudgetPlan возвращает последний предложенный план бюджета или nil, если его нет или срок истек
+func (r *RedisRepository) GetBudgetPlan(ctx context.Context, userUID string) (*models.BudgetPlan, error) {
+	data, err := r.client.Do(ctx, r.client.B().Get().Key(budgetPlanKeyPrefix+userUID).Build()).AsBytes()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get budget plan from Redis: %w", err)
+	}
+
+	var plan models.BudgetPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal budget plan: %w", err)
+	}
+
+	return &plan, nil
+}
+
+// DeleteBudgetPlan удаляет план бюджета пользователя
+func (r *RedisRepository) DeleteBudgetPlan(ctx context.Context, userUID string) error {
+	if err := r.client.Do(ctx, r.client.B().Del().Key(budgetPlanKeyPrefix+userUID).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to delete budget plan from Redis: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/rueidis"
+)
+
+const (
+	forecastNotifiedKeyPrefix = "analytics:forecast:notified:"
+	// forecastNotifiedTTL переживает месяц, к которому относится отметка
+	forecastNotifiedTTL = 40 * 24 * time.Hour
+)
+
+// MarkForecastNotified отмечает, что об ожидаемом превышении key в месяце month уже уведомили.
+// Возвращает false, если отметка уже была
+func (r *RedisRepository) MarkForecastNotified(ctx context.Context, userUID, month, key string) (bool, error) {
+	redisKey := forecastNotifiedKeyPrefix + userUID + ":" + month + ":" + key
+
+	cmd := r.client.B().Set().Key(redisKey).Value("1").Nx().ExSeconds(int64(forecastNotifiedTTL.Seconds())).Build()
+	err := r.client.Do(ctx, cmd).Error()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to mark forecast notification: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"github.com/redis/rueidis"
+)
+
+const healthKeyPrefix = "analytics:health:"
+
+// SaveHealthScore сохраняет оценку за месяц без TTL, повторный расчет в том же месяце заменяет прежний.
+// Месяцы раньше keepFrom (YYYY-MM) удаляются
+func (r *RedisRepository) SaveHealthScore(ctx context.Context, userUID string, point models.HealthScorePoint, keepFrom string) error {
+	key := healthKeyPrefix + userUID
+
+	data, err := json.Marshal(point)
+	if err != nil {
+		return fmt.Errorf("failed to marshal health score: %w", err)
+	}
+
+	months, err := r.client.Do(ctx, r.client.B().Hkeys().Key(key).Build()).AsStrSlice()
+	if err != nil {
+		return fmt.Errorf("failed to get health score months: %w", err)
+	}
+
+	var expired []string
+	for _, month := range months {
+		if month < keepFrom {
+			expired = append(expired, month)
+		}
+	}
+
+	cmds := rueidis.Commands{
+		r.client.B().Hset().Key(key).FieldValue().FieldValue(point.Month, rueidis.BinaryString(data)).Build(),
+	}
+	if len(expired) > 0 {
+		cmds = append(cmds, r.client.B().Hdel().Key(key).Field(expired...).Build())
+	}
+
+	for _, resp := range r.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("failed to save health score to Redis: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetHealthScores возвращает оценки за месяцы не раньше from (YYYY-MM) от старых к новым
+func (r *RedisRepository) GetHealthScores(ctx context.Context, userUID, from string) ([]models.HealthScorePoint, error) {
+	values, err := r.client.Do(ctx, r.client.B().Hgetall().Key(healthKeyPrefix+userUID).Build()).AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get health scores: %w", err)
+	}
+
+	points := make([]models.HealthScorePoint, 0, len(values))
+	for month, value := range values {
+		if month < from {
+			continue
+		}
+
+		var point models.HealthScorePoint
+		if err := json.Unmarshal([]byte(value), &point); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal health score: %w", err)
+		}
+		points = append(points, point)
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Month < points[j].Month
+	})
+
+	return points, nil
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"github.com/redis/rueidis"
+)
+
+const (
+	historyKeyPrefix      = "analytics:history:"
+	historyIndexKeyPrefix = "analytics:history:index:"
+)
+
+// SaveHistorySnapshot сохраняет результат аналитики в историю пользователя без TTL. Результаты хранятся
+// по периоду: повторный расчет того же периода заменяет прежний. Записи с концом периода раньше
+// keepFrom удаляются
+func (r *RedisRepository) SaveHistorySnapshot(ctx context.Context, userUID string, result *models.AnalyticsResult, keepFrom time.Time) error {
+	periodTo, err := time.Parse(time.DateOnly, result.PeriodTo)
+	if err != nil {
+		return fmt.Errorf("invalid period of recommendations: %w", err)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal recommendations: %w", err)
+	}
+
+	key := historyKeyPrefix + userUID
+	indexKey := historyIndexKeyPrefix + userUID
+	field := result.PeriodFrom + ":" + result.PeriodTo
+	cutoff := "(" + strconv.FormatInt(keepFrom.Unix(), 10)
+
+	// Устаревшие поля удаляются из хэша до того, как их уберут из индекса
+	expired, err := r.client.Do(ctx, r.client.B().Zrangebyscore().Key(indexKey).Min("-inf").Max(cutoff).Build()).AsStrSlice()
+	if err != nil {
+		return fmt.Errorf("failed to get expired history: %w", err)
+	}
+
+	cmds := rueidis.Commands{
+		r.client.B().Hset().Key(key).FieldValue().FieldValue(field, rueidis.BinaryString(data)).Build(),
+		r.client.B().Zadd().Key(indexKey).ScoreMember().ScoreMember(float64(periodTo.Unix()), field).Build(),
+	}
+	if len(expired) > 0 {
+		cmds = append(cmds,
+			r.client.B().Hdel().Key(key).Field(expired...).Build(),
+			r.client.B().Zremrangebyscore().Key(indexKey).Min("-inf").Max(cutoff).Build(),
+		)
+	}
+
+	for _, resp := range r.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("failed to save recommendations history to Redis: %w", err)
+		}
+	}
+
+	log.FromContext(ctx).Infof("Saved recommendations of user %s for period %s to history", userUID, field)
+	return nil
+}
+
+// GetHistorySnapshots возвращает результаты из истории пользователя с концом периода не раньше from,
+// упорядоченные по концу периода
+func (r *RedisRepository) GetHistorySnapshots(ctx context.Context, userUID string, from time.Time) ([]*models.AnalyticsResult, error) {
+	key := historyKeyPrefix + userUID
+	indexKey := historyIndexKeyPrefix + userUID
+
+	fields, err := r.client.Do(ctx, r.client.B().Zrangebyscore().Key(indexKey).Min(strconv.FormatInt(from.Unix(), 10)).Max("+inf").Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recommendations history index: %w", err)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	values, err := r.client.Do(ctx, r.client.B().Hmget().Key(key).Field(fields...).Build()).ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recommendations history: %w", err)
+	}
+
+	results := make([]*models.AnalyticsResult, 0, len(values))
+	for _, value := range values {
+		data, err := value.AsBytes()
+		if err != nil {
+			if rueidis.IsRedisNil(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read recommendations history: %w", err)
+		}
+
+		var result models.AnalyticsResult
+		if err := json.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal recommendations history: %w", err)
+		}
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"github.com/redis/rueidis"
+)
+
+const (
+	peerSpendingKey   = "analytics:peers:spending"
+	peerCohortsKey    = "analytics:peers:cohorts"
+	peerCohortsTmpKey = "analytics:peers:cohorts:tmp"
+	peerLockKey       = "analytics:peers:lock"
+	// peerScanCount - сколько полей читается за один HSCAN, чтобы не блокировать Redis на больших хэшах
+	peerScanCount = 500
+)
+
+// SavePeerSpending сохраняет расходы пользователя для расчета групп похожих пользователей
+func (r *RedisRepository) SavePeerSpending(ctx context.Context, userUID string, spending *models.PeerSpending) error {
+	data, err := json.Marshal(spending)
+	if err != nil {
+		return fmt.Errorf("failed to marshal peer spending: %w", err)
+	}
+
+	cmd := r.client.B().Hset().Key(peerSpendingKey).FieldValue().FieldValue(userUID, rueidis.BinaryString(data)).Build()
+	if err := r.client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("failed to save peer spending to Redis: %w", err)
+	}
+
+	return nil
+}
+
+// GetPeerSpending возвращает расходы пользователя или nil, если их еще не сохраняли
+func (r *RedisRepository) GetPeerSpending(ctx context.Context, userUID string) (*models.PeerSpending, error) {
+	data, err := r.client.Do(ctx, r.client.B().Hget().Key(peerSpendingKey).Field(userUID).Build()).AsBytes()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get peer spending from Redis: %w", err)
+	}
+
+	var spending models.PeerSpending
+	if err := json.Unmarshal(data, &spending); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal peer spending: %w", err)
+	}
+
+	return &spending, nil
+}
+
+// ScanPeerSpending обходит сохраненные расходы всех пользователей
+func (r *RedisRepository) ScanPeerSpending(ctx context.Context, fn func(userUID string, spending *models.PeerSpending) error) error {
+	var cursor uint64
+	for {
+		entry, err := r.client.Do(ctx, r.client.B().Hscan().Key(peerSpendingKey).Cursor(cursor).Count(peerScanCount).Build()).AsScanEntry()
+		if err != nil {
+			return fmt.Errorf("failed to scan peer spending: %w", err)
+		}
+
+		for i := 0; i+1 < len(entry.Elements); i += 2 {
+			var spending models.PeerSpending
+			if err := json.Unmarshal([]byte(entry.Elements[i+1]), &spending); err != nil {
+				return fmt.Errorf("failed to unmarshal peer spending of user %s: %w", entry.Elements[i], err)
+			}
+			if err := fn(entry.Elements[i], &spending); err != nil {
+				return err
+			}
+		}
+
+		cursor = entry.Cursor
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
+
+// DeletePeerSpending удаляет расходы пользователей из расчета групп
+func (r *RedisRepository) DeletePeerSpending(ctx context.Context, userUIDs []string) error {
+	if len(userUIDs) == 0 {
+		return nil
+	}
+
+	if err := r.client.Do(ctx, r.client.B().Hdel().Key(peerSpendingKey).Field(userUIDs...).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to delete peer spending from Redis: %w", err)
+	}
+
+	return nil
+}
+
+// ReplacePeerCohorts заменяет все группы новым расчетом. Группы записываются во временный ключ
+// и переименовываются, чтобы читатели не видели частично записанный расчет
+func (r *RedisRepository) ReplacePeerCohorts(ctx context.Context, cohorts []models.PeerCohort) error {
+	if len(cohorts) == 0 {
+		if err := r.client.Do(ctx, r.client.B().Del().Key(peerCohortsKey).Build()).Error(); err != nil {
+			return fmt.Errorf("failed to delete peer cohorts: %w", err)
+		}
+		return nil
+	}
+
+	cmds := make(rueidis.Commands, 0, len(cohorts)+2)
+	cmds = append(cmds, r.client.B().Del().Key(peerCohortsTmpKey).Build())
+	for i := range cohorts {
+		data, err := json.Marshal(&cohorts[i])
+		if err != nil {
+			return fmt.Errorf("failed to marshal peer cohort: %w", err)
+		}
+		cmds = append(cmds, r.client.B().Hset().Key(peerCohortsTmpKey).FieldValue().FieldValue(cohorts[i].Key(), rueidis.BinaryString(data)).Build())
+	}
+	cmds = append(cmds, r.client.B().Rename().Key(peerCohortsTmpKey).Newkey(peerCohortsKey).Build())
+
+	for _, resp := range r.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("failed to save peer cohorts to Redis: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetPeerCohort возвращает группу по ключу или nil, если группы нет или в ней мало пользователей
+func (r *RedisRepository) GetPeerCohort(ctx context.Context, key string) (*models.PeerCohort, error) {
+	data, err := r.client.Do(ctx, r.client.B().Hget().Key(peerCohortsKey).Field(key).Build()).AsBytes()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get peer cohort from Redis: %w", err)
+	}
+
+	var cohort models.PeerCohort
+	if err := json.Unmarshal(data, &cohort); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal peer cohort: %w", err)
+	}
+
+	return &cohort, nil
+}
+
+// LockPeerAggregation занимает расчет групп на ttl, чтобы его не повторяли все реплики сервиса.
+// Возвращает false, если расчет уже занят
+func (r *RedisRepository) LockPeerAggregation(ctx context.Context, ttl time.Duration) (bool, error) {
+	cmd := r.client.B().Set().Key(peerLockKey).Value("1").Nx().PxMilliseconds(ttl.Milliseconds()).Build()
+	err := r.client.Do(ctx, cmd).Error()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to lock peer aggregation: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"github.com/redis/rueidis"
+)
+
+const (
+	recomputePendingKey    = "analytics:recompute:pending"
+	recomputeFirstKey      = "analytics:recompute:first"
+	recomputeProcessingKey = "analytics:recompute:processing"
+	recomputeMetricsKey    = "analytics:recompute:metrics"
+	recomputeAttemptsKey   = "analytics:recompute:attempts"
+)
+
+// Счетчики пересчетов в хэше метрик
+const (
+	recomputeMetricEvents         = "events"
+	recomputeMetricCoalesced      = "coalesced"
+	recomputeMetricRecomputations = "recomputations"
+	recomputeMetricFailures       = "failures"
+	recomputeMetricDropped        = "dropped"
+)
+
+// scheduleRecomputeScript откладывает пересчет пользователя до тишины длиной quiet, но не дальше чем
+// на maxDelay от первого события, которое еще ждет пересчета. Возвращает 1, если событие присоединилось
+// к уже запланированному пересчету
+var scheduleRecomputeScript = rueidis.NewLuaScript(`
+local now = tonumber(ARGV[1])
+local first = tonumber(redis.call('HGET', KEYS[2], ARGV[4]))
+local coalesced = 1
+if not first then
+	first = now
+	coalesced = 0
+	redis.call('HSET', KEYS[2], ARGV[4], now)
+end
+redis.call('ZADD', KEYS[1], math.min(now + tonumber(ARGV[2]), first + tonumber(ARGV[3])), ARGV[4])
+redis.call('HINCRBY', KEYS[3], 'events', 1)
+redis.call('HINCRBY', KEYS[3], 'coalesced', coalesced)
+return coalesced
+`)
+
+// claimRecomputesScript забирает пользователей, чей пересчет пора выполнить, и отмечает их обрабатываемыми
+// до истечения аренды. Пользователь, которого уже пересчитывает другая реплика, остается в очереди.
+// Пересчеты с истекшей арендой, например после падения реплики, возвращаются в очередь
+var claimRecomputesScript = rueidis.NewLuaScript(`
+local now = tonumber(ARGV[1])
+for _, uid in ipairs(redis.call('ZRANGEBYSCORE', KEYS[3], '-inf', now)) do
+	redis.call('ZREM', KEYS[3], uid)
+	if not redis.call('ZSCORE', KEYS[1], uid) then
+		redis.call('ZADD', KEYS[1], now, uid)
+	end
+end
+
+local claimed = {}
+for _, uid in ipairs(redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', now)) do
+	if #claimed >= tonumber(ARGV[2]) then
+		break
+	end
+	if not redis.call('ZSCORE', KEYS[3], uid) then
+		redis.call('ZREM', KEYS[1], uid)
+		redis.call('HDEL', KEYS[2], uid)
+		redis.call('ZADD', KEYS[3], ARGV[3], uid)
+		table.insert(claimed, uid)
+	end
+end
+return claimed
+`)
+
+// completeRecomputeScript снимает аренду пересчета. Неудачный пересчет, например при недоступном
+// user-service, повторяется с экспоненциальной паузой, пока не наберется maxAttempts попыток.
+// Если новое событие уже запланировало пересчет раньше, повтор его не откладывает. Возвращает 1,
+// если пересчет будет повторен
+var completeRecomputeScript = rueidis.NewLuaScript(`
+redis.call('ZREM', KEYS[1], ARGV[1])
+if ARGV[2] == '0' then
+	redis.call('HDEL', KEYS[3], ARGV[1])
+	redis.call('HINCRBY', KEYS[4], 'recomputations', 1)
+	return 0
+end
+
+redis.call('HINCRBY', KEYS[4], 'failures', 1)
+local attempts = redis.call('HINCRBY', KEYS[3], ARGV[1], 1)
+if attempts >= tonumber(ARGV[3]) then
+	redis.call('HDEL', KEYS[3], ARGV[1])
+	redis.call('HINCRBY', KEYS[4], 'dropped', 1)
+	return 0
+end
+
+local due = tonumber(ARGV[4]) + tonumber(ARGV[5]) * 2 ^ (attempts - 1)
+local pending = tonumber(redis.call('ZSCORE', KEYS[2], ARGV[1]))
+if not pending or due < pending then
+	redis.call('ZADD', KEYS[2], due, ARGV[1])
+end
+return 1
+`)
+
+// ScheduleRecompute планирует пересчет аналитики пользователя. Возвращает true, если событие
+// присоединилось к уже запланированному пересчету
+func (r *RedisRepository) ScheduleRecompute(ctx context.Context, userUID string, now time.Time, quiet, maxDelay time.Duration) (bool, error) {
+	coalesced, err := scheduleRecomputeScript.Exec(ctx, r.client,
+		[]string{recomputePendingKey, recomputeFirstKey, recomputeMetricsKey},
+		[]string{
+			strconv.FormatInt(now.UnixMilli(), 10),
+			strconv.FormatInt(quiet.Milliseconds(), 10),
+			strconv.FormatInt(maxDelay.Milliseconds(), 10),
+			userUID,
+		},
+	).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("failed to schedule recompute: %w", err)
+	}
+
+	return coalesced == 1, nil
+}
+
+// ClaimRecomputes забирает не больше limit пользователей, чей пересчет пора выполнить, и арендует их на lease
+func (r *RedisRepository) ClaimRecomputes(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]string, error) {
+	userUIDs, err := claimRecomputesScript.Exec(ctx, r.client,
+		[]string{recomputePendingKey, recomputeFirstKey, recomputeProcessingKey},
+		[]string{
+			strconv.FormatInt(now.UnixMilli(), 10),
+			strconv.Itoa(limit),
+			strconv.FormatInt(now.Add(lease).UnixMilli(), 10),
+		},
+	).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim recomputes: %w", err)
+	}
+
+	return userUIDs, nil
+}
+
+// CompleteRecompute снимает аренду пересчета и учитывает его результат в метриках. Неудачный пересчет
+// планируется повторно через retryDelay, удваивая паузу с каждой попыткой, до maxAttempts попыток.
+// Возвращает true, если пересчет будет повторен
+func (r *RedisRepository) CompleteRecompute(ctx context.Context, userUID string, failed bool, now time.Time, maxAttempts int, retryDelay time.Duration) (bool, error) {
+	failedArg := "0"
+	if failed {
+		failedArg = "1"
+	}
+
+	retried, err := completeRecomputeScript.Exec(ctx, r.client,
+		[]string{recomputeProcessingKey, recomputePendingKey, recomputeAttemptsKey, recomputeMetricsKey},
+		[]string{
+			userUID,
+			failedArg,
+			strconv.Itoa(maxAttempts),
+			strconv.FormatInt(now.UnixMilli(), 10),
+			strconv.FormatInt(retryDelay.Milliseconds(), 10),
+		},
+	).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("failed to complete recompute: %w", err)
+	}
+
+	return retried == 1, nil
+}
+
+// GetRecomputeMetrics возвращает счетчики пересчетов всех реплик и текущий размер очереди
+func (r *RedisRepository) GetRecomputeMetrics(ctx context.Context) (*models.RecomputeMetrics, error) {
+	resps := r.client.DoMulti(ctx,
+		r.client.B().Hgetall().Key(recomputeMetricsKey).Build(),
+		r.client.B().Zcard().Key(recomputePendingKey).Build(),
+		r.client.B().Zcard().Key(recomputeProcessingKey).Build(),
+	)
+
+	counters, err := resps[0].AsIntMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recompute metrics: %w", err)
+	}
+	pending, err := resps[1].AsInt64()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recompute queue size: %w", err)
+	}
+	processing, err := resps[2].AsInt64()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recompute queue size: %w", err)
+	}
+
+	return &models.RecomputeMetrics{
+		Events:         counters[recomputeMetricEvents],
+		Coalesced:      counters[recomputeMetricCoalesced],
+		Recomputations: counters[recomputeMetricRecomputations],
+		Failures:       counters[recomputeMetricFailures],
+		Dropped:        counters[recomputeMetricDropped],
+		Pending:        pending,
+		Processing:     processing,
+	}, nil
+}
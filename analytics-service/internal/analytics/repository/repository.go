@@ -20,6 +20,45 @@ type Repository interface {
 	SaveRecommendations(ctx context.Context, userUID string, recommendations *models.AnalyticsResult, ttl time.Duration) error
 	GetRecommendations(ctx context.Context, userUID string) (*models.AnalyticsResult, error)
 	DeleteRecommendations(ctx context.Context, userUID string) error
+
+	SaveHistorySnapshot(ctx context.Context, userUID string, result *models.AnalyticsResult, keepFrom time.Time) error
+	GetHistorySnapshots(ctx context.Context, userUID string, from time.Time) ([]*models.AnalyticsResult, error)
+
+	GetRulesVersion(ctx context.Context) (int64, error)
+	GetRuleSet(ctx context.Context, version int64) (*models.RuleSet, error)
+	SaveRuleSet(ctx context.Context, rules *models.RuleSet, baseVersion int64) error
+
+	MarkForecastNotified(ctx context.Context, userUID, month, key string) (bool, error)
+
+	SaveAnomalies(ctx context.Context, userUID string, transactionID int64, anomalies []models.Anomaly, keep int) error
+	GetAnomalies(ctx context.Context, userUID string, limit int) ([]models.Anomaly, error)
+
+	SaveHealthScore(ctx context.Context, userUID string, point models.HealthScorePoint, keepFrom string) error
+	GetHealthScores(ctx context.Context, userUID, from string) ([]models.HealthScorePoint, error)
+
+	SavePeerSpending(ctx context.Context, userUID string, spending *models.PeerSpending) error
+	GetPeerSpending(ctx context.Context, userUID string) (*models.PeerSpending, error)
+	ScanPeerSpending(ctx context.Context, fn func(userUID string, spending *models.PeerSpending) error) error
+	DeletePeerSpending(ctx context.Context, userUIDs []string) error
+	ReplacePeerCohorts(ctx context.Context, cohorts []models.PeerCohort) error
+	GetPeerCohort(ctx context.Context, key string) (*models.PeerCohort, error)
+	LockPeerAggregation(ctx context.Context, ttl time.Duration) (bool, error)
+
+	ScheduleRecompute(ctx context.Context, userUID string, now time.Time, quiet, maxDelay time.Duration) (bool, error)
+	ClaimRecomputes(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]string, error)
+	CompleteRecompute(ctx context.Context, userUID string, failed bool, now time.Time, maxAttempts int, retryDelay time.Duration) (bool, error)
+	GetRecomputeMetrics(ctx context.Context) (*models.RecomputeMetrics, error)
+
+	ApplySpendingDeltas(ctx context.Context, userUID, eventID string, deltas []models.SpendingDelta, ttl time.Duration) (bool, error)
+	ResetSpendingAggregates(ctx context.Context, userUID string) error
+	GetSpendingAggregates(ctx context.Context, userUID string) (*models.SpendingAggregates, error)
+	ReplaceSpendingAggregates(ctx context.Context, userUID string, aggregates *models.SpendingAggregates, ttl time.Duration) (bool, error)
+	SaveCategories(ctx context.Context, categories []models.CategoryInfo) error
+	GetCategories(ctx context.Context, ids []int32) (map[int32]models.CategoryInfo, error)
+
+	SaveBudgetPlan(ctx context.Context, userUID string, plan *models.BudgetPlan, ttl time.Duration) error
+	GetBudgetPlan(ctx context.Context, userUID string) (*models.BudgetPlan, error)
+	DeleteBudgetPlan(ctx context.Context, userUID string) error
 }
 
 // RedisRepository реализация репозитория для Redis
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"github.com/redis/rueidis"
+)
+
+const (
+	rulesVersionKey = "analytics:rules:version"
+	rulesKeyPrefix  = "analytics:rules:v:"
+)
+
+// ErrRuleSetVersionConflict возвращается, если правила изменили после версии, от которой считалось изменение
+var ErrRuleSetVersionConflict = errors.New("rule set version conflict")
+
+// saveRulesScript атомарно проверяет текущую версию правил и сохраняет следующую.
+// Все версии хранятся без TTL, чтобы по версии из результата аналитики можно было найти правила
+var saveRulesScript = rueidis.NewLuaScript(`
+local current = tonumber(redis.call('GET', KEYS[1]) or '0')
+if current ~= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call('SET', KEYS[2], ARGV[2])
+redis.call('SET', KEYS[1], current + 1)
+return 1
+`)
+
+// GetRulesVersion возвращает номер текущей версии правил, 0 если правил еще нет
+func (r *RedisRepository) GetRulesVersion(ctx context.Context) (int64, error) {
+	version, err := r.client.Do(ctx, r.client.B().Get().Key(rulesVersionKey).Build()).AsInt64()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get rules version from Redis: %w", err)
+	}
+	return version, nil
+}
+
+// GetRuleSet возвращает правила указанной версии, nil если такой версии нет
+func (r *RedisRepository) GetRuleSet(ctx context.Context, version int64) (*models.RuleSet, error) {
+	key := rulesKeyPrefix + strconv.FormatInt(version, 10)
+
+	val, err := r.client.Do(ctx, r.client.B().Get().Key(key).Build()).AsBytes()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get rules from Redis: %w", err)
+	}
+
+	var rules models.RuleSet
+	if err := json.Unmarshal(val, &rules); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rules: %w", err)
+	}
+
+	return &rules, nil
+}
+
+// SaveRuleSet сохраняет правила следующей версией после baseVersion. Если текущая версия
+// уже не baseVersion, возвращает ErrRuleSetVersionConflict
+func (r *RedisRepository) SaveRuleSet(ctx context.Context, rules *models.RuleSet, baseVersion int64) error {
+	rules.Version = baseVersion + 1
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal rules: %w", err)
+	}
+
+	key := rulesKeyPrefix + strconv.FormatInt(rules.Version, 10)
+	saved, err := saveRulesScript.Exec(ctx, r.client,
+		[]string{rulesVersionKey, key},
+		[]string{strconv.FormatInt(baseVersion, 10), string(data)},
+	).AsInt64()
+	if err != nil {
+		return fmt.Errorf("failed to save rules to Redis: %w", err)
+	}
+	if saved == 0 {
+		return ErrRuleSetVersionConflict
+	}
+
+	return nil
+}
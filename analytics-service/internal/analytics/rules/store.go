@@ -0,0 +1,215 @@
+package rules
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/repository"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/config"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+const (
+	updatedByDefault = "default"
+	updatedByFile    = "file"
+)
+
+// ErrRuleSetNotFound возвращается, если запрошенной версии правил нет
+var ErrRuleSetNotFound = errors.New("rule set not found")
+
+// Store хранит текущие правила рекомендаций в памяти. Версии правил живут в Redis и общие для всех
+// реплик: изменения через админские методы и файл правил сохраняются новой версией, а реплики
+// подхватывают ее при следующей проверке
+type Store struct {
+	repo    repository.Repository
+	file    string
+	current atomic.Pointer[models.RuleSet]
+}
+
+// NewStore создает хранилище правил, до Init в нем действуют встроенные правила
+func NewStore(repo repository.Repository, cfg config.RulesConfig) *Store {
+	s := &Store{
+		repo: repo,
+		file: cfg.File,
+	}
+	s.current.Store(models.DefaultRuleSet())
+	return s
+}
+
+// Current возвращает действующие правила
+func (s *Store) Current() *models.RuleSet {
+	return s.current.Load()
+}
+
+// Init загружает текущую версию правил из Redis. Пустое хранилище заполняется правилами из файла,
+// а без файла - встроенными правилами
+func (s *Store) Init(ctx context.Context) error {
+	if err := s.reload(ctx); err != nil {
+		return err
+	}
+
+	if s.Current().Version == 0 && s.file == "" {
+		err := s.save(ctx, models.DefaultRuleSet(), 0, updatedByDefault)
+		if err != nil && !errors.Is(err, repository.ErrRuleSetVersionConflict) {
+			return err
+		}
+	}
+
+	if err := s.importFile(ctx); err != nil {
+		return err
+	}
+
+	return s.reload(ctx)
+}
+
+// Watch периодически подхватывает новые версии правил из Redis и изменения файла правил
+func (s *Store) Watch(ctx context.Context, interval time.Duration) {
+	l := log.FromContext(ctx)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.importFile(ctx); err != nil {
+					l.Errorf("Failed to import rules file %s: %v", s.file, err)
+				}
+				if err := s.reload(ctx); err != nil {
+					l.Errorf("Failed to reload recommendation rules: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Get возвращает правила указанной версии, 0 - текущие
+func (s *Store) Get(ctx context.Context, version int64) (*models.RuleSet, error) {
+	current := s.Current()
+	if version == 0 || version == current.Version {
+		return current, nil
+	}
+
+	rules, err := s.repo.GetRuleSet(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		return nil, ErrRuleSetNotFound
+	}
+
+	return rules, nil
+}
+
+// Update проверяет правила и сохраняет их версией после baseVersion. Изменение на основе
+// устаревшей версии отклоняется с repository.ErrRuleSetVersionConflict
+func (s *Store) Update(ctx context.Context, rules *models.RuleSet, baseVersion int64, updatedBy string) (*models.RuleSet, error) {
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Контрольная сумма файла берется из последней версии, а не из устаревшей копии в памяти
+	if err := s.reload(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.save(ctx, rules, baseVersion, updatedBy); err != nil {
+		return nil, err
+	}
+
+	if err := s.reload(ctx); err != nil {
+		return nil, err
+	}
+
+	log.FromContext(ctx).Infof("Recommendation rules updated to version %d by %s", rules.Version, updatedBy)
+	return rules, nil
+}
+
+// save сохраняет правила следующей версией, контрольная сумма файла переносится из текущей версии
+func (s *Store) save(ctx context.Context, rules *models.RuleSet, baseVersion int64, updatedBy string) error {
+	saved := *rules
+	saved.UpdatedAt = time.Now().Unix()
+	saved.UpdatedBy = updatedBy
+	if saved.FileChecksum == "" {
+		saved.FileChecksum = s.Current().FileChecksum
+	}
+
+	if err := s.repo.SaveRuleSet(ctx, &saved, baseVersion); err != nil {
+		return err
+	}
+
+	*rules = saved
+	return nil
+}
+
+// reload подменяет правила в памяти, если в Redis появилась более новая версия
+func (s *Store) reload(ctx context.Context) error {
+	version, err := s.repo.GetRulesVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version <= s.Current().Version {
+		return nil
+	}
+
+	rules, err := s.repo.GetRuleSet(ctx, version)
+	if err != nil {
+		return err
+	}
+	if rules == nil {
+		return fmt.Errorf("rules version %d is missing in Redis", version)
+	}
+
+	s.current.Store(rules)
+	log.FromContext(ctx).Infof("Loaded recommendation rules version %d", rules.Version)
+	return nil
+}
+
+// importFile сохраняет файл правил новой версией, если он изменился с последнего импорта.
+// Контрольная сумма хранится в самих правилах, поэтому файл импортирует только одна реплика
+func (s *Store) importFile(ctx context.Context) error {
+	if s.file == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(s.file)
+	if err != nil {
+		return fmt.Errorf("failed to read rules file: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+
+	current := s.Current()
+	if checksum == current.FileChecksum {
+		return nil
+	}
+
+	rules, err := models.ParseRuleSet(data)
+	if err != nil {
+		return err
+	}
+	rules.FileChecksum = checksum
+
+	err = s.save(ctx, rules, current.Version, updatedByFile)
+	if errors.Is(err, repository.ErrRuleSetVersionConflict) {
+		// Правила изменили одновременно с импортом, файл будет проверен еще раз после перезагрузки
+		return s.reload(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).Infof("Imported recommendation rules from %s as version %d", s.file, rules.Version)
+	return s.reload(ctx)
+}
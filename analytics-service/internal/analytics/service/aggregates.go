@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	fundspb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/funds_service"
+	userpb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/user_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// spendingSummary возвращает расходы пользователя по категориям за период. Последние 30 дней
+// считаются по накопленным агрегатам, остальные периоды запрашиваются в funds-service
+func (s *AnalyticsService) spendingSummary(ctx context.Context, userUID string, user *userpb.User, period *fundspb.Period) (*fundspb.GetSpendingSummaryResponse, error) {
+	if period == nil {
+		summary, err := s.aggregatedSpendingSummary(ctx, userUID, user)
+		if err == nil {
+			return summary, nil
+		}
+		log.FromContext(ctx).Warnf("Failed to use spending aggregates of user %s, falling back to funds-service: %v", userUID, err)
+	}
+
+	return s.clients.FundsClient.GetSpendingSummary(ctx, expensesRequest(userUID, user, 0, period))
+}
+
+// aggregatedSpendingSummary складывает накопленные расходы за последние 30 дней в часовом поясе пользователя.
+// Несобранные, устаревшие или не покрывающие период агрегаты сначала пересобираются
+func (s *AnalyticsService) aggregatedSpendingSummary(ctx context.Context, userUID string, user *userpb.User) (*fundspb.GetSpendingSummaryResponse, error) {
+	now := time.Now()
+	today := userToday(now, user.GetTimezone())
+	from := today.AddDate(0, 0, -defaultPeriodDays).Format(time.DateOnly)
+	to := today.Format(time.DateOnly)
+
+	aggregates, err := s.repo.GetSpendingAggregates(ctx, userUID)
+	if err != nil {
+		return nil, err
+	}
+	if aggregates.BuiltAt == 0 || aggregates.From > from || now.Sub(time.Unix(aggregates.BuiltAt, 0)) > s.aggregates.RebuildInterval {
+		aggregates, err = s.rebuildSpendingAggregates(ctx, userUID, today, aggregates.Seq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	totals := make(map[int32]float64)
+	for date, categories := range aggregates.Totals {
+		if date < from || date > to {
+			continue
+		}
+		for categoryID, total := range categories {
+			totals[categoryID] += total
+		}
+	}
+
+	ids := make([]int32, 0, len(totals))
+	for categoryID := range totals {
+		ids = append(ids, categoryID)
+	}
+	slices.Sort(ids)
+
+	categories, err := s.categories(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &fundspb.GetSpendingSummaryResponse{
+		Buckets:  make([]*fundspb.SpendingSummaryBucket, 0, len(ids)),
+		DateFrom: from,
+		DateTo:   to,
+	}
+	for _, id := range ids {
+		summary.Buckets = append(summary.Buckets, &fundspb.SpendingSummaryBucket{
+			CategoryId:   id,
+			CategoryCode: categories[id].Code,
+			CategoryName: categories[id].Name,
+			Type:         "expense",
+			Total:        round2(totals[id]),
+		})
+		summary.TotalExpense += totals[id]
+	}
+	summary.TotalExpense = round2(summary.TotalExpense)
+
+	return summary, nil
+}
+
+// rebuildSpendingAggregates заново собирает расходы по дням и категориям за AGGREGATES_DAYS дней по funds-service.
+// Операции будущими датами берутся с тем же запасом, чтобы войти в окно до следующей пересборки.
+// seq - номер изменения, прочитанный до запроса: если за время пересборки пришли события, собранные
+// агрегаты используются для текущего расчета, но не сохраняются
+func (s *AnalyticsService) rebuildSpendingAggregates(ctx context.Context, userUID string, today time.Time, seq int64) (*models.SpendingAggregates, error) {
+	l := log.FromContext(ctx)
+
+	days := max(s.aggregates.Days, defaultPeriodDays)
+	resp, err := s.clients.FundsClient.GetSpendingSummary(ctx, &fundspb.GetSpendingSummaryRequest{
+		UserUid:  userUID,
+		DateFrom: today.AddDate(0, 0, -days).Format(time.DateOnly),
+		DateTo:   today.AddDate(0, 0, days).Format(time.DateOnly),
+		GroupBy:  []string{"category", "day"},
+		Type:     "expense",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily spending summary: %w", err)
+	}
+
+	aggregates := &models.SpendingAggregates{
+		From:    resp.DateFrom,
+		BuiltAt: time.Now().Unix(),
+		Seq:     seq,
+		Totals:  make(map[string]map[int32]float64),
+	}
+	categories := make([]models.CategoryInfo, 0)
+	seen := make(map[int32]struct{})
+	for _, b := range resp.Buckets {
+		if b.Total == 0 {
+			continue
+		}
+		if aggregates.Totals[b.Period] == nil {
+			aggregates.Totals[b.Period] = make(map[int32]float64)
+		}
+		aggregates.Totals[b.Period][b.CategoryId] += b.Total
+
+		if _, ok := seen[b.CategoryId]; !ok {
+			seen[b.CategoryId] = struct{}{}
+			categories = append(categories, models.CategoryInfo{ID: b.CategoryId, Code: b.CategoryCode, Name: b.CategoryName})
+		}
+	}
+
+	// Категории обновляются при каждой пересборке, чтобы подхватить переименования
+	if err := s.repo.SaveCategories(ctx, categories); err != nil {
+		l.Warnf("Failed to save categories: %v", err)
+	}
+
+	replaced, err := s.repo.ReplaceSpendingAggregates(ctx, userUID, aggregates, s.aggregatesTTL())
+	if err != nil {
+		return nil, err
+	}
+	if replaced {
+		l.Infof("Rebuilt spending aggregates of user %s from %s", userUID, aggregates.From)
+	} else {
+		l.Infof("Spending aggregates of user %s changed during rebuild, they will be rebuilt on the next calculation", userUID)
+	}
+
+	return aggregates, nil
+}
+
+// categories возвращает коды и названия категорий из кэша, отсутствующие запрашиваются в funds-service
+func (s *AnalyticsService) categories(ctx context.Context, ids []int32) (map[int32]models.CategoryInfo, error) {
+	categories, err := s.repo.GetCategories(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []models.CategoryInfo
+	for _, id := range ids {
+		if _, ok := categories[id]; ok {
+			continue
+		}
+
+		resp, err := s.clients.FundsClient.GetCategoryById(ctx, &fundspb.GetCategoryByIdRequest{Id: id})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category %d: %w", id, err)
+		}
+		category := models.CategoryInfo{ID: id, Code: resp.Category.GetCode(), Name: resp.Category.GetName()}
+		categories[id] = category
+		missing = append(missing, category)
+	}
+
+	if err := s.repo.SaveCategories(ctx, missing); err != nil {
+		log.FromContext(ctx).Warnf("Failed to save categories: %v", err)
+	}
+
+	return categories, nil
+}
+
+// applySpendingEvent переносит изменения операций из события в накопленные расходы. Если изменения
+// посчитать нельзя, агрегаты помечаются устаревшими и пересобираются при следующем расчете.
+// Повторно доставленное событие, например после сбоя фиксации смещения или из DLQ, пропускается по EventID
+func (s *AnalyticsService) applySpendingEvent(ctx context.Context, msg models.KafkaAnalyticsMessage) error {
+	deltas, ok := spendingDeltas(msg.Action, msg.Transactions)
+	if !ok {
+		return s.repo.ResetSpendingAggregates(ctx, msg.UserUID)
+	}
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	applied, err := s.repo.ApplySpendingDeltas(ctx, msg.UserUID, msg.EventID, deltas, s.aggregatesTTL())
+	if err != nil {
+		return err
+	}
+	if !applied {
+		log.FromContext(ctx).Infof("Spending changes of event %s of user %s are already applied, skipping", msg.EventID, msg.UserUID)
+	}
+
+	return nil
+}
+
+// spendingDeltas переводит операции из события в изменения расходов по дням и категориям.
+// false означает, что изменения посчитать нельзя: в событии об изменении нет прежних значений
+func spendingDeltas(action string, events []models.TransactionEvent) ([]models.SpendingDelta, bool) {
+	var deltas []models.SpendingDelta
+	add := func(e models.TransactionEvent, sign float64) {
+		if e.Type == "expense" {
+			deltas = append(deltas, models.SpendingDelta{Date: e.TransactionDate, CategoryID: e.CategoryID, Amount: sign * e.Amount})
+		}
+	}
+
+	for _, e := range events {
+		switch action {
+		case models.ActionTransactionsCreated, models.ActionTransactionsRestored:
+			add(e, 1)
+		case models.ActionTransactionsDeleted:
+			add(e, -1)
+		case models.ActionTransactionsUpdated:
+			if e.Previous == nil {
+				return nil, false
+			}
+			add(*e.Previous, -1)
+			add(e, 1)
+		}
+	}
+
+	return deltas, true
+}
+
+// aggregatesTTL - сколько хранятся агрегаты неактивного пользователя: за это время все накопленные дни
+// выходят из окна рекомендаций
+func (s *AnalyticsService) aggregatesTTL() time.Duration {
+	return time.Duration(max(s.aggregates.Days, defaultPeriodDays)) * 24 * time.Hour
+}
+
+// userToday возвращает текущую дату в часовом поясе пользователя в полночь UTC, как даты операций
+func userToday(now time.Time, timezone string) time.Time {
+	y, m, d := now.In(userLocation(timezone)).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
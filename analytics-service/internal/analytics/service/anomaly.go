@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/anomaly"
+	fundspb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/funds_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// anomalyHistoryLimit ограничивает историю, с которой сравниваются операции
+const anomalyHistoryLimit = 2000
+
+// GetAnomalies возвращает аномалии последних отмеченных операций пользователя, начиная с новых
+func (s *AnalyticsService) GetAnomalies(ctx context.Context, userUID string, limit int) ([]models.Anomaly, error) {
+	return s.repo.GetAnomalies(ctx, userUID, limit)
+}
+
+// DetectAnomalies сравнивает созданные или измененные операции с историей пользователя и сохраняет
+// найденные аномалии. Об аномалиях в новых операциях отправляется push-уведомление, если оно включено
+func (s *AnalyticsService) DetectAnomalies(ctx context.Context, userUID, action string, events []models.TransactionEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	resp, err := s.clients.FundsClient.GetUserTransactionsByPeriod(ctx, &fundspb.GetUserTransactionsByPeriodRequest{
+		UserUid: userUID,
+		Days:    int32(s.anomaly.HistoryDays),
+		Limit:   anomalyHistoryLimit,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get transactions history: %w", err)
+	}
+
+	categoryNames := make(map[int32]string)
+	history := make([]anomaly.Transaction, 0, len(resp.Transactions))
+	for _, t := range resp.Transactions {
+		date, err := time.Parse(time.DateOnly, t.TransactionDate)
+		if err != nil {
+			return fmt.Errorf("invalid transaction date %q: %w", t.TransactionDate, err)
+		}
+		history = append(history, anomaly.Transaction{
+			ID:         t.Id,
+			CategoryID: t.CategoryId,
+			Type:       t.Type,
+			Amount:     t.Amount,
+			Title:      t.Title,
+			Date:       date,
+			CreatedAt:  t.CreatedAt,
+		})
+		if t.Category != nil {
+			categoryNames[t.CategoryId] = t.Category.Name
+		}
+	}
+
+	cfg := anomaly.Config{
+		Threshold:       s.anomaly.Threshold,
+		MinSamples:      s.anomaly.MinSamples,
+		DuplicateWindow: s.anomaly.DuplicateWindow,
+	}
+	now := time.Now().Unix()
+
+	var detected []models.Anomaly
+	for _, event := range events {
+		date, err := time.Parse(time.DateOnly, event.TransactionDate)
+		if err != nil {
+			return fmt.Errorf("invalid transaction date %q: %w", event.TransactionDate, err)
+		}
+
+		findings := anomaly.Detect(anomaly.Transaction{
+			ID:         event.ID,
+			CategoryID: event.CategoryID,
+			Type:       event.Type,
+			Amount:     event.Amount,
+			Title:      event.Title,
+			Date:       date,
+			CreatedAt:  event.CreatedAt,
+		}, history, cfg)
+
+		anomalies := make([]models.Anomaly, 0, len(findings))
+		for _, f := range findings {
+			a := models.Anomaly{
+				TransactionID:   event.ID,
+				Kind:            f.Kind,
+				CategoryID:      event.CategoryID,
+				CategoryName:    s.categoryName(ctx, categoryNames, event.CategoryID),
+				Type:            event.Type,
+				Title:           event.Title,
+				Amount:          event.Amount,
+				TransactionDate: event.TransactionDate,
+				Typical:         round2(f.Typical),
+				Score:           round2(f.Score),
+				DuplicateOf:     f.DuplicateOf,
+				DetectedAt:      now,
+			}
+			a.Explanation = anomalyExplanation(a, f, date, s.anomaly.DuplicateWindow)
+			anomalies = append(anomalies, a)
+		}
+
+		if err := s.repo.SaveAnomalies(ctx, userUID, event.ID, anomalies, s.anomaly.Keep); err != nil {
+			return err
+		}
+		detected = append(detected, anomalies...)
+	}
+
+	if len(detected) == 0 {
+		return nil
+	}
+	log.FromContext(ctx).Infof("Detected %d anomalies in %d transactions of user %s", len(detected), len(events), userUID)
+
+	// Об изменениях старых операций не напоминаем: пользователь уже видел их при создании
+	if !s.anomaly.Notify || action != models.ActionTransactionsCreated {
+		return nil
+	}
+	return s.sendAnomalyNotification(ctx, userUID, detected)
+}
+
+// categoryName берет название категории из истории, а для первой операции в категории запрашивает его
+// в funds-service. Без названия объяснение остается понятным, поэтому ошибка только логируется
+func (s *AnalyticsService) categoryName(ctx context.Context, names map[int32]string, categoryID int32) string {
+	if name, ok := names[categoryID]; ok {
+		return name
+	}
+
+	resp, err := s.clients.FundsClient.GetCategoryById(ctx, &fundspb.GetCategoryByIdRequest{Id: categoryID})
+	if err != nil {
+		log.FromContext(ctx).Warnf("Failed to get category %d: %v", categoryID, err)
+		return ""
+	}
+
+	names[categoryID] = resp.Category.GetName()
+	return names[categoryID]
+}
+
+// anomalyExplanation объясняет пользователю, чем операция необычна
+func anomalyExplanation(a models.Anomaly, f anomaly.Finding, date time.Time, window time.Duration) string {
+	category := "этой категории"
+	if a.CategoryName != "" {
+		category = "категории «" + a.CategoryName + "»"
+	}
+
+	switch a.Kind {
+	case models.AnomalyLargeAmount:
+		days := ""
+		if f.SameDayKind {
+			days = " в будни"
+			if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+				days = " в выходные"
+			}
+		}
+		return fmt.Sprintf("Сумма %.0f ₽ намного больше обычной для %s: обычно%s около %.0f ₽ (по %d операциям).",
+			a.Amount, category, days, a.Typical, f.Samples)
+	case models.AnomalyLargeForTitle:
+		return fmt.Sprintf("Сумма %.0f ₽ для «%s» намного больше обычной: обычно около %.0f ₽ (по %d операциям).",
+			a.Amount, a.Title, a.Typical, f.Samples)
+	case models.AnomalyDuplicate:
+		same := "с тем же названием"
+		if models.NormalizeTitle(a.Title) == "" {
+			same = "в " + category
+		}
+		return fmt.Sprintf("Похоже на повтор: операция на %.0f ₽ %s внесена менее чем за %.0f мин. до этой.",
+			a.Amount, same, window.Minutes())
+	case models.AnomalyNewCategory:
+		return fmt.Sprintf("Первая операция в %s.", category)
+	default:
+		return ""
+	}
+}
+
+// sendAnomalyNotification отправляет одно уведомление обо всех аномалиях в новых операциях
+func (s *AnalyticsService) sendAnomalyNotification(ctx context.Context, userUID string, anomalies []models.Anomaly) error {
+	ids := make([]int64, 0, len(anomalies))
+	seen := make(map[int64]struct{}, len(anomalies))
+	for _, a := range anomalies {
+		if _, ok := seen[a.TransactionID]; ok {
+			continue
+		}
+		seen[a.TransactionID] = struct{}{}
+		ids = append(ids, a.TransactionID)
+	}
+
+	var body string
+	if len(ids) == 1 {
+		explanations := make([]string, 0, len(anomalies))
+		for _, a := range anomalies {
+			explanations = append(explanations, a.Explanation)
+		}
+		body = strings.Join(explanations, " ")
+	} else {
+		body = fmt.Sprintf("Найдено необычных операций: %d. Проверьте, все ли они ваши.", len(ids))
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"type":         "anomaly",
+		"transactions": ids,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification data: %w", err)
+	}
+
+	msg, err := json.Marshal(models.KafkaNotificationMessage{
+		UserUID: userUID,
+		Notification: models.Notification{
+			Title: "Необычная операция",
+			Body:  body,
+			Data:  string(data),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	if err := s.notifier.Produce(ctx, []byte(userUID), msg); err != nil {
+		return fmt.Errorf("failed to send anomaly notification: %w", err)
+	}
+
+	log.FromContext(ctx).Infof("Sent anomaly notification to user %s for %d transactions", userUID, len(ids))
+	return nil
+}
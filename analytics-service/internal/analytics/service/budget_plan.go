@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/budget"
+	fundspb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/funds_service"
+	userpb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/user_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// loanPaymentsCode - категория, в которую попадают платежи по долгам
+const loanPaymentsCode = "loan_payments"
+
+var (
+	ErrNoIncome           = errors.New("income is unknown: set salary in the profile or add income transactions")
+	ErrNoSpendingHistory  = errors.New("no expenses to plan a budget from")
+	ErrBudgetPlanNotFound = errors.New("budget plan not found or expired")
+	ErrInvalidBudgetPlan  = errors.New("invalid budget plan request")
+)
+
+// budgetClassOrder - порядок групп в плане: сначала обязательные платежи, затем нужды и желания
+var budgetClassOrder = map[string]int{
+	models.BudgetClassFixed: 0,
+	models.BudgetClassNeeds: 1,
+	models.BudgetClassWants: 2,
+}
+
+// categoryHistory - средние расходы категории за прошлые месяцы
+type categoryHistory struct {
+	id      int32
+	code    string
+	name    string
+	average float64
+}
+
+// GenerateBudgetPlan предлагает бюджеты по категориям на следующий календарный месяц в часовом поясе пользователя.
+// Суммы считаются от средних расходов за последние BUDGET_PLAN_HISTORY_MONTHS полных месяцев, рекомендуемых
+// диапазонов для зарплаты пользователя и платежей по долгам. План сохраняется на BUDGET_PLAN_TTL, чтобы его
+// можно было принять; новый план заменяет предыдущий
+func (s *AnalyticsService) GenerateBudgetPlan(ctx context.Context, userUID, strategy string, savingsTarget float64) (*models.BudgetPlan, error) {
+	l := log.FromContext(ctx)
+
+	switch strategy {
+	case "":
+		strategy = models.BudgetStrategyBalanced
+	case models.BudgetStrategyBalanced, models.BudgetStrategy503020, models.BudgetStrategyZeroBased:
+	default:
+		return nil, fmt.Errorf("%w: unknown strategy %q", ErrInvalidBudgetPlan, strategy)
+	}
+
+	userResp, err := s.clients.UserClient.GetUserById(ctx, &userpb.GetUserByIdRequest{
+		Id: userUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user data: %w", err)
+	}
+
+	salary, incomeSource, _ := s.userSalary(ctx, userUID, userResp.User)
+	if salary <= 0 {
+		return nil, ErrNoIncome
+	}
+	if savingsTarget >= salary {
+		return nil, fmt.Errorf("%w: savings_target must be less than income %.2f", ErrInvalidBudgetPlan, salary)
+	}
+
+	today := userToday(time.Now(), userResp.User.GetTimezone())
+	monthStart := today.AddDate(0, 0, 1-today.Day())
+
+	history, months, err := s.spendingHistory(ctx, userUID, userResp.User, monthStart)
+	if err != nil {
+		return nil, err
+	}
+
+	var debtPayments float64
+	if debt := s.debtSummary(ctx, userUID); debt != nil {
+		debtPayments = debt.MonthlyPayments
+	}
+	if debtPayments > 0 {
+		history, err = s.withLoanPayments(ctx, history)
+		if err != nil {
+			l.Warnf("Failed to add debt payments to budget plan of user %s: %v", userUID, err)
+		}
+	}
+	if len(history) == 0 {
+		return nil, ErrNoSpendingHistory
+	}
+
+	ruleSet := s.rules.Current()
+	ranges := make(map[string]models.CategoryRange)
+	if bracket := ruleSet.BracketForSalary(salary); bracket != nil {
+		for _, cr := range bracket.Categories {
+			ranges[cr.Code] = cr
+		}
+	}
+
+	input := budget.Input{
+		Strategy:      strategy,
+		Income:        salary,
+		SavingsTarget: savingsTarget,
+		Categories:    make([]budget.Category, 0, len(history)),
+	}
+	for _, h := range history {
+		c := budget.Category{Code: h.code, Average: h.average}
+		if h.code == loanPaymentsCode {
+			c.Debt = debtPayments
+		}
+		if cr, ok := ranges[h.code]; ok {
+			c.Min = salary * cr.MinPerc / 100
+			c.Max = salary * cr.MaxPerc / 100
+		}
+		input.Categories = append(input.Categories, c)
+	}
+
+	planned := budget.Build(input)
+
+	now := time.Now()
+	plan := &models.BudgetPlan{
+		ID:               uuid.New().String(),
+		UserUID:          userUID,
+		Month:            monthStart.AddDate(0, 1, 0).Format("2006-01"),
+		Strategy:         strategy,
+		Income:           round2(salary),
+		IncomeSource:     incomeSource,
+		SavingsTarget:    planned.SavingsTarget,
+		Savings:          planned.Savings,
+		SavingsTargetMet: planned.SavingsTargetMet,
+		Fixed:            planned.Fixed,
+		Needs:            planned.Needs,
+		Wants:            planned.Wants,
+		Unallocated:      planned.Unallocated,
+		HistoryMonths:    months,
+		Items:            make([]models.BudgetPlanItem, 0, len(history)),
+		RulesVersion:     ruleSet.Version,
+		CalculatedAt:     now.Unix(),
+		ExpiresAt:        now.Add(s.budgetPlan.TTL).Unix(),
+	}
+	for i, h := range history {
+		c := input.Categories[i]
+		plan.Items = append(plan.Items, models.BudgetPlanItem{
+			CategoryID:      h.id,
+			CategoryCode:    h.code,
+			CategoryName:    h.name,
+			Class:           planned.Items[i].Class,
+			Amount:          planned.Items[i].Amount,
+			AverageSpending: round2(h.average),
+			RecommendedMin:  round2(c.Min),
+			RecommendedMax:  round2(c.Max),
+			Reason:          planned.Items[i].Reason,
+		})
+	}
+	sort.Slice(plan.Items, func(i, j int) bool {
+		a, b := plan.Items[i], plan.Items[j]
+		if a.Class != b.Class {
+			return budgetClassOrder[a.Class] < budgetClassOrder[b.Class]
+		}
+		if a.Amount != b.Amount {
+			return a.Amount > b.Amount
+		}
+		return a.CategoryCode < b.CategoryCode
+	})
+
+	if err := s.repo.SaveBudgetPlan(ctx, userUID, plan, s.budgetPlan.TTL); err != nil {
+		return nil, err
+	}
+
+	l.Infof("Generated %s budget plan %s for user %s for %s", strategy, plan.ID, userUID, plan.Month)
+	return plan, nil
+}
+
+// AcceptBudgetPlan создает в funds-service бюджеты из последнего предложенного плана. Бюджеты из ранее
+// принятого плана по категориям, которых нет в новом, удаляются. Бюджеты, заданные пользователем вручную,
+// не удаляются и не перезаписываются, даже если категория есть в плане: они возвращаются в Kept
+func (s *AnalyticsService) AcceptBudgetPlan(ctx context.Context, userUID, planID string) (*models.AcceptedBudgetPlan, error) {
+	plan, err := s.repo.GetBudgetPlan(ctx, userUID)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil || plan.ID != planID {
+		return nil, ErrBudgetPlanNotFound
+	}
+
+	req := &fundspb.SetBudgetsRequest{
+		UserUid:       userUID,
+		Month:         plan.Month,
+		Items:         make([]*fundspb.BudgetItem, 0, len(plan.Items)),
+		Replace:       true,
+		ReplaceSource: "plan",
+		Source:        "plan",
+	}
+	for _, item := range plan.Items {
+		req.Items = append(req.Items, &fundspb.BudgetItem{CategoryId: item.CategoryID, Amount: item.Amount})
+	}
+
+	resp, err := s.clients.BudgetClient.SetBudgets(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set budgets: %w", err)
+	}
+
+	// Бюджеты уже созданы, оставшийся план просто истечет
+	if err := s.repo.DeleteBudgetPlan(ctx, userUID); err != nil {
+		log.FromContext(ctx).Warnf("Failed to delete accepted budget plan of user %s: %v", userUID, err)
+	}
+
+	accepted := &models.AcceptedBudgetPlan{
+		PlanID:  plan.ID,
+		Month:   plan.Month,
+		Budgets: budgetsFromProto(resp.Budgets),
+		Kept:    budgetsFromProto(resp.Kept),
+	}
+
+	log.FromContext(ctx).Infof("User %s accepted budget plan %s for %s", userUID, plan.ID, plan.Month)
+	return accepted, nil
+}
+
+func budgetsFromProto(budgets []*fundspb.Budget) []models.Budget {
+	result := make([]models.Budget, 0, len(budgets))
+	for _, b := range budgets {
+		result = append(result, models.Budget{
+			ID:           b.Id,
+			CategoryID:   b.CategoryId,
+			CategoryCode: b.CategoryCode,
+			CategoryName: b.CategoryName,
+			Month:        b.Month,
+			Amount:       b.Amount,
+			Source:       b.Source,
+		})
+	}
+	return result
+}
+
+// spendingHistory возвращает средние расходы по категориям за полные месяцы перед monthStart и число месяцев,
+// по которым они посчитаны: от первого месяца с расходами до конца окна, включая месяцы без расходов.
+// Месяцы до первых расходов пользователя не учитываются, чтобы не занижать средние новых пользователей.
+// Без расходов в прошлых месяцах берутся расходы за последние 30 дней
+func (s *AnalyticsService) spendingHistory(ctx context.Context, userUID string, user *userpb.User, monthStart time.Time) ([]categoryHistory, int, error) {
+	summary, err := s.clients.FundsClient.GetSpendingSummary(ctx, &fundspb.GetSpendingSummaryRequest{
+		UserUid:  userUID,
+		DateFrom: monthStart.AddDate(0, -s.budgetPlan.HistoryMonths, 0).Format(time.DateOnly),
+		DateTo:   monthStart.AddDate(0, 0, -1).Format(time.DateOnly),
+		GroupBy:  []string{"category", "month"},
+		Type:     "expense",
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get monthly spending summary: %w", err)
+	}
+
+	var first time.Time
+	for _, b := range summary.Buckets {
+		if b.Total <= 0 {
+			continue
+		}
+		period, err := time.Parse(time.DateOnly, b.Period)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid spending summary period %q: %w", b.Period, err)
+		}
+		if first.IsZero() || period.Before(first) {
+			first = period
+		}
+	}
+
+	var months int
+	if !first.IsZero() {
+		months = (monthStart.Year()-first.Year())*12 + int(monthStart.Month()) - int(first.Month())
+	}
+
+	buckets := summary.Buckets
+	if months == 0 {
+		recent, err := s.spendingSummary(ctx, userUID, user, nil)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get spending summary: %w", err)
+		}
+		buckets = recent.Buckets
+	}
+	divider := float64(max(months, 1))
+
+	byID := make(map[int32]*categoryHistory)
+	for _, b := range buckets {
+		if b.CategoryCode == "" || b.Total <= 0 {
+			continue
+		}
+		h, ok := byID[b.CategoryId]
+		if !ok {
+			h = &categoryHistory{id: b.CategoryId, code: b.CategoryCode, name: b.CategoryName}
+			byID[b.CategoryId] = h
+		}
+		h.average += b.Total / divider
+	}
+
+	history := make([]categoryHistory, 0, len(byID))
+	for _, h := range byID {
+		history = append(history, *h)
+	}
+	sort.Slice(history, func(i, j int) bool { return history[i].id < history[j].id })
+
+	return history, months, nil
+}
+
+// withLoanPayments добавляет категорию кредитов, если по ней не было расходов, чтобы в план попали платежи по долгам
+func (s *AnalyticsService) withLoanPayments(ctx context.Context, history []categoryHistory) ([]categoryHistory, error) {
+	for _, h := range history {
+		if h.code == loanPaymentsCode {
+			return history, nil
+		}
+	}
+
+	resp, err := s.clients.FundsClient.GetCategoriesByType(ctx, &fundspb.GetCategoriesByTypeRequest{Type: "expense"})
+	if err != nil {
+		return history, fmt.Errorf("failed to get expense categories: %w", err)
+	}
+	for _, c := range resp.Categories {
+		if c.Code == loanPaymentsCode {
+			return append(history, categoryHistory{id: c.Id, code: c.Code, name: c.Name}), nil
+		}
+	}
+
+	return history, fmt.Errorf("expense category %s not found", loanPaymentsCode)
+}
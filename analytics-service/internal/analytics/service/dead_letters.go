@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+)
+
+// ReplayDeadLetters возвращает не больше limit событий из DLQ в исходные топики, чтобы они снова прошли
+// обработку, например после восстановления user-service. Пустой dlqTopic - все DLQ сервиса
+func (s *AnalyticsService) ReplayDeadLetters(ctx context.Context, dlqTopic string, limit int) (int, error) {
+	replayed, err := s.deadLetters.Replay(ctx, dlqTopic, limit)
+	log.FromContext(ctx).Infof("Replayed %d messages from dead-letter topics", replayed)
+	return replayed, err
+}
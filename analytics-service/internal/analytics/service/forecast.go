@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/forecast"
+	fundspb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/funds_service"
+	userpb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/user_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// salaryNotificationKey отмечает уведомление о превышении зарплаты, коды категорий с ним не совпадают.
+// Значение осталось от прежнего названия, чтобы уже отправленные уведомления не повторились
+const salaryNotificationKey = "_budget"
+
+// categoryMonthSpending - расходы категории, собранные для прогноза
+type categoryMonthSpending struct {
+	name          string
+	spentToDate   float64
+	scheduled     float64
+	pastRemaining map[string]float64 // месяц YYYY-MM -> расходы после сегодняшнего числа
+}
+
+// GetForecast прогнозирует расходы пользователя на конец текущего месяца
+func (s *AnalyticsService) GetForecast(ctx context.Context, userUID string) (*models.Forecast, error) {
+	userResp, err := s.clients.UserClient.GetUserById(ctx, &userpb.GetUserByIdRequest{
+		Id: userUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user data: %w", err)
+	}
+
+	return s.calculateForecast(ctx, userUID, userResp.User)
+}
+
+// CheckForecast пересчитывает прогноз и отправляет push-уведомление, если превышение стало вероятным.
+// Уведомления отправляются с середины месяца, когда темп трат уже показателен, и один раз
+// за месяц по каждой категории. Уведомление отправляется без повторов: если Kafka недоступна, оно теряется
+func (s *AnalyticsService) CheckForecast(ctx context.Context, userUID string) error {
+	f, err := s.GetForecast(ctx, userUID)
+	if err != nil {
+		return err
+	}
+
+	if f.DaysElapsed < s.forecast.NotifyFromDay || f.DaysElapsed >= f.DaysInMonth {
+		return nil
+	}
+
+	var breached []models.CategoryForecast
+	for _, c := range f.Categories {
+		if !c.LikelyBreach {
+			continue
+		}
+		first, err := s.repo.MarkForecastNotified(ctx, userUID, f.Month, c.CategoryCode)
+		if err != nil {
+			return err
+		}
+		if first {
+			breached = append(breached, c)
+		}
+	}
+
+	salary := false
+	if f.LikelyBudgetBreach {
+		salary, err = s.repo.MarkForecastNotified(ctx, userUID, f.Month, salaryNotificationKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(breached) == 0 && !salary {
+		return nil
+	}
+
+	return s.sendForecastNotification(ctx, f, breached, salary)
+}
+
+// monthSpending - расходы текущего и прошлых месяцев, собранные для прогноза
+type monthSpending struct {
+	month       string // YYYY-MM
+	today       time.Time
+	daysInMonth int
+	categories  map[string]*categoryMonthSpending
+	pastMonths  map[string]struct{}
+	budgets     map[string]float64 // код категории -> бюджет на текущий месяц
+}
+
+// calculateForecast прогнозирует расходы каждой категории на конец месяца от зарплаты пользователя
+func (s *AnalyticsService) calculateForecast(ctx context.Context, userUID string, user *userpb.User) (*models.Forecast, error) {
+	spending, err := s.monthSpending(ctx, userUID, user)
+	if err != nil {
+		return nil, err
+	}
+
+	salary, _, _ := s.userSalary(ctx, userUID, user)
+	return s.projectForecast(userUID, spending, salary, nil), nil
+}
+
+// monthSpending собирает расходы текущего и прошлых месяцев одним запросом по дням и категориям
+// и бюджеты текущего месяца. Месяц считается календарным в часовом поясе пользователя
+func (s *AnalyticsService) monthSpending(ctx context.Context, userUID string, user *userpb.User) (*monthSpending, error) {
+	now := time.Now().In(userLocation(user.GetTimezone()))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monthStart := today.AddDate(0, 0, 1-today.Day())
+	monthEnd := monthStart.AddDate(0, 1, -1)
+	historyStart := monthStart.AddDate(0, -s.forecast.HistoryMonths, 0)
+	month := monthStart.Format("2006-01")
+
+	summary, err := s.clients.FundsClient.GetSpendingSummary(ctx, &fundspb.GetSpendingSummaryRequest{
+		UserUid:  userUID,
+		DateFrom: historyStart.Format(time.DateOnly),
+		DateTo:   monthEnd.Format(time.DateOnly),
+		GroupBy:  []string{"category", "day"},
+		Type:     "expense",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spending summary: %w", err)
+	}
+
+	categories := make(map[string]*categoryMonthSpending)
+	pastMonths := make(map[string]struct{})
+	for _, b := range summary.Buckets {
+		if b.CategoryCode == "" {
+			continue
+		}
+		day, err := time.Parse(time.DateOnly, b.Period)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spending summary bucket date %q: %w", b.Period, err)
+		}
+
+		c, ok := categories[b.CategoryCode]
+		if !ok {
+			c = &categoryMonthSpending{name: b.CategoryName, pastRemaining: make(map[string]float64)}
+			categories[b.CategoryCode] = c
+		}
+
+		bucketMonth := day.Format("2006-01")
+		switch {
+		case bucketMonth == month && !day.After(today):
+			c.spentToDate += b.Total
+		case bucketMonth == month:
+			c.scheduled += b.Total
+		default:
+			pastMonths[bucketMonth] = struct{}{}
+			if day.Day() > today.Day() {
+				c.pastRemaining[bucketMonth] += b.Total
+			}
+		}
+	}
+
+	return &monthSpending{
+		month:       month,
+		today:       today,
+		daysInMonth: monthEnd.Day(),
+		categories:  categories,
+		pastMonths:  pastMonths,
+		budgets:     s.monthBudgets(ctx, userUID, month),
+	}, nil
+}
+
+// monthBudgets возвращает бюджеты пользователя на месяц по кодам категорий. Без бюджетов прогноз
+// сравнивается с рекомендуемыми максимумами, поэтому ошибка funds-service только логируется
+func (s *AnalyticsService) monthBudgets(ctx context.Context, userUID, month string) map[string]float64 {
+	resp, err := s.clients.BudgetClient.GetUserBudgets(ctx, &fundspb.GetUserBudgetsRequest{
+		UserUid: userUID,
+		Month:   month,
+	})
+	if err != nil {
+		log.FromContext(ctx).Warnf("Failed to get budgets of user %s for %s: %v", userUID, month, err)
+		return nil
+	}
+
+	budgets := make(map[string]float64, len(resp.Budgets))
+	for _, b := range resp.Budgets {
+		budgets[b.CategoryCode] = b.Amount
+	}
+	return budgets
+}
+
+// projectForecast прогнозирует каждую категорию и весь месяц. changes - изменения расходов
+// по кодам категорий из сценария, nil для фактического прогноза
+func (s *AnalyticsService) projectForecast(userUID string, spending *monthSpending, salary float64, changes map[string]*scenarioChange) *models.Forecast {
+	ruleSet := s.rules.Current()
+	rangeMap := make(map[string]models.CategoryRange)
+	if bracket := ruleSet.BracketForSalary(salary); bracket != nil {
+		for _, cr := range bracket.Categories {
+			rangeMap[cr.Code] = cr
+		}
+	}
+
+	result := &models.Forecast{
+		UserUID:       userUID,
+		Month:         spending.month,
+		DaysElapsed:   spending.today.Day(),
+		DaysInMonth:   spending.daysInMonth,
+		HistoryMonths: len(spending.pastMonths),
+		Salary:        salary,
+		Categories:    make([]models.CategoryForecast, 0, len(spending.categories)),
+		RulesVersion:  ruleSet.Version,
+		CalculatedAt:  time.Now().Unix(),
+	}
+
+	projections := make([]forecast.Projection, 0, len(spending.categories))
+	for code, c := range spending.categories {
+		// Месяцы, когда у пользователя были расходы, но не в этой категории, считаются нулевыми
+		pastRemaining := make([]float64, 0, len(spending.pastMonths))
+		for m := range spending.pastMonths {
+			pastRemaining = append(pastRemaining, c.pastRemaining[m])
+		}
+
+		p := forecast.Project(forecast.Input{
+			SpentToDate:   c.spentToDate,
+			Scheduled:     c.scheduled,
+			DaysElapsed:   result.DaysElapsed,
+			DaysInMonth:   result.DaysInMonth,
+			PastRemaining: pastRemaining,
+		})
+		scheduled := c.scheduled
+		if change, ok := changes[code]; ok {
+			p, scheduled = change.project(p, c.spentToDate, scheduled, result.DaysElapsed, result.DaysInMonth)
+		}
+		projections = append(projections, p)
+		low, high := p.Interval(c.spentToDate + scheduled)
+
+		cf := models.CategoryForecast{
+			CategoryCode: code,
+			CategoryName: c.name,
+			SpentToDate:  round2(c.spentToDate),
+			Scheduled:    round2(scheduled),
+			Forecast:     round2(p.Expected),
+			Low:          round2(low),
+			High:         round2(high),
+		}
+		if cr, ok := rangeMap[code]; ok && salary > 0 {
+			cf.RecommendedMax = round2(cr.MaxPerc * salary / 100)
+		}
+		// Бюджет, заданный пользователем, важнее рекомендуемого диапазона
+		cf.Budget = round2(spending.budgets[code])
+		if limit := breachLimit(cf); limit > 0 {
+			cf.BreachProbability = round2(p.BreachProbability(limit))
+			cf.LikelyBreach = cf.BreachProbability >= s.forecast.LikelyProbability
+		}
+
+		result.SpentToDate += c.spentToDate
+		result.Categories = append(result.Categories, cf)
+	}
+
+	total := forecast.Sum(projections)
+	low, high := total.Interval(0)
+	result.SpentToDate = round2(result.SpentToDate)
+	result.Forecast = round2(total.Expected)
+	result.Low = round2(math.Max(low, result.SpentToDate))
+	result.High = round2(math.Max(high, result.SpentToDate))
+	if salary > 0 {
+		result.BudgetBreachProbability = round2(total.BreachProbability(salary))
+		result.LikelyBudgetBreach = result.BudgetBreachProbability >= s.forecast.LikelyProbability
+	}
+
+	sort.Slice(result.Categories, func(i, j int) bool {
+		a, b := result.Categories[i], result.Categories[j]
+		if a.BreachProbability != b.BreachProbability {
+			return a.BreachProbability > b.BreachProbability
+		}
+		return a.Forecast > b.Forecast
+	})
+
+	return result
+}
+
+// breachLimit возвращает сумму, превышение которой проверяет прогноз категории: бюджет, если он задан,
+// иначе рекомендуемый максимум. 0 - сравнивать не с чем
+func breachLimit(c models.CategoryForecast) float64 {
+	if c.Budget > 0 {
+		return c.Budget
+	}
+	return c.RecommendedMax
+}
+
+// sendForecastNotification отправляет одно уведомление обо всех новых ожидаемых превышениях
+func (s *AnalyticsService) sendForecastNotification(ctx context.Context, f *models.Forecast, breached []models.CategoryForecast, salary bool) error {
+	var sentences []string
+	codes := make([]string, 0, len(breached))
+
+	switch len(breached) {
+	case 0:
+	case 1:
+		c := breached[0]
+		if c.Budget > 0 {
+			sentences = append(sentences, fmt.Sprintf("К концу месяца расходы на «%s» могут составить %.0f ₽ при бюджете %.0f ₽.", c.CategoryName, c.Forecast, c.Budget))
+		} else {
+			sentences = append(sentences, fmt.Sprintf("К концу месяца расходы на «%s» могут составить %.0f ₽ при рекомендуемых %.0f ₽.", c.CategoryName, c.Forecast, c.RecommendedMax))
+		}
+		codes = append(codes, c.CategoryCode)
+	default:
+		var budgeted, recommended []string
+		for _, c := range breached {
+			if c.Budget > 0 {
+				budgeted = append(budgeted, "«"+c.CategoryName+"»")
+			} else {
+				recommended = append(recommended, "«"+c.CategoryName+"»")
+			}
+			codes = append(codes, c.CategoryCode)
+		}
+		if len(budgeted) > 0 {
+			sentences = append(sentences, fmt.Sprintf("К концу месяца могут быть превышены бюджеты: %s.", strings.Join(budgeted, ", ")))
+		}
+		if len(recommended) > 0 {
+			sentences = append(sentences, fmt.Sprintf("К концу месяца могут быть превышены рекомендуемые расходы: %s.", strings.Join(recommended, ", ")))
+		}
+	}
+	if salary {
+		sentences = append(sentences, fmt.Sprintf("Расходы за месяц могут составить %.0f ₽ и превысить зарплату.", f.Forecast))
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"type":       "forecast",
+		"month":      f.Month,
+		"categories": codes,
+		"budget":     salary,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification data: %w", err)
+	}
+
+	msg, err := json.Marshal(models.KafkaNotificationMessage{
+		UserUID: f.UserUID,
+		Notification: models.Notification{
+			Title: "Прогноз расходов",
+			Body:  strings.Join(sentences, " "),
+			Data:  string(data),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	if err := s.notifier.Produce(ctx, []byte(f.UserUID), msg); err != nil {
+		return fmt.Errorf("failed to send forecast notification: %w", err)
+	}
+
+	log.FromContext(ctx).Infof("Sent forecast notification to user %s for month %s", f.UserUID, f.Month)
+	return nil
+}
+
+// userLocation возвращает часовой пояс пользователя, UTC если он не задан или неизвестен
+func userLocation(timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
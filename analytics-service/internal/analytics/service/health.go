@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/health"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// GetHealthScore возвращает оценку финансового здоровья по последним рекомендациям и ее историю
+// за последние months месяцев. Если рекомендаций в кэше нет, они рассчитываются
+func (s *AnalyticsService) GetHealthScore(ctx context.Context, userUID string, months int) (*models.HealthScore, error) {
+	result, err := s.repo.GetRecommendations(ctx, userUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recommendations from Redis: %w", err)
+	}
+	if result == nil {
+		if result, err = s.calculateRecommendations(ctx, userUID, nil); err != nil {
+			return nil, err
+		}
+		if err := s.repo.SaveRecommendations(ctx, userUID, result, s.ttl); err != nil {
+			return nil, fmt.Errorf("failed to save recommendations to Redis: %w", err)
+		}
+		s.saveHistory(ctx, userUID, result)
+		s.saveHealthScore(ctx, userUID, result)
+		s.savePeerSpending(ctx, userUID, result)
+	}
+
+	score, components, actions := health.Score(result, s.healthWeights)
+
+	history, err := s.repo.GetHealthScores(ctx, userUID, s.historyFrom(min(months, s.historyMonths)).Format("2006-01"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.HealthScore{
+		UserUID:      userUID,
+		Score:        score,
+		Components:   components,
+		Actions:      actions,
+		History:      history,
+		CalculatedAt: result.CalculatedAt,
+	}, nil
+}
+
+// saveHealthScore добавляет оценку в историю за месяц конца периода рекомендаций. Ошибка только логируется,
+// как и у истории рекомендаций
+func (s *AnalyticsService) saveHealthScore(ctx context.Context, userUID string, result *models.AnalyticsResult) {
+	if result.LedgerID != 0 {
+		return
+	}
+
+	score, _, _ := health.Score(result, s.healthWeights)
+	point := models.HealthScorePoint{
+		Month:        result.PeriodTo[:len("2006-01")],
+		Score:        score,
+		CalculatedAt: result.CalculatedAt,
+	}
+
+	if err := s.repo.SaveHealthScore(ctx, userUID, point, s.historyFrom(s.historyMonths).Format("2006-01")); err != nil {
+		log.FromContext(ctx).Warnf("Failed to save health score for user %s: %v", userUID, err)
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// GetRecommendationHistory возвращает рекомендации пользователя по месяцам за последние months месяцев,
+// включая текущий. Месяцы без рассчитанных рекомендаций пропускаются
+func (s *AnalyticsService) GetRecommendationHistory(ctx context.Context, userUID string, months int) ([]models.MonthlySnapshot, error) {
+	results, err := s.repo.GetHistorySnapshots(ctx, userUID, s.historyFrom(min(months, s.historyMonths)))
+	if err != nil {
+		return nil, err
+	}
+
+	return monthlySnapshots(results), nil
+}
+
+// GetCategoryTrend возвращает динамику доли расходов по категориям за последние months месяцев.
+// Пустой categoryCode означает все категории
+func (s *AnalyticsService) GetCategoryTrend(ctx context.Context, userUID string, months int, categoryCode string) ([]models.CategoryTrend, error) {
+	snapshots, err := s.GetRecommendationHistory(ctx, userUID, months)
+	if err != nil {
+		return nil, err
+	}
+
+	trends := make(map[string]*models.CategoryTrend)
+	for _, snapshot := range snapshots {
+		for _, rec := range snapshot.Result.Recommendations {
+			if categoryCode != "" && rec.CategoryCode != categoryCode {
+				continue
+			}
+
+			trend, ok := trends[rec.CategoryCode]
+			if !ok {
+				trend = &models.CategoryTrend{CategoryCode: rec.CategoryCode}
+				trends[rec.CategoryCode] = trend
+			}
+			// Название берется из последнего месяца
+			trend.CategoryName = rec.CategoryName
+			trend.Points = append(trend.Points, models.TrendPoint{
+				Month:            snapshot.Month,
+				ActualAmount:     rec.ActualAmount,
+				ActualPercentage: rec.ActualPercentage,
+				RecommendedMin:   rec.RecommendedMin,
+				RecommendedMax:   rec.RecommendedMax,
+				Status:           rec.Status,
+			})
+		}
+	}
+
+	result := make([]models.CategoryTrend, 0, len(trends))
+	for _, trend := range trends {
+		trend.Direction, trend.Change = trendDirection(trend.Points)
+		result = append(result, *trend)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CategoryCode < result[j].CategoryCode
+	})
+
+	return result, nil
+}
+
+// saveHistory добавляет результат в историю. История не должна мешать выдаче рекомендаций,
+// поэтому ошибка только логируется. Рекомендации общих бюджетов в историю пользователя не попадают
+func (s *AnalyticsService) saveHistory(ctx context.Context, userUID string, result *models.AnalyticsResult) {
+	if result.LedgerID != 0 {
+		return
+	}
+
+	if err := s.repo.SaveHistorySnapshot(ctx, userUID, result, s.historyFrom(s.historyMonths)); err != nil {
+		log.FromContext(ctx).Warnf("Failed to save recommendations history for user %s: %v", userUID, err)
+	}
+}
+
+// historyFrom возвращает начало месяца, с которого начинаются последние months месяцев
+func (s *AnalyticsService) historyFrom(months int) time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -(months - 1), 0)
+}
+
+// monthlySnapshots оставляет по одному результату на месяц конца периода: с самым поздним концом периода,
+// а при равных - последний рассчитанный. Результаты должны быть упорядочены по концу периода
+func monthlySnapshots(results []*models.AnalyticsResult) []models.MonthlySnapshot {
+	var snapshots []models.MonthlySnapshot
+	for _, result := range results {
+		month := result.PeriodTo[:len("2006-01")]
+
+		if n := len(snapshots); n > 0 && snapshots[n-1].Month == month {
+			last := snapshots[n-1].Result
+			if result.PeriodTo > last.PeriodTo || result.CalculatedAt >= last.CalculatedAt {
+				snapshots[n-1].Result = result
+			}
+			continue
+		}
+
+		snapshots = append(snapshots, models.MonthlySnapshot{Month: month, Result: result})
+	}
+
+	return snapshots
+}
+
+// trendDirection сравнивает доли расходов двух последних месяцев: снижение доли - улучшение
+func trendDirection(points []models.TrendPoint) (string, float64) {
+	if len(points) < 2 {
+		return models.TrendNew, 0
+	}
+
+	change := points[len(points)-1].ActualPercentage - points[len(points)-2].ActualPercentage
+	change = math.Round(change*100) / 100
+
+	switch {
+	case change <= -models.TrendStableDelta:
+		return models.TrendImproving, change
+	case change >= models.TrendStableDelta:
+		return models.TrendWorsening, change
+	default:
+		return models.TrendStable, change
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/income"
+	fundspb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/funds_service"
+	userpb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/user_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// userSalary возвращает месячную зарплату, от которой считается аналитика, и ее источник.
+// Если пользователь выбрал фактический доход, но операций дохода нет, берется зарплата из профиля.
+// Анализ дохода дополняет рекомендации, поэтому при ошибке он пропускается
+func (s *AnalyticsService) userSalary(ctx context.Context, userUID string, user *userpb.User) (float64, string, *models.IncomeAnalysis) {
+	analysis, err := s.analyzeIncome(ctx, userUID, user)
+	if err != nil {
+		log.FromContext(ctx).Warnf("Failed to analyze income of user %s: %v", userUID, err)
+		return user.Salary, models.IncomeSourceProfile, nil
+	}
+
+	if user.GetIncomeSource() == models.IncomeSourceTransactions && analysis.EffectiveIncome > 0 {
+		return analysis.EffectiveIncome, models.IncomeSourceTransactions, analysis
+	}
+	return user.Salary, models.IncomeSourceProfile, analysis
+}
+
+// analyzeIncome считает фактический доход, норму сбережений и финансовую подушку по полным месяцам
+// перед текущим в часовом поясе пользователя
+func (s *AnalyticsService) analyzeIncome(ctx context.Context, userUID string, user *userpb.User) (*models.IncomeAnalysis, error) {
+	now := time.Now().In(userLocation(user.GetTimezone()))
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	from := monthStart.AddDate(0, -s.incomeMonths, 0)
+
+	summary, err := s.clients.FundsClient.GetSpendingSummary(ctx, &fundspb.GetSpendingSummaryRequest{
+		UserUid:  userUID,
+		DateFrom: from.Format(time.DateOnly),
+		DateTo:   monthStart.AddDate(0, 0, -1).Format(time.DateOnly),
+		GroupBy:  []string{"month", "type"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get income summary: %w", err)
+	}
+
+	months := make([]income.Month, s.incomeMonths)
+	for _, b := range summary.Buckets {
+		bucketMonth, err := time.Parse(time.DateOnly, b.Period)
+		if err != nil {
+			return nil, fmt.Errorf("invalid income summary bucket date %q: %w", b.Period, err)
+		}
+		i := (bucketMonth.Year()-from.Year())*12 + int(bucketMonth.Month()-from.Month())
+		if i < 0 || i >= len(months) {
+			continue
+		}
+
+		switch b.Type {
+		case "income":
+			months[i].Income += b.Total
+		case "expense":
+			months[i].Expense += b.Total
+		}
+	}
+
+	balanceResp, err := s.clients.FundsClient.GetUserBalance(ctx, &fundspb.GetUserBalanceRequest{
+		UserUid: userUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user balance: %w", err)
+	}
+
+	result := income.Analyze(months)
+	analysis := &models.IncomeAnalysis{
+		ProfileSalary:     user.Salary,
+		EffectiveIncome:   round2(result.Effective),
+		Months:            result.Months,
+		StabilityScore:    round2(result.Stability),
+		AverageExpenses:   round2(result.AvgExpenses),
+		Balance:           round2(balanceResp.Balance.GetTotalBalance()),
+		ExpenseVolatility: round2(result.ExpenseVolatility),
+	}
+	if result.Effective > 0 {
+		analysis.SavingsRate = round2((result.Effective - result.AvgExpenses) / result.Effective * 100)
+	}
+	if result.AvgExpenses > 0 && analysis.Balance > 0 {
+		analysis.EmergencyFundMonths = round2(analysis.Balance / result.AvgExpenses)
+	}
+
+	return analysis, nil
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/peers"
+	userpb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/user_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNoPeerCohort возвращается, если ни в одной группе пользователя недостаточно людей для сравнения
+var ErrNoPeerCohort = errors.New("not enough similar users to compare")
+
+// GetPeerComparison сравнивает расходы пользователя за последние 30 дней с самой узкой группой похожих
+// пользователей, в которой достаточно людей. Категории упорядочены от тех, где пользователь тратит
+// больше остальных
+func (s *AnalyticsService) GetPeerComparison(ctx context.Context, userUID string) (*models.PeerComparison, error) {
+	userResp, err := s.clients.UserClient.GetUserById(ctx, &userpb.GetUserByIdRequest{
+		Id: userUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user data: %w", err)
+	}
+
+	spending, err := s.repo.GetPeerSpending(ctx, userUID)
+	if err != nil {
+		return nil, err
+	}
+	if spending == nil {
+		if err := s.CalculateAndSaveRecommendations(ctx, userUID); err != nil {
+			return nil, err
+		}
+		if spending, err = s.repo.GetPeerSpending(ctx, userUID); err != nil {
+			return nil, err
+		}
+		if spending == nil {
+			return nil, ErrNoPeerCohort
+		}
+	}
+
+	bracket := s.rules.Current().BracketForSalary(spending.Salary)
+	if bracket == nil {
+		return nil, ErrNoPeerCohort
+	}
+
+	var cohort *models.PeerCohort
+	for _, c := range peers.Cohorts(bracket.Label(), peers.AgeBand(userResp.User.GetAge()), userResp.User.GetWorkSphereId()) {
+		if cohort, err = s.repo.GetPeerCohort(ctx, c.Key()); err != nil {
+			return nil, err
+		}
+		if cohort != nil {
+			break
+		}
+	}
+	if cohort == nil {
+		return nil, ErrNoPeerCohort
+	}
+
+	amounts := make(map[string]float64, len(spending.Categories))
+	for _, c := range spending.Categories {
+		amounts[c.Code] = c.Amount
+	}
+
+	result := &models.PeerComparison{
+		UserUID:            userUID,
+		Level:              cohort.Level,
+		SalaryBracket:      cohort.SalaryBracket,
+		AgeBand:            cohort.AgeBand,
+		WorkSphereID:       cohort.WorkSphereID,
+		CohortSize:         cohort.Size,
+		Categories:         make([]models.PeerCategoryComparison, 0, len(cohort.Categories)),
+		PeriodTo:           spending.PeriodTo,
+		CohortCalculatedAt: cohort.CalculatedAt,
+	}
+	for _, stats := range cohort.Categories {
+		c := models.PeerCategoryComparison{
+			CategoryCode:  stats.Code,
+			CategoryName:  stats.Name,
+			Amount:        amounts[stats.Code],
+			CohortMedian:  peers.Median(stats.Quantiles),
+			SpendersShare: math.Round(float64(stats.Spenders) / float64(cohort.Size) * 100),
+			Percentile:    math.Round(peers.Rank(stats.Quantiles, amounts[stats.Code])),
+		}
+		c.Message = peerMessage(c)
+		result.Categories = append(result.Categories, c)
+	}
+
+	sort.SliceStable(result.Categories, func(i, j int) bool {
+		return result.Categories[i].Percentile > result.Categories[j].Percentile
+	})
+
+	return result, nil
+}
+
+func peerMessage(c models.PeerCategoryComparison) string {
+	switch {
+	case c.Amount <= 0:
+		return fmt.Sprintf("Вы не тратили на «%s» за последние 30 дней, а %.0f%% похожих пользователей тратили.", c.CategoryName, c.SpendersShare)
+	case c.Percentile >= 50:
+		return fmt.Sprintf("Вы тратите на «%s» больше, чем %.0f%% похожих пользователей.", c.CategoryName, c.Percentile)
+	default:
+		return fmt.Sprintf("Вы тратите на «%s» меньше, чем %.0f%% похожих пользователей.", c.CategoryName, 100-c.Percentile)
+	}
+}
+
+// savePeerSpending сохраняет расходы по категориям из рекомендаций за последние 30 дней для расчета групп.
+// Сравнение с другими пользователями не должно мешать рекомендациям, поэтому ошибка только логируется
+func (s *AnalyticsService) savePeerSpending(ctx context.Context, userUID string, result *models.AnalyticsResult) {
+	if result.LedgerID != 0 {
+		return
+	}
+
+	spending := &models.PeerSpending{
+		Salary:     result.Salary,
+		Categories: make([]models.PeerCategorySpending, 0, len(result.Recommendations)),
+		PeriodTo:   result.PeriodTo,
+		UpdatedAt:  result.CalculatedAt,
+	}
+	for _, rec := range result.Recommendations {
+		spending.Categories = append(spending.Categories, models.PeerCategorySpending{
+			Code:   rec.CategoryCode,
+			Name:   rec.CategoryName,
+			Amount: rec.ActualAmount,
+		})
+	}
+
+	if err := s.repo.SavePeerSpending(ctx, userUID, spending); err != nil {
+		log.FromContext(ctx).Warnf("Failed to save peer spending for user %s: %v", userUID, err)
+	}
+}
+
+// WatchPeerCohorts сразу и затем каждые interval пересчитывает группы похожих пользователей
+func (s *AnalyticsService) WatchPeerCohorts(ctx context.Context, interval time.Duration) {
+	l := log.FromContext(ctx)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			// Блокировка живет половину интервала, чтобы следующий расчет не пропускался из-за
+			// неточности таймеров, но реплики, запущенные одновременно, не считали группы повторно
+			if err := s.AggregatePeerCohorts(ctx, interval/2); err != nil {
+				l.Errorf("Failed to aggregate peer cohorts: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// AggregatePeerCohorts пересчитывает распределения расходов всех групп. Возраст и сфера работы
+// берутся из user-service на момент расчета, диапазон зарплаты - по действующим правилам.
+// Расходы, которые давно не обновлялись, и расходы удаленных пользователей удаляются
+func (s *AnalyticsService) AggregatePeerCohorts(ctx context.Context, lockTTL time.Duration) error {
+	l := log.FromContext(ctx)
+
+	locked, err := s.repo.LockPeerAggregation(ctx, lockTTL)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		return nil
+	}
+
+	ruleSet := s.rules.Current()
+	staleBefore := time.Now().Add(-s.peers.SpendingMaxAge).Unix()
+
+	var (
+		members []peers.Member
+		stale   []string
+	)
+	err = s.repo.ScanPeerSpending(ctx, func(userUID string, spending *models.PeerSpending) error {
+		if spending.UpdatedAt < staleBefore {
+			stale = append(stale, userUID)
+			return nil
+		}
+
+		bracket := ruleSet.BracketForSalary(spending.Salary)
+		if bracket == nil {
+			return nil
+		}
+
+		userResp, err := s.clients.UserClient.GetUserById(ctx, &userpb.GetUserByIdRequest{
+			Id: userUID,
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				stale = append(stale, userUID)
+			} else {
+				l.Warnf("Failed to get user %s for peer cohorts: %v", userUID, err)
+			}
+			return nil
+		}
+
+		members = append(members, peers.Member{
+			SalaryBracket: bracket.Label(),
+			AgeBand:       peers.AgeBand(userResp.User.GetAge()),
+			WorkSphereID:  userResp.User.GetWorkSphereId(),
+			Spending:      spending.Categories,
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeletePeerSpending(ctx, stale); err != nil {
+		l.Warnf("Failed to delete stale peer spending: %v", err)
+	}
+
+	cohorts := peers.Aggregate(members, peers.Config{
+		MinCohortSize: s.peers.MinCohortSize,
+		MinSpenders:   s.peers.MinSpenders,
+	}, time.Now().Unix())
+	if err := s.repo.ReplacePeerCohorts(ctx, cohorts); err != nil {
+		return err
+	}
+
+	l.Infof("Aggregated %d peer cohorts from %d users", len(cohorts), len(members))
+	return nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// GetRecomputeMetrics возвращает счетчики отложенных пересчетов
+func (s *AnalyticsService) GetRecomputeMetrics(ctx context.Context) (*models.RecomputeMetrics, error) {
+	return s.repo.GetRecomputeMetrics(ctx)
+}
+
+// WatchRecomputations каждые PollInterval выполняет пересчеты, время которых пришло. Очередь общая
+// для всех реплик, каждый пересчет забирает одна из них
+func (s *AnalyticsService) WatchRecomputations(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.recompute.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.runRecomputations(ctx)
+			}
+		}
+	}()
+}
+
+// runRecomputations забирает пересчеты пачками, пока пришедшие по времени не закончатся
+func (s *AnalyticsService) runRecomputations(ctx context.Context) {
+	for {
+		userUIDs, err := s.repo.ClaimRecomputes(ctx, time.Now(), s.recompute.BatchSize, s.recompute.Lease)
+		if err != nil {
+			log.FromContext(ctx).Errorf("Failed to claim recomputes: %v", err)
+			return
+		}
+
+		sem := make(chan struct{}, max(1, s.recompute.Workers))
+		var wg sync.WaitGroup
+		for _, userUID := range userUIDs {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				s.recomputeUser(ctx, userUID)
+			}()
+		}
+		wg.Wait()
+
+		if len(userUIDs) < s.recompute.BatchSize {
+			return
+		}
+	}
+}
+
+// recomputeUser пересчитывает рекомендации и прогноз пользователя. При остановке сервиса аренда
+// не снимается, чтобы пересчет повторила другая реплика
+func (s *AnalyticsService) recomputeUser(ctx context.Context, userUID string) {
+	l := log.FromContext(ctx)
+
+	recomputeCtx, cancel := context.WithTimeout(ctx, s.recompute.Lease)
+	defer cancel()
+
+	err := s.CalculateAndSaveRecommendations(recomputeCtx, userUID)
+	if err != nil {
+		l.Errorf("Failed to recompute analytics for user %s: %v", userUID, err)
+	} else if err := s.CheckForecast(recomputeCtx, userUID); err != nil {
+		// Прогноз на конец месяца нужен только для уведомлений, его ошибка не мешает рекомендациям
+		l.Warnf("Failed to check spending forecast for user %s: %v", userUID, err)
+	}
+
+	if ctx.Err() != nil {
+		return
+	}
+	retried, completeErr := s.repo.CompleteRecompute(ctx, userUID, err != nil, time.Now(), s.recompute.MaxAttempts, s.recompute.RetryDelay)
+	if completeErr != nil {
+		l.Warnf("Failed to complete recompute for user %s: %v", userUID, completeErr)
+		return
+	}
+	if err != nil && !retried {
+		l.Errorf("Giving up recomputing analytics for user %s after %d attempts", userUID, s.recompute.MaxAttempts)
+	}
+}
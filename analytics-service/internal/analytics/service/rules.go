@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// GetRules возвращает правила рекомендаций указанной версии, 0 - действующие
+func (s *AnalyticsService) GetRules(ctx context.Context, version int64) (*models.RuleSet, error) {
+	return s.rules.Get(ctx, version)
+}
+
+// UpdateRules сохраняет новую версию правил рекомендаций. Кэшированные рекомендации не пересчитываются,
+// они помечены версией правил, по которой рассчитаны, и обновятся при следующем событии пользователя
+func (s *AnalyticsService) UpdateRules(ctx context.Context, ruleSet *models.RuleSet, baseVersion int64, updatedBy string) (*models.RuleSet, error) {
+	return s.rules.Update(ctx, ruleSet, baseVersion, updatedBy)
+}
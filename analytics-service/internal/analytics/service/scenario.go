@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/forecast"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/health"
+	fundspb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/funds_service"
+	userpb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/user_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// ErrInvalidScenario возвращается, если сценарий ссылается на неизвестную категорию расходов
+var ErrInvalidScenario = errors.New("invalid scenario")
+
+// scenarioChange - изменение месячных расходов категории в сценарии
+type scenarioChange struct {
+	name      string
+	factor    float64 // Множитель расходов, 1 - без изменений
+	amount    float64 // Изменение суммы за месяц
+	recurring float64 // Новые регулярные расходы за месяц
+}
+
+// apply возвращает расходы категории за месяц после изменений, не меньше нуля
+func (c *scenarioChange) apply(amount float64) float64 {
+	return math.Max(0, amount*c.factor+c.amount+c.recurring)
+}
+
+// project применяет изменения к прогнозу категории и возвращает его вместе с внесенными расходами.
+// Потраченное с начала месяца не меняется: процент применяется к оставшейся части месяца, изменение
+// суммы - пропорционально оставшимся дням, новый регулярный расход добавляется целиком
+func (c *scenarioChange) project(p forecast.Projection, spentToDate, scheduled float64, daysElapsed, daysInMonth int) (forecast.Projection, float64) {
+	remainingDays := daysInMonth - daysElapsed
+	if remainingDays <= 0 {
+		return p, scheduled
+	}
+
+	remaining := (p.Expected-spentToDate)*c.factor + c.amount*float64(remainingDays)/float64(daysInMonth) + c.recurring
+	return forecast.Projection{
+		Expected: spentToDate + math.Max(0, remaining),
+		StdDev:   p.StdDev * c.factor,
+	}, scheduled * c.factor
+}
+
+// SimulateScenario пересчитывает рекомендации за последние 30 дней, оценку финансового здоровья
+// и прогноз на текущий месяц с изменениями из сценария и сравнивает их с текущими.
+// Ни текущий, ни новый результат не сохраняются
+func (s *AnalyticsService) SimulateScenario(ctx context.Context, userUID string, scenario models.Scenario) (*models.ScenarioResult, error) {
+	l := log.FromContext(ctx)
+
+	userResp, err := s.clients.UserClient.GetUserById(ctx, &userpb.GetUserByIdRequest{
+		Id: userUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user data: %w", err)
+	}
+
+	salary, incomeSource, income := s.userSalary(ctx, userUID, userResp.User)
+
+	summary, err := s.spendingSummary(ctx, userUID, userResp.User, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spending summary: %w", err)
+	}
+
+	changes, err := s.scenarioChanges(ctx, scenario, summary)
+	if err != nil {
+		return nil, err
+	}
+
+	spending, err := s.monthSpending(ctx, userUID, userResp.User)
+	if err != nil {
+		return nil, err
+	}
+
+	debt := s.debtSummary(ctx, userUID)
+
+	simulatedSalary := salary
+	if scenario.Salary > 0 {
+		simulatedSalary = scenario.Salary
+	}
+	simulatedSummary := applyScenario(summary, changes)
+
+	// Текущий результат считается заново по тем же данным, а не берется из кэша,
+	// чтобы разница показывала только изменения из сценария
+	current := s.buildRecommendations(userUID, salary, nil, summary, debt)
+	current.IncomeSource = incomeSource
+	current.Income = income
+
+	simulated := s.buildRecommendations(userUID, simulatedSalary, nil, simulatedSummary, debt)
+	simulated.IncomeSource = incomeSource
+	simulated.Income = scenarioIncome(income, simulatedSalary-salary, simulatedSummary.TotalExpense-summary.TotalExpense)
+
+	// Категории без расходов в этом месяце прогнозируются только по изменениям из сценария
+	for code, change := range changes {
+		if _, ok := spending.categories[code]; !ok {
+			spending.categories[code] = &categoryMonthSpending{name: change.name, pastRemaining: make(map[string]float64)}
+		}
+	}
+	currentForecast := s.projectForecast(userUID, spending, salary, nil)
+	simulatedForecast := s.projectForecast(userUID, spending, simulatedSalary, changes)
+
+	currentScore, _, _ := health.Score(current, s.healthWeights)
+	simulatedScore, _, _ := health.Score(simulated, s.healthWeights)
+
+	l.Infof("Simulated scenario for user %s: salary %.2f -> %.2f, %d changed categories", userUID, salary, simulatedSalary, len(changes))
+
+	return &models.ScenarioResult{
+		UserUID:                          userUID,
+		CurrentSalary:                    current.Salary,
+		SimulatedSalary:                  simulated.Salary,
+		CurrentSalaryBracket:             current.SalaryBracket,
+		SimulatedSalaryBracket:           simulated.SalaryBracket,
+		SalaryBracketChanged:             current.SalaryBracket != simulated.SalaryBracket,
+		CurrentOverallStatus:             current.OverallStatus,
+		SimulatedOverallStatus:           simulated.OverallStatus,
+		CurrentHealthScore:               currentScore,
+		SimulatedHealthScore:             simulatedScore,
+		CurrentForecast:                  currentForecast.Forecast,
+		SimulatedForecast:                simulatedForecast.Forecast,
+		CurrentBudgetBreachProbability:   currentForecast.BudgetBreachProbability,
+		SimulatedBudgetBreachProbability: simulatedForecast.BudgetBreachProbability,
+		Categories:                       scenarioCategoryDiffs(summary, simulatedSummary, current, simulated, currentForecast, simulatedForecast),
+		Simulated:                        simulated,
+		CalculatedAt:                     time.Now().Unix(),
+	}, nil
+}
+
+// scenarioChanges собирает изменения из сценария по кодам категорий. Категории, по которым
+// у пользователя нет расходов, ищутся среди категорий расходов funds-service
+func (s *AnalyticsService) scenarioChanges(ctx context.Context, scenario models.Scenario, summary *fundspb.GetSpendingSummaryResponse) (map[string]*scenarioChange, error) {
+	changes := make(map[string]*scenarioChange)
+	change := func(code string) *scenarioChange {
+		c, ok := changes[code]
+		if !ok {
+			c = &scenarioChange{factor: 1}
+			changes[code] = c
+		}
+		return c
+	}
+	for _, a := range scenario.Categories {
+		c := change(a.CategoryCode)
+		c.factor *= 1 + a.PercentChange/100
+		c.amount += a.AmountChange
+	}
+	for _, e := range scenario.RecurringExpenses {
+		change(e.CategoryCode).recurring += e.MonthlyAmount
+	}
+
+	names := make(map[string]string, len(summary.Buckets))
+	for _, b := range summary.Buckets {
+		names[b.CategoryCode] = b.CategoryName
+	}
+
+	missing := false
+	for code := range changes {
+		if _, ok := names[code]; !ok {
+			missing = true
+		}
+	}
+	if missing {
+		resp, err := s.clients.FundsClient.GetCategoriesByType(ctx, &fundspb.GetCategoriesByTypeRequest{Type: "expense"})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get expense categories: %w", err)
+		}
+		for _, c := range resp.Categories {
+			if _, ok := names[c.Code]; !ok {
+				names[c.Code] = c.Name
+			}
+		}
+	}
+
+	for code, c := range changes {
+		name, ok := names[code]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown expense category %q", ErrInvalidScenario, code)
+		}
+		c.name = name
+	}
+
+	return changes, nil
+}
+
+// applyScenario возвращает расходы по категориям после изменений из сценария. Категории без расходов,
+// которые появляются в сценарии, добавляются в конец
+func applyScenario(summary *fundspb.GetSpendingSummaryResponse, changes map[string]*scenarioChange) *fundspb.GetSpendingSummaryResponse {
+	simulated := &fundspb.GetSpendingSummaryResponse{
+		Buckets:      make([]*fundspb.SpendingSummaryBucket, 0, len(summary.Buckets)+len(changes)),
+		DateFrom:     summary.DateFrom,
+		DateTo:       summary.DateTo,
+		TotalExpense: summary.TotalExpense,
+	}
+
+	seen := make(map[string]struct{}, len(changes))
+	for _, b := range summary.Buckets {
+		total := b.Total
+		if c, ok := changes[b.CategoryCode]; ok {
+			seen[b.CategoryCode] = struct{}{}
+			total = round2(c.apply(b.Total))
+		}
+
+		simulated.TotalExpense += total - b.Total
+		simulated.Buckets = append(simulated.Buckets, &fundspb.SpendingSummaryBucket{
+			CategoryId:   b.CategoryId,
+			CategoryCode: b.CategoryCode,
+			CategoryName: b.CategoryName,
+			Type:         b.Type,
+			Total:        total,
+		})
+	}
+
+	added := make([]string, 0, len(changes))
+	for code := range changes {
+		if _, ok := seen[code]; !ok {
+			added = append(added, code)
+		}
+	}
+	sort.Strings(added)
+
+	for _, code := range added {
+		c := changes[code]
+		total := round2(c.apply(0))
+		simulated.TotalExpense += total
+		simulated.Buckets = append(simulated.Buckets, &fundspb.SpendingSummaryBucket{
+			CategoryCode: code,
+			CategoryName: c.name,
+			Type:         "expense",
+			Total:        total,
+		})
+	}
+	simulated.TotalExpense = round2(simulated.TotalExpense)
+
+	return simulated
+}
+
+// scenarioIncome пересчитывает норму сбережений и финансовую подушку так, как если бы средний доход
+// и средние расходы изменились на incomeDelta и expensesDelta в месяц
+func scenarioIncome(income *models.IncomeAnalysis, incomeDelta, expensesDelta float64) *models.IncomeAnalysis {
+	if income == nil {
+		return nil
+	}
+
+	simulated := *income
+	if simulated.EffectiveIncome > 0 {
+		simulated.EffectiveIncome = round2(math.Max(0, simulated.EffectiveIncome+incomeDelta))
+	}
+	simulated.AverageExpenses = round2(math.Max(0, simulated.AverageExpenses+expensesDelta))
+
+	simulated.SavingsRate = 0
+	if simulated.EffectiveIncome > 0 {
+		simulated.SavingsRate = round2((simulated.EffectiveIncome - simulated.AverageExpenses) / simulated.EffectiveIncome * 100)
+	}
+	simulated.EmergencyFundMonths = 0
+	if simulated.AverageExpenses > 0 && simulated.Balance > 0 {
+		simulated.EmergencyFundMonths = round2(simulated.Balance / simulated.AverageExpenses)
+	}
+
+	return &simulated
+}
+
+// scenarioCategoryDiffs сравнивает расходы, статусы и прогноз по категориям. В результат попадают
+// только изменившиеся категории, сначала с наибольшим изменением расходов
+func scenarioCategoryDiffs(
+	summary, simulatedSummary *fundspb.GetSpendingSummaryResponse,
+	current, simulated *models.AnalyticsResult,
+	currentForecast, simulatedForecast *models.Forecast,
+) []models.CategoryScenarioDiff {
+	diffs := make(map[string]*models.CategoryScenarioDiff)
+	diff := func(code, name string) *models.CategoryScenarioDiff {
+		d, ok := diffs[code]
+		if !ok {
+			d = &models.CategoryScenarioDiff{CategoryCode: code, CategoryName: name}
+			diffs[code] = d
+		}
+		return d
+	}
+
+	for _, b := range summary.Buckets {
+		if b.CategoryCode != "" {
+			diff(b.CategoryCode, b.CategoryName).CurrentAmount = b.Total
+		}
+	}
+	for _, b := range simulatedSummary.Buckets {
+		if b.CategoryCode != "" {
+			diff(b.CategoryCode, b.CategoryName).SimulatedAmount = b.Total
+		}
+	}
+	for _, r := range current.Recommendations {
+		diff(r.CategoryCode, r.CategoryName).CurrentStatus = r.Status
+	}
+	for _, r := range simulated.Recommendations {
+		d := diff(r.CategoryCode, r.CategoryName)
+		d.SimulatedStatus = r.Status
+		d.RecommendedMin = r.RecommendedMin
+		d.RecommendedMax = r.RecommendedMax
+	}
+	for _, c := range currentForecast.Categories {
+		diff(c.CategoryCode, c.CategoryName).CurrentForecast = c.Forecast
+	}
+	for _, c := range simulatedForecast.Categories {
+		diff(c.CategoryCode, c.CategoryName).SimulatedForecast = c.Forecast
+	}
+
+	result := make([]models.CategoryScenarioDiff, 0, len(diffs))
+	for _, d := range diffs {
+		if current.Salary > 0 {
+			d.CurrentPercentage = round2(d.CurrentAmount / current.Salary * 100)
+		}
+		if simulated.Salary > 0 {
+			d.SimulatedPercentage = round2(d.SimulatedAmount / simulated.Salary * 100)
+		}
+
+		if d.CurrentAmount == d.SimulatedAmount && d.CurrentPercentage == d.SimulatedPercentage &&
+			d.CurrentStatus == d.SimulatedStatus && d.CurrentForecast == d.SimulatedForecast {
+			continue
+		}
+		result = append(result, *d)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		da, db := math.Abs(a.SimulatedAmount-a.CurrentAmount), math.Abs(b.SimulatedAmount-b.CurrentAmount)
+		if da != db {
+			return da > db
+		}
+		return a.CategoryCode < b.CategoryCode
+	})
+
+	return result
+}
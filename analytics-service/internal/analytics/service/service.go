@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/adapters/producers"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/health"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/repository"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/rules"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/clients"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/config"
 	fundspb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/funds_service"
@@ -14,19 +17,60 @@ import (
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
 )
 
+// defaultPeriodDays - длина окна расходов, за которое рекомендации кэшируются в Redis
+const defaultPeriodDays = 30
+
+// DeadLetterReplayer возвращает сообщения из DLQ в исходные топики
+type DeadLetterReplayer interface {
+	Replay(ctx context.Context, dlqTopic string, limit int) (int, error)
+}
+
 // AnalyticsService предоставляет методы для работы с аналитикой
 type AnalyticsService struct {
-	clients *clients.Clients
-	repo    repository.Repository
-	ttl     time.Duration
+	clients     *clients.Clients
+	repo        repository.Repository
+	rules       *rules.Store
+	notifier    producers.Producer
+	deadLetters DeadLetterReplayer
+	ttl         time.Duration
+
+	historyMonths int
+	forecast      config.ForecastConfig
+	anomaly       config.AnomalyConfig
+	subscription  config.SubscriptionConfig
+	incomeMonths  int
+	healthWeights health.Weights
+	peers         config.PeersConfig
+	recompute     config.RecomputeConfig
+	aggregates    config.AggregatesConfig
+	budgetPlan    config.BudgetPlanConfig
 }
 
 // NewAnalyticsService создает новый экземпляр сервиса аналитики
-func NewAnalyticsService(clients *clients.Clients, repo repository.Repository, cfg config.RedisConfig) *AnalyticsService {
+func NewAnalyticsService(clients *clients.Clients, repo repository.Repository, rulesStore *rules.Store, notifier producers.Producer, deadLetters DeadLetterReplayer, cfg config.AppConfig) *AnalyticsService {
 	return &AnalyticsService{
-		clients: clients,
-		repo:    repo,
-		ttl:     cfg.TTL,
+		clients:       clients,
+		repo:          repo,
+		rules:         rulesStore,
+		notifier:      notifier,
+		deadLetters:   deadLetters,
+		ttl:           cfg.Redis.TTL,
+		historyMonths: cfg.History.RetentionMonths,
+		forecast:      cfg.Forecast,
+		anomaly:       cfg.Anomaly,
+		subscription:  cfg.Subscription,
+		incomeMonths:  cfg.Income.Months,
+		healthWeights: health.Weights{
+			SavingsRate:     cfg.Health.SavingsRateWeight,
+			BudgetAdherence: cfg.Health.BudgetAdherenceWeight,
+			DebtToIncome:    cfg.Health.DebtToIncomeWeight,
+			Volatility:      cfg.Health.VolatilityWeight,
+			EmergencyFund:   cfg.Health.EmergencyFundWeight,
+		},
+		peers:      cfg.Peers,
+		recompute:  cfg.Recompute,
+		aggregates: cfg.Aggregates,
+		budgetPlan: cfg.BudgetPlan,
 	}
 }
 
@@ -58,87 +102,210 @@ func (s *AnalyticsService) CalculateAndSaveRecommendations(ctx context.Context,
 
 	l.Infof("Calculating recommendations for user %s", userUID)
 
-	// Получаем данные пользователя (зарплату)
+	result, err := s.calculateRecommendations(ctx, userUID, nil)
+	if err != nil {
+		return err
+	}
+
+	// Сохраняем в Redis
+	err = s.repo.SaveRecommendations(ctx, userUID, result, s.ttl)
+	if err != nil {
+		l.Errorf("Failed to save recommendations to Redis: %v", err)
+		return fmt.Errorf("failed to save recommendations to Redis: %w", err)
+	}
+
+	s.saveHistory(ctx, userUID, result)
+	s.saveHealthScore(ctx, userUID, result)
+	s.savePeerSpending(ctx, userUID, result)
+
+	l.Infof("Successfully calculated and saved recommendations for user %s", userUID)
+	return nil
+}
+
+// GetPeriodRecommendations рассчитывает рекомендации за выбранный период. Результат не кэшируется,
+// в Redis хранятся только рекомендации за последние 30 дней, но попадает в историю
+func (s *AnalyticsService) GetPeriodRecommendations(ctx context.Context, userUID string, period *fundspb.Period) (*models.AnalyticsResult, error) {
+	log.FromContext(ctx).Infof("Calculating recommendations for user %s for period %s", userUID, period.Kind)
+
+	result, err := s.calculateRecommendations(ctx, userUID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	s.saveHistory(ctx, userUID, result)
+
+	return result, nil
+}
+
+// calculateRecommendations рассчитывает рекомендации пользователя за период,
+// nil означает последние 30 дней
+func (s *AnalyticsService) calculateRecommendations(ctx context.Context, userUID string, period *fundspb.Period) (*models.AnalyticsResult, error) {
+	l := log.FromContext(ctx)
+
+	// Получаем данные пользователя (зарплату, источник дохода и календарь)
 	userResp, err := s.clients.UserClient.GetUserById(ctx, &userpb.GetUserByIdRequest{
 		Id: userUID,
 	})
 	if err != nil {
 		l.Errorf("Failed to get user data: %v", err)
-		return fmt.Errorf("failed to get user data: %w", err)
+		return nil, fmt.Errorf("failed to get user data: %w", err)
+	}
+
+	salary, incomeSource, incomeAnalysis := s.userSalary(ctx, userUID, userResp.User)
+	l.Infof("User %s salary: %.2f (%s)", userUID, salary, incomeSource)
+
+	// Получаем агрегированные расходы по категориям за период в часовом поясе пользователя
+	summaryResp, err := s.spendingSummary(ctx, userUID, userResp.User, period)
+	if err != nil {
+		l.Errorf("Failed to get spending summary: %v", err)
+		return nil, fmt.Errorf("failed to get spending summary: %w", err)
+	}
+
+	l.Infof("Found %d spending categories for user %s", len(summaryResp.Buckets), userUID)
+
+	result := s.buildRecommendations(userUID, salary, period, summaryResp, s.debtSummary(ctx, userUID))
+	result.IncomeSource = incomeSource
+	result.Income = incomeAnalysis
+
+	return result, nil
+}
+
+// buildRecommendations формирует рекомендации по зарплате и расходам за период. debt может быть nil
+func (s *AnalyticsService) buildRecommendations(userUID string, salary float64, period *fundspb.Period, summary *fundspb.GetSpendingSummaryResponse, debt *fundspb.GetDebtSummaryResponse) *models.AnalyticsResult {
+	// Считаем проценты от зарплаты за период по категориям
+	categorySpending := s.calculateCategorySpending(summary.Buckets, periodSalary(salary, period, summary))
+
+	// Генерируем рекомендации
+	result := s.generateRecommendations(userUID, salary, categorySpending)
+	result.PeriodFrom = summary.DateFrom
+	result.PeriodTo = summary.DateTo
+
+	if debt != nil {
+		s.applyDebtLoad(result, debt)
 	}
 
-	salary := userResp.User.Salary
-	l.Infof("User %s salary: %.2f", userUID, salary)
+	return result
+}
 
-	// Получаем транзакции за последний месяц (30 дней)
-	transactionsResp, err := s.clients.FundsClient.GetUserTransactionsByPeriod(ctx, &fundspb.GetUserTransactionsByPeriodRequest{
+// debtSummary возвращает долги пользователя или nil, если их не удалось получить:
+// без долговой нагрузки рекомендации по категориям все равно полезны
+func (s *AnalyticsService) debtSummary(ctx context.Context, userUID string) *fundspb.GetDebtSummaryResponse {
+	debtResp, err := s.clients.DebtClient.GetDebtSummary(ctx, &fundspb.GetDebtSummaryRequest{
 		UserUid: userUID,
-		Days:    30,
-		Limit:   1000,
-		Offset:  0,
 	})
 	if err != nil {
-		l.Errorf("Failed to get transactions: %v", err)
-		return fmt.Errorf("failed to get transactions: %w", err)
+		log.FromContext(ctx).Warnf("Failed to get debt summary for user %s: %v", userUID, err)
+		return nil
 	}
 
-	l.Infof("Found %d transactions for user %s", len(transactionsResp.Transactions), userUID)
+	return debtResp
+}
+
+// GetLedgerRecommendations рассчитывает рекомендации для общего бюджета. Зарплаты участников
+// складываются, расходы берутся по всем транзакциям бюджета. Результат не кэшируется: состав
+// участников и их транзакции меняются независимо от событий отдельного пользователя.
+// Период считается в календаре запросившего пользователя
+func (s *AnalyticsService) GetLedgerRecommendations(ctx context.Context, userUID string, ledgerID int64, period *fundspb.Period) (*models.AnalyticsResult, error) {
+	l := log.FromContext(ctx)
+
+	l.Infof("Calculating recommendations for ledger %d requested by user %s", ledgerID, userUID)
 
-	// Получаем все категории
-	categoriesResp, err := s.clients.FundsClient.GetAllCategories(ctx, &fundspb.GetAllCategoriesRequest{})
+	// Запрос участников заодно проверяет, что пользователь состоит в бюджете
+	ledgerResp, err := s.clients.LedgerClient.GetLedgerById(ctx, &fundspb.GetLedgerByIdRequest{
+		Id:      ledgerID,
+		UserUid: userUID,
+	})
 	if err != nil {
-		l.Errorf("Failed to get categories: %v", err)
-		return fmt.Errorf("failed to get categories: %w", err)
+		return nil, fmt.Errorf("failed to get ledger: %w", err)
+	}
+
+	var (
+		salary    float64
+		requester *userpb.User
+	)
+	for _, member := range ledgerResp.Ledger.Members {
+		userResp, err := s.clients.UserClient.GetUserById(ctx, &userpb.GetUserByIdRequest{
+			Id: member.UserUid,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get data of ledger member %s: %w", member.UserUid, err)
+		}
+		salary += userResp.User.Salary
+		if member.UserUid == userUID {
+			requester = userResp.User
+		}
 	}
 
-	// Создаем карту категорий: id -> имя
-	categoryMap := make(map[int32]string)
-	for _, cat := range categoriesResp.Categories {
-		categoryMap[cat.Id] = cat.Name
+	summaryResp, err := s.clients.FundsClient.GetSpendingSummary(ctx, expensesRequest(userUID, requester, ledgerID, period))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger spending summary: %w", err)
 	}
 
-	// Группируем траты по категориям
-	categorySpending := s.calculateCategorySpending(transactionsResp.Transactions, categoryMap, salary)
+	categorySpending := s.calculateCategorySpending(summaryResp.Buckets, periodSalary(salary, period, summaryResp))
 
-	// Генерируем рекомендации
 	result := s.generateRecommendations(userUID, salary, categorySpending)
+	result.LedgerID = ledgerID
+	result.PeriodFrom = summaryResp.DateFrom
+	result.PeriodTo = summaryResp.DateTo
 
-	// Сохраняем в Redis
-	err = s.repo.SaveRecommendations(ctx, userUID, result, s.ttl)
-	if err != nil {
-		l.Errorf("Failed to save recommendations to Redis: %v", err)
-		return fmt.Errorf("failed to save recommendations to Redis: %w", err)
+	return result, nil
+}
+
+// expensesRequest формирует запрос расходов по категориям за период, по умолчанию за последние 30 дней.
+// Период считается в часовом поясе пользователя, при ненулевом ledgerID - по всем транзакциям общего бюджета
+func expensesRequest(userUID string, user *userpb.User, ledgerID int64, period *fundspb.Period) *fundspb.GetSpendingSummaryRequest {
+	if period == nil {
+		period = &fundspb.Period{Kind: "days", Days: defaultPeriodDays}
 	}
 
-	l.Infof("Successfully calculated and saved recommendations for user %s", userUID)
-	return nil
+	return &fundspb.GetSpendingSummaryRequest{
+		UserUid:  userUID,
+		GroupBy:  []string{"category"},
+		Type:     "expense",
+		LedgerId: ledgerID,
+		Period:   period,
+		Calendar: &fundspb.Calendar{
+			Timezone:  user.GetTimezone(),
+			WeekStart: user.GetWeekStart(),
+			SalaryDay: user.GetSalaryDay(),
+		},
+	}
 }
 
-// calculateCategorySpending группирует траты по категориям и вычисляет проценты от зарплаты
-func (s *AnalyticsService) calculateCategorySpending(transactions []*fundspb.Transaction, categoryMap map[int32]string, salary float64) []models.CategorySpending {
-	spendingMap := make(map[string]float64)
-
-	// Суммируем расходы по категориям
-	for _, tx := range transactions {
-		if tx.Type == "expense" {
-			categoryName := categoryMap[tx.CategoryId]
-			if categoryName != "" {
-				spendingMap[categoryName] += tx.Amount
-			}
-		}
+// periodSalary приводит месячную зарплату к длине периода. Календарный и зарплатный месяцы,
+// как и окно по умолчанию, сравниваются с зарплатой целиком, остальные периоды - пропорционально числу дней
+func periodSalary(salary float64, period *fundspb.Period, summary *fundspb.GetSpendingSummaryResponse) float64 {
+	if period == nil || period.Kind == "month" || period.Kind == "salary_month" {
+		return salary
 	}
 
-	// Конвертируем в массив с процентами
+	from, errFrom := time.Parse("2006-01-02", summary.DateFrom)
+	to, errTo := time.Parse("2006-01-02", summary.DateTo)
+	if errFrom != nil || errTo != nil {
+		return salary
+	}
+
+	days := to.Sub(from).Hours()/24 + 1
+	return salary * days / defaultPeriodDays
+}
+
+// calculateCategorySpending вычисляет проценты от зарплаты для сумм расходов по категориям
+func (s *AnalyticsService) calculateCategorySpending(buckets []*fundspb.SpendingSummaryBucket, salary float64) []models.CategorySpending {
 	var result []models.CategorySpending
-	for catName, amount := range spendingMap {
+	for _, b := range buckets {
+		if b.CategoryCode == "" || b.Total == 0 {
+			continue
+		}
+
 		percentage := 0.0
 		if salary > 0 {
-			percentage = (amount / salary) * 100
+			percentage = (b.Total / salary) * 100
 		}
 
 		result = append(result, models.CategorySpending{
-			CategoryName: catName,
-			Amount:       amount,
+			CategoryCode: b.CategoryCode,
+			CategoryName: b.CategoryName,
+			Amount:       b.Total,
 			Percentage:   percentage,
 		})
 	}
@@ -146,9 +313,11 @@ func (s *AnalyticsService) calculateCategorySpending(transactions []*fundspb.Tra
 	return result
 }
 
-// generateRecommendations генерирует список рекомендаций по категориям
+// generateRecommendations генерирует список рекомендаций по категориям по действующим правилам
 func (s *AnalyticsService) generateRecommendations(userUID string, salary float64, spending []models.CategorySpending) *models.AnalyticsResult {
-	bracket := models.GetBracketForSalary(salary)
+	ruleSet := s.rules.Current()
+
+	bracket := ruleSet.BracketForSalary(salary)
 	if bracket == nil {
 		return &models.AnalyticsResult{
 			UserUID:         userUID,
@@ -159,13 +328,15 @@ func (s *AnalyticsService) generateRecommendations(userUID string, salary float6
 			OverallMessage:  "Не удалось определить диапазон зарплаты для формирования рекомендаций.",
 			Recommendations: []models.CategoryRecommendation{},
 			CalculatedAt:    time.Now().Unix(),
+			RulesVersion:    ruleSet.Version,
 		}
 	}
+	thresholds := ruleSet.Thresholds
 
 	// Создаем карту рекомендуемых диапазонов
 	rangeMap := make(map[string]models.CategoryRange)
 	for _, cr := range bracket.Categories {
-		rangeMap[cr.Name] = cr
+		rangeMap[cr.Code] = cr
 	}
 
 	var recommendations []models.CategoryRecommendation
@@ -173,7 +344,7 @@ func (s *AnalyticsService) generateRecommendations(userUID string, salary float6
 
 	// Анализируем каждую категорию трат
 	for _, cs := range spending {
-		rec, exists := rangeMap[cs.CategoryName]
+		rec, exists := rangeMap[cs.CategoryCode]
 		if !exists {
 			continue
 		}
@@ -183,20 +354,20 @@ func (s *AnalyticsService) generateRecommendations(userUID string, salary float6
 		var message string
 		var deviation float64
 
-		// Очень плохо - превышение на 20% от верхней границы
-		criticalThreshold := rec.MaxPerc * 1.2
+		// Очень плохо - превышение верхней границы больше, чем допускают правила (по умолчанию на 20%)
+		criticalThreshold := rec.MaxPerc * thresholds.CriticalMultiplier
 
 		if cs.Percentage > criticalThreshold {
 			status = models.StatusCritical
 			deviation = cs.Percentage - rec.MaxPerc
 			message = fmt.Sprintf("Критическое превышение на %.1f%%. Необходимо срочно сократить расходы!", deviation)
 			criticalCount++
-		} else if cs.Percentage > rec.MaxPerc {
+		} else if cs.Percentage > rec.MaxPerc*thresholds.WarningMultiplier {
 			status = models.StatusWarning
 			deviation = cs.Percentage - rec.MaxPerc
 			message = fmt.Sprintf("Превышение на %.1f%%. Рекомендуем снизить расходы.", deviation)
 			warningCount++
-		} else if cs.Percentage < rec.MinPerc && rec.MinPerc > 0 {
+		} else if cs.Percentage < rec.MinPerc*thresholds.ExcellentMultiplier && rec.MinPerc > 0 {
 			status = models.StatusExcellent
 			deviation = rec.MinPerc - cs.Percentage
 			message = fmt.Sprintf("Вы очень экономны! Экономия %.1f%% от минимальной нормы.", deviation)
@@ -209,6 +380,7 @@ func (s *AnalyticsService) generateRecommendations(userUID string, salary float6
 		}
 
 		recommendations = append(recommendations, models.CategoryRecommendation{
+			CategoryCode:     cs.CategoryCode,
 			CategoryName:     cs.CategoryName,
 			ActualAmount:     cs.Amount,
 			ActualPercentage: cs.Percentage,
@@ -224,16 +396,10 @@ func (s *AnalyticsService) generateRecommendations(userUID string, salary float6
 	overallStatus := s.determineOverallStatus(excellentCount, normalCount, warningCount, criticalCount)
 	overallMessage := s.generateOverallMessage(overallStatus, excellentCount, normalCount, warningCount, criticalCount)
 
-	// Формируем диапазон зарплаты
-	salaryBracket := fmt.Sprintf("%.0f-%.0f", bracket.MinSalary, bracket.MaxSalary)
-	if bracket.MaxSalary >= 999999999 {
-		salaryBracket = fmt.Sprintf("%.0f+", bracket.MinSalary)
-	}
-
 	return &models.AnalyticsResult{
 		UserUID:         userUID,
 		Salary:          salary,
-		SalaryBracket:   salaryBracket,
+		SalaryBracket:   bracket.Label(),
 		TotalCategories: len(recommendations),
 		ExcellentCount:  excellentCount,
 		NormalCount:     normalCount,
@@ -243,6 +409,48 @@ func (s *AnalyticsService) generateRecommendations(userUID string, salary float6
 		OverallMessage:  overallMessage,
 		Recommendations: recommendations,
 		CalculatedAt:    time.Now().Unix(),
+		RulesVersion:    ruleSet.Version,
+	}
+}
+
+// applyDebtLoad рассчитывает отношение платежей по долгам к зарплате и учитывает его в общем статусе
+func (s *AnalyticsService) applyDebtLoad(result *models.AnalyticsResult, summary *fundspb.GetDebtSummaryResponse) {
+	ratio := 0.0
+	if result.Salary > 0 {
+		ratio = summary.MonthlyPayments / result.Salary * 100
+	}
+
+	debt := &models.DebtLoad{
+		TotalOwed:         summary.TotalOwed,
+		MonthlyPayments:   summary.MonthlyPayments,
+		DebtToIncomeRatio: ratio,
+		DebtFreeDate:      summary.DebtFreeDate,
+	}
+
+	switch {
+	case summary.MonthlyPayments == 0:
+		debt.Status = models.StatusExcellent
+		debt.Message = "У вас нет регулярных платежей по долгам."
+	case result.Salary <= 0 || ratio > models.DebtRatioWarning:
+		debt.Status = models.StatusCritical
+		debt.Message = fmt.Sprintf("Платежи по долгам составляют %.1f%% от зарплаты. Не берите новых кредитов и рассмотрите досрочное погашение или рефинансирование.", ratio)
+	case ratio > models.DebtRatioNormal:
+		debt.Status = models.StatusWarning
+		debt.Message = fmt.Sprintf("Платежи по долгам составляют %.1f%% от зарплаты. Нагрузка выше рекомендуемой, избегайте новых кредитов.", ratio)
+	case ratio > models.DebtRatioExcellent:
+		debt.Status = models.StatusNormal
+		debt.Message = fmt.Sprintf("Платежи по долгам составляют %.1f%% от зарплаты. Нагрузка в пределах нормы.", ratio)
+	default:
+		debt.Status = models.StatusExcellent
+		debt.Message = fmt.Sprintf("Платежи по долгам составляют всего %.1f%% от зарплаты.", ratio)
+	}
+
+	result.Debt = debt
+
+	// Критическая долговая нагрузка требует внимания, даже если расходы по категориям в норме
+	if debt.Status == models.StatusCritical && result.OverallStatus != models.OverallStatusCritical {
+		result.OverallStatus = models.OverallStatusAttentionRequired
+		result.OverallMessage = s.generateOverallMessage(result.OverallStatus, result.ExcellentCount, result.NormalCount, result.WarningCount, result.CriticalCount)
 	}
 }
 
@@ -282,18 +490,40 @@ func (s *AnalyticsService) generateOverallMessage(status string, excellent, norm
 }
 
 // ProcessAnalyticsEvent обрабатывает событие аналитики из Kafka
-func (s *AnalyticsService) ProcessAnalyticsEvent(ctx context.Context, userUID string) error {
+func (s *AnalyticsService) ProcessAnalyticsEvent(ctx context.Context, msg models.KafkaAnalyticsMessage) error {
 	l := log.FromContext(ctx)
+	userUID := msg.UserUID
 
 	l.Infof("Processing analytics event for user: %s", userUID)
 
-	// Рассчитываем и сохраняем рекомендации в Redis
-	err := s.CalculateAndSaveRecommendations(ctx, userUID)
+	// Аномалии ищутся только в новых и измененных операциях. Они не зависят от рекомендаций,
+	// поэтому их ошибка только логируется
+	if msg.Action == models.ActionTransactionsCreated || msg.Action == models.ActionTransactionsUpdated {
+		if err := s.DetectAnomalies(ctx, userUID, msg.Action, msg.Transactions); err != nil {
+			l.Warnf("Failed to detect anomalies for user %s: %v", userUID, err)
+		}
+	}
+
+	// Рекомендации и прогноз пересчитываются отложенно, чтобы серия событий, например импорт выписки,
+	// вызвала один пересчет
+	coalesced, err := s.repo.ScheduleRecompute(ctx, userUID, time.Now(), s.recompute.QuietWindow, s.recompute.MaxDelay)
 	if err != nil {
-		l.Errorf("Failed to calculate and save recommendations: %v", err)
-		return fmt.Errorf("failed to calculate and save recommendations: %w", err)
+		l.Errorf("Failed to schedule recompute: %v", err)
+		return err
 	}
 
-	l.Infof("Successfully processed analytics event and saved recommendations for user %s", userUID)
+	// Накопленные расходы обновляются после планирования: если планирование не удалось, событие
+	// повторится до применения изменений. Изменения из повторно доставленного события пропускаются
+	// по его идентификатору, пересчет при повторе только присоединится к запланированному
+	if err := s.applySpendingEvent(ctx, msg); err != nil {
+		l.Errorf("Failed to apply spending changes: %v", err)
+		return err
+	}
+
+	if coalesced {
+		l.Infof("Analytics event for user %s joined the pending recompute", userUID)
+	} else {
+		l.Infof("Scheduled analytics recompute for user %s", userUID)
+	}
 	return nil
 }
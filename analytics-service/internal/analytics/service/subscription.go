@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/subscription"
+	fundspb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/funds_service"
+	userpb "github.com/cg-2025-crutch/backend/analytics-service/internal/grpc/gen/user_service"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+// subscriptionHistoryLimit ограничивает число расходов, в которых ищутся подписки
+const subscriptionHistoryLimit = 5000
+
+// GetDetectedSubscriptions находит регулярные платежи пользователя: сначала действующие, затем
+// по убыванию стоимости в месяц
+func (s *AnalyticsService) GetDetectedSubscriptions(ctx context.Context, userUID string) (*models.DetectedSubscriptions, error) {
+	userResp, err := s.clients.UserClient.GetUserById(ctx, &userpb.GetUserByIdRequest{
+		Id: userUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user data: %w", err)
+	}
+
+	resp, err := s.clients.FundsClient.GetUserTransactionsByPeriod(ctx, &fundspb.GetUserTransactionsByPeriodRequest{
+		UserUid: userUID,
+		Days:    int32(s.subscription.HistoryDays),
+		Limit:   subscriptionHistoryLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	categoryNames := make(map[int32]string)
+	charges := make([]subscription.Charge, 0, len(resp.Transactions))
+	for _, t := range resp.Transactions {
+		if t.Type != "expense" {
+			continue
+		}
+		date, err := time.Parse(time.DateOnly, t.TransactionDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction date %q: %w", t.TransactionDate, err)
+		}
+		charges = append(charges, subscription.Charge{
+			CategoryID: t.CategoryId,
+			Amount:     t.Amount,
+			Title:      t.Title,
+			Date:       date,
+		})
+		if t.Category != nil {
+			categoryNames[t.CategoryId] = t.Category.Name
+		}
+	}
+
+	now := time.Now().In(userLocation(userResp.User.GetTimezone()))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	detected := subscription.Detect(charges, today, subscription.Config{
+		AmountTolerance: s.subscription.AmountTolerance,
+	})
+
+	result := &models.DetectedSubscriptions{
+		UserUID:       userUID,
+		Subscriptions: make([]models.Subscription, 0, len(detected)),
+		CalculatedAt:  time.Now().Unix(),
+	}
+	for _, d := range detected {
+		first, last := d.Charges[0], d.Charges[len(d.Charges)-1]
+
+		sub := models.Subscription{
+			Title:        last.Title,
+			CategoryID:   last.CategoryID,
+			CategoryName: categoryNames[last.CategoryID],
+			Period:       d.Period,
+			Amount:       last.Amount,
+			MonthlyCost:  round2(d.MonthlyCost),
+			Charges:      len(d.Charges),
+			FirstCharge:  first.Date.Format(time.DateOnly),
+			LastCharge:   last.Date.Format(time.DateOnly),
+			NextCharge:   d.NextCharge.Format(time.DateOnly),
+			Status:       models.SubscriptionActive,
+			PriceChanges: d.PriceChanges,
+		}
+		if d.Cancelled {
+			sub.Status = models.SubscriptionPossiblyCancelled
+		} else {
+			result.TotalMonthlyCost += sub.MonthlyCost
+		}
+
+		result.Subscriptions = append(result.Subscriptions, sub)
+	}
+	result.TotalMonthlyCost = round2(result.TotalMonthlyCost)
+
+	sort.Slice(result.Subscriptions, func(i, j int) bool {
+		a, b := result.Subscriptions[i], result.Subscriptions[j]
+		if a.Status != b.Status {
+			return a.Status == models.SubscriptionActive
+		}
+		if a.MonthlyCost != b.MonthlyCost {
+			return a.MonthlyCost > b.MonthlyCost
+		}
+		return a.Title < b.Title
+	})
+
+	return result, nil
+}
@@ -0,0 +1,212 @@
+// Package subscription находит регулярные платежи: расходы с одним названием и близкой суммой,
+// которые повторяются раз в неделю, месяц или год
+package subscription
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/models"
+)
+
+const (
+	// regularShare - доля интервалов между списаниями, которые должны попадать в период
+	regularShare = 0.75
+	// priceEpsilon - относительное изменение суммы, которое считается изменением цены
+	priceEpsilon = 0.005
+)
+
+// Config - параметры детектора
+type Config struct {
+	// AmountTolerance - насколько суммы одного платежа могут отличаться от минимальной, доля.
+	// Покрывает повышение цены подписки, но отделяет разные тарифы одного сервиса
+	AmountTolerance float64
+}
+
+// Charge - расход пользователя
+type Charge struct {
+	CategoryID int32
+	Amount     float64
+	Title      string
+	Date       time.Time
+}
+
+// Detected - найденный регулярный платеж
+type Detected struct {
+	Period       string
+	Charges      []Charge // По дате списания
+	NextCharge   time.Time
+	Cancelled    bool // Очередное списание пропущено
+	MonthlyCost  float64
+	PriceChanges []models.PriceChange
+}
+
+// period - периодичность платежа и допустимый разброс интервалов между списаниями в днях
+type period struct {
+	name       string
+	minDays    int
+	maxDays    int
+	graceDays  int     // Задержка списания, после которой подписка считается, возможно, отмененной
+	perMonth   float64 // Сколько списаний приходится на месяц
+	minCharges int
+	next       func(time.Time) time.Time
+}
+
+var periods = []period{
+	{
+		name: models.SubscriptionWeekly, minDays: 6, maxDays: 8, graceDays: 3, perMonth: 52.0 / 12, minCharges: 3,
+		next: func(t time.Time) time.Time { return t.AddDate(0, 0, 7) },
+	},
+	{
+		name: models.SubscriptionMonthly, minDays: 26, maxDays: 35, graceDays: 7, perMonth: 1, minCharges: 3,
+		next: func(t time.Time) time.Time { return addMonths(t, 1) },
+	},
+	{
+		name: models.SubscriptionYearly, minDays: 350, maxDays: 380, graceDays: 14, perMonth: 1.0 / 12, minCharges: 2,
+		next: func(t time.Time) time.Time { return addMonths(t, 12) },
+	},
+}
+
+// Detect группирует расходы по нормализованному названию и сумме и возвращает группы, списания
+// в которых повторяются с постоянным периодом. Расходы без названия не рассматриваются
+func Detect(charges []Charge, today time.Time, cfg Config) []Detected {
+	byTitle := make(map[string][]Charge)
+	for _, c := range charges {
+		title := models.NormalizeTitle(c.Title)
+		if title == "" || c.Amount <= 0 {
+			continue
+		}
+		byTitle[title] = append(byTitle[title], c)
+	}
+
+	var result []Detected
+	for _, group := range byTitle {
+		for _, cluster := range clusterByAmount(group, cfg.AmountTolerance) {
+			if d, ok := detectPeriod(cluster, today); ok {
+				result = append(result, d)
+			}
+		}
+	}
+
+	return result
+}
+
+// clusterByAmount делит расходы на группы, в которых суммы превышают минимальную не больше чем на tolerance.
+// Группы возвращаются упорядоченными по дате, из нескольких списаний за день остается одно
+func clusterByAmount(charges []Charge, tolerance float64) [][]Charge {
+	sort.Slice(charges, func(i, j int) bool {
+		return charges[i].Amount < charges[j].Amount
+	})
+
+	var clusters [][]Charge
+	start := 0
+	for i := 1; i <= len(charges); i++ {
+		if i < len(charges) && charges[i].Amount <= charges[start].Amount*(1+tolerance) {
+			continue
+		}
+		clusters = append(clusters, byDate(charges[start:i]))
+		start = i
+	}
+
+	return clusters
+}
+
+func byDate(charges []Charge) []Charge {
+	sorted := append([]Charge(nil), charges...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	result := sorted[:0]
+	for _, c := range sorted {
+		if n := len(result); n > 0 && result[n-1].Date.Equal(c.Date) {
+			continue
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+// detectPeriod ищет период, в который попадает медианный интервал между списаниями, и проверяет,
+// что списания регулярны, а сумма меняется редко, как у подписки, а не как у обычных покупок
+func detectPeriod(charges []Charge, today time.Time) (Detected, bool) {
+	if len(charges) < 2 {
+		return Detected{}, false
+	}
+
+	intervals := make([]int, 0, len(charges)-1)
+	for i := 1; i < len(charges); i++ {
+		intervals = append(intervals, int(charges[i].Date.Sub(charges[i-1].Date).Hours()/24))
+	}
+
+	sorted := append([]int(nil), intervals...)
+	sort.Ints(sorted)
+	median := sorted[len(sorted)/2]
+
+	for _, p := range periods {
+		if median < p.minDays || median > p.maxDays || len(charges) < p.minCharges {
+			continue
+		}
+
+		regular := 0
+		for _, days := range intervals {
+			if days >= p.minDays && days <= p.maxDays {
+				regular++
+			}
+		}
+		if float64(regular) < regularShare*float64(len(intervals)) {
+			return Detected{}, false
+		}
+
+		changes := priceChanges(charges)
+		if len(changes) > maxPriceChanges(len(intervals)) {
+			return Detected{}, false
+		}
+
+		last := charges[len(charges)-1]
+		next := p.next(last.Date)
+		return Detected{
+			Period:       p.name,
+			Charges:      charges,
+			NextCharge:   next,
+			Cancelled:    today.After(next.AddDate(0, 0, p.graceDays)),
+			MonthlyCost:  last.Amount * p.perMonth,
+			PriceChanges: changes,
+		}, true
+	}
+
+	return Detected{}, false
+}
+
+// maxPriceChanges - сколько раз может меняться сумма: у подписки цена меняется не чаще чем в каждом
+// третьем списании, а из двух списаний нельзя отличить повышение цены от разных покупок
+func maxPriceChanges(intervals int) int {
+	if intervals < 2 {
+		return 0
+	}
+	return max(1, intervals/3)
+}
+
+func priceChanges(charges []Charge) []models.PriceChange {
+	var changes []models.PriceChange
+	for i := 1; i < len(charges); i++ {
+		prev, cur := charges[i-1].Amount, charges[i].Amount
+		if math.Abs(cur-prev) <= priceEpsilon*prev {
+			continue
+		}
+		changes = append(changes, models.PriceChange{
+			Date:      charges[i].Date.Format(time.DateOnly),
+			OldAmount: prev,
+			NewAmount: cur,
+		})
+	}
+	return changes
+}
+
+// addMonths прибавляет месяцы, сохраняя число месяца, а в коротких месяцах переносит его на последний день
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, months, 0)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), lastDay)-1)
+}
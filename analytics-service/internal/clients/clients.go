@@ -12,8 +12,11 @@ import (
 
 // Clients содержит клиенты для всех внешних gRPC сервисов
 type Clients struct {
-	UserClient  userpb.UserServiceClient
-	FundsClient fundspb.FundsServiceClient
+	UserClient   userpb.UserServiceClient
+	FundsClient  fundspb.FundsServiceClient
+	DebtClient   fundspb.DebtServiceClient
+	LedgerClient fundspb.LedgerServiceClient
+	BudgetClient fundspb.BudgetServiceClient
 
 	userConn  *grpc.ClientConn
 	fundsConn *grpc.ClientConn
@@ -51,6 +54,9 @@ func NewClients(userServiceAddr, fundsServiceAddr string) (*Clients, error) {
 	}
 	clients.fundsConn = fundsConn
 	clients.FundsClient = fundspb.NewFundsServiceClient(fundsConn)
+	clients.DebtClient = fundspb.NewDebtServiceClient(fundsConn)
+	clients.LedgerClient = fundspb.NewLedgerServiceClient(fundsConn)
+	clients.BudgetClient = fundspb.NewBudgetServiceClient(fundsConn)
 
 	return clients, nil
 }
@@ -13,6 +13,17 @@ type AppConfig struct {
 	Logger           LoggerConfig
 	Kafka            KafkaConfig
 	Redis            RedisConfig
+	Rules            RulesConfig
+	History          HistoryConfig
+	Forecast         ForecastConfig
+	Anomaly          AnomalyConfig
+	Subscription     SubscriptionConfig
+	Income           IncomeConfig
+	Health           HealthConfig
+	Peers            PeersConfig
+	Recompute        RecomputeConfig
+	Aggregates       AggregatesConfig
+	BudgetPlan       BudgetPlanConfig
 	UserServiceAddr  string `env:"USER_SERVICE_ADDR"`
 	FundsServiceAddr string `env:"FUNDS_SERVICE_ADDR"`
 }
@@ -30,7 +41,18 @@ type LoggerConfig struct {
 type KafkaConfig struct {
 	Brokers      []string      `env:"KAFKA_BROKERS" envDefault:"localhost:9092" envSeparator:","`
 	ConsTopic    []string      `env:"KAFKA_CONS_TOPIC" envDefault:"analytics" envSeparator:","`
+	NotifyTopic  string        `env:"KAFKA_NOTIFY_TOPIC" envDefault:"webpush"`
 	ConnDeadline time.Duration `env:"KAFKA_CONN_DEADLINE" envDefault:"10s"`
+	Retry        KafkaRetryConfig
+}
+
+// KafkaRetryConfig задает повторы обработки сообщений: Attempts попыток на месте с паузой от Backoff
+// до MaxBackoff, затем по топику повторов <topic>.retry.N на каждую задержку из Delays, затем <topic>.dlq
+type KafkaRetryConfig struct {
+	Attempts   int             `env:"KAFKA_RETRY_ATTEMPTS" envDefault:"3"`
+	Backoff    time.Duration   `env:"KAFKA_RETRY_BACKOFF" envDefault:"500ms"`
+	MaxBackoff time.Duration   `env:"KAFKA_RETRY_MAX_BACKOFF" envDefault:"10s"`
+	Delays     []time.Duration `env:"KAFKA_RETRY_DELAYS" envDefault:"1m,10m" envSeparator:","`
 }
 
 type RedisConfig struct {
@@ -40,6 +62,101 @@ type RedisConfig struct {
 	TTL          time.Duration `env:"REDIS_TTL" envDefault:"24h"`
 }
 
+// RulesConfig задает источник правил рекомендаций. Файл правил импортируется новой версией
+// при каждом изменении, без файла хранилище заполняется встроенными правилами
+type RulesConfig struct {
+	File           string        `env:"RULES_FILE" envDefault:""`
+	ReloadInterval time.Duration `env:"RULES_RELOAD_INTERVAL" envDefault:"30s"`
+}
+
+// HistoryConfig задает, сколько месяцев хранится история рекомендаций пользователей
+type HistoryConfig struct {
+	RetentionMonths int `env:"HISTORY_RETENTION_MONTHS" envDefault:"24"`
+}
+
+// ForecastConfig задает прогноз расходов на конец месяца: сколько прошлых месяцев учитывать,
+// с какой вероятности превышение считается вероятным и с какого дня месяца о нем уведомлять
+type ForecastConfig struct {
+	HistoryMonths     int     `env:"FORECAST_HISTORY_MONTHS" envDefault:"3"`
+	LikelyProbability float64 `env:"FORECAST_LIKELY_PROBABILITY" envDefault:"0.7"`
+	NotifyFromDay     int     `env:"FORECAST_NOTIFY_FROM_DAY" envDefault:"7"`
+}
+
+// AnomalyConfig задает поиск необычных операций: за сколько дней брать историю, порог робастной
+// z-оценки, минимум операций категории для сравнения сумм и окно поиска дублей. Push-уведомления
+// об аномалиях отправляются только при включенном Notify
+type AnomalyConfig struct {
+	HistoryDays     int           `env:"ANOMALY_HISTORY_DAYS" envDefault:"180"`
+	Threshold       float64       `env:"ANOMALY_THRESHOLD" envDefault:"3.5"`
+	MinSamples      int           `env:"ANOMALY_MIN_SAMPLES" envDefault:"5"`
+	DuplicateWindow time.Duration `env:"ANOMALY_DUPLICATE_WINDOW" envDefault:"10m"`
+	Keep            int           `env:"ANOMALY_KEEP" envDefault:"200"`
+	Notify          bool          `env:"ANOMALY_NOTIFY" envDefault:"false"`
+}
+
+// SubscriptionConfig задает поиск подписок: за сколько дней брать расходы, чтобы найти и годовые
+// платежи, и насколько могут отличаться суммы списаний одной подписки
+type SubscriptionConfig struct {
+	HistoryDays     int     `env:"SUBSCRIPTION_HISTORY_DAYS" envDefault:"400"`
+	AmountTolerance float64 `env:"SUBSCRIPTION_AMOUNT_TOLERANCE" envDefault:"0.2"`
+}
+
+// IncomeConfig задает, за сколько полных месяцев считается фактический доход по операциям
+type IncomeConfig struct {
+	Months int `env:"INCOME_MONTHS" envDefault:"6"`
+}
+
+// HealthConfig задает веса компонентов оценки финансового здоровья. Веса нормируются,
+// поэтому важны только их соотношения
+type HealthConfig struct {
+	SavingsRateWeight     float64 `env:"HEALTH_WEIGHT_SAVINGS_RATE" envDefault:"0.25"`
+	BudgetAdherenceWeight float64 `env:"HEALTH_WEIGHT_BUDGET_ADHERENCE" envDefault:"0.25"`
+	DebtToIncomeWeight    float64 `env:"HEALTH_WEIGHT_DEBT_TO_INCOME" envDefault:"0.2"`
+	VolatilityWeight      float64 `env:"HEALTH_WEIGHT_SPENDING_VOLATILITY" envDefault:"0.1"`
+	EmergencyFundWeight   float64 `env:"HEALTH_WEIGHT_EMERGENCY_FUND" envDefault:"0.2"`
+}
+
+// PeersConfig задает сравнение с похожими пользователями: как часто пересчитываются группы,
+// сколько в группе должно быть людей и сколько из них должны тратить в категории, чтобы ее распределение
+// публиковалось, и через сколько не обновлявшиеся расходы пользователя перестают учитываться
+type PeersConfig struct {
+	AggregationInterval time.Duration `env:"PEERS_AGGREGATION_INTERVAL" envDefault:"1h"`
+	MinCohortSize       int           `env:"PEERS_MIN_COHORT_SIZE" envDefault:"20"`
+	MinSpenders         int           `env:"PEERS_MIN_SPENDERS" envDefault:"5"`
+	SpendingMaxAge      time.Duration `env:"PEERS_SPENDING_MAX_AGE" envDefault:"1440h"`
+}
+
+// RecomputeConfig задает отложенный пересчет аналитики по событиям: пересчет выполняется после
+// QuietWindow без новых событий пользователя, но не позже MaxDelay после первого из них. Очередь
+// проверяется каждые PollInterval, за раз забирается до BatchSize пользователей, которые пересчитываются
+// в Workers потоков. Пересчет, не завершенный за Lease, повторяется. Неудачный пересчет повторяется
+// через RetryDelay с удвоением паузы, всего до MaxAttempts попыток
+type RecomputeConfig struct {
+	QuietWindow  time.Duration `env:"RECOMPUTE_QUIET_WINDOW" envDefault:"3s"`
+	MaxDelay     time.Duration `env:"RECOMPUTE_MAX_DELAY" envDefault:"30s"`
+	PollInterval time.Duration `env:"RECOMPUTE_POLL_INTERVAL" envDefault:"500ms"`
+	BatchSize    int           `env:"RECOMPUTE_BATCH_SIZE" envDefault:"50"`
+	Workers      int           `env:"RECOMPUTE_WORKERS" envDefault:"4"`
+	Lease        time.Duration `env:"RECOMPUTE_LEASE" envDefault:"1m"`
+	MaxAttempts  int           `env:"RECOMPUTE_MAX_ATTEMPTS" envDefault:"5"`
+	RetryDelay   time.Duration `env:"RECOMPUTE_RETRY_DELAY" envDefault:"30s"`
+}
+
+// AggregatesConfig задает накопленные расходы по дням и категориям, из которых считаются рекомендации
+// за последние 30 дней: расходы хранятся за Days дней и раз в RebuildInterval пересобираются по funds-service,
+// чтобы исправить расхождения из-за пропущенных событий
+type AggregatesConfig struct {
+	Days            int           `env:"AGGREGATES_DAYS" envDefault:"40"`
+	RebuildInterval time.Duration `env:"AGGREGATES_REBUILD_INTERVAL" envDefault:"24h"`
+}
+
+// BudgetPlanConfig задает план бюджета на следующий месяц: за сколько полных месяцев берутся средние
+// расходы по категориям и сколько предложенный план можно принять
+type BudgetPlanConfig struct {
+	HistoryMonths int           `env:"BUDGET_PLAN_HISTORY_MONTHS" envDefault:"3"`
+	TTL           time.Duration `env:"BUDGET_PLAN_TTL" envDefault:"24h"`
+}
+
 func New() (AppConfig, error) {
 	var cfg AppConfig
 	_ = godotenv.Load()
@@ -19,7 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AnalyticsService_GetRecommendations_FullMethodName = "/analytics_service.AnalyticsService/GetRecommendations"
+	AnalyticsService_GetRecommendations_FullMethodName        = "/analytics_service.AnalyticsService/GetRecommendations"
+	AnalyticsService_GetRecommendationHistory_FullMethodName  = "/analytics_service.AnalyticsService/GetRecommendationHistory"
+	AnalyticsService_GetCategoryTrend_FullMethodName          = "/analytics_service.AnalyticsService/GetCategoryTrend"
+	AnalyticsService_GetForecast_FullMethodName               = "/analytics_service.AnalyticsService/GetForecast"
+	AnalyticsService_GetAnomalies_FullMethodName              = "/analytics_service.AnalyticsService/GetAnomalies"
+	AnalyticsService_GetDetectedSubscriptions_FullMethodName  = "/analytics_service.AnalyticsService/GetDetectedSubscriptions"
+	AnalyticsService_GetHealthScore_FullMethodName            = "/analytics_service.AnalyticsService/GetHealthScore"
+	AnalyticsService_GetPeerComparison_FullMethodName         = "/analytics_service.AnalyticsService/GetPeerComparison"
+	AnalyticsService_SimulateScenario_FullMethodName          = "/analytics_service.AnalyticsService/SimulateScenario"
+	AnalyticsService_GenerateBudgetPlan_FullMethodName        = "/analytics_service.AnalyticsService/GenerateBudgetPlan"
+	AnalyticsService_AcceptBudgetPlan_FullMethodName          = "/analytics_service.AnalyticsService/AcceptBudgetPlan"
+	AnalyticsService_GetRecommendationRules_FullMethodName    = "/analytics_service.AnalyticsService/GetRecommendationRules"
+	AnalyticsService_UpdateRecommendationRules_FullMethodName = "/analytics_service.AnalyticsService/UpdateRecommendationRules"
+	AnalyticsService_GetRecomputeMetrics_FullMethodName       = "/analytics_service.AnalyticsService/GetRecomputeMetrics"
+	AnalyticsService_ReplayDeadLetters_FullMethodName         = "/analytics_service.AnalyticsService/ReplayDeadLetters"
 )
 
 // AnalyticsServiceClient is the client API for AnalyticsService service.
@@ -27,6 +41,23 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AnalyticsServiceClient interface {
 	GetRecommendations(ctx context.Context, in *GetRecommendationsReq, opts ...grpc.CallOption) (*GetRecommendationsResp, error)
+	GetRecommendationHistory(ctx context.Context, in *GetRecommendationHistoryReq, opts ...grpc.CallOption) (*GetRecommendationHistoryResp, error)
+	GetCategoryTrend(ctx context.Context, in *GetCategoryTrendReq, opts ...grpc.CallOption) (*GetCategoryTrendResp, error)
+	GetForecast(ctx context.Context, in *GetForecastReq, opts ...grpc.CallOption) (*GetForecastResp, error)
+	GetAnomalies(ctx context.Context, in *GetAnomaliesReq, opts ...grpc.CallOption) (*GetAnomaliesResp, error)
+	GetDetectedSubscriptions(ctx context.Context, in *GetDetectedSubscriptionsReq, opts ...grpc.CallOption) (*GetDetectedSubscriptionsResp, error)
+	GetHealthScore(ctx context.Context, in *GetHealthScoreReq, opts ...grpc.CallOption) (*GetHealthScoreResp, error)
+	GetPeerComparison(ctx context.Context, in *GetPeerComparisonReq, opts ...grpc.CallOption) (*GetPeerComparisonResp, error)
+	SimulateScenario(ctx context.Context, in *SimulateScenarioReq, opts ...grpc.CallOption) (*SimulateScenarioResp, error)
+	GenerateBudgetPlan(ctx context.Context, in *GenerateBudgetPlanReq, opts ...grpc.CallOption) (*BudgetPlan, error)
+	AcceptBudgetPlan(ctx context.Context, in *AcceptBudgetPlanReq, opts ...grpc.CallOption) (*AcceptBudgetPlanResp, error)
+	// Правила рекомендаций, изменение доступно только администраторам
+	GetRecommendationRules(ctx context.Context, in *GetRecommendationRulesReq, opts ...grpc.CallOption) (*RecommendationRules, error)
+	UpdateRecommendationRules(ctx context.Context, in *UpdateRecommendationRulesReq, opts ...grpc.CallOption) (*RecommendationRules, error)
+	// Метрики отложенного пересчета аналитики по событиям, доступны только администраторам
+	GetRecomputeMetrics(ctx context.Context, in *GetRecomputeMetricsReq, opts ...grpc.CallOption) (*RecomputeMetrics, error)
+	// Повторная отправка сообщений из dead-letter топиков в исходные топики, доступна только администраторам
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersReq, opts ...grpc.CallOption) (*ReplayDeadLettersResp, error)
 }
 
 type analyticsServiceClient struct {
@@ -47,11 +78,168 @@ func (c *analyticsServiceClient) GetRecommendations(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *analyticsServiceClient) GetRecommendationHistory(ctx context.Context, in *GetRecommendationHistoryReq, opts ...grpc.CallOption) (*GetRecommendationHistoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecommendationHistoryResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetRecommendationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetCategoryTrend(ctx context.Context, in *GetCategoryTrendReq, opts ...grpc.CallOption) (*GetCategoryTrendResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryTrendResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetCategoryTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetForecast(ctx context.Context, in *GetForecastReq, opts ...grpc.CallOption) (*GetForecastResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetForecastResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetAnomalies(ctx context.Context, in *GetAnomaliesReq, opts ...grpc.CallOption) (*GetAnomaliesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnomaliesResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetDetectedSubscriptions(ctx context.Context, in *GetDetectedSubscriptionsReq, opts ...grpc.CallOption) (*GetDetectedSubscriptionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDetectedSubscriptionsResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetDetectedSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetHealthScore(ctx context.Context, in *GetHealthScoreReq, opts ...grpc.CallOption) (*GetHealthScoreResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHealthScoreResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetHealthScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetPeerComparison(ctx context.Context, in *GetPeerComparisonReq, opts ...grpc.CallOption) (*GetPeerComparisonResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPeerComparisonResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetPeerComparison_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) SimulateScenario(ctx context.Context, in *SimulateScenarioReq, opts ...grpc.CallOption) (*SimulateScenarioResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateScenarioResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_SimulateScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GenerateBudgetPlan(ctx context.Context, in *GenerateBudgetPlanReq, opts ...grpc.CallOption) (*BudgetPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetPlan)
+	err := c.cc.Invoke(ctx, AnalyticsService_GenerateBudgetPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) AcceptBudgetPlan(ctx context.Context, in *AcceptBudgetPlanReq, opts ...grpc.CallOption) (*AcceptBudgetPlanResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptBudgetPlanResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_AcceptBudgetPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetRecommendationRules(ctx context.Context, in *GetRecommendationRulesReq, opts ...grpc.CallOption) (*RecommendationRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendationRules)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetRecommendationRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) UpdateRecommendationRules(ctx context.Context, in *UpdateRecommendationRulesReq, opts ...grpc.CallOption) (*RecommendationRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendationRules)
+	err := c.cc.Invoke(ctx, AnalyticsService_UpdateRecommendationRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetRecomputeMetrics(ctx context.Context, in *GetRecomputeMetricsReq, opts ...grpc.CallOption) (*RecomputeMetrics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeMetrics)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetRecomputeMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersReq, opts ...grpc.CallOption) (*ReplayDeadLettersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLettersResp)
+	err := c.cc.Invoke(ctx, AnalyticsService_ReplayDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnalyticsServiceServer is the server API for AnalyticsService service.
 // All implementations must embed UnimplementedAnalyticsServiceServer
 // for forward compatibility.
 type AnalyticsServiceServer interface {
 	GetRecommendations(context.Context, *GetRecommendationsReq) (*GetRecommendationsResp, error)
+	GetRecommendationHistory(context.Context, *GetRecommendationHistoryReq) (*GetRecommendationHistoryResp, error)
+	GetCategoryTrend(context.Context, *GetCategoryTrendReq) (*GetCategoryTrendResp, error)
+	GetForecast(context.Context, *GetForecastReq) (*GetForecastResp, error)
+	GetAnomalies(context.Context, *GetAnomaliesReq) (*GetAnomaliesResp, error)
+	GetDetectedSubscriptions(context.Context, *GetDetectedSubscriptionsReq) (*GetDetectedSubscriptionsResp, error)
+	GetHealthScore(context.Context, *GetHealthScoreReq) (*GetHealthScoreResp, error)
+	GetPeerComparison(context.Context, *GetPeerComparisonReq) (*GetPeerComparisonResp, error)
+	SimulateScenario(context.Context, *SimulateScenarioReq) (*SimulateScenarioResp, error)
+	GenerateBudgetPlan(context.Context, *GenerateBudgetPlanReq) (*BudgetPlan, error)
+	AcceptBudgetPlan(context.Context, *AcceptBudgetPlanReq) (*AcceptBudgetPlanResp, error)
+	// Правила рекомендаций, изменение доступно только администраторам
+	GetRecommendationRules(context.Context, *GetRecommendationRulesReq) (*RecommendationRules, error)
+	UpdateRecommendationRules(context.Context, *UpdateRecommendationRulesReq) (*RecommendationRules, error)
+	// Метрики отложенного пересчета аналитики по событиям, доступны только администраторам
+	GetRecomputeMetrics(context.Context, *GetRecomputeMetricsReq) (*RecomputeMetrics, error)
+	// Повторная отправка сообщений из dead-letter топиков в исходные топики, доступна только администраторам
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersReq) (*ReplayDeadLettersResp, error)
 	mustEmbedUnimplementedAnalyticsServiceServer()
 }
 
@@ -65,6 +253,48 @@ type UnimplementedAnalyticsServiceServer struct{}
 func (UnimplementedAnalyticsServiceServer) GetRecommendations(context.Context, *GetRecommendationsReq) (*GetRecommendationsResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetRecommendations not implemented")
 }
+func (UnimplementedAnalyticsServiceServer) GetRecommendationHistory(context.Context, *GetRecommendationHistoryReq) (*GetRecommendationHistoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecommendationHistory not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetCategoryTrend(context.Context, *GetCategoryTrendReq) (*GetCategoryTrendResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryTrend not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetForecast(context.Context, *GetForecastReq) (*GetForecastResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetForecast not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetAnomalies(context.Context, *GetAnomaliesReq) (*GetAnomaliesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAnomalies not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetDetectedSubscriptions(context.Context, *GetDetectedSubscriptionsReq) (*GetDetectedSubscriptionsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDetectedSubscriptions not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetHealthScore(context.Context, *GetHealthScoreReq) (*GetHealthScoreResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHealthScore not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetPeerComparison(context.Context, *GetPeerComparisonReq) (*GetPeerComparisonResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPeerComparison not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) SimulateScenario(context.Context, *SimulateScenarioReq) (*SimulateScenarioResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateScenario not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GenerateBudgetPlan(context.Context, *GenerateBudgetPlanReq) (*BudgetPlan, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateBudgetPlan not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) AcceptBudgetPlan(context.Context, *AcceptBudgetPlanReq) (*AcceptBudgetPlanResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptBudgetPlan not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetRecommendationRules(context.Context, *GetRecommendationRulesReq) (*RecommendationRules, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecommendationRules not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) UpdateRecommendationRules(context.Context, *UpdateRecommendationRulesReq) (*RecommendationRules, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRecommendationRules not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetRecomputeMetrics(context.Context, *GetRecomputeMetricsReq) (*RecomputeMetrics, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecomputeMetrics not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ReplayDeadLetters(context.Context, *ReplayDeadLettersReq) (*ReplayDeadLettersResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
 func (UnimplementedAnalyticsServiceServer) mustEmbedUnimplementedAnalyticsServiceServer() {}
 func (UnimplementedAnalyticsServiceServer) testEmbeddedByValue()                          {}
 
@@ -104,6 +334,258 @@ func _AnalyticsService_GetRecommendations_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyticsService_GetRecommendationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetRecommendationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetRecommendationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetRecommendationHistory(ctx, req.(*GetRecommendationHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetCategoryTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTrendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetCategoryTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetCategoryTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetCategoryTrend(ctx, req.(*GetCategoryTrendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForecastReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetForecast(ctx, req.(*GetForecastReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnomaliesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetAnomalies(ctx, req.(*GetAnomaliesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetDetectedSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDetectedSubscriptionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetDetectedSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetDetectedSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetDetectedSubscriptions(ctx, req.(*GetDetectedSubscriptionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetHealthScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthScoreReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetHealthScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetHealthScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetHealthScore(ctx, req.(*GetHealthScoreReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetPeerComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerComparisonReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetPeerComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetPeerComparison_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetPeerComparison(ctx, req.(*GetPeerComparisonReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_SimulateScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateScenarioReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).SimulateScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_SimulateScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).SimulateScenario(ctx, req.(*SimulateScenarioReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GenerateBudgetPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateBudgetPlanReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GenerateBudgetPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GenerateBudgetPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GenerateBudgetPlan(ctx, req.(*GenerateBudgetPlanReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_AcceptBudgetPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptBudgetPlanReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).AcceptBudgetPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_AcceptBudgetPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).AcceptBudgetPlan(ctx, req.(*AcceptBudgetPlanReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetRecommendationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetRecommendationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetRecommendationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetRecommendationRules(ctx, req.(*GetRecommendationRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_UpdateRecommendationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecommendationRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).UpdateRecommendationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_UpdateRecommendationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).UpdateRecommendationRules(ctx, req.(*UpdateRecommendationRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetRecomputeMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecomputeMetricsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetRecomputeMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetRecomputeMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetRecomputeMetrics(ctx, req.(*GetRecomputeMetricsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AnalyticsService_ServiceDesc is the grpc.ServiceDesc for AnalyticsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +597,62 @@ var AnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecommendations",
 			Handler:    _AnalyticsService_GetRecommendations_Handler,
 		},
+		{
+			MethodName: "GetRecommendationHistory",
+			Handler:    _AnalyticsService_GetRecommendationHistory_Handler,
+		},
+		{
+			MethodName: "GetCategoryTrend",
+			Handler:    _AnalyticsService_GetCategoryTrend_Handler,
+		},
+		{
+			MethodName: "GetForecast",
+			Handler:    _AnalyticsService_GetForecast_Handler,
+		},
+		{
+			MethodName: "GetAnomalies",
+			Handler:    _AnalyticsService_GetAnomalies_Handler,
+		},
+		{
+			MethodName: "GetDetectedSubscriptions",
+			Handler:    _AnalyticsService_GetDetectedSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetHealthScore",
+			Handler:    _AnalyticsService_GetHealthScore_Handler,
+		},
+		{
+			MethodName: "GetPeerComparison",
+			Handler:    _AnalyticsService_GetPeerComparison_Handler,
+		},
+		{
+			MethodName: "SimulateScenario",
+			Handler:    _AnalyticsService_SimulateScenario_Handler,
+		},
+		{
+			MethodName: "GenerateBudgetPlan",
+			Handler:    _AnalyticsService_GenerateBudgetPlan_Handler,
+		},
+		{
+			MethodName: "AcceptBudgetPlan",
+			Handler:    _AnalyticsService_AcceptBudgetPlan_Handler,
+		},
+		{
+			MethodName: "GetRecommendationRules",
+			Handler:    _AnalyticsService_GetRecommendationRules_Handler,
+		},
+		{
+			MethodName: "UpdateRecommendationRules",
+			Handler:    _AnalyticsService_UpdateRecommendationRules_Handler,
+		},
+		{
+			MethodName: "GetRecomputeMetrics",
+			Handler:    _AnalyticsService_GetRecomputeMetrics_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _AnalyticsService_ReplayDeadLetters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "analytics_service.proto",
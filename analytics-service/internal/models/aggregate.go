@@ -0,0 +1,23 @@
+package models
+
+// SpendingDelta - изменение расходов пользователя за день в категории
+type SpendingDelta struct {
+	Date       string // YYYY-MM-DD
+	CategoryID int32
+	Amount     float64 // Отрицательное при удалении или изменении операции
+}
+
+// SpendingAggregates - накопленные расходы пользователя по дням и категориям
+type SpendingAggregates struct {
+	From    string                       // Первый день, с которого накоплены расходы, YYYY-MM-DD
+	BuiltAt int64                        // Unix timestamp последней полной пересборки, 0 - не собраны
+	Seq     int64                        // Номер последнего изменения, по нему пересборка узнает о пропущенных событиях
+	Totals  map[string]map[int32]float64 // День -> категория -> сумма расходов
+}
+
+// CategoryInfo - код и название категории funds-service
+type CategoryInfo struct {
+	ID   int32  `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
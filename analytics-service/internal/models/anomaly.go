@@ -0,0 +1,26 @@
+package models
+
+// Виды аномалий операций
+const (
+	AnomalyLargeAmount   = "large_amount"    // Сумма намного больше обычной для категории
+	AnomalyLargeForTitle = "large_for_title" // Сумма намного больше обычной для этого получателя
+	AnomalyDuplicate     = "duplicate"       // Похожая операция с той же суммой внесена несколькими минутами раньше
+	AnomalyNewCategory   = "new_category"    // Первая операция в категории
+)
+
+// Anomaly - отметка о необычной операции с объяснением для пользователя
+type Anomaly struct {
+	TransactionID   int64   `json:"transaction_id"`
+	Kind            string  `json:"kind"`
+	CategoryID      int32   `json:"category_id"`
+	CategoryName    string  `json:"category_name"`
+	Type            string  `json:"type"`
+	Title           string  `json:"title"`
+	Amount          float64 `json:"amount"`
+	TransactionDate string  `json:"transaction_date"`
+	Typical         float64 `json:"typical,omitempty"`      // Медиана сумм, с которыми сравнивалась операция
+	Score           float64 `json:"score,omitempty"`        // Робастная z-оценка, 0 - если разброс сумм нулевой
+	DuplicateOf     int64   `json:"duplicate_of,omitempty"` // Операция, которую повторяет эта
+	Explanation     string  `json:"explanation"`
+	DetectedAt      int64   `json:"detected_at"`
+}
@@ -0,0 +1,70 @@
+package models
+
+// Стратегии распределения дохода в плане бюджета
+const (
+	BudgetStrategyBalanced  = "balanced"   // Средние расходы в пределах рекомендуемых диапазонов
+	BudgetStrategy503020    = "50_30_20"   // 50% на нужды, 30% на желания, 20% на сбережения
+	BudgetStrategyZeroBased = "zero_based" // Каждый рубль распределен, остаток уходит в сбережения
+)
+
+// Группы категорий в плане бюджета
+const (
+	BudgetClassFixed = "fixed" // Обязательные платежи: жилье, коммунальные услуги, кредиты, связь
+	BudgetClassNeeds = "needs" // Необходимые расходы, которые можно немного сократить
+	BudgetClassWants = "wants" // Желания, которые сокращаются в первую очередь
+)
+
+// BudgetPlanItem - предлагаемый бюджет категории на месяц
+type BudgetPlanItem struct {
+	CategoryID      int32   `json:"category_id"`
+	CategoryCode    string  `json:"category_code"`
+	CategoryName    string  `json:"category_name"`
+	Class           string  `json:"class"`
+	Amount          float64 `json:"amount"`
+	AverageSpending float64 `json:"average_spending"` // Средние расходы в месяц за прошлые месяцы
+	RecommendedMin  float64 `json:"recommended_min"`  // Рекомендуемый диапазон в рублях, 0 - для категории нет правила
+	RecommendedMax  float64 `json:"recommended_max"`
+	Reason          string  `json:"reason"`
+}
+
+// BudgetPlan - предлагаемые бюджеты по категориям на следующий месяц. План хранится до принятия
+// или истечения срока, принятый план становится бюджетами в funds-service
+type BudgetPlan struct {
+	ID               string           `json:"id"`
+	UserUID          string           `json:"user_uid"`
+	Month            string           `json:"month"` // YYYY-MM
+	Strategy         string           `json:"strategy"`
+	Income           float64          `json:"income"`
+	IncomeSource     string           `json:"income_source"`
+	SavingsTarget    float64          `json:"savings_target"`
+	Savings          float64          `json:"savings"` // Сколько останется на сбережения, отрицательное - план больше дохода
+	SavingsTargetMet bool             `json:"savings_target_met"`
+	Fixed            float64          `json:"fixed"`
+	Needs            float64          `json:"needs"`
+	Wants            float64          `json:"wants"`
+	Unallocated      float64          `json:"unallocated"`    // Не распределено ни на расходы, ни на сбережения
+	HistoryMonths    int              `json:"history_months"` // За сколько месяцев посчитаны средние расходы
+	Items            []BudgetPlanItem `json:"items"`
+	RulesVersion     int64            `json:"rules_version"`
+	CalculatedAt     int64            `json:"calculated_at"`
+	ExpiresAt        int64            `json:"expires_at"` // До какого времени план можно принять
+}
+
+// Budget - бюджет категории на месяц, созданный в funds-service
+type Budget struct {
+	ID           int64   `json:"id"`
+	CategoryID   int32   `json:"category_id"`
+	CategoryCode string  `json:"category_code"`
+	CategoryName string  `json:"category_name"`
+	Month        string  `json:"month"` // YYYY-MM
+	Amount       float64 `json:"amount"`
+	Source       string  `json:"source"`
+}
+
+// AcceptedBudgetPlan - бюджеты, созданные из принятого плана
+type AcceptedBudgetPlan struct {
+	PlanID  string   `json:"plan_id"`
+	Month   string   `json:"month"`
+	Budgets []Budget `json:"budgets"`
+	Kept    []Budget `json:"kept"` // Бюджеты, заданные вручную, которые план не изменил
+}
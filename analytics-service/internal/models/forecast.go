@@ -0,0 +1,36 @@
+package models
+
+// CategoryForecast - прогноз расходов категории на конец месяца
+type CategoryForecast struct {
+	CategoryCode      string  `json:"category_code"`
+	CategoryName      string  `json:"category_name"`
+	SpentToDate       float64 `json:"spent_to_date"`      // Потрачено с начала месяца по сегодня
+	Scheduled         float64 `json:"scheduled"`          // Уже внесенные расходы на оставшиеся дни месяца
+	Forecast          float64 `json:"forecast"`           // Ожидаемые расходы за весь месяц
+	Low               float64 `json:"low"`                // Нижняя граница интервала прогноза
+	High              float64 `json:"high"`               // Верхняя граница интервала прогноза
+	RecommendedMax    float64 `json:"recommended_max"`    // Рекомендуемый максимум в рублях, 0 - нет правила для категории
+	Budget            float64 `json:"budget"`             // Бюджет категории на месяц из funds-service, 0 - не задан
+	BreachProbability float64 `json:"breach_probability"` // Вероятность превысить бюджет, а без него - рекомендуемый максимум, 0..1
+	LikelyBreach      bool    `json:"likely_breach"`
+}
+
+// Forecast - прогноз расходов пользователя на конец текущего месяца
+type Forecast struct {
+	UserUID       string  `json:"user_uid"`
+	Month         string  `json:"month"` // YYYY-MM в часовом поясе пользователя
+	DaysElapsed   int     `json:"days_elapsed"`
+	DaysInMonth   int     `json:"days_in_month"`
+	HistoryMonths int     `json:"history_months"` // Число прошлых месяцев с расходами, учтенных в прогнозе
+	Salary        float64 `json:"salary"`
+	SpentToDate   float64 `json:"spent_to_date"`
+	Forecast      float64 `json:"forecast"`
+	Low           float64 `json:"low"`
+	High          float64 `json:"high"`
+	// Общий бюджет пользователя - месячная зарплата
+	BudgetBreachProbability float64            `json:"budget_breach_probability"`
+	LikelyBudgetBreach      bool               `json:"likely_budget_breach"`
+	Categories              []CategoryForecast `json:"categories"`
+	RulesVersion            int64              `json:"rules_version"`
+	CalculatedAt            int64              `json:"calculated_at"` // Unix timestamp
+}
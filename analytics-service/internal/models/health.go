@@ -0,0 +1,44 @@
+package models
+
+// Компоненты оценки финансового здоровья
+const (
+	HealthSavingsRate        = "savings_rate"
+	HealthBudgetAdherence    = "budget_adherence"
+	HealthDebtToIncome       = "debt_to_income"
+	HealthSpendingVolatility = "spending_volatility"
+	HealthEmergencyFund      = "emergency_fund"
+)
+
+// HealthComponent - вклад одного показателя в оценку
+type HealthComponent struct {
+	Code        string  `json:"code"`
+	Available   bool    `json:"available"` // false - данных нет, компонент не учитывается
+	Value       float64 `json:"value"`     // Значение показателя: проценты, коэффициент или месяцы
+	Score       float64 `json:"score"`     // 0..100
+	Weight      float64 `json:"weight"`    // Доля в итоговой оценке среди учтенных компонентов
+	Explanation string  `json:"explanation"`
+}
+
+// HealthAction - действие, которое сильнее всего поднимет оценку
+type HealthAction struct {
+	Component string  `json:"component"`
+	Gain      float64 `json:"gain"` // На сколько баллов вырастет оценка, если компонент достигнет 100
+	Message   string  `json:"message"`
+}
+
+// HealthScorePoint - оценка за месяц, последняя рассчитанная в этом месяце
+type HealthScorePoint struct {
+	Month        string  `json:"month"` // YYYY-MM
+	Score        float64 `json:"score"`
+	CalculatedAt int64   `json:"calculated_at"`
+}
+
+// HealthScore - оценка финансового здоровья 0..100
+type HealthScore struct {
+	UserUID      string             `json:"user_uid"`
+	Score        float64            `json:"score"`
+	Components   []HealthComponent  `json:"components"`
+	Actions      []HealthAction     `json:"actions"`
+	History      []HealthScorePoint `json:"history"` // От старых месяцев к новым, включая текущий
+	CalculatedAt int64              `json:"calculated_at"`
+}
@@ -0,0 +1,38 @@
+package models
+
+// MonthlySnapshot - рекомендации, сохраненные за месяц. За месяц берется последний рассчитанный
+// результат с концом периода в этом месяце
+type MonthlySnapshot struct {
+	Month  string           `json:"month"` // YYYY-MM
+	Result *AnalyticsResult `json:"result"`
+}
+
+// TrendPoint - доля расходов категории за месяц
+type TrendPoint struct {
+	Month            string  `json:"month"` // YYYY-MM
+	ActualAmount     float64 `json:"actual_amount"`
+	ActualPercentage float64 `json:"actual_percentage"`
+	RecommendedMin   float64 `json:"recommended_min"`
+	RecommendedMax   float64 `json:"recommended_max"`
+	Status           string  `json:"status"`
+}
+
+// CategoryTrend - динамика доли расходов категории по месяцам
+type CategoryTrend struct {
+	CategoryCode string       `json:"category_code"`
+	CategoryName string       `json:"category_name"`
+	Points       []TrendPoint `json:"points"`
+	Direction    string       `json:"direction"` // improving, worsening, stable, new
+	Change       float64      `json:"change"`    // Изменение доли за последний месяц в процентных пунктах
+}
+
+// TrendDirection константы для направления изменения расходов категории
+const (
+	TrendImproving = "improving"
+	TrendWorsening = "worsening"
+	TrendStable    = "stable"
+	TrendNew       = "new"
+)
+
+// TrendStableDelta - изменение доли в процентных пунктах, меньше которого категория считается стабильной
+const TrendStableDelta = 0.5
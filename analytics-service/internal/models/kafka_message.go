@@ -2,6 +2,48 @@ package models
 
 // KafkaAnalyticsMessage представляет сообщение из Kafka для аналитики
 type KafkaAnalyticsMessage struct {
+	// EventID - идентификатор события, по нему повторно доставленное событие не применяется дважды.
+	// Пустой в сообщениях без операций
+	EventID string `json:"event_id,omitempty"`
 	UserUID string `json:"user_uid"`
-	Action  string `json:"action"` // "update"
+	Action  string `json:"action"` // "update" или "transactions_*"
+	// Transactions - затронутые операции, для проверки на аномалии и обновления накопленных расходов
+	Transactions []TransactionEvent `json:"transactions,omitempty"`
+}
+
+// Действия в сообщениях аналитики от funds-service
+const (
+	ActionUpdate               = "update"
+	ActionTransactionsCreated  = "transactions_created"
+	ActionTransactionsUpdated  = "transactions_updated"
+	ActionTransactionsDeleted  = "transactions_deleted"
+	ActionTransactionsRestored = "transactions_restored"
+)
+
+// TransactionEvent - операция из сообщения funds-service
+type TransactionEvent struct {
+	ID              int64   `json:"id"`
+	CategoryID      int32   `json:"category_id"`
+	Type            string  `json:"type"`
+	Amount          float64 `json:"amount"`
+	Title           string  `json:"title"`
+	TransactionDate string  `json:"transaction_date"` // YYYY-MM-DD
+	CreatedAt       int64   `json:"created_at"`
+	LedgerID        int64   `json:"ledger_id,omitempty"`
+
+	// Previous - значения до изменения, только в transactions_updated
+	Previous *TransactionEvent `json:"previous,omitempty"`
+}
+
+// KafkaNotificationMessage - push-уведомление пользователю для notification-service
+type KafkaNotificationMessage struct {
+	UserUID      string       `json:"user_uid"`
+	Notification Notification `json:"notification"`
+}
+
+// Notification - содержимое push-уведомления, Data - JSON для клиента
+type Notification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Data  string `json:"data,omitempty"`
 }
@@ -0,0 +1,88 @@
+package models
+
+import "strconv"
+
+// Уровни групп похожих пользователей, от самой узкой к самой широкой. Если в узкой группе
+// недостаточно людей, пользователь сравнивается с более широкой
+const (
+	PeerLevelSalaryAgeSphere = "salary_age_sphere"
+	PeerLevelSalaryAge       = "salary_age"
+	PeerLevelSalary          = "salary"
+)
+
+// PeerCategorySpending - расходы по категории за последние 30 дней
+type PeerCategorySpending struct {
+	Code   string  `json:"code"`
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+// PeerSpending - расходы пользователя, из которых собираются распределения групп. Обновляется
+// при каждом расчете рекомендаций за последние 30 дней
+type PeerSpending struct {
+	Salary     float64                `json:"salary"`
+	Categories []PeerCategorySpending `json:"categories"`
+	PeriodTo   string                 `json:"period_to"`
+	UpdatedAt  int64                  `json:"updated_at"` // Unix timestamp
+}
+
+// PeerCategoryStats - распределение расходов группы по категории. Пользователи без расходов
+// в категории учитываются с нулем
+type PeerCategoryStats struct {
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Spenders  int       `json:"spenders"`  // Сколько пользователей группы тратили в категории
+	Quantiles []float64 `json:"quantiles"` // Процентили 5, 10, ..., 95
+}
+
+// PeerCohort - обезличенная группа похожих пользователей: только размер и процентили расходов
+type PeerCohort struct {
+	Level         string              `json:"level"`
+	SalaryBracket string              `json:"salary_bracket"`
+	AgeBand       string              `json:"age_band,omitempty"`
+	WorkSphereID  int64               `json:"work_sphere_id,omitempty"`
+	Size          int                 `json:"size"`
+	Categories    []PeerCategoryStats `json:"categories"`
+	CalculatedAt  int64               `json:"calculated_at"`
+}
+
+// Key возвращает ключ группы
+func (c *PeerCohort) Key() string {
+	return PeerCohortKey(c.Level, c.SalaryBracket, c.AgeBand, c.WorkSphereID)
+}
+
+// PeerCohortKey возвращает ключ группы уровня level. Параметры, которые уровень не учитывает, пропускаются
+func PeerCohortKey(level, salaryBracket, ageBand string, workSphereID int64) string {
+	switch level {
+	case PeerLevelSalaryAgeSphere:
+		return level + "|" + salaryBracket + "|" + ageBand + "|" + strconv.FormatInt(workSphereID, 10)
+	case PeerLevelSalaryAge:
+		return level + "|" + salaryBracket + "|" + ageBand
+	default:
+		return level + "|" + salaryBracket
+	}
+}
+
+// PeerCategoryComparison - расходы пользователя по категории относительно группы
+type PeerCategoryComparison struct {
+	CategoryCode  string  `json:"category_code"`
+	CategoryName  string  `json:"category_name"`
+	Amount        float64 `json:"amount"`
+	CohortMedian  float64 `json:"cohort_median"`
+	SpendersShare float64 `json:"spenders_share"` // Процент пользователей группы, тративших в категории
+	Percentile    float64 `json:"percentile"`     // Процент пользователей группы, потративших меньше
+	Message       string  `json:"message"`
+}
+
+// PeerComparison - сравнение расходов пользователя с похожими пользователями
+type PeerComparison struct {
+	UserUID            string                   `json:"user_uid"`
+	Level              string                   `json:"level"`
+	SalaryBracket      string                   `json:"salary_bracket"`
+	AgeBand            string                   `json:"age_band,omitempty"`
+	WorkSphereID       int64                    `json:"work_sphere_id,omitempty"`
+	CohortSize         int                      `json:"cohort_size"`
+	Categories         []PeerCategoryComparison `json:"categories"`
+	PeriodTo           string                   `json:"period_to"`
+	CohortCalculatedAt int64                    `json:"cohort_calculated_at"`
+}
@@ -2,6 +2,7 @@ package models
 
 // CategorySpending представляет траты по категории
 type CategorySpending struct {
+	CategoryCode string
 	CategoryName string
 	Amount       float64
 	Percentage   float64 // процент от зарплаты
@@ -9,6 +10,7 @@ type CategorySpending struct {
 
 // CategoryRecommendation представляет рекомендацию по одной категории
 type CategoryRecommendation struct {
+	CategoryCode     string  `json:"category_code"`
 	CategoryName     string  `json:"category_name"`
 	ActualAmount     float64 `json:"actual_amount"`
 	ActualPercentage float64 `json:"actual_percentage"`
@@ -49,4 +51,47 @@ type AnalyticsResult struct {
 	OverallMessage  string                   `json:"overall_message"`
 	Recommendations []CategoryRecommendation `json:"recommendations"`
 	CalculatedAt    int64                    `json:"calculated_at"` // Unix timestamp
+	Debt            *DebtLoad                `json:"debt,omitempty"`
+	LedgerID        int64                    `json:"ledger_id,omitempty"` // Для общего бюджета: зарплата и расходы всех участников
+	PeriodFrom      string                   `json:"period_from"`         // Период расходов в часовом поясе пользователя (YYYY-MM-DD)
+	PeriodTo        string                   `json:"period_to"`
+	RulesVersion    int64                    `json:"rules_version"`           // Версия правил, по которой сформированы рекомендации
+	IncomeSource    string                   `json:"income_source,omitempty"` // От чего посчитана зарплата: profile или transactions
+	Income          *IncomeAnalysis          `json:"income,omitempty"`
 }
+
+// Источники зарплаты, от которой считаются рекомендации
+const (
+	IncomeSourceProfile      = "profile"      // Зарплата из профиля
+	IncomeSourceTransactions = "transactions" // Средний доход по операциям
+)
+
+// IncomeAnalysis - фактический доход пользователя по операциям за прошлые полные месяцы
+type IncomeAnalysis struct {
+	ProfileSalary       float64 `json:"profile_salary"`
+	EffectiveIncome     float64 `json:"effective_income"` // Средний месячный доход, разовые выбросы ограничены
+	Months              int     `json:"months"`           // Число учтенных месяцев
+	StabilityScore      float64 `json:"stability_score"`  // 0..100, 100 - доход не меняется от месяца к месяцу
+	AverageExpenses     float64 `json:"average_expenses"` // Средние расходы в месяц за те же месяцы
+	SavingsRate         float64 `json:"savings_rate"`     // Процент дохода, который остается, отрицательный - расходы больше дохода
+	Balance             float64 `json:"balance"`
+	EmergencyFundMonths float64 `json:"emergency_fund_months"` // На сколько месяцев средних расходов хватит баланса
+	ExpenseVolatility   float64 `json:"expense_volatility"`    // Коэффициент вариации расходов по месяцам
+}
+
+// DebtLoad представляет долговую нагрузку пользователя
+type DebtLoad struct {
+	TotalOwed         float64 `json:"total_owed"`
+	MonthlyPayments   float64 `json:"monthly_payments"`
+	DebtToIncomeRatio float64 `json:"debt_to_income_ratio"` // процент от зарплаты
+	Status            string  `json:"status"`               // excellent, normal, warning, critical
+	Message           string  `json:"message"`
+	DebtFreeDate      string  `json:"debt_free_date,omitempty"`
+}
+
+// Пороги долговой нагрузки в процентах от зарплаты
+const (
+	DebtRatioExcellent = 15.0
+	DebtRatioNormal    = 30.0
+	DebtRatioWarning   = 40.0
+)
@@ -0,0 +1,13 @@
+package models
+
+// RecomputeMetrics - счетчики отложенных пересчетов аналитики по всем репликам с момента
+// появления метрик в Redis
+type RecomputeMetrics struct {
+	Events         int64 `json:"events"`         // Событий, запросивших пересчет
+	Coalesced      int64 `json:"coalesced"`      // Событий, присоединившихся к уже запланированному пересчету
+	Recomputations int64 `json:"recomputations"` // Выполненных пересчетов
+	Failures       int64 `json:"failures"`       // Неудачных попыток пересчета
+	Dropped        int64 `json:"dropped"`        // Пересчетов, отброшенных после всех попыток
+	Pending        int64 `json:"pending"`        // Пользователей, ожидающих пересчета
+	Processing     int64 `json:"processing"`     // Пользователей, пересчитываемых сейчас
+}
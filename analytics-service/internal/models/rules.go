@@ -0,0 +1,128 @@
+package models
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// defaultRules - правила, с которыми сервис стартует при пустом хранилище и без файла правил
+//
+//go:embed default_rules.json
+var defaultRules []byte
+
+// ErrInvalidRuleSet возвращается, если набор правил не прошел проверку
+var ErrInvalidRuleSet = errors.New("invalid rule set")
+
+// categoryCodePattern совпадает с форматом кодов категорий в funds-service
+var categoryCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// RuleThresholds задает границы статусов категории относительно рекомендуемого диапазона:
+// critical - выше MaxPerc * CriticalMultiplier, warning - выше MaxPerc * WarningMultiplier,
+// excellent - ниже MinPerc * ExcellentMultiplier при ненулевом MinPerc, иначе normal
+type RuleThresholds struct {
+	CriticalMultiplier  float64 `json:"critical_multiplier"`
+	WarningMultiplier   float64 `json:"warning_multiplier"`
+	ExcellentMultiplier float64 `json:"excellent_multiplier"`
+}
+
+// RuleSet - версия правил формирования рекомендаций
+type RuleSet struct {
+	Version   int64  `json:"version"`
+	UpdatedAt int64  `json:"updated_at"` // Unix timestamp
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// FileChecksum - sha256 последнего импортированного файла правил, переносится в следующие версии,
+	// чтобы файл импортировался один раз, а не при каждом перезапуске
+	FileChecksum string          `json:"file_checksum,omitempty"`
+	Thresholds   RuleThresholds  `json:"thresholds"`
+	Brackets     []SalaryBracket `json:"brackets"`
+}
+
+// DefaultRuleSet возвращает встроенные правила без версии
+func DefaultRuleSet() *RuleSet {
+	rules, err := ParseRuleSet(defaultRules)
+	if err != nil {
+		panic(fmt.Sprintf("default rules: %v", err))
+	}
+	return rules
+}
+
+// ParseRuleSet читает правила из JSON и проверяет их. Неизвестные поля считаются ошибкой,
+// чтобы опечатка в файле правил не превращалась в нулевой порог
+func ParseRuleSet(data []byte) (*RuleSet, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var rules RuleSet
+	if err := dec.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRuleSet, err)
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &rules, nil
+}
+
+// Validate проверяет пороги и диапазоны зарплат: диапазоны идут по возрастанию с нуля без пропусков
+// и пересечений, только последний не ограничен сверху, коды категорий внутри диапазона не повторяются
+func (r *RuleSet) Validate() error {
+	t := r.Thresholds
+	if t.WarningMultiplier < 1 || t.CriticalMultiplier < t.WarningMultiplier {
+		return fmt.Errorf("%w: multipliers must satisfy 1 <= warning_multiplier <= critical_multiplier", ErrInvalidRuleSet)
+	}
+	if t.ExcellentMultiplier <= 0 || t.ExcellentMultiplier > 1 {
+		return fmt.Errorf("%w: excellent_multiplier must be in (0, 1]", ErrInvalidRuleSet)
+	}
+
+	if len(r.Brackets) == 0 {
+		return fmt.Errorf("%w: at least one salary bracket is required", ErrInvalidRuleSet)
+	}
+
+	for i, b := range r.Brackets {
+		last := i == len(r.Brackets)-1
+
+		switch {
+		case i == 0 && b.MinSalary != 0:
+			return fmt.Errorf("%w: the first bracket must start at 0", ErrInvalidRuleSet)
+		case i > 0 && b.MinSalary < r.Brackets[i-1].MaxSalary:
+			return fmt.Errorf("%w: bracket %s overlaps the previous one", ErrInvalidRuleSet, b.Label())
+		case i > 0 && b.MinSalary > r.Brackets[i-1].MaxSalary:
+			return fmt.Errorf("%w: gap between brackets %s and %s", ErrInvalidRuleSet, r.Brackets[i-1].Label(), b.Label())
+		case last && b.MaxSalary != 0:
+			return fmt.Errorf("%w: the last bracket must have no upper bound (max_salary 0)", ErrInvalidRuleSet)
+		case !last && b.MaxSalary <= b.MinSalary:
+			return fmt.Errorf("%w: bracket %s must have max_salary greater than min_salary", ErrInvalidRuleSet, b.Label())
+		}
+
+		codes := make(map[string]struct{}, len(b.Categories))
+		for _, c := range b.Categories {
+			if !categoryCodePattern.MatchString(c.Code) {
+				return fmt.Errorf("%w: invalid category code %q in bracket %s", ErrInvalidRuleSet, c.Code, b.Label())
+			}
+			if _, ok := codes[c.Code]; ok {
+				return fmt.Errorf("%w: duplicate category %s in bracket %s", ErrInvalidRuleSet, c.Code, b.Label())
+			}
+			codes[c.Code] = struct{}{}
+
+			if c.MinPerc < 0 || c.MaxPerc > 100 || c.MinPerc > c.MaxPerc {
+				return fmt.Errorf("%w: category %s in bracket %s must satisfy 0 <= min_percent <= max_percent <= 100", ErrInvalidRuleSet, c.Code, b.Label())
+			}
+		}
+	}
+
+	return nil
+}
+
+// BracketForSalary возвращает диапазон, в который попадает зарплата, или nil
+func (r *RuleSet) BracketForSalary(salary float64) *SalaryBracket {
+	for i := range r.Brackets {
+		if r.Brackets[i].Contains(salary) {
+			return &r.Brackets[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package models
+
+// CategoryAdjustment - изменение месячных расходов категории в сценарии. Сначала применяется процент, затем сумма
+type CategoryAdjustment struct {
+	CategoryCode  string  `json:"category_code"`
+	PercentChange float64 `json:"percent_change"` // -20 - расходы на 20% меньше
+	AmountChange  float64 `json:"amount_change"`  // Изменение в рублях за месяц, может быть отрицательным
+}
+
+// RecurringExpense - новый регулярный расход в сценарии
+type RecurringExpense struct {
+	CategoryCode  string  `json:"category_code"`
+	Title         string  `json:"title"`
+	MonthlyAmount float64 `json:"monthly_amount"`
+}
+
+// Scenario - изменения, результат которых пользователь хочет увидеть до того, как их внести
+type Scenario struct {
+	Salary            float64              `json:"salary"` // Новая месячная зарплата, 0 - без изменений
+	Categories        []CategoryAdjustment `json:"categories"`
+	RecurringExpenses []RecurringExpense   `json:"recurring_expenses"`
+}
+
+// CategoryScenarioDiff - расходы, рекомендация и прогноз категории до и после изменений
+type CategoryScenarioDiff struct {
+	CategoryCode        string  `json:"category_code"`
+	CategoryName        string  `json:"category_name"`
+	CurrentAmount       float64 `json:"current_amount"` // Расходы за последние 30 дней
+	SimulatedAmount     float64 `json:"simulated_amount"`
+	CurrentPercentage   float64 `json:"current_percentage"` // Процент от зарплаты
+	SimulatedPercentage float64 `json:"simulated_percentage"`
+	CurrentStatus       string  `json:"current_status"` // Пусто, если для категории нет правила
+	SimulatedStatus     string  `json:"simulated_status"`
+	RecommendedMin      float64 `json:"recommended_min"` // Границы диапазона зарплаты из сценария
+	RecommendedMax      float64 `json:"recommended_max"`
+	CurrentForecast     float64 `json:"current_forecast"` // Прогноз расходов на текущий месяц
+	SimulatedForecast   float64 `json:"simulated_forecast"`
+}
+
+// ScenarioResult - разница между текущей аналитикой и аналитикой после изменений из сценария.
+// Ничего из результата не сохраняется
+type ScenarioResult struct {
+	UserUID                          string                 `json:"user_uid"`
+	CurrentSalary                    float64                `json:"current_salary"`
+	SimulatedSalary                  float64                `json:"simulated_salary"`
+	CurrentSalaryBracket             string                 `json:"current_salary_bracket"`
+	SimulatedSalaryBracket           string                 `json:"simulated_salary_bracket"`
+	SalaryBracketChanged             bool                   `json:"salary_bracket_changed"`
+	CurrentOverallStatus             string                 `json:"current_overall_status"`
+	SimulatedOverallStatus           string                 `json:"simulated_overall_status"`
+	CurrentHealthScore               float64                `json:"current_health_score"`
+	SimulatedHealthScore             float64                `json:"simulated_health_score"`
+	CurrentForecast                  float64                `json:"current_forecast"` // Прогноз расходов на текущий месяц
+	SimulatedForecast                float64                `json:"simulated_forecast"`
+	CurrentBudgetBreachProbability   float64                `json:"current_budget_breach_probability"`
+	SimulatedBudgetBreachProbability float64                `json:"simulated_budget_breach_probability"`
+	Categories                       []CategoryScenarioDiff `json:"categories"` // Только изменившиеся категории
+	Simulated                        *AnalyticsResult       `json:"simulated"`  // Рекомендации после изменений целиком
+	CalculatedAt                     int64                  `json:"calculated_at"`
+}
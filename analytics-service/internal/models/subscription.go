@@ -0,0 +1,45 @@
+package models
+
+// Периодичность подписок
+const (
+	SubscriptionWeekly  = "weekly"
+	SubscriptionMonthly = "monthly"
+	SubscriptionYearly  = "yearly"
+)
+
+// Статусы подписок
+const (
+	SubscriptionActive            = "active"
+	SubscriptionPossiblyCancelled = "possibly_cancelled" // Очередное списание пропущено
+)
+
+// PriceChange - изменение суммы списания подписки
+type PriceChange struct {
+	Date      string  `json:"date"` // YYYY-MM-DD первого списания по новой цене
+	OldAmount float64 `json:"old_amount"`
+	NewAmount float64 `json:"new_amount"`
+}
+
+// Subscription - регулярный платеж, найденный в расходах пользователя
+type Subscription struct {
+	Title        string        `json:"title"` // Название последнего списания
+	CategoryID   int32         `json:"category_id"`
+	CategoryName string        `json:"category_name"`
+	Period       string        `json:"period"` // weekly, monthly или yearly
+	Amount       float64       `json:"amount"` // Сумма последнего списания
+	MonthlyCost  float64       `json:"monthly_cost"`
+	Charges      int           `json:"charges"`
+	FirstCharge  string        `json:"first_charge"` // YYYY-MM-DD
+	LastCharge   string        `json:"last_charge"`
+	NextCharge   string        `json:"next_charge"` // Ожидаемая дата следующего списания
+	Status       string        `json:"status"`
+	PriceChanges []PriceChange `json:"price_changes,omitempty"`
+}
+
+// DetectedSubscriptions - подписки пользователя и их суммарная стоимость в месяц
+type DetectedSubscriptions struct {
+	UserUID          string         `json:"user_uid"`
+	Subscriptions    []Subscription `json:"subscriptions"`
+	TotalMonthlyCost float64        `json:"total_monthly_cost"` // Без подписок, которые, возможно, отменены
+	CalculatedAt     int64          `json:"calculated_at"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeTitle приводит название операции к виду для сравнения: нижний регистр, без цифр, знаков
+// и лишних пробелов. Так "YANDEX*TAXI 1234" и "Yandex Taxi" совпадают
+func NormalizeTitle(title string) string {
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	return strings.Join(fields, " ")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/adapters/consumers"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/handler"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/repository"
+	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/rules"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/analytics/service"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/clients"
 	"github.com/cg-2025-crutch/backend/analytics-service/internal/config"
@@ -22,6 +23,9 @@ import (
 
 var defaultLevel = zap.NewAtomicLevelAt(zap.InfoLevel)
 
+// consumerGroup - группа потребителей событий аналитики
+const consumerGroup = "analytics-consumer"
+
 func Run(mainCtx context.Context) error {
 	ctx, cancel := signal.NotifyContext(mainCtx, syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
@@ -46,6 +50,14 @@ func Run(mainCtx context.Context) error {
 	// Создаем repository
 	repo := repository.NewRedisRepository(redisClient)
 
+	// Загружаем правила рекомендаций и следим за их изменениями
+	rulesStore := rules.NewStore(repo, conf.Rules)
+	if err = rulesStore.Init(ctx); err != nil {
+		return fmt.Errorf("failed to load recommendation rules: %w", err)
+	}
+	rulesStore.Watch(ctx, conf.Rules.ReloadInterval)
+	logger.Infof("Recommendation rules version %d loaded", rulesStore.Current().Version)
+
 	// Создаем gRPC клиенты для других сервисов
 	grpcClients, err := clients.NewClients(conf.UserServiceAddr, conf.FundsServiceAddr)
 	if err != nil {
@@ -55,23 +67,49 @@ func Run(mainCtx context.Context) error {
 	defer grpcClients.Close()
 	logger.Info("gRPC clients initialized")
 
+	// Создаем producer для push-уведомлений
+	notifier, err := kafka.InitKafkaProducer(ctx, "analytics-producer", conf.Kafka)
+	if err != nil {
+		logger.Fatal("failed to initialize Kafka producer", zap.Error(err))
+		return err
+	}
+	defer notifier.Close()
+
+	// Producer для перекладывания необработанных событий в топики повторов и DLQ
+	retryProducer, err := kafka.NewSyncProducer(ctx, "analytics-retry-producer", conf.Kafka)
+	if err != nil {
+		logger.Fatal("failed to initialize Kafka retry producer", zap.Error(err))
+		return err
+	}
+	defer retryProducer.Close()
+
+	deadLetters := kafka.NewDeadLetters(conf.Kafka.Brokers, consumerGroup, conf.Kafka.ConsTopic)
+
 	// Создаем сервис аналитики
-	analyticsService := service.NewAnalyticsService(grpcClients, repo, conf.Redis)
+	analyticsService := service.NewAnalyticsService(grpcClients, repo, rulesStore, notifier, deadLetters, conf)
+
+	// Периодически пересчитываем группы похожих пользователей для сравнения расходов
+	analyticsService.WatchPeerCohorts(ctx, conf.Peers.AggregationInterval)
+
+	// Запускаем отложенные пересчеты аналитики по событиям из Kafka
+	analyticsService.WatchRecomputations(ctx)
 
 	// Инициализируем Kafka consumer
-	kafkaConsumer, err := kafka.InitKafkaConsumer(ctx, "analytics-consumer", conf.Kafka)
+	kafkaConsumer, err := kafka.InitKafkaConsumer(ctx, consumerGroup, conf.Kafka)
 	if err != nil {
 		logger.Fatal("failed to initialize Kafka consumer", zap.Error(err))
 		return err
 	}
 	defer kafkaConsumer.Close()
 
-	// Создаем обработчик для Kafka
-	consumerHandler := consumers.NewAnalyticsConsumer(analyticsService)
+	// Создаем обработчик для Kafka: неудачные события повторяются, затем уходят в топики повторов и DLQ
+	retryCfg := retryConfig(conf.Kafka.Retry)
+	consumerHandler := kafka.NewRetryingHandler(consumers.NewAnalyticsConsumer(analyticsService).Process, retryProducer, retryCfg)
+	topics := retryCfg.Topics(conf.Kafka.ConsTopic)
 
 	// Запускаем consumer
-	consumers.StartConsuming(ctx, kafkaConsumer, conf.Kafka.ConsTopic, consumerHandler)
-	logger.Infof("Kafka consumer started, listening to topics: %v", conf.Kafka.ConsTopic)
+	consumers.StartConsuming(ctx, kafkaConsumer, topics, consumerHandler)
+	logger.Infof("Kafka consumer started, listening to topics: %v", topics)
 
 	// Создаем gRPC handler
 	analyticsHandler := handler.NewAnalyticsHandler(analyticsService)
@@ -88,3 +126,12 @@ func Run(mainCtx context.Context) error {
 
 	return nil
 }
+
+func retryConfig(conf config.KafkaRetryConfig) kafka.RetryConfig {
+	return kafka.RetryConfig{
+		Attempts:   conf.Attempts,
+		Backoff:    conf.Backoff,
+		MaxBackoff: conf.MaxBackoff,
+		Delays:     conf.Delays,
+	}
+}
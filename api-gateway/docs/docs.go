@@ -23,14 +23,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/analytics/recommendations": {
-            "get": {
+        "/admin/analytics/dlq/replay": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Получает финансовые рекомендации для пользователя на основе его транзакций и категорий расходов",
+                "description": "События, которые не удалось обработать после всех повторов, попадают в dead-letter топик (например analytics.dlq) с причиной ошибки в заголовках. Метод отправляет их обратно в исходные топики, например после восстановления user-service. topic - dead-letter топик, пустой - все топики сервиса. limit - сколько сообщений отправить, по умолчанию 100, не больше 1000. Каждое сообщение возвращается один раз. Доступно только администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -38,48 +38,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "analytics"
+                    "admin"
+                ],
+                "summary": "Вернуть события аналитики из dead-letter топика",
+                "parameters": [
+                    {
+                        "description": "Топик и число сообщений",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/analytics.ReplayDeadLettersRequest"
+                        }
+                    }
                 ],
-                "summary": "Получить рекомендации пользователя",
                 "responses": {
                     "200": {
-                        "description": "Рекомендации пользователя",
+                        "description": "Сколько сообщений возвращено",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "500": {
-                        "description": "Внутренняя ошибка сервера",
+                    "400": {
+                        "description": "Неверный формат запроса, неизвестный топик или limit вне диапазона",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
-                    }
-                }
-            }
-        },
-        "/funds/balance": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Получает текущий баланс пользователя",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "funds"
-                ],
-                "summary": "Получить баланс пользователя",
-                "responses": {
-                    "200": {
-                        "description": "Баланс пользователя",
+                    },
+                    "403": {
+                        "description": "Нет прав администратора",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -95,27 +83,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/funds/categories": {
+        "/admin/analytics/recompute/metrics": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Получает список всех категорий транзакций",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Аналитика пересчитывается не на каждое событие, а после паузы в событиях пользователя, но не позже заданной задержки после первого из них. Возвращает счетчики по всем репликам: событий (events), событий, присоединившихся к уже запланированному пересчету (coalesced), выполненных пересчетов и неудачных попыток, пересчетов, отброшенных после всех повторов (dropped), а также число пользователей в очереди и в обработке. Доступно только администраторам",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "funds"
+                    "admin"
                 ],
-                "summary": "Получить все категории",
+                "summary": "Получить метрики пересчета аналитики",
                 "responses": {
                     "200": {
-                        "description": "Список категорий",
+                        "description": "Метрики пересчета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав администратора",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -131,43 +123,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/funds/categories/type/{type}": {
+        "/admin/analytics/rules": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Получает список категорий по типу (income или expense)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Возвращает диапазоны зарплат с рекомендуемыми долями расходов по категориям и пороги статусов. Без version возвращает действующие правила, с version - одну из прошлых версий, например указанную в rules_version рекомендаций. Доступно только администраторам",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "funds"
+                    "admin"
                 ],
-                "summary": "Получить категории по типу",
+                "summary": "Получить правила рекомендаций",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Тип категории (income или expense)",
-                        "name": "type",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Версия правил, по умолчанию действующая",
+                        "name": "version",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список категорий",
+                        "description": "Правила рекомендаций",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Неверный тип категории",
+                        "description": "Неверная версия",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав администратора",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Версия правил не найдена",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -181,16 +183,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/funds/categories/{id}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Получает информацию о категории по ее ID",
+                "description": "Сохраняет правила новой версией. Диапазоны зарплат должны идти подряд с нуля без пропусков и пересечений, max_salary 0 допустим только у последнего диапазона. base_version - версия, от которой сделано изменение: если правила за это время уже изменили, вернется 409. Рекомендации, рассчитанные по прежним правилам, обновятся при следующем пересчете. Доступно только администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -198,80 +198,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "funds"
+                    "admin"
                 ],
-                "summary": "Получить категорию по ID",
+                "summary": "Изменить правила рекомендаций",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID категории",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Новые правила",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/analytics.UpdateRulesRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о категории",
+                        "description": "Правила сохранены",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Неверный ID категории",
+                        "description": "Неверный формат запроса или правила не прошли проверку",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Категория не найдена",
+                    "403": {
+                        "description": "Нет прав администратора",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
-                    }
-                }
-            }
-        },
-        "/funds/transactions": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Получает список всех транзакций пользователя с пагинацией",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "funds"
-                ],
-                "summary": "Получить транзакции пользователя",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Лимит транзакций",
-                        "name": "limit",
-                        "in": "query"
                     },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Смещение",
-                        "name": "offset",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Список транзакций",
+                    "409": {
+                        "description": "Правила уже изменили после base_version",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -285,14 +249,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/categories": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Создает новую финансовую транзакцию для пользователя",
+                "description": "Создает системную категорию транзакций. code - стабильный идентификатор из латинских строчных букв, цифр и подчеркиваний, name - название на русском, translations - названия на других языках. Доступно только администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -300,23 +266,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "funds"
+                    "admin"
                 ],
-                "summary": "Создать транзакцию",
+                "summary": "Создать категорию",
                 "parameters": [
                     {
-                        "description": "Данные транзакции",
+                        "description": "Данные категории",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/funds.CreateTransactionRequest"
+                            "$ref": "#/definitions/funds.CreateCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Транзакция успешно создана",
+                        "description": "Категория успешно создана",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -329,6 +295,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Нет прав администратора",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Категория с таким кодом уже существует",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Внутренняя ошибка сервера",
                         "schema": {
@@ -339,14 +319,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/funds/transactions/period": {
-            "get": {
+        "/admin/categories/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Получает транзакции пользователя за указанное количество дней",
+                "description": "Обновляет название на русском, иконку и переводы категории. Переданные переводы добавляются или заменяются, пустое название удаляет перевод. Код и тип категории не меняются. Доступно только администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -354,35 +334,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "funds"
+                    "admin"
                 ],
-                "summary": "Получить транзакции за период",
+                "summary": "Обновить категорию",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 30,
-                        "description": "Количество дней",
-                        "name": "days",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Лимит транзакций",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "ID категории",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Смещение",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Изменяемые поля категории",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.UpdateCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список транзакций за период",
+                        "description": "Категория успешно обновлена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав администратора",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Категория не найдена",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -398,14 +394,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/funds/transactions/{id}": {
-            "get": {
+        "/admin/categories/{id}/archive": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Получает информацию о транзакции по ее ID",
+                "description": "Архивирует категорию: она пропадает из списков и не может использоваться в новых транзакциях, существующие транзакции сохраняются. Доступно только администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -413,13 +409,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "funds"
+                    "admin"
                 ],
-                "summary": "Получить транзакцию по ID",
+                "summary": "Архивировать категорию",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID транзакции",
+                        "description": "ID категории",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -427,35 +423,51 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о транзакции",
+                        "description": "Категория архивирована",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Неверный ID транзакции",
+                        "description": "Неверный ID категории",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав администратора",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "404": {
-                        "description": "Транзакция не найдена",
+                        "description": "Категория не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/admin/categories/{id}/restore": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Обновляет информацию о транзакции",
+                "description": "Возвращает архивную категорию в списки и снова разрешает ее использование в транзакциях. Доступно только администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -463,37 +475,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "funds"
+                    "admin"
                 ],
-                "summary": "Обновить транзакцию",
+                "summary": "Восстановить категорию из архива",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID транзакции",
+                        "description": "ID категории",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Обновленные данные транзакции",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/funds.UpdateTransactionRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Транзакция успешно обновлена",
+                        "description": "Категория восстановлена",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Неверный формат запроса",
+                        "description": "Неверный ID категории",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав администратора",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Категория не найдена",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -507,43 +524,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/analytics/anomalies": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Удаляет транзакцию по ее ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Возвращает операции, отмеченные как необычные при создании или изменении, с объяснением. Операция сравнивается с операциями пользователя за последние полгода по медиане и медианному отклонению: large_amount - сумма намного больше обычной для категории (с учетом будних и выходных дней), large_for_title - намного больше обычной для того же получателя, duplicate - та же сумма и название внесены несколькими минутами раньше, new_category - первая операция в категории",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "funds"
+                    "analytics"
                 ],
-                "summary": "Удалить транзакцию",
+                "summary": "Получить необычные операции",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID транзакции",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 20,
+                        "description": "Число последних отмеченных операций, не больше 200",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Транзакция успешно удалена",
+                        "description": "Необычные операции",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Неверный ID транзакции",
+                        "description": "Неверный лимит",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -559,14 +575,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications/subscribe": {
+        "/analytics/budget-plan": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Подписывает пользователя на push-уведомления",
+                "description": "Предлагает сумму бюджета по каждой категории расходов на следующий календарный месяц. Суммы считаются от средних расходов за последние полные месяцы и рекомендуемых диапазонов для зарплаты. Обязательные платежи (fixed: жилье, коммунальные услуги, кредиты, связь) не сокращаются, по кредитам закладываются не меньше ежемесячных платежей по долгам. Остальные категории делятся на нужды (needs) и желания (wants) и сокращаются не ниже рекомендуемого минимума, чтобы выполнить цель по сбережениям. strategy: balanced (по умолчанию) - пропорциональное сокращение, 50_30_20 - нужды с обязательными платежами до 50% дохода, желания до 30%, сбережения от 20%, zero_based - каждый рубль распределен, остаток уходит в сбережения. savings_target - сколько отложить за месяц, 0 - 10% дохода. План действует сутки, принять его можно через POST /analytics/budget-plan/accept; новый план заменяет предыдущий",
                 "consumes": [
                     "application/json"
                 ],
@@ -574,66 +590,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notifications"
+                    "analytics"
                 ],
-                "summary": "Подписаться на уведомления",
+                "summary": "Составить план бюджета на следующий месяц",
                 "parameters": [
                     {
-                        "description": "Данные подписки",
+                        "description": "Стратегия и цель по сбережениям",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/notifications.SubscribeRequest"
+                            "$ref": "#/definitions/analytics.GenerateBudgetPlanRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Успешная подписка",
+                        "description": "План бюджета",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Неверный формат запроса",
+                        "description": "Неверный формат запроса, неизвестная стратегия или цель не меньше дохода",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "500": {
-                        "description": "Внутренняя ошибка сервера",
+                    "404": {
+                        "description": "Пользователь не найден",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
-                    }
-                }
-            }
-        },
-        "/notifications/vapid-key": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Получает публичный VAPID ключ для push-уведомлений",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "notifications"
-                ],
-                "summary": "Получить VAPID ключ",
-                "responses": {
-                    "200": {
-                        "description": "VAPID ключ",
+                    },
+                    "409": {
+                        "description": "Доход неизвестен или нет расходов для плана",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -649,9 +643,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/login": {
+        "/analytics/budget-plan/accept": {
             "post": {
-                "description": "Аутентификация пользователя и получение токенов доступа",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает бюджеты на месяц плана из последнего предложенного плана. Бюджеты ранее принятого плана по категориям, которых нет в новом плане, удаляются. Бюджеты, заданные вручную, не удаляются и не перезаписываются, даже если категория есть в плане, - они возвращаются в kept. Возвращает все бюджеты месяца",
                 "consumes": [
                     "application/json"
                 ],
@@ -659,23 +658,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "analytics"
                 ],
-                "summary": "Вход в систему",
+                "summary": "Принять план бюджета",
                 "parameters": [
                     {
-                        "description": "Данные для входа",
+                        "description": "Идентификатор плана",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_user.LoginRequest"
+                            "$ref": "#/definitions/analytics.AcceptBudgetPlanRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Успешная аутентификация",
+                        "description": "Созданные бюджеты",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -688,8 +687,22 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Неверные учетные данные",
+                    "404": {
+                        "description": "План не найден, истек или заменен новым",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Категория из плана перенесена в архив",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -698,40 +711,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/register": {
-            "post": {
-                "description": "Создает нового пользователя в системе",
-                "consumes": [
-                    "application/json"
+        "/analytics/forecast": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Прогнозирует расходы по категориям на конец текущего календарного месяца в часовом поясе пользователя: по темпу трат с начала месяца, уже внесенным будущим расходам и тратам в оставшиеся дни прошлых месяцев. Для каждой категории возвращается 80% интервал и вероятность превысить ее бюджет на месяц (PUT /funds/budgets), а если бюджет не задан - рекомендуемый максимум; для всех расходов - вероятность превысить зарплату. Когда превышение становится вероятным в середине месяца, пользователь получает push-уведомление",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
-                ],
-                "summary": "Регистрация нового пользователя",
-                "parameters": [
-                    {
-                        "description": "Данные нового пользователя",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_handlers_user.CreateUserRequest"
-                        }
-                    }
+                    "analytics"
                 ],
+                "summary": "Получить прогноз расходов на конец месяца",
                 "responses": {
-                    "201": {
-                        "description": "Пользователь успешно создан",
+                    "200": {
+                        "description": "Прогноз расходов",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Неверный формат запроса",
+                    "404": {
+                        "description": "Пользователь не найден",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -747,43 +751,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/username/{username}": {
+        "/analytics/health": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Получает информацию о пользователе по его username",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Сводит норму сбережений, соблюдение рекомендаций по категориям, долговую нагрузку, разброс расходов по месяцам и финансовую подушку в одну оценку от 0 до 100. Для каждого показателя возвращаются значение, оценка, вес и пояснение; показатели без данных не учитываются, а веса остальных пересчитываются. Также возвращаются до трех действий, которые сильнее всего поднимут оценку, и оценки за прошлые месяцы",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "analytics"
                 ],
-                "summary": "Получить пользователя по имени",
+                "summary": "Получить оценку финансового здоровья",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Имя пользователя",
-                        "name": "username",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 6,
+                        "description": "Число последних месяцев истории, включая текущий, не больше 24",
+                        "name": "months",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о пользователе",
+                        "description": "Оценка финансового здоровья",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Username обязателен",
+                        "description": "Неверное число месяцев",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -795,113 +796,146 @@ const docTemplate = `{
                             "type": "object",
                             "additionalProperties": true
                         }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     }
                 }
             }
         },
-        "/users/{id}": {
+        "/analytics/history": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Получает информацию о пользователе по его ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Возвращает рекомендации пользователя по месяцам, чтобы видеть, улучшается ли ситуация. За месяц берутся последние рассчитанные рекомендации с концом периода в этом месяце, месяцы без рекомендаций пропускаются. Рекомендации общих бюджетов в историю не попадают",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "analytics"
                 ],
-                "summary": "Получить пользователя по ID",
+                "summary": "Получить историю рекомендаций",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID пользователя",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 6,
+                        "description": "Число последних месяцев, включая текущий, не больше 24",
+                        "name": "months",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о пользователе",
+                        "description": "Рекомендации по месяцам",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "ID пользователя обязателен",
+                        "description": "Неверное число месяцев",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Пользователь не найден",
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/analytics/history/trends": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Обновляет информацию о пользователе (только свой профиль)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Возвращает процент от зарплаты и статус каждой категории по месяцам и направление изменения за последний месяц: improving - доля снизилась хотя бы на 0.5 п.п., worsening - выросла, stable - изменилась меньше, new - данных только за один месяц",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "analytics"
                 ],
-                "summary": "Обновить профиль пользователя",
+                "summary": "Получить динамику расходов по категориям",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID пользователя",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 6,
+                        "description": "Число последних месяцев, включая текущий, не больше 24",
+                        "name": "months",
+                        "in": "query"
                     },
                     {
-                        "description": "Обновленные данные пользователя",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_handlers_user.UpdateUserRequest"
-                        }
+                        "type": "string",
+                        "description": "Код категории, по умолчанию все категории",
+                        "name": "category",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Пользователь успешно обновлен",
+                        "description": "Динамика по категориям",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Неверный формат запроса",
+                        "description": "Неверное число месяцев",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "403": {
-                        "description": "Можно обновлять только свой профиль",
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/peers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Сравнивает расходы за последние 30 дней по категориям с пользователями того же диапазона зарплаты, возраста и сферы работы. Если в такой группе мало людей, используется более широкая: без сферы работы (salary_age), затем только по зарплате (salary). Для каждой категории возвращаются медиана группы, доля тративших и процент пользователей, потративших меньше. Данные других пользователей не раскрываются: группы пересчитываются раз в час, хранятся только процентили, группы меньше 20 человек и категории, в которых тратили меньше 5 человек, не публикуются",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Сравнить расходы с похожими пользователями",
+                "responses": {
+                    "200": {
+                        "description": "Сравнение с похожими пользователями",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден или недостаточно похожих пользователей",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -916,21 +950,3881 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "funds.CreateTransactionRequest": {
-            "type": "object",
-            "required": [
-                "amount",
-                "category_id",
-                "title",
-                "transaction_date",
-                "type"
-            ],
-            "properties": {
-                "amount": {
-                    "type": "number",
+        },
+        "/analytics/recommendations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает финансовые рекомендации для пользователя на основе его транзакций и категорий расходов, а также оценку долговой нагрузки (доля платежей по долгам от зарплаты). С ledger_id рекомендации считаются для общего бюджета: по сумме зарплат участников и расходам всех участников. По умолчанию используются расходы за последние 30 дней, с period рекомендации считаются за выбранный период в часовом поясе пользователя. Зарплата берется из профиля или, если в профиле выбран income_source=transactions, из среднего фактического дохода по операциям (income_source в ответе). В income - фактический доход за прошлые полные месяцы, стабильность дохода, норма сбережений и на сколько месяцев расходов хватит баланса",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Получить рекомендации пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID общего бюджета",
+                        "name": "ledger_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Период: days, week, month, quarter, salary_month, custom",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение периода: 0 - текущий, -1 - предыдущий",
+                        "name": "period_offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Длина окна для period=days",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода (YYYY-MM-DD) для period=custom",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода включительно (YYYY-MM-DD) для period=custom",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Рекомендации пользователя",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID бюджета или период",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не участник бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Рекомендации еще не рассчитаны или бюджет не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/scenario": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Показывает, что будет, если изменить зарплату или расходы: рекомендации за последние 30 дней, оценка финансового здоровья и прогноз на текущий месяц пересчитываются с изменениями и сравниваются с текущими. Ничего не сохраняется. salary - новая месячная зарплата, 0 - без изменений; при смене диапазона зарплаты salary_bracket_changed равен true. categories - изменения расходов по кодам категорий: percent_change в процентах (не меньше -100), затем amount_change в рублях за месяц. recurring_expenses - новые регулярные расходы с суммой в месяц. В прогнозе уже потраченное не меняется: процент применяется к оставшейся части месяца, amount_change - пропорционально оставшимся дням, новый регулярный расход учитывается целиком. В categories ответа только изменившиеся категории, simulated - рекомендации после изменений целиком",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Смоделировать изменение бюджета",
+                "parameters": [
+                    {
+                        "description": "Изменения зарплаты и расходов",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/analytics.SimulateScenarioRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Разница между текущей аналитикой и аналитикой после изменений",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса, пустой сценарий или неизвестная категория",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/subscriptions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Находит регулярные платежи в расходах за последние 400 дней: расходы с одним названием и близкой суммой, которые повторяются раз в неделю (weekly), месяц (monthly) или год (yearly). Для каждой подписки возвращаются стоимость в месяц, дата следующего списания и изменения цены. Если очередное списание пропущено, подписка получает статус possibly_cancelled и не учитывается в общей стоимости",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Получить найденные подписки",
+                "responses": {
+                    "200": {
+                        "description": "Найденные подписки",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/attachments/usage": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает занятый объем, квоту пользователя, максимальный размер файла и разрешенные типы файлов",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить использование хранилища вложений",
+                "responses": {
+                    "200": {
+                        "description": "Использование хранилища",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/attachments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает содержимое вложения. При thumbnail=true возвращает JPEG-миниатюру изображения",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Скачать вложение",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID вложения",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Скачать миниатюру",
+                        "name": "thumbnail",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Содержимое файла",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID вложения",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Вложение принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Вложение или миниатюра не найдены",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаляет вложение и его миниатюру, освобождая место в квоте пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Удалить вложение",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID вложения",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Вложение успешно удалено",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID вложения",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Вложение принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Вложение не найдено",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/balance": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает текущий баланс пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить баланс пользователя",
+                "responses": {
+                    "200": {
+                        "description": "Баланс пользователя",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/balance/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает временной ряд баланса для графика капитала: баланс на начало и конец каждого дня, недели, месяца или года, а также доходы и расходы за этот интервал. Ряд строится по ежедневным снимкам баланса в часовом поясе пользователя. По умолчанию - последние 30 дней по дням",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить историю баланса",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "day",
+                        "description": "Интервал: day, week, month, year",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Период: days, week, month, quarter, salary_month, custom",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение периода: 0 - текущий, -1 - предыдущий",
+                        "name": "period_offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Длина окна для period=days",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода включительно (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "История баланса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/budgets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает бюджеты пользователя по категориям расходов на месяц. Поле source показывает, задан бюджет вручную (manual) или создан из принятого плана бюджета (plan). Названия категорий возвращаются на языке из заголовка Accept-Language",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить бюджеты на месяц",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Месяц в формате YYYY-MM",
+                        "name": "month",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки названий, например en-US,en;q=0.9",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Бюджеты на месяц",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат месяца",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Задает суммы бюджетов по категориям расходов на месяц, существующие бюджеты этих категорий перезаписываются. С replace=true бюджеты месяца по категориям, которых нет в items, удаляются. Возвращает все бюджеты месяца",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Задать бюджеты на месяц",
+                "parameters": [
+                    {
+                        "description": "Бюджеты на месяц",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.SetBudgetsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Бюджеты на месяц",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса, месяц, сумма или категория не является категорией расходов",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Категория не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Категория в архиве",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает список всех категорий транзакций. Названия возвращаются на языке из заголовка Accept-Language, при отсутствии перевода - на русском. Поле code - стабильный идентификатор категории, не зависящий от языка",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить все категории",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки названий, например en-US,en;q=0.9",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Включить архивные категории",
+                        "name": "include_archived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список категорий",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/categories/type/{type}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает список активных категорий по типу (income или expense) с названиями на языке из заголовка Accept-Language",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить категории по типу",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Тип категории (income или expense)",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки названий, например en-US,en;q=0.9",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список категорий",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный тип категории",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/categories/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает информацию о категории по ее ID с названием на языке из заголовка Accept-Language",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить категорию по ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID категории",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Предпочитаемые языки названий, например en-US,en;q=0.9",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация о категории",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID категории",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Категория не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/debts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает долги пользователя с остатком, выплаченными процентами и прогнозируемой датой погашения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить долги пользователя",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Включить погашенные долги",
+                        "name": "include_paid_off",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список долгов",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет кредит, кредитную карту, долг перед знакомым (borrowed) или деньги, одолженные другому человеку (lent). Для долгов со сроком ежемесячный платеж по умолчанию рассчитывается как аннуитетный",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Создать долг",
+                "parameters": [
+                    {
+                        "description": "Данные долга",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.CreateDebtRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Долг успешно создан",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Ключ идемпотентности использован с другим запросом",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/debts/summary": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает общий остаток долгов, сумму одолженных денег, ежемесячные платежи и прогнозируемую дату полного погашения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить сводку по долгам",
+                "responses": {
+                    "200": {
+                        "description": "Сводка по долгам",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/debts/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает долг, его платежи с разбивкой на проценты и основной долг, а также график платежей, рассчитанный от текущего остатка",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить долг по ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID долга",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Долг, платежи и график погашения",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID долга",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Долг принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Долг не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаляет долг и привязки платежей к нему. Сами транзакции платежей сохраняются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Удалить долг",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID долга",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Долг успешно удален",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID долга",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Долг принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Долг не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/debts/{id}/payments": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отмечает существующую транзакцию как платеж по долгу и делит ее сумму на проценты и основной долг. Кредиты и займы погашаются расходами, одолженные деньги возвращаются доходами",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Привязать платеж к долгу",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID долга",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Транзакция платежа",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.LinkDebtPaymentRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Платеж привязан",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос или тип транзакции не подходит для долга",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Долг или транзакция принадлежат другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Долг или транзакция не найдены",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Долг погашен, транзакция удалена или уже привязана",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/debts/{id}/payments/{paymentId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Убирает привязку платежа к долгу и возвращает его основную часть в остаток долга. Транзакция сохраняется",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Отвязать платеж от долга",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID долга",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID платежа",
+                        "name": "paymentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Платеж отвязан",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID долга или платежа",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Долг принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Долг или платеж не найдены",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/ledgers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает общие бюджеты, в которых состоит пользователь, с его ролью в каждом",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить общие бюджеты пользователя",
+                "responses": {
+                    "200": {
+                        "description": "Список бюджетов",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает общий бюджет, например семейный. Создатель становится его владельцем",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Создать общий бюджет",
+                "parameters": [
+                    {
+                        "description": "Данные бюджета",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.CreateLedgerRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Бюджет успешно создан",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Ключ идемпотентности использован с другим запросом",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/ledgers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает общий бюджет с участниками и их ролями",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить общий бюджет по ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID бюджета",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Бюджет и участники",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не участник бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Бюджет не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаляет общий бюджет. Доступно только владельцу. Транзакции бюджета остаются личными транзакциями оплативших их участников",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Удалить общий бюджет",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID бюджета",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Бюджет успешно удален",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не владелец бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Бюджет не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/ledgers/{id}/balance": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает доходы, расходы и баланс бюджета в целом и по каждому участнику",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить баланс общего бюджета",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID бюджета",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Баланс бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не участник бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Бюджет не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/ledgers/{id}/members": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет пользователя в бюджет по username. Доступно только владельцу. Редактор добавляет свои транзакции в бюджет, наблюдатель только просматривает его",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Пригласить участника в общий бюджет",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID бюджета",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Username и роль участника",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.AddLedgerMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Участник добавлен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не владелец бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Бюджет или пользователь не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Пользователь уже участник бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/ledgers/{id}/members/{uid}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Меняет роль участника на editor или viewer. Доступно только владельцу, роль владельца изменить нельзя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Изменить роль участника общего бюджета",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID бюджета",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UID участника",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новая роль",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.UpdateLedgerMemberRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Роль изменена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не владелец бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Бюджет или участник не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Роль владельца изменить нельзя",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Владелец может исключить любого участника, остальные участники могут выйти из бюджета сами. Транзакции участника остаются в бюджете",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Исключить участника из общего бюджета",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID бюджета",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UID участника",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Участник исключен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Бюджет или участник не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Владелец не может покинуть бюджет",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/ledgers/{id}/settlement": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Делит расходы бюджета за период поровну между участниками и показывает, кто кому сколько должен перевести. В расчете участвуют владелец, редакторы и все, кто оплачивал расходы",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Рассчитать взаиморасчеты по общему бюджету",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID бюджета",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода (YYYY-MM-DD), по умолчанию с начала ведения бюджета",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода включительно (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Доли участников и переводы",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не участник бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Бюджет не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/ledgers/{id}/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает транзакции всех участников бюджета с пагинацией. user_uid транзакции - участник, который ее оплатил. Без exact_total подсчет останавливается на 1000 записях после страницы: тогда total_estimated=true и total - нижняя граница",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить транзакции общего бюджета",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID бюджета",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Лимит записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Точно посчитать общее количество транзакций",
+                        "name": "exact_total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список транзакций",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не участник бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Бюджет не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/reports/summary": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Агрегирует транзакции пользователя за период по категориям, типу, дням/неделям/месяцам/годам и тегам. При compare=true считает изменения относительно предыдущего периода: с group_by=month или year - относительно предыдущих календарных месяцев или лет (март сравнивается с февралем), с group_by=week - со сдвигом на целые недели, иначе - относительно предыдущего периода той же длины. С ledger_id сводка строится по транзакциям всех участников общего бюджета, а group_by=member разбивает ее по участникам. Период и недели считаются в часовом поясе пользователя с учетом первого дня недели и дня зарплаты из профиля",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить сводку по транзакциям",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Период: days, week, month, quarter, salary_month, custom. Без period и from/to - текущий месяц",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение периода: 0 - текущий, -1 - предыдущий",
+                        "name": "period_offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Длина окна для period=days",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода (YYYY-MM-DD) для period=custom или без period",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода включительно (YYYY-MM-DD) для period=custom или без period, по умолчанию сегодня",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "category",
+                        "description": "Измерения через запятую: category, type, day, week, month, year, tag, member",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по типу (income или expense)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Сравнить с предыдущим периодом",
+                        "name": "compare",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID общего бюджета",
+                        "name": "ledger_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Сводка по транзакциям",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не участник бюджета",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Бюджет не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает список всех транзакций пользователя с пагинацией. Без exact_total подсчет останавливается на 1000 записях после страницы: тогда total_estimated=true и total - нижняя граница. has_more показывает, есть ли следующая страница",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить транзакции пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Лимит транзакций",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Точно посчитать общее количество транзакций",
+                        "name": "exact_total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список транзакций",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает новую финансовую транзакцию для пользователя. С ledger_id транзакция попадает в общий бюджет и остается за пользователем как за оплатившим участником",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Создать транзакцию",
+                "parameters": [
+                    {
+                        "description": "Данные транзакции",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.CreateTransactionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Транзакция успешно создана",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь не участник бюджета или только просматривает его",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Общий бюджет не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Ключ идемпотентности использован с другим запросом",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/transactions/batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает до 500 транзакций в одной транзакции БД с одним обновлением баланса. Ошибки возвращаются по каждому элементу; при all_or_nothing=true любая ошибка отменяет весь пакет",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Создать транзакции пакетом",
+                "parameters": [
+                    {
+                        "description": "Транзакции",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.BatchCreateTransactionsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Все транзакции созданы",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "207": {
+                        "description": "Часть транзакций создана",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Ни одна транзакция не создана",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Меняет категорию, название или сдвигает дату у нескольких транзакций в одной транзакции БД. Ошибки возвращаются по каждому элементу; при all_or_nothing=true любая ошибка отменяет весь пакет",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Изменить транзакции пакетом",
+                "parameters": [
+                    {
+                        "description": "Изменения транзакций",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.BatchUpdateTransactionsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Все транзакции изменены",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "207": {
+                        "description": "Часть транзакций изменена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Ни одна транзакция не изменена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/transactions/batch/delete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Перемещает несколько транзакций в корзину в одной транзакции БД с одним обновлением баланса. Ошибки возвращаются по каждому элементу; при all_or_nothing=true любая ошибка отменяет весь пакет",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Удалить транзакции пакетом",
+                "parameters": [
+                    {
+                        "description": "ID транзакций",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.BatchDeleteTransactionsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Все транзакции удалены",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "207": {
+                        "description": "Часть транзакций удалена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Ни одна транзакция не удалена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/transactions/period": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает транзакции пользователя за указанное количество дней или за период (неделя, месяц, квартал, зарплатный месяц, произвольный диапазон). Даты считаются в часовом поясе пользователя. Без exact_total подсчет останавливается на 1000 записях после страницы: тогда total_estimated=true и total - нижняя граница. has_more показывает, есть ли следующая страница",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить транзакции за период",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Количество дней",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Период: days, week, month, quarter, salary_month, custom",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение периода: 0 - текущий, -1 - предыдущий",
+                        "name": "period_offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода (YYYY-MM-DD) для period=custom",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода включительно (YYYY-MM-DD) для period=custom",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Лимит транзакций",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Точно посчитать общее количество транзакций",
+                        "name": "exact_total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список транзакций за период",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный период",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/transactions/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает список удаленных транзакций пользователя, которые еще можно восстановить. Без exact_total подсчет останавливается на 1000 записях после страницы: тогда total_estimated=true и total - нижняя граница. has_more показывает, есть ли следующая страница",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить корзину транзакций",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Лимит транзакций",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Точно посчитать общее количество удаленных транзакций",
+                        "name": "exact_total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список удаленных транзакций",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/transactions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает информацию о транзакции по ее ID. Версия транзакции возвращается в заголовке ETag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить транзакцию по ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID транзакции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag ранее полученной версии транзакции",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация о транзакции",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "304": {
+                        "description": "Транзакция не изменилась"
+                    },
+                    "400": {
+                        "description": "Неверный ID транзакции",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Транзакция не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Обновляет информацию о транзакции. С заголовком If-Match обновление применяется, только если транзакция не менялась с указанной версии",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Обновить транзакцию",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID транзакции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Обновленные данные транзакции",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/funds.UpdateTransactionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag версии транзакции, которую изменяет клиент",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Транзакция успешно обновлена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Транзакция принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Транзакция не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Транзакция находится в корзине или ключ идемпотентности использован с другим запросом",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Версия транзакции не совпадает с If-Match",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Перемещает транзакцию в корзину и откатывает ее влияние на баланс. Транзакцию можно восстановить, пока она не удалена окончательно по истечении срока хранения. С заголовком If-Match удаление применяется, только если транзакция не менялась с указанной версии",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Удалить транзакцию",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID транзакции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag версии транзакции, которую изменяет клиент",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Транзакция успешно удалена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID транзакции",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Транзакция принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Транзакция не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Транзакция уже в корзине или ключ идемпотентности использован с другим запросом",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Версия транзакции не совпадает с If-Match",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/transactions/{id}/attachments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает список файлов, прикрепленных к транзакции",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить вложения транзакции",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID транзакции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список вложений",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID транзакции",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Транзакция принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Транзакция не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Транзакция находится в корзине",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Загружает чек или документ к транзакции. Тип файла определяется по содержимому и должен входить в список разрешенных; для изображений создается миниатюра. Размер файла и суммарный объем вложений пользователя ограничены",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Прикрепить файл к транзакции",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID транзакции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Файл вложения",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Вложение успешно загружено",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос или недопустимый тип файла",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Транзакция принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Транзакция не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Транзакция находится в корзине",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "Файл слишком большой или превышена квота",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/transactions/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает ревизии транзакции: кто и когда ее создал, изменил, удалил или восстановил, с состоянием до и после изменения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Получить историю изменений транзакции",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID транзакции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "История изменений транзакции",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID транзакции",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Транзакция принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Транзакция не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/funds/transactions/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Восстанавливает транзакцию из корзины и заново применяет ее к балансу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "funds"
+                ],
+                "summary": "Восстановить транзакцию",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID транзакции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Транзакция успешно восстановлена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID транзакции",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Транзакция принадлежит другому пользователю",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Транзакция не найдена",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Транзакция не находится в корзине",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/subscribe": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Подписывает пользователя на push-уведомления",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Подписаться на уведомления",
+                "parameters": [
+                    {
+                        "description": "Данные подписки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notifications.SubscribeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешная подписка",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/vapid-key": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает публичный VAPID ключ для push-уведомлений",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Получить VAPID ключ",
+                "responses": {
+                    "200": {
+                        "description": "VAPID ключ",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Аутентификация пользователя и получение токенов доступа",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Вход в систему",
+                "parameters": [
+                    {
+                        "description": "Данные для входа",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_user.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешная аутентификация",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Неверные учетные данные",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/register": {
+            "post": {
+                "description": "Создает нового пользователя в системе. Часовой пояс (по умолчанию UTC), первый день недели (1 - понедельник, 7 - воскресенье) и день зарплаты задают календарь, в котором считаются периоды отчетов и аналитики. income_source задает, от чего считаются рекомендации: profile (по умолчанию) - зарплата из профиля, transactions - средний фактический доход по операциям",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Регистрация нового пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные нового пользователя",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_user.CreateUserRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Пользователь успешно создан",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Имя пользователя занято или ключ идемпотентности использован с другим запросом",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/username/{username}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает информацию о пользователе по его username",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Получить пользователя по имени",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Имя пользователя",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация о пользователе",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Username обязателен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получает информацию о пользователе по его ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Получить пользователя по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация о пользователе",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID пользователя обязателен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Обновляет информацию о пользователе (только свой профиль). Не переданные timezone, week_start, salary_day и income_source остаются без изменений. income_source задает, от чего считаются рекомендации: profile - зарплата из профиля, transactions - средний фактический доход по операциям",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Обновить профиль пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Обновленные данные пользователя",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_user.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь успешно обновлен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Можно обновлять только свой профиль",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Имя пользователя занято",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "analytics.AcceptBudgetPlanRequest": {
+            "type": "object",
+            "properties": {
+                "plan_id": {
+                    "type": "string",
+                    "example": "3f0c1a9e-5b7d-4c2e-9a61-2d8e4f7b1c05"
+                }
+            }
+        },
+        "analytics.GenerateBudgetPlanRequest": {
+            "type": "object",
+            "properties": {
+                "savings_target": {
+                    "type": "number",
+                    "example": 25000
+                },
+                "strategy": {
+                    "type": "string",
+                    "example": "50_30_20"
+                }
+            }
+        },
+        "analytics.ReplayDeadLettersRequest": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "analytics.dlq"
+                }
+            }
+        },
+        "analytics.RuleCategoryRange": {
+            "type": "object",
+            "required": [
+                "category_code"
+            ],
+            "properties": {
+                "category_code": {
+                    "type": "string",
+                    "example": "groceries"
+                },
+                "max_percent": {
+                    "type": "number",
+                    "example": 45
+                },
+                "min_percent": {
+                    "type": "number",
+                    "example": 35
+                }
+            }
+        },
+        "analytics.RuleThresholds": {
+            "type": "object",
+            "properties": {
+                "critical_multiplier": {
+                    "type": "number",
+                    "example": 1.2
+                },
+                "excellent_multiplier": {
+                    "type": "number",
+                    "example": 1
+                },
+                "warning_multiplier": {
+                    "type": "number",
+                    "example": 1
+                }
+            }
+        },
+        "analytics.SalaryBracket": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/analytics.RuleCategoryRange"
+                    }
+                },
+                "max_salary": {
+                    "type": "number",
+                    "example": 20000
+                },
+                "min_salary": {
+                    "type": "number",
+                    "example": 0
+                }
+            }
+        },
+        "analytics.ScenarioCategoryAdjustment": {
+            "type": "object",
+            "properties": {
+                "amount_change": {
+                    "type": "number",
+                    "example": 0
+                },
+                "category_code": {
+                    "type": "string",
+                    "example": "restaurants"
+                },
+                "percent_change": {
+                    "type": "number",
+                    "example": -30
+                }
+            }
+        },
+        "analytics.ScenarioRecurringExpense": {
+            "type": "object",
+            "properties": {
+                "category_code": {
+                    "type": "string",
+                    "example": "entertainment"
+                },
+                "monthly_amount": {
+                    "type": "number",
+                    "example": 599
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Онлайн-кинотеатр"
+                }
+            }
+        },
+        "analytics.SimulateScenarioRequest": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/analytics.ScenarioCategoryAdjustment"
+                    }
+                },
+                "recurring_expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/analytics.ScenarioRecurringExpense"
+                    }
+                },
+                "salary": {
+                    "type": "number",
+                    "example": 120000
+                }
+            }
+        },
+        "analytics.UpdateRulesRequest": {
+            "type": "object",
+            "required": [
+                "base_version",
+                "brackets"
+            ],
+            "properties": {
+                "base_version": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "brackets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/analytics.SalaryBracket"
+                    }
+                },
+                "thresholds": {
+                    "$ref": "#/definitions/analytics.RuleThresholds"
+                }
+            }
+        },
+        "funds.AddLedgerMemberRequest": {
+            "type": "object",
+            "required": [
+                "role",
+                "username"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "editor",
+                        "viewer"
+                    ],
+                    "example": "editor"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "maria"
+                }
+            }
+        },
+        "funds.BatchCreateTransactionsRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "all_or_nothing": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/funds.CreateTransactionRequest"
+                    }
+                }
+            }
+        },
+        "funds.BatchDeleteTransactionsRequest": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "all_or_nothing": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2,
+                        3
+                    ]
+                }
+            }
+        },
+        "funds.BatchUpdateTransactionsRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "all_or_nothing": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/funds.TransactionPatchRequest"
+                    }
+                }
+            }
+        },
+        "funds.BudgetItemRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 15000
+                },
+                "category_id": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "funds.CreateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "pets"
+                },
+                "icon": {
+                    "type": "string",
+                    "example": "🐾"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Домашние животные"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Pets"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "income",
+                        "expense"
+                    ],
+                    "example": "expense"
+                }
+            }
+        },
+        "funds.CreateDebtRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "principal",
+                "start_date",
+                "title"
+            ],
+            "properties": {
+                "counterparty": {
+                    "type": "string",
+                    "example": "Сбербанк"
+                },
+                "interest_rate": {
+                    "description": "Годовая ставка, %",
+                    "type": "number",
+                    "example": 12.5
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "loan",
+                        "credit_card",
+                        "borrowed",
+                        "lent"
+                    ],
+                    "example": "loan"
+                },
+                "monthly_payment": {
+                    "description": "0 - рассчитать аннуитетный платеж",
+                    "type": "number",
+                    "example": 0
+                },
+                "principal": {
+                    "type": "number",
+                    "example": 3000000
+                },
+                "start_date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string",
+                    "example": "2025-01-15"
+                },
+                "term_months": {
+                    "type": "integer",
+                    "example": 240
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Ипотека"
+                }
+            }
+        },
+        "funds.CreateLedgerRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Семейный бюджет"
+                }
+            }
+        },
+        "funds.CreateTransactionRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "category_id",
+                "title",
+                "transaction_date",
+                "type"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
                     "example": 1000.5
                 },
                 "category_id": {
@@ -941,6 +4835,21 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Месячная зарплата"
                 },
+                "ledger_id": {
+                    "description": "Общий бюджет, 0 - личная транзакция",
+                    "type": "integer",
+                    "example": 0
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "работа",
+                        "бонус"
+                    ]
+                },
                 "title": {
                     "type": "string",
                     "example": "Зарплата"
@@ -960,6 +4869,105 @@ const docTemplate = `{
                 }
             }
         },
+        "funds.LinkDebtPaymentRequest": {
+            "type": "object",
+            "required": [
+                "transaction_id"
+            ],
+            "properties": {
+                "transaction_id": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "funds.SetBudgetsRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/funds.BudgetItemRequest"
+                    }
+                },
+                "month": {
+                    "description": "YYYY-MM",
+                    "type": "string",
+                    "example": "2025-02"
+                },
+                "replace": {
+                    "description": "Удалить бюджеты месяца по категориям, которых нет в items",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "funds.TransactionPatchRequest": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "expected_version": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "shift_days": {
+                    "type": "integer",
+                    "example": -1
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Продукты"
+                }
+            }
+        },
+        "funds.UpdateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "icon": {
+                    "type": "string",
+                    "example": "🐶"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Питомцы"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Pets"
+                    }
+                }
+            }
+        },
+        "funds.UpdateLedgerMemberRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "editor",
+                        "viewer"
+                    ],
+                    "example": "viewer"
+                }
+            }
+        },
         "funds.UpdateTransactionRequest": {
             "type": "object",
             "properties": {
@@ -975,6 +4983,15 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Покупка продуктов"
                 },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "еда"
+                    ]
+                },
                 "title": {
                     "type": "string",
                     "example": "Продукты"
@@ -1010,6 +5027,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "John"
                 },
+                "income_source": {
+                    "type": "string",
+                    "example": "profile"
+                },
                 "password": {
                     "type": "string",
                     "minLength": 6,
@@ -1020,14 +5041,26 @@ const docTemplate = `{
                     "minimum": 0,
                     "example": 50000
                 },
+                "salary_day": {
+                    "type": "integer",
+                    "example": 10
+                },
                 "second_name": {
                     "type": "string",
                     "example": "Doe"
                 },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                },
                 "username": {
                     "type": "string",
                     "example": "john_doe"
                 },
+                "week_start": {
+                    "type": "integer",
+                    "example": 1
+                },
                 "work_sphere_id": {
                     "type": "integer",
                     "example": 1
@@ -1062,18 +5095,34 @@ const docTemplate = `{
                     "type": "string",
                     "example": "John"
                 },
+                "income_source": {
+                    "type": "string",
+                    "example": "transactions"
+                },
                 "salary": {
                     "type": "number",
                     "example": 55000
                 },
+                "salary_day": {
+                    "type": "integer",
+                    "example": 25
+                },
                 "second_name": {
                     "type": "string",
                     "example": "Doe"
                 },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Yekaterinburg"
+                },
                 "username": {
                     "type": "string",
                     "example": "john_doe"
                 },
+                "week_start": {
+                    "type": "integer",
+                    "example": 7
+                },
                 "work_sphere_id": {
                     "type": "integer",
                     "example": 2
@@ -16,10 +16,14 @@ import (
 )
 
 type GRPCClients struct {
-	UserService      user_pb.UserServiceClient
-	FundsService     funds_pb.FundsServiceClient
-	NotifService     notif_pb.NotificationServiceClient
-	AnalyticsService analytics_pb.AnalyticsServiceClient
+	UserService       user_pb.UserServiceClient
+	FundsService      funds_pb.FundsServiceClient
+	AttachmentService funds_pb.AttachmentServiceClient
+	DebtService       funds_pb.DebtServiceClient
+	LedgerService     funds_pb.LedgerServiceClient
+	BudgetService     funds_pb.BudgetServiceClient
+	NotifService      notif_pb.NotificationServiceClient
+	AnalyticsService  analytics_pb.AnalyticsServiceClient
 
 	userConn      *grpc.ClientConn
 	fundsConn     *grpc.ClientConn
@@ -60,6 +64,10 @@ func NewGRPCClients(cfg config.AppConfig) (*GRPCClients, error) {
 	}
 	clients.fundsConn = fundsConn
 	clients.FundsService = funds_pb.NewFundsServiceClient(fundsConn)
+	clients.AttachmentService = funds_pb.NewAttachmentServiceClient(fundsConn)
+	clients.DebtService = funds_pb.NewDebtServiceClient(fundsConn)
+	clients.LedgerService = funds_pb.NewLedgerServiceClient(fundsConn)
+	clients.BudgetService = funds_pb.NewBudgetServiceClient(fundsConn)
 
 	// Connect to Notification Service
 	notifAddr := fmt.Sprintf("%s:%s", cfg.NotifServiceClient.Host, cfg.NotifServiceClient.Port)
@@ -14,11 +14,19 @@ type AppConfig struct {
 	FundsServiceClient     ServiceClientConfig
 	NotifServiceClient     ServiceClientConfig
 	AnalyticsServiceClient ServiceClientConfig
+	Admin                  AdminConfig
 }
 
 type ServerConfig struct {
 	Host string `env:"API_GATEWAY_HOST" envDefault:""`
 	Port string `env:"API_GATEWAY_PORT" envDefault:"8080"`
+	// BodyLimit must leave room for multipart overhead on top of the attachment size limit
+	BodyLimit int `env:"API_GATEWAY_BODY_LIMIT" envDefault:"12582912"`
+}
+
+// AdminConfig lists the users allowed to manage system-wide data such as categories
+type AdminConfig struct {
+	UserIDs []string `env:"API_GATEWAY_ADMIN_USER_IDS" envSeparator:","`
 }
 
 type ServiceClientConfig struct {
@@ -37,6 +45,12 @@ func New() (AppConfig, error) {
 		return AppConfig{}, fmt.Errorf("error parsing server config: %w", err)
 	}
 
+	// Parse admin config
+	err = env.Parse(&cfg.Admin)
+	if err != nil {
+		return AppConfig{}, fmt.Errorf("error parsing admin config: %w", err)
+	}
+
 	// Parse user service config
 	err = env.ParseWithOptions(&cfg.UserServiceClient, env.Options{
 		Prefix: "USER_SERVICE_",
@@ -24,6 +24,8 @@ const (
 type GetRecommendationsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	LedgerId      int64                  `protobuf:"varint,2,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"` // Рекомендации для общего бюджета, пользователь должен быть его участником
+	Period        *Period                `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`                      // Период расходов, по умолчанию последние 30 дней из кэша
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,6 +67,97 @@ func (x *GetRecommendationsReq) GetUserUid() string {
 	return ""
 }
 
+func (x *GetRecommendationsReq) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *GetRecommendationsReq) GetPeriod() *Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+// Period задает период относительно текущей даты в часовом поясе пользователя
+type Period struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                         // days, week, month, quarter, salary_month, custom
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                    // 0 - текущий период, -1 - предыдущий и т.д.
+	DateFrom      string                 `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD, только для custom
+	DateTo        string                 `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD включительно, только для custom
+	Days          int32                  `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`                        // Длина окна, только для days
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Period) Reset() {
+	*x = Period{}
+	mi := &file_analytics_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Period) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Period) ProtoMessage() {}
+
+func (x *Period) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Period.ProtoReflect.Descriptor instead.
+func (*Period) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Period) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Period) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Period) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *Period) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *Period) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
 type CategoryRecommendation struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	CategoryName     string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`               // Название категории
@@ -75,13 +168,14 @@ type CategoryRecommendation struct {
 	Status           string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                               // excellent, normal, warning, critical
 	Message          string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`                                             // Текстовое сообщение для пользователя
 	Deviation        float64                `protobuf:"fixed64,8,opt,name=deviation,proto3" json:"deviation,omitempty"`                                       // Отклонение от нормы (может быть отрицательным)
+	CategoryCode     string                 `protobuf:"bytes,9,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`               // Стабильный код категории, не зависит от языка
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CategoryRecommendation) Reset() {
 	*x = CategoryRecommendation{}
-	mi := &file_analytics_service_proto_msgTypes[1]
+	mi := &file_analytics_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -93,7 +187,7 @@ func (x *CategoryRecommendation) String() string {
 func (*CategoryRecommendation) ProtoMessage() {}
 
 func (x *CategoryRecommendation) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_service_proto_msgTypes[1]
+	mi := &file_analytics_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -106,7 +200,7 @@ func (x *CategoryRecommendation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryRecommendation.ProtoReflect.Descriptor instead.
 func (*CategoryRecommendation) Descriptor() ([]byte, []int) {
-	return file_analytics_service_proto_rawDescGZIP(), []int{1}
+	return file_analytics_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CategoryRecommendation) GetCategoryName() string {
@@ -165,6 +259,13 @@ func (x *CategoryRecommendation) GetDeviation() float64 {
 	return 0
 }
 
+func (x *CategoryRecommendation) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
 type GetRecommendationsResp struct {
 	state           protoimpl.MessageState    `protogen:"open.v1"`
 	UserUid         string                    `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
@@ -179,13 +280,20 @@ type GetRecommendationsResp struct {
 	OverallMessage  string                    `protobuf:"bytes,10,opt,name=overall_message,json=overallMessage,proto3" json:"overall_message,omitempty"` // Общее сообщение
 	Recommendations []*CategoryRecommendation `protobuf:"bytes,11,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
 	CalculatedAt    int64                     `protobuf:"varint,12,opt,name=calculated_at,json=calculatedAt,proto3" json:"calculated_at,omitempty"` // Unix timestamp
+	Debt            *DebtLoad                 `protobuf:"bytes,13,opt,name=debt,proto3" json:"debt,omitempty"`                                      // Долговая нагрузка, отсутствует если не удалось получить долги
+	LedgerId        int64                     `protobuf:"varint,14,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`             // Заполнен для общего бюджета, salary - сумма зарплат участников
+	PeriodFrom      string                    `protobuf:"bytes,15,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty"`        // Начало периода расходов (YYYY-MM-DD)
+	PeriodTo        string                    `protobuf:"bytes,16,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty"`              // Конец периода расходов включительно (YYYY-MM-DD)
+	RulesVersion    int64                     `protobuf:"varint,17,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"` // Версия правил, по которой сформированы рекомендации
+	IncomeSource    string                    `protobuf:"bytes,18,opt,name=income_source,json=incomeSource,proto3" json:"income_source,omitempty"`  // От чего посчитана salary: profile - зарплата из профиля, transactions - средний доход по операциям
+	Income          *IncomeAnalysis           `protobuf:"bytes,19,opt,name=income,proto3" json:"income,omitempty"`                                  // Фактический доход, отсутствует для общего бюджета и если его не удалось посчитать
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetRecommendationsResp) Reset() {
 	*x = GetRecommendationsResp{}
-	mi := &file_analytics_service_proto_msgTypes[2]
+	mi := &file_analytics_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -197,7 +305,7 @@ func (x *GetRecommendationsResp) String() string {
 func (*GetRecommendationsResp) ProtoMessage() {}
 
 func (x *GetRecommendationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_service_proto_msgTypes[2]
+	mi := &file_analytics_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,7 +318,7 @@ func (x *GetRecommendationsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRecommendationsResp.ProtoReflect.Descriptor instead.
 func (*GetRecommendationsResp) Descriptor() ([]byte, []int) {
-	return file_analytics_service_proto_rawDescGZIP(), []int{2}
+	return file_analytics_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetRecommendationsResp) GetUserUid() string {
@@ -297,38 +405,4173 @@ func (x *GetRecommendationsResp) GetCalculatedAt() int64 {
 	return 0
 }
 
-var File_analytics_service_proto protoreflect.FileDescriptor
+func (x *GetRecommendationsResp) GetDebt() *DebtLoad {
+	if x != nil {
+		return x.Debt
+	}
+	return nil
+}
 
-const file_analytics_service_proto_rawDesc = "" +
-	"\n" +
-	"\x17analytics_service.proto\x12\x11analytics_service\"2\n" +
-	"\x15GetRecommendationsReq\x12\x19\n" +
-	"\buser_uid\x18\x01 \x01(\tR\auserUid\"\xb1\x02\n" +
-	"\x16CategoryRecommendation\x12#\n" +
-	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12#\n" +
-	"\ractual_amount\x18\x02 \x01(\x01R\factualAmount\x12+\n" +
-	"\x11actual_percentage\x18\x03 \x01(\x01R\x10actualPercentage\x12'\n" +
-	"\x0frecommended_min\x18\x04 \x01(\x01R\x0erecommendedMin\x12'\n" +
-	"\x0frecommended_max\x18\x05 \x01(\x01R\x0erecommendedMax\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\a \x01(\tR\amessage\x12\x1c\n" +
-	"\tdeviation\x18\b \x01(\x01R\tdeviation\"\xff\x03\n" +
-	"\x16GetRecommendationsResp\x12\x19\n" +
-	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x16\n" +
-	"\x06salary\x18\x02 \x01(\x01R\x06salary\x12%\n" +
-	"\x0esalary_bracket\x18\x03 \x01(\tR\rsalaryBracket\x12)\n" +
-	"\x10total_categories\x18\x04 \x01(\x05R\x0ftotalCategories\x12'\n" +
-	"\x0fexcellent_count\x18\x05 \x01(\x05R\x0eexcellentCount\x12!\n" +
-	"\fnormal_count\x18\x06 \x01(\x05R\vnormalCount\x12#\n" +
-	"\rwarning_count\x18\a \x01(\x05R\fwarningCount\x12%\n" +
-	"\x0ecritical_count\x18\b \x01(\x05R\rcriticalCount\x12%\n" +
-	"\x0eoverall_status\x18\t \x01(\tR\roverallStatus\x12'\n" +
-	"\x0foverall_message\x18\n" +
-	" \x01(\tR\x0eoverallMessage\x12S\n" +
-	"\x0frecommendations\x18\v \x03(\v2).analytics_service.CategoryRecommendationR\x0frecommendations\x12#\n" +
-	"\rcalculated_at\x18\f \x01(\x03R\fcalculatedAt2}\n" +
+func (x *GetRecommendationsResp) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *GetRecommendationsResp) GetPeriodFrom() string {
+	if x != nil {
+		return x.PeriodFrom
+	}
+	return ""
+}
+
+func (x *GetRecommendationsResp) GetPeriodTo() string {
+	if x != nil {
+		return x.PeriodTo
+	}
+	return ""
+}
+
+func (x *GetRecommendationsResp) GetRulesVersion() int64 {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return 0
+}
+
+func (x *GetRecommendationsResp) GetIncomeSource() string {
+	if x != nil {
+		return x.IncomeSource
+	}
+	return ""
+}
+
+func (x *GetRecommendationsResp) GetIncome() *IncomeAnalysis {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+// IncomeAnalysis - фактический доход по операциям за прошлые полные месяцы
+type IncomeAnalysis struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ProfileSalary       float64                `protobuf:"fixed64,1,opt,name=profile_salary,json=profileSalary,proto3" json:"profile_salary,omitempty"`
+	EffectiveIncome     float64                `protobuf:"fixed64,2,opt,name=effective_income,json=effectiveIncome,proto3" json:"effective_income,omitempty"` // Средний месячный доход, разовые выбросы ограничены
+	Months              int32                  `protobuf:"varint,3,opt,name=months,proto3" json:"months,omitempty"`                                           // Число учтенных месяцев, начиная с первого месяца с доходом
+	StabilityScore      float64                `protobuf:"fixed64,4,opt,name=stability_score,json=stabilityScore,proto3" json:"stability_score,omitempty"`    // 0..100, 100 - доход не меняется от месяца к месяцу
+	AverageExpenses     float64                `protobuf:"fixed64,5,opt,name=average_expenses,json=averageExpenses,proto3" json:"average_expenses,omitempty"` // Средние расходы в месяц за те же месяцы
+	SavingsRate         float64                `protobuf:"fixed64,6,opt,name=savings_rate,json=savingsRate,proto3" json:"savings_rate,omitempty"`             // Процент дохода, который остается, отрицательный - расходы больше дохода
+	Balance             float64                `protobuf:"fixed64,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	EmergencyFundMonths float64                `protobuf:"fixed64,8,opt,name=emergency_fund_months,json=emergencyFundMonths,proto3" json:"emergency_fund_months,omitempty"` // На сколько месяцев средних расходов хватит баланса
+	ExpenseVolatility   float64                `protobuf:"fixed64,9,opt,name=expense_volatility,json=expenseVolatility,proto3" json:"expense_volatility,omitempty"`         // Коэффициент вариации расходов по месяцам, 0 - расходы не меняются
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *IncomeAnalysis) Reset() {
+	*x = IncomeAnalysis{}
+	mi := &file_analytics_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomeAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeAnalysis) ProtoMessage() {}
+
+func (x *IncomeAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeAnalysis.ProtoReflect.Descriptor instead.
+func (*IncomeAnalysis) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IncomeAnalysis) GetProfileSalary() float64 {
+	if x != nil {
+		return x.ProfileSalary
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetEffectiveIncome() float64 {
+	if x != nil {
+		return x.EffectiveIncome
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetStabilityScore() float64 {
+	if x != nil {
+		return x.StabilityScore
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetAverageExpenses() float64 {
+	if x != nil {
+		return x.AverageExpenses
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetSavingsRate() float64 {
+	if x != nil {
+		return x.SavingsRate
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetEmergencyFundMonths() float64 {
+	if x != nil {
+		return x.EmergencyFundMonths
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetExpenseVolatility() float64 {
+	if x != nil {
+		return x.ExpenseVolatility
+	}
+	return 0
+}
+
+type DebtLoad struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TotalOwed         float64                `protobuf:"fixed64,1,opt,name=total_owed,json=totalOwed,proto3" json:"total_owed,omitempty"`                             // Остаток долга по кредитам, картам и займам
+	MonthlyPayments   float64                `protobuf:"fixed64,2,opt,name=monthly_payments,json=monthlyPayments,proto3" json:"monthly_payments,omitempty"`           // Ежемесячные платежи по долгам
+	DebtToIncomeRatio float64                `protobuf:"fixed64,3,opt,name=debt_to_income_ratio,json=debtToIncomeRatio,proto3" json:"debt_to_income_ratio,omitempty"` // Платежи в процентах от зарплаты
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                                      // excellent, normal, warning, critical
+	Message           string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	DebtFreeDate      string                 `protobuf:"bytes,6,opt,name=debt_free_date,json=debtFreeDate,proto3" json:"debt_free_date,omitempty"` // Прогнозируемая дата погашения всех долгов (YYYY-MM-DD)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DebtLoad) Reset() {
+	*x = DebtLoad{}
+	mi := &file_analytics_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtLoad) ProtoMessage() {}
+
+func (x *DebtLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtLoad.ProtoReflect.Descriptor instead.
+func (*DebtLoad) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DebtLoad) GetTotalOwed() float64 {
+	if x != nil {
+		return x.TotalOwed
+	}
+	return 0
+}
+
+func (x *DebtLoad) GetMonthlyPayments() float64 {
+	if x != nil {
+		return x.MonthlyPayments
+	}
+	return 0
+}
+
+func (x *DebtLoad) GetDebtToIncomeRatio() float64 {
+	if x != nil {
+		return x.DebtToIncomeRatio
+	}
+	return 0
+}
+
+func (x *DebtLoad) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DebtLoad) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DebtLoad) GetDebtFreeDate() string {
+	if x != nil {
+		return x.DebtFreeDate
+	}
+	return ""
+}
+
+type GetRecommendationHistoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Months        int32                  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"` // Число последних месяцев, включая текущий, по умолчанию 6, не больше 24
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationHistoryReq) Reset() {
+	*x = GetRecommendationHistoryReq{}
+	mi := &file_analytics_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationHistoryReq) ProtoMessage() {}
+
+func (x *GetRecommendationHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetRecommendationHistoryReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRecommendationHistoryReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetRecommendationHistoryReq) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+// RecommendationSnapshot - последние рассчитанные рекомендации с концом периода в этом месяце
+type RecommendationSnapshot struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Month           string                  `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM
+	Recommendations *GetRecommendationsResp `protobuf:"bytes,2,opt,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecommendationSnapshot) Reset() {
+	*x = RecommendationSnapshot{}
+	mi := &file_analytics_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationSnapshot) ProtoMessage() {}
+
+func (x *RecommendationSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationSnapshot.ProtoReflect.Descriptor instead.
+func (*RecommendationSnapshot) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RecommendationSnapshot) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *RecommendationSnapshot) GetRecommendations() *GetRecommendationsResp {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type GetRecommendationHistoryResp struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Snapshots     []*RecommendationSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"` // По возрастанию месяца, месяцы без рекомендаций пропущены
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationHistoryResp) Reset() {
+	*x = GetRecommendationHistoryResp{}
+	mi := &file_analytics_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationHistoryResp) ProtoMessage() {}
+
+func (x *GetRecommendationHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetRecommendationHistoryResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetRecommendationHistoryResp) GetSnapshots() []*RecommendationSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type GetCategoryTrendReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Months        int32                  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"`                                // Число последних месяцев, включая текущий, по умолчанию 6, не больше 24
+	CategoryCode  string                 `protobuf:"bytes,3,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"` // Код категории, пусто - все категории
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTrendReq) Reset() {
+	*x = GetCategoryTrendReq{}
+	mi := &file_analytics_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTrendReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTrendReq) ProtoMessage() {}
+
+func (x *GetCategoryTrendReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTrendReq.ProtoReflect.Descriptor instead.
+func (*GetCategoryTrendReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetCategoryTrendReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetCategoryTrendReq) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *GetCategoryTrendReq) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+type CategoryTrendPoint struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Month            string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM
+	ActualAmount     float64                `protobuf:"fixed64,2,opt,name=actual_amount,json=actualAmount,proto3" json:"actual_amount,omitempty"`
+	ActualPercentage float64                `protobuf:"fixed64,3,opt,name=actual_percentage,json=actualPercentage,proto3" json:"actual_percentage,omitempty"` // Процент от зарплаты
+	RecommendedMin   float64                `protobuf:"fixed64,4,opt,name=recommended_min,json=recommendedMin,proto3" json:"recommended_min,omitempty"`
+	RecommendedMax   float64                `protobuf:"fixed64,5,opt,name=recommended_max,json=recommendedMax,proto3" json:"recommended_max,omitempty"`
+	Status           string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // excellent, normal, warning, critical
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CategoryTrendPoint) Reset() {
+	*x = CategoryTrendPoint{}
+	mi := &file_analytics_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTrendPoint) ProtoMessage() {}
+
+func (x *CategoryTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTrendPoint.ProtoReflect.Descriptor instead.
+func (*CategoryTrendPoint) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CategoryTrendPoint) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *CategoryTrendPoint) GetActualAmount() float64 {
+	if x != nil {
+		return x.ActualAmount
+	}
+	return 0
+}
+
+func (x *CategoryTrendPoint) GetActualPercentage() float64 {
+	if x != nil {
+		return x.ActualPercentage
+	}
+	return 0
+}
+
+func (x *CategoryTrendPoint) GetRecommendedMin() float64 {
+	if x != nil {
+		return x.RecommendedMin
+	}
+	return 0
+}
+
+func (x *CategoryTrendPoint) GetRecommendedMax() float64 {
+	if x != nil {
+		return x.RecommendedMax
+	}
+	return 0
+}
+
+func (x *CategoryTrendPoint) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CategoryTrend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryCode  string                 `protobuf:"bytes,1,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Points        []*CategoryTrendPoint  `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	Direction     string                 `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"` // improving, worsening, stable, new - по двум последним месяцам
+	Change        float64                `protobuf:"fixed64,5,opt,name=change,proto3" json:"change,omitempty"`     // Изменение процента от зарплаты за последний месяц, п.п.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTrend) Reset() {
+	*x = CategoryTrend{}
+	mi := &file_analytics_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTrend) ProtoMessage() {}
+
+func (x *CategoryTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTrend.ProtoReflect.Descriptor instead.
+func (*CategoryTrend) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CategoryTrend) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *CategoryTrend) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryTrend) GetPoints() []*CategoryTrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *CategoryTrend) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *CategoryTrend) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+type GetCategoryTrendResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trends        []*CategoryTrend       `protobuf:"bytes,1,rep,name=trends,proto3" json:"trends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTrendResp) Reset() {
+	*x = GetCategoryTrendResp{}
+	mi := &file_analytics_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTrendResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTrendResp) ProtoMessage() {}
+
+func (x *GetCategoryTrendResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTrendResp.ProtoReflect.Descriptor instead.
+func (*GetCategoryTrendResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCategoryTrendResp) GetTrends() []*CategoryTrend {
+	if x != nil {
+		return x.Trends
+	}
+	return nil
+}
+
+type GetForecastReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastReq) Reset() {
+	*x = GetForecastReq{}
+	mi := &file_analytics_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastReq) ProtoMessage() {}
+
+func (x *GetForecastReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastReq.ProtoReflect.Descriptor instead.
+func (*GetForecastReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetForecastReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+// CategoryForecast - прогноз расходов категории на конец месяца
+type CategoryForecast struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CategoryCode      string                 `protobuf:"bytes,1,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	CategoryName      string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	SpentToDate       float64                `protobuf:"fixed64,3,opt,name=spent_to_date,json=spentToDate,proto3" json:"spent_to_date,omitempty"` // Потрачено с начала месяца по сегодня
+	Scheduled         float64                `protobuf:"fixed64,4,opt,name=scheduled,proto3" json:"scheduled,omitempty"`                          // Уже внесенные расходы на оставшиеся дни месяца
+	Forecast          float64                `protobuf:"fixed64,5,opt,name=forecast,proto3" json:"forecast,omitempty"`                            // Ожидаемые расходы за месяц
+	Low               float64                `protobuf:"fixed64,6,opt,name=low,proto3" json:"low,omitempty"`                                      // 80% интервал прогноза
+	High              float64                `protobuf:"fixed64,7,opt,name=high,proto3" json:"high,omitempty"`
+	RecommendedMax    float64                `protobuf:"fixed64,8,opt,name=recommended_max,json=recommendedMax,proto3" json:"recommended_max,omitempty"`          // Рекомендуемый максимум в рублях, 0 - нет правила для категории
+	BreachProbability float64                `protobuf:"fixed64,9,opt,name=breach_probability,json=breachProbability,proto3" json:"breach_probability,omitempty"` // Вероятность превысить бюджет, а без него - рекомендуемый максимум, 0..1
+	LikelyBreach      bool                   `protobuf:"varint,10,opt,name=likely_breach,json=likelyBreach,proto3" json:"likely_breach,omitempty"`
+	Budget            float64                `protobuf:"fixed64,11,opt,name=budget,proto3" json:"budget,omitempty"` // Бюджет категории на месяц из funds-service, 0 - не задан
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CategoryForecast) Reset() {
+	*x = CategoryForecast{}
+	mi := &file_analytics_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryForecast) ProtoMessage() {}
+
+func (x *CategoryForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryForecast.ProtoReflect.Descriptor instead.
+func (*CategoryForecast) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CategoryForecast) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *CategoryForecast) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryForecast) GetSpentToDate() float64 {
+	if x != nil {
+		return x.SpentToDate
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetScheduled() float64 {
+	if x != nil {
+		return x.Scheduled
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetForecast() float64 {
+	if x != nil {
+		return x.Forecast
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetRecommendedMax() float64 {
+	if x != nil {
+		return x.RecommendedMax
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetBreachProbability() float64 {
+	if x != nil {
+		return x.BreachProbability
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetLikelyBreach() bool {
+	if x != nil {
+		return x.LikelyBreach
+	}
+	return false
+}
+
+func (x *CategoryForecast) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+type GetForecastResp struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	UserUid                 string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Month                   string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM в часовом поясе пользователя
+	DaysElapsed             int32                  `protobuf:"varint,3,opt,name=days_elapsed,json=daysElapsed,proto3" json:"days_elapsed,omitempty"`
+	DaysInMonth             int32                  `protobuf:"varint,4,opt,name=days_in_month,json=daysInMonth,proto3" json:"days_in_month,omitempty"`
+	HistoryMonths           int32                  `protobuf:"varint,5,opt,name=history_months,json=historyMonths,proto3" json:"history_months,omitempty"` // Число прошлых месяцев, учтенных в прогнозе
+	Salary                  float64                `protobuf:"fixed64,6,opt,name=salary,proto3" json:"salary,omitempty"`
+	SpentToDate             float64                `protobuf:"fixed64,7,opt,name=spent_to_date,json=spentToDate,proto3" json:"spent_to_date,omitempty"`
+	Forecast                float64                `protobuf:"fixed64,8,opt,name=forecast,proto3" json:"forecast,omitempty"`
+	Low                     float64                `protobuf:"fixed64,9,opt,name=low,proto3" json:"low,omitempty"`
+	High                    float64                `protobuf:"fixed64,10,opt,name=high,proto3" json:"high,omitempty"`
+	BudgetBreachProbability float64                `protobuf:"fixed64,11,opt,name=budget_breach_probability,json=budgetBreachProbability,proto3" json:"budget_breach_probability,omitempty"` // Вероятность, что расходы за месяц превысят зарплату
+	LikelyBudgetBreach      bool                   `protobuf:"varint,12,opt,name=likely_budget_breach,json=likelyBudgetBreach,proto3" json:"likely_budget_breach,omitempty"`
+	Categories              []*CategoryForecast    `protobuf:"bytes,13,rep,name=categories,proto3" json:"categories,omitempty"` // Сначала категории с наибольшей вероятностью превышения
+	RulesVersion            int64                  `protobuf:"varint,14,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
+	CalculatedAt            int64                  `protobuf:"varint,15,opt,name=calculated_at,json=calculatedAt,proto3" json:"calculated_at,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetForecastResp) Reset() {
+	*x = GetForecastResp{}
+	mi := &file_analytics_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastResp) ProtoMessage() {}
+
+func (x *GetForecastResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastResp.ProtoReflect.Descriptor instead.
+func (*GetForecastResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetForecastResp) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetForecastResp) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *GetForecastResp) GetDaysElapsed() int32 {
+	if x != nil {
+		return x.DaysElapsed
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetDaysInMonth() int32 {
+	if x != nil {
+		return x.DaysInMonth
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetHistoryMonths() int32 {
+	if x != nil {
+		return x.HistoryMonths
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetSalary() float64 {
+	if x != nil {
+		return x.Salary
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetSpentToDate() float64 {
+	if x != nil {
+		return x.SpentToDate
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetForecast() float64 {
+	if x != nil {
+		return x.Forecast
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetBudgetBreachProbability() float64 {
+	if x != nil {
+		return x.BudgetBreachProbability
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetLikelyBudgetBreach() bool {
+	if x != nil {
+		return x.LikelyBudgetBreach
+	}
+	return false
+}
+
+func (x *GetForecastResp) GetCategories() []*CategoryForecast {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetForecastResp) GetRulesVersion() int64 {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return 0
+}
+
+func (x *GetForecastResp) GetCalculatedAt() int64 {
+	if x != nil {
+		return x.CalculatedAt
+	}
+	return 0
+}
+
+type GetAnomaliesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Число последних отмеченных операций, по умолчанию 20, не больше 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnomaliesReq) Reset() {
+	*x = GetAnomaliesReq{}
+	mi := &file_analytics_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnomaliesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnomaliesReq) ProtoMessage() {}
+
+func (x *GetAnomaliesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnomaliesReq.ProtoReflect.Descriptor instead.
+func (*GetAnomaliesReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAnomaliesReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetAnomaliesReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Anomaly - отметка о необычной операции
+type Anomaly struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId   int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // large_amount, large_for_title, duplicate, new_category
+	CategoryId      int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName    string                 `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Type            string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"` // income или expense
+	Title           string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Amount          float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionDate string                 `protobuf:"bytes,8,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"` // YYYY-MM-DD
+	Typical         float64                `protobuf:"fixed64,9,opt,name=typical,proto3" json:"typical,omitempty"`                                      // Медиана сумм, с которыми сравнивалась операция
+	Score           float64                `protobuf:"fixed64,10,opt,name=score,proto3" json:"score,omitempty"`                                         // Робастная z-оценка
+	DuplicateOf     int64                  `protobuf:"varint,11,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`           // Операция, которую повторяет эта
+	Explanation     string                 `protobuf:"bytes,12,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	DetectedAt      int64                  `protobuf:"varint,13,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	mi := &file_analytics_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Anomaly) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *Anomaly) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Anomaly) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Anomaly) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *Anomaly) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Anomaly) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Anomaly) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Anomaly) GetTransactionDate() string {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return ""
+}
+
+func (x *Anomaly) GetTypical() float64 {
+	if x != nil {
+		return x.Typical
+	}
+	return 0
+}
+
+func (x *Anomaly) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Anomaly) GetDuplicateOf() int64 {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return 0
+}
+
+func (x *Anomaly) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *Anomaly) GetDetectedAt() int64 {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return 0
+}
+
+type GetAnomaliesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies     []*Anomaly             `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"` // Сначала аномалии последних отмеченных операций
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnomaliesResp) Reset() {
+	*x = GetAnomaliesResp{}
+	mi := &file_analytics_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnomaliesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnomaliesResp) ProtoMessage() {}
+
+func (x *GetAnomaliesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnomaliesResp.ProtoReflect.Descriptor instead.
+func (*GetAnomaliesResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAnomaliesResp) GetAnomalies() []*Anomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+type GetDetectedSubscriptionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDetectedSubscriptionsReq) Reset() {
+	*x = GetDetectedSubscriptionsReq{}
+	mi := &file_analytics_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDetectedSubscriptionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDetectedSubscriptionsReq) ProtoMessage() {}
+
+func (x *GetDetectedSubscriptionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDetectedSubscriptionsReq.ProtoReflect.Descriptor instead.
+func (*GetDetectedSubscriptionsReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetDetectedSubscriptionsReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+// PriceChange - изменение суммы списания подписки
+type PriceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD первого списания по новой цене
+	OldAmount     float64                `protobuf:"fixed64,2,opt,name=old_amount,json=oldAmount,proto3" json:"old_amount,omitempty"`
+	NewAmount     float64                `protobuf:"fixed64,3,opt,name=new_amount,json=newAmount,proto3" json:"new_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	mi := &file_analytics_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PriceChange) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PriceChange) GetOldAmount() float64 {
+	if x != nil {
+		return x.OldAmount
+	}
+	return 0
+}
+
+func (x *PriceChange) GetNewAmount() float64 {
+	if x != nil {
+		return x.NewAmount
+	}
+	return 0
+}
+
+// Subscription - регулярный платеж, найденный в расходах
+type Subscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // Название последнего списания
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Period        string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`   // weekly, monthly или yearly
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"` // Сумма последнего списания
+	MonthlyCost   float64                `protobuf:"fixed64,6,opt,name=monthly_cost,json=monthlyCost,proto3" json:"monthly_cost,omitempty"`
+	Charges       int32                  `protobuf:"varint,7,opt,name=charges,proto3" json:"charges,omitempty"`
+	FirstCharge   string                 `protobuf:"bytes,8,opt,name=first_charge,json=firstCharge,proto3" json:"first_charge,omitempty"` // YYYY-MM-DD
+	LastCharge    string                 `protobuf:"bytes,9,opt,name=last_charge,json=lastCharge,proto3" json:"last_charge,omitempty"`
+	NextCharge    string                 `protobuf:"bytes,10,opt,name=next_charge,json=nextCharge,proto3" json:"next_charge,omitempty"` // Ожидаемая дата следующего списания
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`                           // active или possibly_cancelled
+	PriceChanges  []*PriceChange         `protobuf:"bytes,12,rep,name=price_changes,json=priceChanges,proto3" json:"price_changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_analytics_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Subscription) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Subscription) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Subscription) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *Subscription) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Subscription) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Subscription) GetMonthlyCost() float64 {
+	if x != nil {
+		return x.MonthlyCost
+	}
+	return 0
+}
+
+func (x *Subscription) GetCharges() int32 {
+	if x != nil {
+		return x.Charges
+	}
+	return 0
+}
+
+func (x *Subscription) GetFirstCharge() string {
+	if x != nil {
+		return x.FirstCharge
+	}
+	return ""
+}
+
+func (x *Subscription) GetLastCharge() string {
+	if x != nil {
+		return x.LastCharge
+	}
+	return ""
+}
+
+func (x *Subscription) GetNextCharge() string {
+	if x != nil {
+		return x.NextCharge
+	}
+	return ""
+}
+
+func (x *Subscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Subscription) GetPriceChanges() []*PriceChange {
+	if x != nil {
+		return x.PriceChanges
+	}
+	return nil
+}
+
+type GetDetectedSubscriptionsResp struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserUid          string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Subscriptions    []*Subscription        `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`                                   // Сначала действующие, по убыванию стоимости в месяц
+	TotalMonthlyCost float64                `protobuf:"fixed64,3,opt,name=total_monthly_cost,json=totalMonthlyCost,proto3" json:"total_monthly_cost,omitempty"` // Без подписок, которые, возможно, отменены
+	CalculatedAt     int64                  `protobuf:"varint,4,opt,name=calculated_at,json=calculatedAt,proto3" json:"calculated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetDetectedSubscriptionsResp) Reset() {
+	*x = GetDetectedSubscriptionsResp{}
+	mi := &file_analytics_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDetectedSubscriptionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDetectedSubscriptionsResp) ProtoMessage() {}
+
+func (x *GetDetectedSubscriptionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDetectedSubscriptionsResp.ProtoReflect.Descriptor instead.
+func (*GetDetectedSubscriptionsResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetDetectedSubscriptionsResp) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetDetectedSubscriptionsResp) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *GetDetectedSubscriptionsResp) GetTotalMonthlyCost() float64 {
+	if x != nil {
+		return x.TotalMonthlyCost
+	}
+	return 0
+}
+
+func (x *GetDetectedSubscriptionsResp) GetCalculatedAt() int64 {
+	if x != nil {
+		return x.CalculatedAt
+	}
+	return 0
+}
+
+type GetHealthScoreReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Months        int32                  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"` // Глубина истории, по умолчанию 6, не больше 24
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHealthScoreReq) Reset() {
+	*x = GetHealthScoreReq{}
+	mi := &file_analytics_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHealthScoreReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthScoreReq) ProtoMessage() {}
+
+func (x *GetHealthScoreReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthScoreReq.ProtoReflect.Descriptor instead.
+func (*GetHealthScoreReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetHealthScoreReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetHealthScoreReq) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+// HealthComponent - вклад одного показателя в оценку финансового здоровья
+type HealthComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`            // savings_rate, budget_adherence, debt_to_income, spending_volatility, emergency_fund
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"` // false - данных нет, компонент не учитывается
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`   // 0..100
+	Weight        float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"` // Доля в итоговой оценке среди учтенных компонентов
+	Explanation   string                 `protobuf:"bytes,6,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthComponent) Reset() {
+	*x = HealthComponent{}
+	mi := &file_analytics_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthComponent) ProtoMessage() {}
+
+func (x *HealthComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthComponent.ProtoReflect.Descriptor instead.
+func (*HealthComponent) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *HealthComponent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *HealthComponent) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *HealthComponent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *HealthComponent) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *HealthComponent) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *HealthComponent) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+// HealthAction - действие, которое сильнее всего поднимет оценку
+type HealthAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     string                 `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Gain          float64                `protobuf:"fixed64,2,opt,name=gain,proto3" json:"gain,omitempty"` // На сколько баллов вырастет оценка, если компонент достигнет 100
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthAction) Reset() {
+	*x = HealthAction{}
+	mi := &file_analytics_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthAction) ProtoMessage() {}
+
+func (x *HealthAction) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthAction.ProtoReflect.Descriptor instead.
+func (*HealthAction) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HealthAction) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *HealthAction) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *HealthAction) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type HealthScorePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	CalculatedAt  int64                  `protobuf:"varint,3,opt,name=calculated_at,json=calculatedAt,proto3" json:"calculated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthScorePoint) Reset() {
+	*x = HealthScorePoint{}
+	mi := &file_analytics_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthScorePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthScorePoint) ProtoMessage() {}
+
+func (x *HealthScorePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthScorePoint.ProtoReflect.Descriptor instead.
+func (*HealthScorePoint) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HealthScorePoint) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *HealthScorePoint) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *HealthScorePoint) GetCalculatedAt() int64 {
+	if x != nil {
+		return x.CalculatedAt
+	}
+	return 0
+}
+
+type GetHealthScoreResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // 0..100
+	Components    []*HealthComponent     `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
+	Actions       []*HealthAction        `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"` // Не больше трех, по убыванию прироста оценки
+	History       []*HealthScorePoint    `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"` // От старых месяцев к новым
+	CalculatedAt  int64                  `protobuf:"varint,6,opt,name=calculated_at,json=calculatedAt,proto3" json:"calculated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHealthScoreResp) Reset() {
+	*x = GetHealthScoreResp{}
+	mi := &file_analytics_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHealthScoreResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthScoreResp) ProtoMessage() {}
+
+func (x *GetHealthScoreResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthScoreResp.ProtoReflect.Descriptor instead.
+func (*GetHealthScoreResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetHealthScoreResp) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetHealthScoreResp) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetHealthScoreResp) GetComponents() []*HealthComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *GetHealthScoreResp) GetActions() []*HealthAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *GetHealthScoreResp) GetHistory() []*HealthScorePoint {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GetHealthScoreResp) GetCalculatedAt() int64 {
+	if x != nil {
+		return x.CalculatedAt
+	}
+	return 0
+}
+
+type GetPeerComparisonReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPeerComparisonReq) Reset() {
+	*x = GetPeerComparisonReq{}
+	mi := &file_analytics_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPeerComparisonReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerComparisonReq) ProtoMessage() {}
+
+func (x *GetPeerComparisonReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerComparisonReq.ProtoReflect.Descriptor instead.
+func (*GetPeerComparisonReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPeerComparisonReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+// PeerCategoryComparison - расходы пользователя по категории относительно похожих пользователей
+type PeerCategoryComparison struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryCode  string                 `protobuf:"bytes,1,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // Расходы пользователя за последние 30 дней
+	CohortMedian  float64                `protobuf:"fixed64,4,opt,name=cohort_median,json=cohortMedian,proto3" json:"cohort_median,omitempty"`
+	SpendersShare float64                `protobuf:"fixed64,5,opt,name=spenders_share,json=spendersShare,proto3" json:"spenders_share,omitempty"` // Процент пользователей группы, тративших в категории
+	Percentile    float64                `protobuf:"fixed64,6,opt,name=percentile,proto3" json:"percentile,omitempty"`                            // Процент пользователей группы, потративших меньше
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerCategoryComparison) Reset() {
+	*x = PeerCategoryComparison{}
+	mi := &file_analytics_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerCategoryComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerCategoryComparison) ProtoMessage() {}
+
+func (x *PeerCategoryComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerCategoryComparison.ProtoReflect.Descriptor instead.
+func (*PeerCategoryComparison) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PeerCategoryComparison) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *PeerCategoryComparison) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *PeerCategoryComparison) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PeerCategoryComparison) GetCohortMedian() float64 {
+	if x != nil {
+		return x.CohortMedian
+	}
+	return 0
+}
+
+func (x *PeerCategoryComparison) GetSpendersShare() float64 {
+	if x != nil {
+		return x.SpendersShare
+	}
+	return 0
+}
+
+func (x *PeerCategoryComparison) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *PeerCategoryComparison) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetPeerComparisonResp struct {
+	state              protoimpl.MessageState    `protogen:"open.v1"`
+	UserUid            string                    `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Level              string                    `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"` // salary_age_sphere, salary_age или salary - самая узкая группа, в которой достаточно людей
+	SalaryBracket      string                    `protobuf:"bytes,3,opt,name=salary_bracket,json=salaryBracket,proto3" json:"salary_bracket,omitempty"`
+	AgeBand            string                    `protobuf:"bytes,4,opt,name=age_band,json=ageBand,proto3" json:"age_band,omitempty"`                   // Пусто, если группа не учитывает возраст
+	WorkSphereId       int64                     `protobuf:"varint,5,opt,name=work_sphere_id,json=workSphereId,proto3" json:"work_sphere_id,omitempty"` // 0, если группа не учитывает сферу работы
+	CohortSize         int32                     `protobuf:"varint,6,opt,name=cohort_size,json=cohortSize,proto3" json:"cohort_size,omitempty"`
+	Categories         []*PeerCategoryComparison `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`             // Сначала категории, где пользователь тратит больше остальных
+	PeriodTo           string                    `protobuf:"bytes,8,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty"` // Конец 30-дневного периода расходов пользователя, YYYY-MM-DD
+	CohortCalculatedAt int64                     `protobuf:"varint,9,opt,name=cohort_calculated_at,json=cohortCalculatedAt,proto3" json:"cohort_calculated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetPeerComparisonResp) Reset() {
+	*x = GetPeerComparisonResp{}
+	mi := &file_analytics_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPeerComparisonResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerComparisonResp) ProtoMessage() {}
+
+func (x *GetPeerComparisonResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerComparisonResp.ProtoReflect.Descriptor instead.
+func (*GetPeerComparisonResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPeerComparisonResp) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetPeerComparisonResp) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *GetPeerComparisonResp) GetSalaryBracket() string {
+	if x != nil {
+		return x.SalaryBracket
+	}
+	return ""
+}
+
+func (x *GetPeerComparisonResp) GetAgeBand() string {
+	if x != nil {
+		return x.AgeBand
+	}
+	return ""
+}
+
+func (x *GetPeerComparisonResp) GetWorkSphereId() int64 {
+	if x != nil {
+		return x.WorkSphereId
+	}
+	return 0
+}
+
+func (x *GetPeerComparisonResp) GetCohortSize() int32 {
+	if x != nil {
+		return x.CohortSize
+	}
+	return 0
+}
+
+func (x *GetPeerComparisonResp) GetCategories() []*PeerCategoryComparison {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetPeerComparisonResp) GetPeriodTo() string {
+	if x != nil {
+		return x.PeriodTo
+	}
+	return ""
+}
+
+func (x *GetPeerComparisonResp) GetCohortCalculatedAt() int64 {
+	if x != nil {
+		return x.CohortCalculatedAt
+	}
+	return 0
+}
+
+type SimulateScenarioReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserUid           string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Salary            float64                `protobuf:"fixed64,2,opt,name=salary,proto3" json:"salary,omitempty"` // Новая месячная зарплата, 0 - без изменений
+	Categories        []*CategoryAdjustment  `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	RecurringExpenses []*RecurringExpense    `protobuf:"bytes,4,rep,name=recurring_expenses,json=recurringExpenses,proto3" json:"recurring_expenses,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SimulateScenarioReq) Reset() {
+	*x = SimulateScenarioReq{}
+	mi := &file_analytics_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateScenarioReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateScenarioReq) ProtoMessage() {}
+
+func (x *SimulateScenarioReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateScenarioReq.ProtoReflect.Descriptor instead.
+func (*SimulateScenarioReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SimulateScenarioReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *SimulateScenarioReq) GetSalary() float64 {
+	if x != nil {
+		return x.Salary
+	}
+	return 0
+}
+
+func (x *SimulateScenarioReq) GetCategories() []*CategoryAdjustment {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SimulateScenarioReq) GetRecurringExpenses() []*RecurringExpense {
+	if x != nil {
+		return x.RecurringExpenses
+	}
+	return nil
+}
+
+// CategoryAdjustment - изменение месячных расходов категории, сначала применяется процент, затем сумма
+type CategoryAdjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryCode  string                 `protobuf:"bytes,1,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	PercentChange float64                `protobuf:"fixed64,2,opt,name=percent_change,json=percentChange,proto3" json:"percent_change,omitempty"` // -20 - расходы на 20% меньше, не меньше -100
+	AmountChange  float64                `protobuf:"fixed64,3,opt,name=amount_change,json=amountChange,proto3" json:"amount_change,omitempty"`    // Изменение в рублях за месяц, может быть отрицательным
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAdjustment) Reset() {
+	*x = CategoryAdjustment{}
+	mi := &file_analytics_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAdjustment) ProtoMessage() {}
+
+func (x *CategoryAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAdjustment.ProtoReflect.Descriptor instead.
+func (*CategoryAdjustment) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CategoryAdjustment) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *CategoryAdjustment) GetPercentChange() float64 {
+	if x != nil {
+		return x.PercentChange
+	}
+	return 0
+}
+
+func (x *CategoryAdjustment) GetAmountChange() float64 {
+	if x != nil {
+		return x.AmountChange
+	}
+	return 0
+}
+
+// RecurringExpense - новый регулярный расход
+type RecurringExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryCode  string                 `protobuf:"bytes,1,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	MonthlyAmount float64                `protobuf:"fixed64,3,opt,name=monthly_amount,json=monthlyAmount,proto3" json:"monthly_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringExpense) Reset() {
+	*x = RecurringExpense{}
+	mi := &file_analytics_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringExpense) ProtoMessage() {}
+
+func (x *RecurringExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringExpense.ProtoReflect.Descriptor instead.
+func (*RecurringExpense) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RecurringExpense) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetMonthlyAmount() float64 {
+	if x != nil {
+		return x.MonthlyAmount
+	}
+	return 0
+}
+
+// CategoryScenarioDiff - расходы, рекомендация и прогноз категории до и после изменений
+type CategoryScenarioDiff struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CategoryCode        string                 `protobuf:"bytes,1,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	CategoryName        string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	CurrentAmount       float64                `protobuf:"fixed64,3,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"` // Расходы за последние 30 дней
+	SimulatedAmount     float64                `protobuf:"fixed64,4,opt,name=simulated_amount,json=simulatedAmount,proto3" json:"simulated_amount,omitempty"`
+	CurrentPercentage   float64                `protobuf:"fixed64,5,opt,name=current_percentage,json=currentPercentage,proto3" json:"current_percentage,omitempty"` // Процент от зарплаты
+	SimulatedPercentage float64                `protobuf:"fixed64,6,opt,name=simulated_percentage,json=simulatedPercentage,proto3" json:"simulated_percentage,omitempty"`
+	CurrentStatus       string                 `protobuf:"bytes,7,opt,name=current_status,json=currentStatus,proto3" json:"current_status,omitempty"` // Пусто, если для категории нет правила
+	SimulatedStatus     string                 `protobuf:"bytes,8,opt,name=simulated_status,json=simulatedStatus,proto3" json:"simulated_status,omitempty"`
+	RecommendedMin      float64                `protobuf:"fixed64,9,opt,name=recommended_min,json=recommendedMin,proto3" json:"recommended_min,omitempty"` // Границы диапазона зарплаты из сценария (%)
+	RecommendedMax      float64                `protobuf:"fixed64,10,opt,name=recommended_max,json=recommendedMax,proto3" json:"recommended_max,omitempty"`
+	CurrentForecast     float64                `protobuf:"fixed64,11,opt,name=current_forecast,json=currentForecast,proto3" json:"current_forecast,omitempty"` // Прогноз расходов на текущий месяц
+	SimulatedForecast   float64                `protobuf:"fixed64,12,opt,name=simulated_forecast,json=simulatedForecast,proto3" json:"simulated_forecast,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CategoryScenarioDiff) Reset() {
+	*x = CategoryScenarioDiff{}
+	mi := &file_analytics_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryScenarioDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryScenarioDiff) ProtoMessage() {}
+
+func (x *CategoryScenarioDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryScenarioDiff.ProtoReflect.Descriptor instead.
+func (*CategoryScenarioDiff) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CategoryScenarioDiff) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *CategoryScenarioDiff) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryScenarioDiff) GetCurrentAmount() float64 {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return 0
+}
+
+func (x *CategoryScenarioDiff) GetSimulatedAmount() float64 {
+	if x != nil {
+		return x.SimulatedAmount
+	}
+	return 0
+}
+
+func (x *CategoryScenarioDiff) GetCurrentPercentage() float64 {
+	if x != nil {
+		return x.CurrentPercentage
+	}
+	return 0
+}
+
+func (x *CategoryScenarioDiff) GetSimulatedPercentage() float64 {
+	if x != nil {
+		return x.SimulatedPercentage
+	}
+	return 0
+}
+
+func (x *CategoryScenarioDiff) GetCurrentStatus() string {
+	if x != nil {
+		return x.CurrentStatus
+	}
+	return ""
+}
+
+func (x *CategoryScenarioDiff) GetSimulatedStatus() string {
+	if x != nil {
+		return x.SimulatedStatus
+	}
+	return ""
+}
+
+func (x *CategoryScenarioDiff) GetRecommendedMin() float64 {
+	if x != nil {
+		return x.RecommendedMin
+	}
+	return 0
+}
+
+func (x *CategoryScenarioDiff) GetRecommendedMax() float64 {
+	if x != nil {
+		return x.RecommendedMax
+	}
+	return 0
+}
+
+func (x *CategoryScenarioDiff) GetCurrentForecast() float64 {
+	if x != nil {
+		return x.CurrentForecast
+	}
+	return 0
+}
+
+func (x *CategoryScenarioDiff) GetSimulatedForecast() float64 {
+	if x != nil {
+		return x.SimulatedForecast
+	}
+	return 0
+}
+
+type SimulateScenarioResp struct {
+	state                            protoimpl.MessageState  `protogen:"open.v1"`
+	UserUid                          string                  `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	CurrentSalary                    float64                 `protobuf:"fixed64,2,opt,name=current_salary,json=currentSalary,proto3" json:"current_salary,omitempty"`
+	SimulatedSalary                  float64                 `protobuf:"fixed64,3,opt,name=simulated_salary,json=simulatedSalary,proto3" json:"simulated_salary,omitempty"`
+	CurrentSalaryBracket             string                  `protobuf:"bytes,4,opt,name=current_salary_bracket,json=currentSalaryBracket,proto3" json:"current_salary_bracket,omitempty"`
+	SimulatedSalaryBracket           string                  `protobuf:"bytes,5,opt,name=simulated_salary_bracket,json=simulatedSalaryBracket,proto3" json:"simulated_salary_bracket,omitempty"`
+	SalaryBracketChanged             bool                    `protobuf:"varint,6,opt,name=salary_bracket_changed,json=salaryBracketChanged,proto3" json:"salary_bracket_changed,omitempty"`
+	CurrentOverallStatus             string                  `protobuf:"bytes,7,opt,name=current_overall_status,json=currentOverallStatus,proto3" json:"current_overall_status,omitempty"`
+	SimulatedOverallStatus           string                  `protobuf:"bytes,8,opt,name=simulated_overall_status,json=simulatedOverallStatus,proto3" json:"simulated_overall_status,omitempty"`
+	CurrentHealthScore               float64                 `protobuf:"fixed64,9,opt,name=current_health_score,json=currentHealthScore,proto3" json:"current_health_score,omitempty"`
+	SimulatedHealthScore             float64                 `protobuf:"fixed64,10,opt,name=simulated_health_score,json=simulatedHealthScore,proto3" json:"simulated_health_score,omitempty"`
+	CurrentForecast                  float64                 `protobuf:"fixed64,11,opt,name=current_forecast,json=currentForecast,proto3" json:"current_forecast,omitempty"` // Прогноз расходов на текущий месяц
+	SimulatedForecast                float64                 `protobuf:"fixed64,12,opt,name=simulated_forecast,json=simulatedForecast,proto3" json:"simulated_forecast,omitempty"`
+	CurrentBudgetBreachProbability   float64                 `protobuf:"fixed64,13,opt,name=current_budget_breach_probability,json=currentBudgetBreachProbability,proto3" json:"current_budget_breach_probability,omitempty"`
+	SimulatedBudgetBreachProbability float64                 `protobuf:"fixed64,14,opt,name=simulated_budget_breach_probability,json=simulatedBudgetBreachProbability,proto3" json:"simulated_budget_breach_probability,omitempty"`
+	Categories                       []*CategoryScenarioDiff `protobuf:"bytes,15,rep,name=categories,proto3" json:"categories,omitempty"` // Только изменившиеся категории, сначала с наибольшим изменением расходов
+	Simulated                        *GetRecommendationsResp `protobuf:"bytes,16,opt,name=simulated,proto3" json:"simulated,omitempty"`   // Рекомендации после изменений целиком, ничего не сохраняется
+	CalculatedAt                     int64                   `protobuf:"varint,17,opt,name=calculated_at,json=calculatedAt,proto3" json:"calculated_at,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *SimulateScenarioResp) Reset() {
+	*x = SimulateScenarioResp{}
+	mi := &file_analytics_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateScenarioResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateScenarioResp) ProtoMessage() {}
+
+func (x *SimulateScenarioResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateScenarioResp.ProtoReflect.Descriptor instead.
+func (*SimulateScenarioResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SimulateScenarioResp) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *SimulateScenarioResp) GetCurrentSalary() float64 {
+	if x != nil {
+		return x.CurrentSalary
+	}
+	return 0
+}
+
+func (x *SimulateScenarioResp) GetSimulatedSalary() float64 {
+	if x != nil {
+		return x.SimulatedSalary
+	}
+	return 0
+}
+
+func (x *SimulateScenarioResp) GetCurrentSalaryBracket() string {
+	if x != nil {
+		return x.CurrentSalaryBracket
+	}
+	return ""
+}
+
+func (x *SimulateScenarioResp) GetSimulatedSalaryBracket() string {
+	if x != nil {
+		return x.SimulatedSalaryBracket
+	}
+	return ""
+}
+
+func (x *SimulateScenarioResp) GetSalaryBracketChanged() bool {
+	if x != nil {
+		return x.SalaryBracketChanged
+	}
+	return false
+}
+
+func (x *SimulateScenarioResp) GetCurrentOverallStatus() string {
+	if x != nil {
+		return x.CurrentOverallStatus
+	}
+	return ""
+}
+
+func (x *SimulateScenarioResp) GetSimulatedOverallStatus() string {
+	if x != nil {
+		return x.SimulatedOverallStatus
+	}
+	return ""
+}
+
+func (x *SimulateScenarioResp) GetCurrentHealthScore() float64 {
+	if x != nil {
+		return x.CurrentHealthScore
+	}
+	return 0
+}
+
+func (x *SimulateScenarioResp) GetSimulatedHealthScore() float64 {
+	if x != nil {
+		return x.SimulatedHealthScore
+	}
+	return 0
+}
+
+func (x *SimulateScenarioResp) GetCurrentForecast() float64 {
+	if x != nil {
+		return x.CurrentForecast
+	}
+	return 0
+}
+
+func (x *SimulateScenarioResp) GetSimulatedForecast() float64 {
+	if x != nil {
+		return x.SimulatedForecast
+	}
+	return 0
+}
+
+func (x *SimulateScenarioResp) GetCurrentBudgetBreachProbability() float64 {
+	if x != nil {
+		return x.CurrentBudgetBreachProbability
+	}
+	return 0
+}
+
+func (x *SimulateScenarioResp) GetSimulatedBudgetBreachProbability() float64 {
+	if x != nil {
+		return x.SimulatedBudgetBreachProbability
+	}
+	return 0
+}
+
+func (x *SimulateScenarioResp) GetCategories() []*CategoryScenarioDiff {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SimulateScenarioResp) GetSimulated() *GetRecommendationsResp {
+	if x != nil {
+		return x.Simulated
+	}
+	return nil
+}
+
+func (x *SimulateScenarioResp) GetCalculatedAt() int64 {
+	if x != nil {
+		return x.CalculatedAt
+	}
+	return 0
+}
+
+type GenerateBudgetPlanReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Strategy      string                 `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`                                  // balanced (по умолчанию), 50_30_20 или zero_based
+	SavingsTarget float64                `protobuf:"fixed64,3,opt,name=savings_target,json=savingsTarget,proto3" json:"savings_target,omitempty"` // Сколько отложить за месяц, 0 - 10% дохода (20% для 50_30_20)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateBudgetPlanReq) Reset() {
+	*x = GenerateBudgetPlanReq{}
+	mi := &file_analytics_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateBudgetPlanReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateBudgetPlanReq) ProtoMessage() {}
+
+func (x *GenerateBudgetPlanReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateBudgetPlanReq.ProtoReflect.Descriptor instead.
+func (*GenerateBudgetPlanReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GenerateBudgetPlanReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GenerateBudgetPlanReq) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *GenerateBudgetPlanReq) GetSavingsTarget() float64 {
+	if x != nil {
+		return x.SavingsTarget
+	}
+	return 0
+}
+
+// BudgetPlanItem - предлагаемый бюджет категории на месяц
+type BudgetPlanItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId      int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryCode    string                 `protobuf:"bytes,2,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	CategoryName    string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Class           string                 `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"` // fixed, needs или wants
+	Amount          float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AverageSpending float64                `protobuf:"fixed64,6,opt,name=average_spending,json=averageSpending,proto3" json:"average_spending,omitempty"` // Средние расходы в месяц за прошлые месяцы
+	RecommendedMin  float64                `protobuf:"fixed64,7,opt,name=recommended_min,json=recommendedMin,proto3" json:"recommended_min,omitempty"`    // Рекомендуемый диапазон в рублях, 0 - для категории нет правила
+	RecommendedMax  float64                `protobuf:"fixed64,8,opt,name=recommended_max,json=recommendedMax,proto3" json:"recommended_max,omitempty"`
+	Reason          string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BudgetPlanItem) Reset() {
+	*x = BudgetPlanItem{}
+	mi := &file_analytics_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetPlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetPlanItem) ProtoMessage() {}
+
+func (x *BudgetPlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetPlanItem.ProtoReflect.Descriptor instead.
+func (*BudgetPlanItem) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BudgetPlanItem) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BudgetPlanItem) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *BudgetPlanItem) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *BudgetPlanItem) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *BudgetPlanItem) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BudgetPlanItem) GetAverageSpending() float64 {
+	if x != nil {
+		return x.AverageSpending
+	}
+	return 0
+}
+
+func (x *BudgetPlanItem) GetRecommendedMin() float64 {
+	if x != nil {
+		return x.RecommendedMin
+	}
+	return 0
+}
+
+func (x *BudgetPlanItem) GetRecommendedMax() float64 {
+	if x != nil {
+		return x.RecommendedMax
+	}
+	return 0
+}
+
+func (x *BudgetPlanItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BudgetPlan - предлагаемые бюджеты по категориям на следующий месяц
+type BudgetPlan struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Передается в AcceptBudgetPlan
+	UserUid          string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Month            string                 `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM
+	Strategy         string                 `protobuf:"bytes,4,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Income           float64                `protobuf:"fixed64,5,opt,name=income,proto3" json:"income,omitempty"`
+	IncomeSource     string                 `protobuf:"bytes,6,opt,name=income_source,json=incomeSource,proto3" json:"income_source,omitempty"`
+	SavingsTarget    float64                `protobuf:"fixed64,7,opt,name=savings_target,json=savingsTarget,proto3" json:"savings_target,omitempty"`
+	Savings          float64                `protobuf:"fixed64,8,opt,name=savings,proto3" json:"savings,omitempty"` // Сколько останется на сбережения, отрицательное - план больше дохода
+	SavingsTargetMet bool                   `protobuf:"varint,9,opt,name=savings_target_met,json=savingsTargetMet,proto3" json:"savings_target_met,omitempty"`
+	Fixed            float64                `protobuf:"fixed64,10,opt,name=fixed,proto3" json:"fixed,omitempty"` // Итоги по группам категорий
+	Needs            float64                `protobuf:"fixed64,11,opt,name=needs,proto3" json:"needs,omitempty"`
+	Wants            float64                `protobuf:"fixed64,12,opt,name=wants,proto3" json:"wants,omitempty"`
+	Unallocated      float64                `protobuf:"fixed64,13,opt,name=unallocated,proto3" json:"unallocated,omitempty"`                         // Не распределено ни на расходы, ни на сбережения
+	HistoryMonths    int32                  `protobuf:"varint,14,opt,name=history_months,json=historyMonths,proto3" json:"history_months,omitempty"` // За сколько месяцев посчитаны средние расходы, 0 - за последние 30 дней
+	Items            []*BudgetPlanItem      `protobuf:"bytes,15,rep,name=items,proto3" json:"items,omitempty"`
+	RulesVersion     int64                  `protobuf:"varint,16,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
+	CalculatedAt     int64                  `protobuf:"varint,17,opt,name=calculated_at,json=calculatedAt,proto3" json:"calculated_at,omitempty"`
+	ExpiresAt        int64                  `protobuf:"varint,18,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // До какого времени план можно принять
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BudgetPlan) Reset() {
+	*x = BudgetPlan{}
+	mi := &file_analytics_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetPlan) ProtoMessage() {}
+
+func (x *BudgetPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetPlan.ProtoReflect.Descriptor instead.
+func (*BudgetPlan) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BudgetPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BudgetPlan) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *BudgetPlan) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *BudgetPlan) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *BudgetPlan) GetIncome() float64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetIncomeSource() string {
+	if x != nil {
+		return x.IncomeSource
+	}
+	return ""
+}
+
+func (x *BudgetPlan) GetSavingsTarget() float64 {
+	if x != nil {
+		return x.SavingsTarget
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetSavings() float64 {
+	if x != nil {
+		return x.Savings
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetSavingsTargetMet() bool {
+	if x != nil {
+		return x.SavingsTargetMet
+	}
+	return false
+}
+
+func (x *BudgetPlan) GetFixed() float64 {
+	if x != nil {
+		return x.Fixed
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetNeeds() float64 {
+	if x != nil {
+		return x.Needs
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetWants() float64 {
+	if x != nil {
+		return x.Wants
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetUnallocated() float64 {
+	if x != nil {
+		return x.Unallocated
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetHistoryMonths() int32 {
+	if x != nil {
+		return x.HistoryMonths
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetItems() []*BudgetPlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BudgetPlan) GetRulesVersion() int64 {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetCalculatedAt() int64 {
+	if x != nil {
+		return x.CalculatedAt
+	}
+	return 0
+}
+
+func (x *BudgetPlan) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type AcceptBudgetPlanReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	PlanId        string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptBudgetPlanReq) Reset() {
+	*x = AcceptBudgetPlanReq{}
+	mi := &file_analytics_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptBudgetPlanReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptBudgetPlanReq) ProtoMessage() {}
+
+func (x *AcceptBudgetPlanReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptBudgetPlanReq.ProtoReflect.Descriptor instead.
+func (*AcceptBudgetPlanReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AcceptBudgetPlanReq) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *AcceptBudgetPlanReq) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// PlannedBudget - бюджет категории, созданный из плана
+type PlannedBudget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryCode  string                 `protobuf:"bytes,3,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Month         string                 `protobuf:"bytes,5,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedBudget) Reset() {
+	*x = PlannedBudget{}
+	mi := &file_analytics_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedBudget) ProtoMessage() {}
+
+func (x *PlannedBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedBudget.ProtoReflect.Descriptor instead.
+func (*PlannedBudget) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PlannedBudget) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlannedBudget) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *PlannedBudget) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *PlannedBudget) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *PlannedBudget) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *PlannedBudget) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PlannedBudget) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type AcceptBudgetPlanResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	Budgets       []*PlannedBudget       `protobuf:"bytes,3,rep,name=budgets,proto3" json:"budgets,omitempty"` // Все бюджеты месяца
+	Kept          []*PlannedBudget       `protobuf:"bytes,4,rep,name=kept,proto3" json:"kept,omitempty"`       // Бюджеты, заданные вручную, которые план не изменил
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptBudgetPlanResp) Reset() {
+	*x = AcceptBudgetPlanResp{}
+	mi := &file_analytics_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptBudgetPlanResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptBudgetPlanResp) ProtoMessage() {}
+
+func (x *AcceptBudgetPlanResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptBudgetPlanResp.ProtoReflect.Descriptor instead.
+func (*AcceptBudgetPlanResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AcceptBudgetPlanResp) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *AcceptBudgetPlanResp) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *AcceptBudgetPlanResp) GetBudgets() []*PlannedBudget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+func (x *AcceptBudgetPlanResp) GetKept() []*PlannedBudget {
+	if x != nil {
+		return x.Kept
+	}
+	return nil
+}
+
+type GetRecommendationRulesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // Версия правил, 0 - действующие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationRulesReq) Reset() {
+	*x = GetRecommendationRulesReq{}
+	mi := &file_analytics_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationRulesReq) ProtoMessage() {}
+
+func (x *GetRecommendationRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationRulesReq.ProtoReflect.Descriptor instead.
+func (*GetRecommendationRulesReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetRecommendationRulesReq) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateRecommendationRulesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseVersion   int64                  `protobuf:"varint,1,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"` // Версия, от которой сделано изменение; если правила уже изменили, вернется ABORTED
+	UpdatedBy     string                 `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`        // UID администратора
+	Thresholds    *RuleThresholds        `protobuf:"bytes,3,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	Brackets      []*SalaryBracket       `protobuf:"bytes,4,rep,name=brackets,proto3" json:"brackets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecommendationRulesReq) Reset() {
+	*x = UpdateRecommendationRulesReq{}
+	mi := &file_analytics_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecommendationRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecommendationRulesReq) ProtoMessage() {}
+
+func (x *UpdateRecommendationRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecommendationRulesReq.ProtoReflect.Descriptor instead.
+func (*UpdateRecommendationRulesReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateRecommendationRulesReq) GetBaseVersion() int64 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+func (x *UpdateRecommendationRulesReq) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateRecommendationRulesReq) GetThresholds() *RuleThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *UpdateRecommendationRulesReq) GetBrackets() []*SalaryBracket {
+	if x != nil {
+		return x.Brackets
+	}
+	return nil
+}
+
+// RuleThresholds задает границы статусов относительно рекомендуемого диапазона категории
+type RuleThresholds struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CriticalMultiplier  float64                `protobuf:"fixed64,1,opt,name=critical_multiplier,json=criticalMultiplier,proto3" json:"critical_multiplier,omitempty"`    // critical - выше max_percent * critical_multiplier
+	WarningMultiplier   float64                `protobuf:"fixed64,2,opt,name=warning_multiplier,json=warningMultiplier,proto3" json:"warning_multiplier,omitempty"`       // warning - выше max_percent * warning_multiplier
+	ExcellentMultiplier float64                `protobuf:"fixed64,3,opt,name=excellent_multiplier,json=excellentMultiplier,proto3" json:"excellent_multiplier,omitempty"` // excellent - ниже min_percent * excellent_multiplier
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RuleThresholds) Reset() {
+	*x = RuleThresholds{}
+	mi := &file_analytics_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleThresholds) ProtoMessage() {}
+
+func (x *RuleThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleThresholds.ProtoReflect.Descriptor instead.
+func (*RuleThresholds) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RuleThresholds) GetCriticalMultiplier() float64 {
+	if x != nil {
+		return x.CriticalMultiplier
+	}
+	return 0
+}
+
+func (x *RuleThresholds) GetWarningMultiplier() float64 {
+	if x != nil {
+		return x.WarningMultiplier
+	}
+	return 0
+}
+
+func (x *RuleThresholds) GetExcellentMultiplier() float64 {
+	if x != nil {
+		return x.ExcellentMultiplier
+	}
+	return 0
+}
+
+type RuleCategoryRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryCode  string                 `protobuf:"bytes,1,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"` // Стабильный код категории
+	MinPercent    float64                `protobuf:"fixed64,2,opt,name=min_percent,json=minPercent,proto3" json:"min_percent,omitempty"`     // Рекомендуемый минимум, % от зарплаты
+	MaxPercent    float64                `protobuf:"fixed64,3,opt,name=max_percent,json=maxPercent,proto3" json:"max_percent,omitempty"`     // Рекомендуемый максимум, % от зарплаты
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleCategoryRange) Reset() {
+	*x = RuleCategoryRange{}
+	mi := &file_analytics_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleCategoryRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleCategoryRange) ProtoMessage() {}
+
+func (x *RuleCategoryRange) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleCategoryRange.ProtoReflect.Descriptor instead.
+func (*RuleCategoryRange) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RuleCategoryRange) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *RuleCategoryRange) GetMinPercent() float64 {
+	if x != nil {
+		return x.MinPercent
+	}
+	return 0
+}
+
+func (x *RuleCategoryRange) GetMaxPercent() float64 {
+	if x != nil {
+		return x.MaxPercent
+	}
+	return 0
+}
+
+// SalaryBracket - диапазон зарплат, диапазоны идут подряд с нуля без пропусков и пересечений
+type SalaryBracket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinSalary     float64                `protobuf:"fixed64,1,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary     float64                `protobuf:"fixed64,2,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"` // 0 только у последнего диапазона - без ограничения сверху
+	Categories    []*RuleCategoryRange   `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalaryBracket) Reset() {
+	*x = SalaryBracket{}
+	mi := &file_analytics_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalaryBracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalaryBracket) ProtoMessage() {}
+
+func (x *SalaryBracket) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalaryBracket.ProtoReflect.Descriptor instead.
+func (*SalaryBracket) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SalaryBracket) GetMinSalary() float64 {
+	if x != nil {
+		return x.MinSalary
+	}
+	return 0
+}
+
+func (x *SalaryBracket) GetMaxSalary() float64 {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return 0
+}
+
+func (x *SalaryBracket) GetCategories() []*RuleCategoryRange {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type RecommendationRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`  // UID администратора, file или default
+	Thresholds    *RuleThresholds        `protobuf:"bytes,4,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	Brackets      []*SalaryBracket       `protobuf:"bytes,5,rep,name=brackets,proto3" json:"brackets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationRules) Reset() {
+	*x = RecommendationRules{}
+	mi := &file_analytics_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationRules) ProtoMessage() {}
+
+func (x *RecommendationRules) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationRules.ProtoReflect.Descriptor instead.
+func (*RecommendationRules) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RecommendationRules) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RecommendationRules) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *RecommendationRules) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *RecommendationRules) GetThresholds() *RuleThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *RecommendationRules) GetBrackets() []*SalaryBracket {
+	if x != nil {
+		return x.Brackets
+	}
+	return nil
+}
+
+type GetRecomputeMetricsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecomputeMetricsReq) Reset() {
+	*x = GetRecomputeMetricsReq{}
+	mi := &file_analytics_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecomputeMetricsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecomputeMetricsReq) ProtoMessage() {}
+
+func (x *GetRecomputeMetricsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecomputeMetricsReq.ProtoReflect.Descriptor instead.
+func (*GetRecomputeMetricsReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{48}
+}
+
+// RecomputeMetrics - счетчики отложенных пересчетов по всем репликам
+type RecomputeMetrics struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Events         int64                  `protobuf:"varint,1,opt,name=events,proto3" json:"events,omitempty"`                 // Событий, запросивших пересчет
+	Coalesced      int64                  `protobuf:"varint,2,opt,name=coalesced,proto3" json:"coalesced,omitempty"`           // Событий, присоединившихся к уже запланированному пересчету
+	Recomputations int64                  `protobuf:"varint,3,opt,name=recomputations,proto3" json:"recomputations,omitempty"` // Выполненных пересчетов
+	Failures       int64                  `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`             // Неудачных попыток пересчета
+	Pending        int64                  `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`               // Пользователей, ожидающих пересчета
+	Processing     int64                  `protobuf:"varint,6,opt,name=processing,proto3" json:"processing,omitempty"`         // Пользователей, пересчитываемых сейчас
+	Dropped        int64                  `protobuf:"varint,7,opt,name=dropped,proto3" json:"dropped,omitempty"`               // Пересчетов, отброшенных после всех попыток
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecomputeMetrics) Reset() {
+	*x = RecomputeMetrics{}
+	mi := &file_analytics_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeMetrics) ProtoMessage() {}
+
+func (x *RecomputeMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeMetrics.ProtoReflect.Descriptor instead.
+func (*RecomputeMetrics) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RecomputeMetrics) GetEvents() int64 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *RecomputeMetrics) GetCoalesced() int64 {
+	if x != nil {
+		return x.Coalesced
+	}
+	return 0
+}
+
+func (x *RecomputeMetrics) GetRecomputations() int64 {
+	if x != nil {
+		return x.Recomputations
+	}
+	return 0
+}
+
+func (x *RecomputeMetrics) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *RecomputeMetrics) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *RecomputeMetrics) GetProcessing() int64 {
+	if x != nil {
+		return x.Processing
+	}
+	return 0
+}
+
+func (x *RecomputeMetrics) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type ReplayDeadLettersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`  // Dead-letter топик, например analytics.dlq; пустой - все топики
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Сколько сообщений отправить, по умолчанию 100, не больше 1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersReq) Reset() {
+	*x = ReplayDeadLettersReq{}
+	mi := &file_analytics_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersReq) ProtoMessage() {}
+
+func (x *ReplayDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ReplayDeadLettersReq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ReplayDeadLettersReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReplayDeadLettersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replayed      int32                  `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"` // Сколько сообщений отправлено в исходные топики
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersResp) Reset() {
+	*x = ReplayDeadLettersResp{}
+	mi := &file_analytics_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResp) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_analytics_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReplayDeadLettersResp) GetReplayed() int32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+var File_analytics_service_proto protoreflect.FileDescriptor
+
+const file_analytics_service_proto_rawDesc = "" +
+	"\n" +
+	"\x17analytics_service.proto\x12\x11analytics_service\"\x82\x01\n" +
+	"\x15GetRecommendationsReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x1b\n" +
+	"\tledger_id\x18\x02 \x01(\x03R\bledgerId\x121\n" +
+	"\x06period\x18\x03 \x01(\v2\x19.analytics_service.PeriodR\x06period\"~\n" +
+	"\x06Period\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1b\n" +
+	"\tdate_from\x18\x03 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x04 \x01(\tR\x06dateTo\x12\x12\n" +
+	"\x04days\x18\x05 \x01(\x05R\x04days\"\xd6\x02\n" +
+	"\x16CategoryRecommendation\x12#\n" +
+	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12#\n" +
+	"\ractual_amount\x18\x02 \x01(\x01R\factualAmount\x12+\n" +
+	"\x11actual_percentage\x18\x03 \x01(\x01R\x10actualPercentage\x12'\n" +
+	"\x0frecommended_min\x18\x04 \x01(\x01R\x0erecommendedMin\x12'\n" +
+	"\x0frecommended_max\x18\x05 \x01(\x01R\x0erecommendedMax\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x1c\n" +
+	"\tdeviation\x18\b \x01(\x01R\tdeviation\x12#\n" +
+	"\rcategory_code\x18\t \x01(\tR\fcategoryCode\"\x90\x06\n" +
+	"\x16GetRecommendationsResp\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x16\n" +
+	"\x06salary\x18\x02 \x01(\x01R\x06salary\x12%\n" +
+	"\x0esalary_bracket\x18\x03 \x01(\tR\rsalaryBracket\x12)\n" +
+	"\x10total_categories\x18\x04 \x01(\x05R\x0ftotalCategories\x12'\n" +
+	"\x0fexcellent_count\x18\x05 \x01(\x05R\x0eexcellentCount\x12!\n" +
+	"\fnormal_count\x18\x06 \x01(\x05R\vnormalCount\x12#\n" +
+	"\rwarning_count\x18\a \x01(\x05R\fwarningCount\x12%\n" +
+	"\x0ecritical_count\x18\b \x01(\x05R\rcriticalCount\x12%\n" +
+	"\x0eoverall_status\x18\t \x01(\tR\roverallStatus\x12'\n" +
+	"\x0foverall_message\x18\n" +
+	" \x01(\tR\x0eoverallMessage\x12S\n" +
+	"\x0frecommendations\x18\v \x03(\v2).analytics_service.CategoryRecommendationR\x0frecommendations\x12#\n" +
+	"\rcalculated_at\x18\f \x01(\x03R\fcalculatedAt\x12/\n" +
+	"\x04debt\x18\r \x01(\v2\x1b.analytics_service.DebtLoadR\x04debt\x12\x1b\n" +
+	"\tledger_id\x18\x0e \x01(\x03R\bledgerId\x12\x1f\n" +
+	"\vperiod_from\x18\x0f \x01(\tR\n" +
+	"periodFrom\x12\x1b\n" +
+	"\tperiod_to\x18\x10 \x01(\tR\bperiodTo\x12#\n" +
+	"\rrules_version\x18\x11 \x01(\x03R\frulesVersion\x12#\n" +
+	"\rincome_source\x18\x12 \x01(\tR\fincomeSource\x129\n" +
+	"\x06income\x18\x13 \x01(\v2!.analytics_service.IncomeAnalysisR\x06income\"\xee\x02\n" +
+	"\x0eIncomeAnalysis\x12%\n" +
+	"\x0eprofile_salary\x18\x01 \x01(\x01R\rprofileSalary\x12)\n" +
+	"\x10effective_income\x18\x02 \x01(\x01R\x0feffectiveIncome\x12\x16\n" +
+	"\x06months\x18\x03 \x01(\x05R\x06months\x12'\n" +
+	"\x0fstability_score\x18\x04 \x01(\x01R\x0estabilityScore\x12)\n" +
+	"\x10average_expenses\x18\x05 \x01(\x01R\x0faverageExpenses\x12!\n" +
+	"\fsavings_rate\x18\x06 \x01(\x01R\vsavingsRate\x12\x18\n" +
+	"\abalance\x18\a \x01(\x01R\abalance\x122\n" +
+	"\x15emergency_fund_months\x18\b \x01(\x01R\x13emergencyFundMonths\x12-\n" +
+	"\x12expense_volatility\x18\t \x01(\x01R\x11expenseVolatility\"\xdd\x01\n" +
+	"\bDebtLoad\x12\x1d\n" +
+	"\n" +
+	"total_owed\x18\x01 \x01(\x01R\ttotalOwed\x12)\n" +
+	"\x10monthly_payments\x18\x02 \x01(\x01R\x0fmonthlyPayments\x12/\n" +
+	"\x14debt_to_income_ratio\x18\x03 \x01(\x01R\x11debtToIncomeRatio\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12$\n" +
+	"\x0edebt_free_date\x18\x06 \x01(\tR\fdebtFreeDate\"P\n" +
+	"\x1bGetRecommendationHistoryReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x16\n" +
+	"\x06months\x18\x02 \x01(\x05R\x06months\"\x83\x01\n" +
+	"\x16RecommendationSnapshot\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12S\n" +
+	"\x0frecommendations\x18\x02 \x01(\v2).analytics_service.GetRecommendationsRespR\x0frecommendations\"g\n" +
+	"\x1cGetRecommendationHistoryResp\x12G\n" +
+	"\tsnapshots\x18\x01 \x03(\v2).analytics_service.RecommendationSnapshotR\tsnapshots\"m\n" +
+	"\x13GetCategoryTrendReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x16\n" +
+	"\x06months\x18\x02 \x01(\x05R\x06months\x12#\n" +
+	"\rcategory_code\x18\x03 \x01(\tR\fcategoryCode\"\xe6\x01\n" +
+	"\x12CategoryTrendPoint\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12#\n" +
+	"\ractual_amount\x18\x02 \x01(\x01R\factualAmount\x12+\n" +
+	"\x11actual_percentage\x18\x03 \x01(\x01R\x10actualPercentage\x12'\n" +
+	"\x0frecommended_min\x18\x04 \x01(\x01R\x0erecommendedMin\x12'\n" +
+	"\x0frecommended_max\x18\x05 \x01(\x01R\x0erecommendedMax\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"\xce\x01\n" +
+	"\rCategoryTrend\x12#\n" +
+	"\rcategory_code\x18\x01 \x01(\tR\fcategoryCode\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12=\n" +
+	"\x06points\x18\x03 \x03(\v2%.analytics_service.CategoryTrendPointR\x06points\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12\x16\n" +
+	"\x06change\x18\x05 \x01(\x01R\x06change\"P\n" +
+	"\x14GetCategoryTrendResp\x128\n" +
+	"\x06trends\x18\x01 \x03(\v2 .analytics_service.CategoryTrendR\x06trends\"+\n" +
+	"\x0eGetForecastReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\"\xf5\x02\n" +
+	"\x10CategoryForecast\x12#\n" +
+	"\rcategory_code\x18\x01 \x01(\tR\fcategoryCode\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\"\n" +
+	"\rspent_to_date\x18\x03 \x01(\x01R\vspentToDate\x12\x1c\n" +
+	"\tscheduled\x18\x04 \x01(\x01R\tscheduled\x12\x1a\n" +
+	"\bforecast\x18\x05 \x01(\x01R\bforecast\x12\x10\n" +
+	"\x03low\x18\x06 \x01(\x01R\x03low\x12\x12\n" +
+	"\x04high\x18\a \x01(\x01R\x04high\x12'\n" +
+	"\x0frecommended_max\x18\b \x01(\x01R\x0erecommendedMax\x12-\n" +
+	"\x12breach_probability\x18\t \x01(\x01R\x11breachProbability\x12#\n" +
+	"\rlikely_breach\x18\n" +
+	" \x01(\bR\flikelyBreach\x12\x16\n" +
+	"\x06budget\x18\v \x01(\x01R\x06budget\"\xab\x04\n" +
+	"\x0fGetForecastResp\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\x12!\n" +
+	"\fdays_elapsed\x18\x03 \x01(\x05R\vdaysElapsed\x12\"\n" +
+	"\rdays_in_month\x18\x04 \x01(\x05R\vdaysInMonth\x12%\n" +
+	"\x0ehistory_months\x18\x05 \x01(\x05R\rhistoryMonths\x12\x16\n" +
+	"\x06salary\x18\x06 \x01(\x01R\x06salary\x12\"\n" +
+	"\rspent_to_date\x18\a \x01(\x01R\vspentToDate\x12\x1a\n" +
+	"\bforecast\x18\b \x01(\x01R\bforecast\x12\x10\n" +
+	"\x03low\x18\t \x01(\x01R\x03low\x12\x12\n" +
+	"\x04high\x18\n" +
+	" \x01(\x01R\x04high\x12:\n" +
+	"\x19budget_breach_probability\x18\v \x01(\x01R\x17budgetBreachProbability\x120\n" +
+	"\x14likely_budget_breach\x18\f \x01(\bR\x12likelyBudgetBreach\x12C\n" +
+	"\n" +
+	"categories\x18\r \x03(\v2#.analytics_service.CategoryForecastR\n" +
+	"categories\x12#\n" +
+	"\rrules_version\x18\x0e \x01(\x03R\frulesVersion\x12#\n" +
+	"\rcalculated_at\x18\x0f \x01(\x03R\fcalculatedAt\"B\n" +
+	"\x0fGetAnomaliesReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x8d\x03\n" +
+	"\aAnomaly\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x04 \x01(\tR\fcategoryName\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12)\n" +
+	"\x10transaction_date\x18\b \x01(\tR\x0ftransactionDate\x12\x18\n" +
+	"\atypical\x18\t \x01(\x01R\atypical\x12\x14\n" +
+	"\x05score\x18\n" +
+	" \x01(\x01R\x05score\x12!\n" +
+	"\fduplicate_of\x18\v \x01(\x03R\vduplicateOf\x12 \n" +
+	"\vexplanation\x18\f \x01(\tR\vexplanation\x12\x1f\n" +
+	"\vdetected_at\x18\r \x01(\x03R\n" +
+	"detectedAt\"L\n" +
+	"\x10GetAnomaliesResp\x128\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x1a.analytics_service.AnomalyR\tanomalies\"8\n" +
+	"\x1bGetDetectedSubscriptionsReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\"_\n" +
+	"\vPriceChange\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"old_amount\x18\x02 \x01(\x01R\toldAmount\x12\x1d\n" +
+	"\n" +
+	"new_amount\x18\x03 \x01(\x01R\tnewAmount\"\x99\x03\n" +
+	"\fSubscription\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12!\n" +
+	"\fmonthly_cost\x18\x06 \x01(\x01R\vmonthlyCost\x12\x18\n" +
+	"\acharges\x18\a \x01(\x05R\acharges\x12!\n" +
+	"\ffirst_charge\x18\b \x01(\tR\vfirstCharge\x12\x1f\n" +
+	"\vlast_charge\x18\t \x01(\tR\n" +
+	"lastCharge\x12\x1f\n" +
+	"\vnext_charge\x18\n" +
+	" \x01(\tR\n" +
+	"nextCharge\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12C\n" +
+	"\rprice_changes\x18\f \x03(\v2\x1e.analytics_service.PriceChangeR\fpriceChanges\"\xd3\x01\n" +
+	"\x1cGetDetectedSubscriptionsResp\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12E\n" +
+	"\rsubscriptions\x18\x02 \x03(\v2\x1f.analytics_service.SubscriptionR\rsubscriptions\x12,\n" +
+	"\x12total_monthly_cost\x18\x03 \x01(\x01R\x10totalMonthlyCost\x12#\n" +
+	"\rcalculated_at\x18\x04 \x01(\x03R\fcalculatedAt\"F\n" +
+	"\x11GetHealthScoreReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x16\n" +
+	"\x06months\x18\x02 \x01(\x05R\x06months\"\xa9\x01\n" +
+	"\x0fHealthComponent\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\x12 \n" +
+	"\vexplanation\x18\x06 \x01(\tR\vexplanation\"Z\n" +
+	"\fHealthAction\x12\x1c\n" +
+	"\tcomponent\x18\x01 \x01(\tR\tcomponent\x12\x12\n" +
+	"\x04gain\x18\x02 \x01(\x01R\x04gain\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"c\n" +
+	"\x10HealthScorePoint\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12#\n" +
+	"\rcalculated_at\x18\x03 \x01(\x03R\fcalculatedAt\"\xa8\x02\n" +
+	"\x12GetHealthScoreResp\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12B\n" +
+	"\n" +
+	"components\x18\x03 \x03(\v2\".analytics_service.HealthComponentR\n" +
+	"components\x129\n" +
+	"\aactions\x18\x04 \x03(\v2\x1f.analytics_service.HealthActionR\aactions\x12=\n" +
+	"\ahistory\x18\x05 \x03(\v2#.analytics_service.HealthScorePointR\ahistory\x12#\n" +
+	"\rcalculated_at\x18\x06 \x01(\x03R\fcalculatedAt\"1\n" +
+	"\x14GetPeerComparisonReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\"\x80\x02\n" +
+	"\x16PeerCategoryComparison\x12#\n" +
+	"\rcategory_code\x18\x01 \x01(\tR\fcategoryCode\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12#\n" +
+	"\rcohort_median\x18\x04 \x01(\x01R\fcohortMedian\x12%\n" +
+	"\x0espenders_share\x18\x05 \x01(\x01R\rspendersShare\x12\x1e\n" +
+	"\n" +
+	"percentile\x18\x06 \x01(\x01R\n" +
+	"percentile\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\xeb\x02\n" +
+	"\x15GetPeerComparisonResp\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12%\n" +
+	"\x0esalary_bracket\x18\x03 \x01(\tR\rsalaryBracket\x12\x19\n" +
+	"\bage_band\x18\x04 \x01(\tR\aageBand\x12$\n" +
+	"\x0ework_sphere_id\x18\x05 \x01(\x03R\fworkSphereId\x12\x1f\n" +
+	"\vcohort_size\x18\x06 \x01(\x05R\n" +
+	"cohortSize\x12I\n" +
+	"\n" +
+	"categories\x18\a \x03(\v2).analytics_service.PeerCategoryComparisonR\n" +
+	"categories\x12\x1b\n" +
+	"\tperiod_to\x18\b \x01(\tR\bperiodTo\x120\n" +
+	"\x14cohort_calculated_at\x18\t \x01(\x03R\x12cohortCalculatedAt\"\xe3\x01\n" +
+	"\x13SimulateScenarioReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x16\n" +
+	"\x06salary\x18\x02 \x01(\x01R\x06salary\x12E\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2%.analytics_service.CategoryAdjustmentR\n" +
+	"categories\x12R\n" +
+	"\x12recurring_expenses\x18\x04 \x03(\v2#.analytics_service.RecurringExpenseR\x11recurringExpenses\"\x85\x01\n" +
+	"\x12CategoryAdjustment\x12#\n" +
+	"\rcategory_code\x18\x01 \x01(\tR\fcategoryCode\x12%\n" +
+	"\x0epercent_change\x18\x02 \x01(\x01R\rpercentChange\x12#\n" +
+	"\ramount_change\x18\x03 \x01(\x01R\famountChange\"t\n" +
+	"\x10RecurringExpense\x12#\n" +
+	"\rcategory_code\x18\x01 \x01(\tR\fcategoryCode\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\x0emonthly_amount\x18\x03 \x01(\x01R\rmonthlyAmount\"\x92\x04\n" +
+	"\x14CategoryScenarioDiff\x12#\n" +
+	"\rcategory_code\x18\x01 \x01(\tR\fcategoryCode\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12%\n" +
+	"\x0ecurrent_amount\x18\x03 \x01(\x01R\rcurrentAmount\x12)\n" +
+	"\x10simulated_amount\x18\x04 \x01(\x01R\x0fsimulatedAmount\x12-\n" +
+	"\x12current_percentage\x18\x05 \x01(\x01R\x11currentPercentage\x121\n" +
+	"\x14simulated_percentage\x18\x06 \x01(\x01R\x13simulatedPercentage\x12%\n" +
+	"\x0ecurrent_status\x18\a \x01(\tR\rcurrentStatus\x12)\n" +
+	"\x10simulated_status\x18\b \x01(\tR\x0fsimulatedStatus\x12'\n" +
+	"\x0frecommended_min\x18\t \x01(\x01R\x0erecommendedMin\x12'\n" +
+	"\x0frecommended_max\x18\n" +
+	" \x01(\x01R\x0erecommendedMax\x12)\n" +
+	"\x10current_forecast\x18\v \x01(\x01R\x0fcurrentForecast\x12-\n" +
+	"\x12simulated_forecast\x18\f \x01(\x01R\x11simulatedForecast\"\xac\a\n" +
+	"\x14SimulateScenarioResp\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12%\n" +
+	"\x0ecurrent_salary\x18\x02 \x01(\x01R\rcurrentSalary\x12)\n" +
+	"\x10simulated_salary\x18\x03 \x01(\x01R\x0fsimulatedSalary\x124\n" +
+	"\x16current_salary_bracket\x18\x04 \x01(\tR\x14currentSalaryBracket\x128\n" +
+	"\x18simulated_salary_bracket\x18\x05 \x01(\tR\x16simulatedSalaryBracket\x124\n" +
+	"\x16salary_bracket_changed\x18\x06 \x01(\bR\x14salaryBracketChanged\x124\n" +
+	"\x16current_overall_status\x18\a \x01(\tR\x14currentOverallStatus\x128\n" +
+	"\x18simulated_overall_status\x18\b \x01(\tR\x16simulatedOverallStatus\x120\n" +
+	"\x14current_health_score\x18\t \x01(\x01R\x12currentHealthScore\x124\n" +
+	"\x16simulated_health_score\x18\n" +
+	" \x01(\x01R\x14simulatedHealthScore\x12)\n" +
+	"\x10current_forecast\x18\v \x01(\x01R\x0fcurrentForecast\x12-\n" +
+	"\x12simulated_forecast\x18\f \x01(\x01R\x11simulatedForecast\x12I\n" +
+	"!current_budget_breach_probability\x18\r \x01(\x01R\x1ecurrentBudgetBreachProbability\x12M\n" +
+	"#simulated_budget_breach_probability\x18\x0e \x01(\x01R simulatedBudgetBreachProbability\x12G\n" +
+	"\n" +
+	"categories\x18\x0f \x03(\v2'.analytics_service.CategoryScenarioDiffR\n" +
+	"categories\x12G\n" +
+	"\tsimulated\x18\x10 \x01(\v2).analytics_service.GetRecommendationsRespR\tsimulated\x12#\n" +
+	"\rcalculated_at\x18\x11 \x01(\x03R\fcalculatedAt\"u\n" +
+	"\x15GenerateBudgetPlanReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\x12%\n" +
+	"\x0esavings_target\x18\x03 \x01(\x01R\rsavingsTarget\"\xbe\x02\n" +
+	"\x0eBudgetPlanItem\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_code\x18\x02 \x01(\tR\fcategoryCode\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12\x14\n" +
+	"\x05class\x18\x04 \x01(\tR\x05class\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12)\n" +
+	"\x10average_spending\x18\x06 \x01(\x01R\x0faverageSpending\x12'\n" +
+	"\x0frecommended_min\x18\a \x01(\x01R\x0erecommendedMin\x12'\n" +
+	"\x0frecommended_max\x18\b \x01(\x01R\x0erecommendedMax\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\"\xc2\x04\n" +
+	"\n" +
+	"BudgetPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\tR\x05month\x12\x1a\n" +
+	"\bstrategy\x18\x04 \x01(\tR\bstrategy\x12\x16\n" +
+	"\x06income\x18\x05 \x01(\x01R\x06income\x12#\n" +
+	"\rincome_source\x18\x06 \x01(\tR\fincomeSource\x12%\n" +
+	"\x0esavings_target\x18\a \x01(\x01R\rsavingsTarget\x12\x18\n" +
+	"\asavings\x18\b \x01(\x01R\asavings\x12,\n" +
+	"\x12savings_target_met\x18\t \x01(\bR\x10savingsTargetMet\x12\x14\n" +
+	"\x05fixed\x18\n" +
+	" \x01(\x01R\x05fixed\x12\x14\n" +
+	"\x05needs\x18\v \x01(\x01R\x05needs\x12\x14\n" +
+	"\x05wants\x18\f \x01(\x01R\x05wants\x12 \n" +
+	"\vunallocated\x18\r \x01(\x01R\vunallocated\x12%\n" +
+	"\x0ehistory_months\x18\x0e \x01(\x05R\rhistoryMonths\x127\n" +
+	"\x05items\x18\x0f \x03(\v2!.analytics_service.BudgetPlanItemR\x05items\x12#\n" +
+	"\rrules_version\x18\x10 \x01(\x03R\frulesVersion\x12#\n" +
+	"\rcalculated_at\x18\x11 \x01(\x03R\fcalculatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x12 \x01(\x03R\texpiresAt\"I\n" +
+	"\x13AcceptBudgetPlanReq\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\tR\x06planId\"\xd0\x01\n" +
+	"\rPlannedBudget\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_code\x18\x03 \x01(\tR\fcategoryCode\x12#\n" +
+	"\rcategory_name\x18\x04 \x01(\tR\fcategoryName\x12\x14\n" +
+	"\x05month\x18\x05 \x01(\tR\x05month\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\"\xb7\x01\n" +
+	"\x14AcceptBudgetPlanResp\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\tR\x06planId\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\x12:\n" +
+	"\abudgets\x18\x03 \x03(\v2 .analytics_service.PlannedBudgetR\abudgets\x124\n" +
+	"\x04kept\x18\x04 \x03(\v2 .analytics_service.PlannedBudgetR\x04kept\"5\n" +
+	"\x19GetRecommendationRulesReq\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"\xe1\x01\n" +
+	"\x1cUpdateRecommendationRulesReq\x12!\n" +
+	"\fbase_version\x18\x01 \x01(\x03R\vbaseVersion\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\tR\tupdatedBy\x12A\n" +
+	"\n" +
+	"thresholds\x18\x03 \x01(\v2!.analytics_service.RuleThresholdsR\n" +
+	"thresholds\x12<\n" +
+	"\bbrackets\x18\x04 \x03(\v2 .analytics_service.SalaryBracketR\bbrackets\"\xa3\x01\n" +
+	"\x0eRuleThresholds\x12/\n" +
+	"\x13critical_multiplier\x18\x01 \x01(\x01R\x12criticalMultiplier\x12-\n" +
+	"\x12warning_multiplier\x18\x02 \x01(\x01R\x11warningMultiplier\x121\n" +
+	"\x14excellent_multiplier\x18\x03 \x01(\x01R\x13excellentMultiplier\"z\n" +
+	"\x11RuleCategoryRange\x12#\n" +
+	"\rcategory_code\x18\x01 \x01(\tR\fcategoryCode\x12\x1f\n" +
+	"\vmin_percent\x18\x02 \x01(\x01R\n" +
+	"minPercent\x12\x1f\n" +
+	"\vmax_percent\x18\x03 \x01(\x01R\n" +
+	"maxPercent\"\x93\x01\n" +
+	"\rSalaryBracket\x12\x1d\n" +
+	"\n" +
+	"min_salary\x18\x01 \x01(\x01R\tminSalary\x12\x1d\n" +
+	"\n" +
+	"max_salary\x18\x02 \x01(\x01R\tmaxSalary\x12D\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2$.analytics_service.RuleCategoryRangeR\n" +
+	"categories\"\xee\x01\n" +
+	"\x13RecommendationRules\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\x03R\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\x12A\n" +
+	"\n" +
+	"thresholds\x18\x04 \x01(\v2!.analytics_service.RuleThresholdsR\n" +
+	"thresholds\x12<\n" +
+	"\bbrackets\x18\x05 \x03(\v2 .analytics_service.SalaryBracketR\bbrackets\"\x18\n" +
+	"\x16GetRecomputeMetricsReq\"\xe0\x01\n" +
+	"\x10RecomputeMetrics\x12\x16\n" +
+	"\x06events\x18\x01 \x01(\x03R\x06events\x12\x1c\n" +
+	"\tcoalesced\x18\x02 \x01(\x03R\tcoalesced\x12&\n" +
+	"\x0erecomputations\x18\x03 \x01(\x03R\x0erecomputations\x12\x1a\n" +
+	"\bfailures\x18\x04 \x01(\x03R\bfailures\x12\x18\n" +
+	"\apending\x18\x05 \x01(\x03R\apending\x12\x1e\n" +
+	"\n" +
+	"processing\x18\x06 \x01(\x03R\n" +
+	"processing\x12\x18\n" +
+	"\adropped\x18\a \x01(\x03R\adropped\"B\n" +
+	"\x14ReplayDeadLettersReq\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"3\n" +
+	"\x15ReplayDeadLettersResp\x12\x1a\n" +
+	"\breplayed\x18\x01 \x01(\x05R\breplayed2\xb0\f\n" +
 	"\x10AnalyticsService\x12i\n" +
-	"\x12GetRecommendations\x12(.analytics_service.GetRecommendationsReq\x1a).analytics_service.GetRecommendationsRespBCZAgithub.com/cg-2025-crutch/backend/funds-service/internal/grpc/genb\x06proto3"
+	"\x12GetRecommendations\x12(.analytics_service.GetRecommendationsReq\x1a).analytics_service.GetRecommendationsResp\x12{\n" +
+	"\x18GetRecommendationHistory\x12..analytics_service.GetRecommendationHistoryReq\x1a/.analytics_service.GetRecommendationHistoryResp\x12c\n" +
+	"\x10GetCategoryTrend\x12&.analytics_service.GetCategoryTrendReq\x1a'.analytics_service.GetCategoryTrendResp\x12T\n" +
+	"\vGetForecast\x12!.analytics_service.GetForecastReq\x1a\".analytics_service.GetForecastResp\x12W\n" +
+	"\fGetAnomalies\x12\".analytics_service.GetAnomaliesReq\x1a#.analytics_service.GetAnomaliesResp\x12{\n" +
+	"\x18GetDetectedSubscriptions\x12..analytics_service.GetDetectedSubscriptionsReq\x1a/.analytics_service.GetDetectedSubscriptionsResp\x12]\n" +
+	"\x0eGetHealthScore\x12$.analytics_service.GetHealthScoreReq\x1a%.analytics_service.GetHealthScoreResp\x12f\n" +
+	"\x11GetPeerComparison\x12'.analytics_service.GetPeerComparisonReq\x1a(.analytics_service.GetPeerComparisonResp\x12c\n" +
+	"\x10SimulateScenario\x12&.analytics_service.SimulateScenarioReq\x1a'.analytics_service.SimulateScenarioResp\x12]\n" +
+	"\x12GenerateBudgetPlan\x12(.analytics_service.GenerateBudgetPlanReq\x1a\x1d.analytics_service.BudgetPlan\x12c\n" +
+	"\x10AcceptBudgetPlan\x12&.analytics_service.AcceptBudgetPlanReq\x1a'.analytics_service.AcceptBudgetPlanResp\x12n\n" +
+	"\x16GetRecommendationRules\x12,.analytics_service.GetRecommendationRulesReq\x1a&.analytics_service.RecommendationRules\x12t\n" +
+	"\x19UpdateRecommendationRules\x12/.analytics_service.UpdateRecommendationRulesReq\x1a&.analytics_service.RecommendationRules\x12e\n" +
+	"\x13GetRecomputeMetrics\x12).analytics_service.GetRecomputeMetricsReq\x1a#.analytics_service.RecomputeMetrics\x12f\n" +
+	"\x11ReplayDeadLetters\x12'.analytics_service.ReplayDeadLettersReq\x1a(.analytics_service.ReplayDeadLettersRespBCZAgithub.com/cg-2025-crutch/backend/funds-service/internal/grpc/genb\x06proto3"
 
 var (
 	file_analytics_service_proto_rawDescOnce sync.Once
@@ -342,21 +4585,125 @@ func file_analytics_service_proto_rawDescGZIP() []byte {
 	return file_analytics_service_proto_rawDescData
 }
 
-var file_analytics_service_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_analytics_service_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_analytics_service_proto_goTypes = []any{
-	(*GetRecommendationsReq)(nil),  // 0: analytics_service.GetRecommendationsReq
-	(*CategoryRecommendation)(nil), // 1: analytics_service.CategoryRecommendation
-	(*GetRecommendationsResp)(nil), // 2: analytics_service.GetRecommendationsResp
+	(*GetRecommendationsReq)(nil),        // 0: analytics_service.GetRecommendationsReq
+	(*Period)(nil),                       // 1: analytics_service.Period
+	(*CategoryRecommendation)(nil),       // 2: analytics_service.CategoryRecommendation
+	(*GetRecommendationsResp)(nil),       // 3: analytics_service.GetRecommendationsResp
+	(*IncomeAnalysis)(nil),               // 4: analytics_service.IncomeAnalysis
+	(*DebtLoad)(nil),                     // 5: analytics_service.DebtLoad
+	(*GetRecommendationHistoryReq)(nil),  // 6: analytics_service.GetRecommendationHistoryReq
+	(*RecommendationSnapshot)(nil),       // 7: analytics_service.RecommendationSnapshot
+	(*GetRecommendationHistoryResp)(nil), // 8: analytics_service.GetRecommendationHistoryResp
+	(*GetCategoryTrendReq)(nil),          // 9: analytics_service.GetCategoryTrendReq
+	(*CategoryTrendPoint)(nil),           // 10: analytics_service.CategoryTrendPoint
+	(*CategoryTrend)(nil),                // 11: analytics_service.CategoryTrend
+	(*GetCategoryTrendResp)(nil),         // 12: analytics_service.GetCategoryTrendResp
+	(*GetForecastReq)(nil),               // 13: analytics_service.GetForecastReq
+	(*CategoryForecast)(nil),             // 14: analytics_service.CategoryForecast
+	(*GetForecastResp)(nil),              // 15: analytics_service.GetForecastResp
+	(*GetAnomaliesReq)(nil),              // 16: analytics_service.GetAnomaliesReq
+	(*Anomaly)(nil),                      // 17: analytics_service.Anomaly
+	(*GetAnomaliesResp)(nil),             // 18: analytics_service.GetAnomaliesResp
+	(*GetDetectedSubscriptionsReq)(nil),  // 19: analytics_service.GetDetectedSubscriptionsReq
+	(*PriceChange)(nil),                  // 20: analytics_service.PriceChange
+	(*Subscription)(nil),                 // 21: analytics_service.Subscription
+	(*GetDetectedSubscriptionsResp)(nil), // 22: analytics_service.GetDetectedSubscriptionsResp
+	(*GetHealthScoreReq)(nil),            // 23: analytics_service.GetHealthScoreReq
+	(*HealthComponent)(nil),              // 24: analytics_service.HealthComponent
+	(*HealthAction)(nil),                 // 25: analytics_service.HealthAction
+	(*HealthScorePoint)(nil),             // 26: analytics_service.HealthScorePoint
+	(*GetHealthScoreResp)(nil),           // 27: analytics_service.GetHealthScoreResp
+	(*GetPeerComparisonReq)(nil),         // 28: analytics_service.GetPeerComparisonReq
+	(*PeerCategoryComparison)(nil),       // 29: analytics_service.PeerCategoryComparison
+	(*GetPeerComparisonResp)(nil),        // 30: analytics_service.GetPeerComparisonResp
+	(*SimulateScenarioReq)(nil),          // 31: analytics_service.SimulateScenarioReq
+	(*CategoryAdjustment)(nil),           // 32: analytics_service.CategoryAdjustment
+	(*RecurringExpense)(nil),             // 33: analytics_service.RecurringExpense
+	(*CategoryScenarioDiff)(nil),         // 34: analytics_service.CategoryScenarioDiff
+	(*SimulateScenarioResp)(nil),         // 35: analytics_service.SimulateScenarioResp
+	(*GenerateBudgetPlanReq)(nil),        // 36: analytics_service.GenerateBudgetPlanReq
+	(*BudgetPlanItem)(nil),               // 37: analytics_service.BudgetPlanItem
+	(*BudgetPlan)(nil),                   // 38: analytics_service.BudgetPlan
+	(*AcceptBudgetPlanReq)(nil),          // 39: analytics_service.AcceptBudgetPlanReq
+	(*PlannedBudget)(nil),                // 40: analytics_service.PlannedBudget
+	(*AcceptBudgetPlanResp)(nil),         // 41: analytics_service.AcceptBudgetPlanResp
+	(*GetRecommendationRulesReq)(nil),    // 42: analytics_service.GetRecommendationRulesReq
+	(*UpdateRecommendationRulesReq)(nil), // 43: analytics_service.UpdateRecommendationRulesReq
+	(*RuleThresholds)(nil),               // 44: analytics_service.RuleThresholds
+	(*RuleCategoryRange)(nil),            // 45: analytics_service.RuleCategoryRange
+	(*SalaryBracket)(nil),                // 46: analytics_service.SalaryBracket
+	(*RecommendationRules)(nil),          // 47: analytics_service.RecommendationRules
+	(*GetRecomputeMetricsReq)(nil),       // 48: analytics_service.GetRecomputeMetricsReq
+	(*RecomputeMetrics)(nil),             // 49: analytics_service.RecomputeMetrics
+	(*ReplayDeadLettersReq)(nil),         // 50: analytics_service.ReplayDeadLettersReq
+	(*ReplayDeadLettersResp)(nil),        // 51: analytics_service.ReplayDeadLettersResp
 }
 var file_analytics_service_proto_depIdxs = []int32{
-	1, // 0: analytics_service.GetRecommendationsResp.recommendations:type_name -> analytics_service.CategoryRecommendation
-	0, // 1: analytics_service.AnalyticsService.GetRecommendations:input_type -> analytics_service.GetRecommendationsReq
-	2, // 2: analytics_service.AnalyticsService.GetRecommendations:output_type -> analytics_service.GetRecommendationsResp
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: analytics_service.GetRecommendationsReq.period:type_name -> analytics_service.Period
+	2,  // 1: analytics_service.GetRecommendationsResp.recommendations:type_name -> analytics_service.CategoryRecommendation
+	5,  // 2: analytics_service.GetRecommendationsResp.debt:type_name -> analytics_service.DebtLoad
+	4,  // 3: analytics_service.GetRecommendationsResp.income:type_name -> analytics_service.IncomeAnalysis
+	3,  // 4: analytics_service.RecommendationSnapshot.recommendations:type_name -> analytics_service.GetRecommendationsResp
+	7,  // 5: analytics_service.GetRecommendationHistoryResp.snapshots:type_name -> analytics_service.RecommendationSnapshot
+	10, // 6: analytics_service.CategoryTrend.points:type_name -> analytics_service.CategoryTrendPoint
+	11, // 7: analytics_service.GetCategoryTrendResp.trends:type_name -> analytics_service.CategoryTrend
+	14, // 8: analytics_service.GetForecastResp.categories:type_name -> analytics_service.CategoryForecast
+	17, // 9: analytics_service.GetAnomaliesResp.anomalies:type_name -> analytics_service.Anomaly
+	20, // 10: analytics_service.Subscription.price_changes:type_name -> analytics_service.PriceChange
+	21, // 11: analytics_service.GetDetectedSubscriptionsResp.subscriptions:type_name -> analytics_service.Subscription
+	24, // 12: analytics_service.GetHealthScoreResp.components:type_name -> analytics_service.HealthComponent
+	25, // 13: analytics_service.GetHealthScoreResp.actions:type_name -> analytics_service.HealthAction
+	26, // 14: analytics_service.GetHealthScoreResp.history:type_name -> analytics_service.HealthScorePoint
+	29, // 15: analytics_service.GetPeerComparisonResp.categories:type_name -> analytics_service.PeerCategoryComparison
+	32, // 16: analytics_service.SimulateScenarioReq.categories:type_name -> analytics_service.CategoryAdjustment
+	33, // 17: analytics_service.SimulateScenarioReq.recurring_expenses:type_name -> analytics_service.RecurringExpense
+	34, // 18: analytics_service.SimulateScenarioResp.categories:type_name -> analytics_service.CategoryScenarioDiff
+	3,  // 19: analytics_service.SimulateScenarioResp.simulated:type_name -> analytics_service.GetRecommendationsResp
+	37, // 20: analytics_service.BudgetPlan.items:type_name -> analytics_service.BudgetPlanItem
+	40, // 21: analytics_service.AcceptBudgetPlanResp.budgets:type_name -> analytics_service.PlannedBudget
+	40, // 22: analytics_service.AcceptBudgetPlanResp.kept:type_name -> analytics_service.PlannedBudget
+	44, // 23: analytics_service.UpdateRecommendationRulesReq.thresholds:type_name -> analytics_service.RuleThresholds
+	46, // 24: analytics_service.UpdateRecommendationRulesReq.brackets:type_name -> analytics_service.SalaryBracket
+	45, // 25: analytics_service.SalaryBracket.categories:type_name -> analytics_service.RuleCategoryRange
+	44, // 26: analytics_service.RecommendationRules.thresholds:type_name -> analytics_service.RuleThresholds
+	46, // 27: analytics_service.RecommendationRules.brackets:type_name -> analytics_service.SalaryBracket
+	0,  // 28: analytics_service.AnalyticsService.GetRecommendations:input_type -> analytics_service.GetRecommendationsReq
+	6,  // 29: analytics_service.AnalyticsService.GetRecommendationHistory:input_type -> analytics_service.GetRecommendationHistoryReq
+	9,  // 30: analytics_service.AnalyticsService.GetCategoryTrend:input_type -> analytics_service.GetCategoryTrendReq
+	13, // 31: analytics_service.AnalyticsService.GetForecast:input_type -> analytics_service.GetForecastReq
+	16, // 32: analytics_service.AnalyticsService.GetAnomalies:input_type -> analytics_service.GetAnomaliesReq
+	19, // 33: analytics_service.AnalyticsService.GetDetectedSubscriptions:input_type -> analytics_service.GetDetectedSubscriptionsReq
+	23, // 34: analytics_service.AnalyticsService.GetHealthScore:input_type -> analytics_service.GetHealthScoreReq
+	28, // 35: analytics_service.AnalyticsService.GetPeerComparison:input_type -> analytics_service.GetPeerComparisonReq
+	31, // 36: analytics_service.AnalyticsService.SimulateScenario:input_type -> analytics_service.SimulateScenarioReq
+	36, // 37: analytics_service.AnalyticsService.GenerateBudgetPlan:input_type -> analytics_service.GenerateBudgetPlanReq
+	39, // 38: analytics_service.AnalyticsService.AcceptBudgetPlan:input_type -> analytics_service.AcceptBudgetPlanReq
+	42, // 39: analytics_service.AnalyticsService.GetRecommendationRules:input_type -> analytics_service.GetRecommendationRulesReq
+	43, // 40: analytics_service.AnalyticsService.UpdateRecommendationRules:input_type -> analytics_service.UpdateRecommendationRulesReq
+	48, // 41: analytics_service.AnalyticsService.GetRecomputeMetrics:input_type -> analytics_service.GetRecomputeMetricsReq
+	50, // 42: analytics_service.AnalyticsService.ReplayDeadLetters:input_type -> analytics_service.ReplayDeadLettersReq
+	3,  // 43: analytics_service.AnalyticsService.GetRecommendations:output_type -> analytics_service.GetRecommendationsResp
+	8,  // 44: analytics_service.AnalyticsService.GetRecommendationHistory:output_type -> analytics_service.GetRecommendationHistoryResp
+	12, // 45: analytics_service.AnalyticsService.GetCategoryTrend:output_type -> analytics_service.GetCategoryTrendResp
+	15, // 46: analytics_service.AnalyticsService.GetForecast:output_type -> analytics_service.GetForecastResp
+	18, // 47: analytics_service.AnalyticsService.GetAnomalies:output_type -> analytics_service.GetAnomaliesResp
+	22, // 48: analytics_service.AnalyticsService.GetDetectedSubscriptions:output_type -> analytics_service.GetDetectedSubscriptionsResp
+	27, // 49: analytics_service.AnalyticsService.GetHealthScore:output_type -> analytics_service.GetHealthScoreResp
+	30, // 50: analytics_service.AnalyticsService.GetPeerComparison:output_type -> analytics_service.GetPeerComparisonResp
+	35, // 51: analytics_service.AnalyticsService.SimulateScenario:output_type -> analytics_service.SimulateScenarioResp
+	38, // 52: analytics_service.AnalyticsService.GenerateBudgetPlan:output_type -> analytics_service.BudgetPlan
+	41, // 53: analytics_service.AnalyticsService.AcceptBudgetPlan:output_type -> analytics_service.AcceptBudgetPlanResp
+	47, // 54: analytics_service.AnalyticsService.GetRecommendationRules:output_type -> analytics_service.RecommendationRules
+	47, // 55: analytics_service.AnalyticsService.UpdateRecommendationRules:output_type -> analytics_service.RecommendationRules
+	49, // 56: analytics_service.AnalyticsService.GetRecomputeMetrics:output_type -> analytics_service.RecomputeMetrics
+	51, // 57: analytics_service.AnalyticsService.ReplayDeadLetters:output_type -> analytics_service.ReplayDeadLettersResp
+	43, // [43:58] is the sub-list for method output_type
+	28, // [28:43] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_analytics_service_proto_init() }
@@ -370,7 +4717,7 @@ func file_analytics_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_analytics_service_proto_rawDesc), len(file_analytics_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,10 +25,14 @@ const (
 type Category struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Name in the first requested locale that has a translation
 	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // income or expense
 	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Code          string                 `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"` // Stable identifier, does not depend on the locale
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ArchivedAt    int64                  `protobuf:"varint,8,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`                                                            // 0 if the category is active
+	Translations  map[string]string      `protobuf:"bytes,9,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Locale to name, only in category management responses
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,10 +102,40 @@ func (x *Category) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *Category) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Category) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Category) GetArchivedAt() int64 {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return 0
+}
+
+func (x *Category) GetTranslations() map[string]string {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
 type GetAllCategoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Locales         []string               `protobuf:"bytes,1,rep,name=locales,proto3" json:"locales,omitempty"` // Preferred locales in order, e.g. ["en-us", "en"]
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetAllCategoriesRequest) Reset() {
@@ -134,6 +168,20 @@ func (*GetAllCategoriesRequest) Descriptor() ([]byte, []int) {
 	return file_funds_service_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetAllCategoriesRequest) GetLocales() []string {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+func (x *GetAllCategoriesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type GetAllCategoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
@@ -181,6 +229,7 @@ func (x *GetAllCategoriesResponse) GetCategories() []*Category {
 type GetCategoriesByTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // income or expense
+	Locales       []string               `protobuf:"bytes,2,rep,name=locales,proto3" json:"locales,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -222,6 +271,13 @@ func (x *GetCategoriesByTypeRequest) GetType() string {
 	return ""
 }
 
+func (x *GetCategoriesByTypeRequest) GetLocales() []string {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
 type GetCategoriesByTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
@@ -269,6 +325,7 @@ func (x *GetCategoriesByTypeResponse) GetCategories() []*Category {
 type GetCategoryByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Locales       []string               `protobuf:"bytes,2,rep,name=locales,proto3" json:"locales,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -310,6 +367,13 @@ func (x *GetCategoryByIdRequest) GetId() int32 {
 	return 0
 }
 
+func (x *GetCategoryByIdRequest) GetLocales() []string {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
 type GetCategoryByIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -354,38 +418,31 @@ func (x *GetCategoryByIdResponse) GetCategory() *Category {
 	return nil
 }
 
-// Transaction messages
-type Transaction struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserUid         string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
-	CategoryId      int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // income or expense
-	Amount          float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Title           string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	TransactionDate string                 `protobuf:"bytes,8,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"` // YYYY-MM-DD format
-	CreatedAt       int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Category        *Category              `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"` // Optional, populated with category details
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Lowercase letters, digits and underscores
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Name in the default (ru) locale
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // income or expense
+	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Translations  map[string]string      `protobuf:"bytes,5,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
 	mi := &file_funds_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *CreateCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*CreateCategoryRequest) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_funds_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -397,116 +454,115 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
 	return file_funds_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Transaction) GetId() int64 {
+func (x *CreateCategoryRequest) GetCode() string {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *Transaction) GetUserUid() string {
+func (x *CreateCategoryRequest) GetName() string {
 	if x != nil {
-		return x.UserUid
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Transaction) GetCategoryId() int32 {
+func (x *CreateCategoryRequest) GetType() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *Transaction) GetType() string {
+func (x *CreateCategoryRequest) GetIcon() string {
 	if x != nil {
-		return x.Type
+		return x.Icon
 	}
 	return ""
 }
 
-func (x *Transaction) GetAmount() float64 {
+func (x *CreateCategoryRequest) GetTranslations() map[string]string {
 	if x != nil {
-		return x.Amount
+		return x.Translations
 	}
-	return 0
+	return nil
 }
 
-func (x *Transaction) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_funds_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) GetTransactionDate() string {
-	if x != nil {
-		return x.TransactionDate
-	}
-	return ""
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Transaction) GetCreatedAt() int64 {
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[8]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Transaction) GetCategory() *Category {
+func (x *CreateCategoryResponse) GetCategory() *Category {
 	if x != nil {
 		return x.Category
 	}
 	return nil
 }
 
-type CreateTransactionRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserUid         string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
-	CategoryId      int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Amount          float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Title           string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	TransactionDate string                 `protobuf:"bytes,7,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"` // YYYY-MM-DD format
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Icon          *string                `protobuf:"bytes,3,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	Translations  map[string]string      `protobuf:"bytes,4,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Upserted, an empty name removes the translation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTransactionRequest) Reset() {
-	*x = CreateTransactionRequest{}
-	mi := &file_funds_service_proto_msgTypes[8]
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_funds_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTransactionRequest) String() string {
+func (x *UpdateCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransactionRequest) ProtoMessage() {}
+func (*UpdateCategoryRequest) ProtoMessage() {}
 
-func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[8]
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,82 +573,61 @@ func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
-func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *CreateTransactionRequest) GetUserUid() string {
-	if x != nil {
-		return x.UserUid
-	}
-	return ""
-}
-
-func (x *CreateTransactionRequest) GetCategoryId() int32 {
-	if x != nil {
-		return x.CategoryId
-	}
-	return 0
-}
-
-func (x *CreateTransactionRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateTransactionRequest) GetAmount() float64 {
+func (x *UpdateCategoryRequest) GetId() int32 {
 	if x != nil {
-		return x.Amount
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CreateTransactionRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *CreateTransactionRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *UpdateCategoryRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
 	}
 	return ""
 }
 
-func (x *CreateTransactionRequest) GetTransactionDate() string {
+func (x *UpdateCategoryRequest) GetTranslations() map[string]string {
 	if x != nil {
-		return x.TransactionDate
+		return x.Translations
 	}
-	return ""
+	return nil
 }
 
-type CreateTransactionResponse struct {
+type UpdateCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTransactionResponse) Reset() {
-	*x = CreateTransactionResponse{}
-	mi := &file_funds_service_proto_msgTypes[9]
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_funds_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTransactionResponse) String() string {
+func (x *UpdateCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransactionResponse) ProtoMessage() {}
+func (*UpdateCategoryResponse) ProtoMessage() {}
 
-func (x *CreateTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[9]
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,40 +638,40 @@ func (x *CreateTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransactionResponse.ProtoReflect.Descriptor instead.
-func (*CreateTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateTransactionResponse) GetTransaction() *Transaction {
+func (x *UpdateCategoryResponse) GetCategory() *Category {
 	if x != nil {
-		return x.Transaction
+		return x.Category
 	}
 	return nil
 }
 
-type GetTransactionByIdRequest struct {
+type ArchiveCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionByIdRequest) Reset() {
-	*x = GetTransactionByIdRequest{}
-	mi := &file_funds_service_proto_msgTypes[10]
+func (x *ArchiveCategoryRequest) Reset() {
+	*x = ArchiveCategoryRequest{}
+	mi := &file_funds_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionByIdRequest) String() string {
+func (x *ArchiveCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionByIdRequest) ProtoMessage() {}
+func (*ArchiveCategoryRequest) ProtoMessage() {}
 
-func (x *GetTransactionByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[10]
+func (x *ArchiveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,40 +682,40 @@ func (x *GetTransactionByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionByIdRequest) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ArchiveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetTransactionByIdRequest) GetId() int64 {
+func (x *ArchiveCategoryRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetTransactionByIdResponse struct {
+type ArchiveCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionByIdResponse) Reset() {
-	*x = GetTransactionByIdResponse{}
-	mi := &file_funds_service_proto_msgTypes[11]
+func (x *ArchiveCategoryResponse) Reset() {
+	*x = ArchiveCategoryResponse{}
+	mi := &file_funds_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionByIdResponse) String() string {
+func (x *ArchiveCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionByIdResponse) ProtoMessage() {}
+func (*ArchiveCategoryResponse) ProtoMessage() {}
 
-func (x *GetTransactionByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[11]
+func (x *ArchiveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,42 +726,6077 @@ func (x *GetTransactionByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionByIdResponse) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ArchiveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetTransactionByIdResponse) GetTransaction() *Transaction {
+func (x *ArchiveCategoryResponse) GetCategory() *Category {
 	if x != nil {
-		return x.Transaction
+		return x.Category
 	}
 	return nil
 }
 
-type GetUserTransactionsRequest struct {
+type RestoreCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCategoryRequest) Reset() {
+	*x = RestoreCategoryRequest{}
+	mi := &file_funds_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCategoryRequest) ProtoMessage() {}
+
+func (x *RestoreCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCategoryRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCategoryResponse) Reset() {
+	*x = RestoreCategoryResponse{}
+	mi := &file_funds_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCategoryResponse) ProtoMessage() {}
+
+func (x *RestoreCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCategoryResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RestoreCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Transaction messages
+type Transaction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid         string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	CategoryId      int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // income or expense
+	Amount          float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Title           string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	TransactionDate string                 `protobuf:"bytes,8,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"` // YYYY-MM-DD format
+	CreatedAt       int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Category        *Category              `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"` // Optional, populated with category details
+	Tags            []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	DeletedAt       int64                  `protobuf:"varint,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Set for transactions in the trash
+	Version         int64                  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`                      // Incremented on every change, used for optimistic concurrency
+	LedgerId        int64                  `protobuf:"varint,15,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`    // Set for transactions shared with a ledger
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_funds_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Transaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Transaction) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *Transaction) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Transaction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Transaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Transaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transaction) GetTransactionDate() string {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return ""
+}
+
+func (x *Transaction) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Transaction) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Transaction) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *Transaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Transaction) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Transaction) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Transaction) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+type CreateTransactionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserUid         string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	CategoryId      int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Amount          float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Title           string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	TransactionDate string                 `protobuf:"bytes,7,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"` // YYYY-MM-DD format
+	Tags            []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	LedgerId        int64                  `protobuf:"varint,9,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"` // Optional, shares the transaction with a ledger, requires the owner or editor role
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTransactionRequest) Reset() {
+	*x = CreateTransactionRequest{}
+	mi := &file_funds_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionRequest) ProtoMessage() {}
+
+func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateTransactionRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetTransactionDate() string {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTransactionRequest) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+type CreateTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransactionResponse) Reset() {
+	*x = CreateTransactionResponse{}
+	mi := &file_funds_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionResponse) ProtoMessage() {}
+
+func (x *CreateTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CreateTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GetTransactionByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionByIdRequest) Reset() {
+	*x = GetTransactionByIdRequest{}
+	mi := &file_funds_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionByIdRequest) ProtoMessage() {}
+
+func (x *GetTransactionByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionByIdRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTransactionByIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTransactionByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionByIdResponse) Reset() {
+	*x = GetTransactionByIdResponse{}
+	mi := &file_funds_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionByIdResponse) ProtoMessage() {}
+
+func (x *GetTransactionByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionByIdResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTransactionByIdResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GetUserTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	ExactTotal    bool                   `protobuf:"varint,4,opt,name=exact_total,json=exactTotal,proto3" json:"exact_total,omitempty"` // Count all transactions, by default counting stops at a cap
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTransactionsRequest) Reset() {
+	*x = GetUserTransactionsRequest{}
+	mi := &file_funds_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTransactionsRequest) ProtoMessage() {}
+
+func (x *GetUserTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserTransactionsRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetUserTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetUserTransactionsRequest) GetExactTotal() bool {
+	if x != nil {
+		return x.ExactTotal
+	}
+	return false
+}
+
+type GetUserTransactionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Transactions   []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Total          int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	TotalEstimated bool                   `protobuf:"varint,3,opt,name=total_estimated,json=totalEstimated,proto3" json:"total_estimated,omitempty"` // total is a lower bound
+	HasMore        bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                      // There are transactions after this page
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUserTransactionsResponse) Reset() {
+	*x = GetUserTransactionsResponse{}
+	mi := &file_funds_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTransactionsResponse) ProtoMessage() {}
+
+func (x *GetUserTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUserTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetUserTransactionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUserTransactionsResponse) GetTotalEstimated() bool {
+	if x != nil {
+		return x.TotalEstimated
+	}
+	return false
+}
+
+func (x *GetUserTransactionsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// Period selects a date range relative to the current date in the user's calendar
+type Period struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                         // days, week, month, quarter, salary_month, custom
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                    // 0 - the current period, -1 - the previous one and so on
+	DateFrom      string                 `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD format, custom only
+	DateTo        string                 `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD format, inclusive, custom only
+	Days          int32                  `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`                        // Window length, days only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Period) Reset() {
+	*x = Period{}
+	mi := &file_funds_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Period) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Period) ProtoMessage() {}
+
+func (x *Period) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Period.ProtoReflect.Descriptor instead.
+func (*Period) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Period) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Period) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Period) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *Period) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *Period) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// Calendar holds the user's preferences periods are evaluated with
+type Calendar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`                     // IANA time zone name, UTC if empty
+	WeekStart     int32                  `protobuf:"varint,2,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"` // ISO weekday the week starts on: 1 - Monday, 7 - Sunday
+	SalaryDay     int32                  `protobuf:"varint,3,opt,name=salary_day,json=salaryDay,proto3" json:"salary_day,omitempty"` // Day of month salary months start on, 0 - calendar months
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_funds_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Calendar) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Calendar) GetWeekStart() int32 {
+	if x != nil {
+		return x.WeekStart
+	}
+	return 0
+}
+
+func (x *Calendar) GetSalaryDay() int32 {
+	if x != nil {
+		return x.SalaryDay
+	}
+	return 0
+}
+
+type GetUserTransactionsByPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // Number of days from today, used when period is not set
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Period        *Period                `protobuf:"bytes,5,opt,name=period,proto3" json:"period,omitempty"`
+	Calendar      *Calendar              `protobuf:"bytes,6,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	ExactTotal    bool                   `protobuf:"varint,7,opt,name=exact_total,json=exactTotal,proto3" json:"exact_total,omitempty"` // Count all transactions, by default counting stops at a cap
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTransactionsByPeriodRequest) Reset() {
+	*x = GetUserTransactionsByPeriodRequest{}
+	mi := &file_funds_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTransactionsByPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTransactionsByPeriodRequest) ProtoMessage() {}
+
+func (x *GetUserTransactionsByPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTransactionsByPeriodRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTransactionsByPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserTransactionsByPeriodRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetUserTransactionsByPeriodRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetUserTransactionsByPeriodRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserTransactionsByPeriodRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetUserTransactionsByPeriodRequest) GetPeriod() *Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *GetUserTransactionsByPeriodRequest) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+func (x *GetUserTransactionsByPeriodRequest) GetExactTotal() bool {
+	if x != nil {
+		return x.ExactTotal
+	}
+	return false
+}
+
+type GetUserTransactionsByPeriodResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Transactions   []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Total          int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	DateFrom       string                 `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                    // Resolved period, YYYY-MM-DD format
+	DateTo         string                 `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                          // YYYY-MM-DD format, inclusive
+	TotalEstimated bool                   `protobuf:"varint,5,opt,name=total_estimated,json=totalEstimated,proto3" json:"total_estimated,omitempty"` // total is a lower bound
+	HasMore        bool                   `protobuf:"varint,6,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                      // There are transactions after this page
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUserTransactionsByPeriodResponse) Reset() {
+	*x = GetUserTransactionsByPeriodResponse{}
+	mi := &file_funds_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTransactionsByPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTransactionsByPeriodResponse) ProtoMessage() {}
+
+func (x *GetUserTransactionsByPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTransactionsByPeriodResponse.ProtoReflect.Descriptor instead.
+func (*GetUserTransactionsByPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetUserTransactionsByPeriodResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetUserTransactionsByPeriodResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUserTransactionsByPeriodResponse) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetUserTransactionsByPeriodResponse) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetUserTransactionsByPeriodResponse) GetTotalEstimated() bool {
+	if x != nil {
+		return x.TotalEstimated
+	}
+	return false
+}
+
+func (x *GetUserTransactionsByPeriodResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type UpdateTransactionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId      int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Amount          float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Title           string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	TransactionDate string                 `protobuf:"bytes,7,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	UserUid         string                 `protobuf:"bytes,8,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Tags            []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,10,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Optional, 0 skips the version check
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionRequest) Reset() {
+	*x = UpdateTransactionRequest{}
+	mi := &file_funds_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetTransactionDate() string {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateTransactionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionResponse) Reset() {
+	*x = UpdateTransactionResponse{}
+	mi := &file_funds_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionResponse) ProtoMessage() {}
+
+func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type DeleteTransactionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid         string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Optional, 0 skips the version check
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionRequest) Reset() {
+	*x = DeleteTransactionRequest{}
+	mi := &file_funds_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteTransactionRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *DeleteTransactionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionResponse) Reset() {
+	*x = DeleteTransactionResponse{}
+	mi := &file_funds_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionResponse) ProtoMessage() {}
+
+func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteTransactionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTransactionRequest) Reset() {
+	*x = RestoreTransactionRequest{}
+	mi := &file_funds_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTransactionRequest) ProtoMessage() {}
+
+func (x *RestoreTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTransactionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RestoreTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RestoreTransactionRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type RestoreTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTransactionResponse) Reset() {
+	*x = RestoreTransactionResponse{}
+	mi := &file_funds_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTransactionResponse) ProtoMessage() {}
+
+func (x *RestoreTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTransactionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RestoreTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GetDeletedTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	ExactTotal    bool                   `protobuf:"varint,4,opt,name=exact_total,json=exactTotal,proto3" json:"exact_total,omitempty"` // Count all deleted transactions, by default counting stops at a cap
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeletedTransactionsRequest) Reset() {
+	*x = GetDeletedTransactionsRequest{}
+	mi := &file_funds_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeletedTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeletedTransactionsRequest) ProtoMessage() {}
+
+func (x *GetDeletedTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeletedTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeletedTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetDeletedTransactionsRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetDeletedTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetDeletedTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetDeletedTransactionsRequest) GetExactTotal() bool {
+	if x != nil {
+		return x.ExactTotal
+	}
+	return false
+}
+
+type GetDeletedTransactionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Transactions   []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Total          int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	TotalEstimated bool                   `protobuf:"varint,3,opt,name=total_estimated,json=totalEstimated,proto3" json:"total_estimated,omitempty"` // total is a lower bound
+	HasMore        bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                      // There are transactions after this page
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDeletedTransactionsResponse) Reset() {
+	*x = GetDeletedTransactionsResponse{}
+	mi := &file_funds_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeletedTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeletedTransactionsResponse) ProtoMessage() {}
+
+func (x *GetDeletedTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeletedTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeletedTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetDeletedTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetDeletedTransactionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetDeletedTransactionsResponse) GetTotalEstimated() bool {
+	if x != nil {
+		return x.TotalEstimated
+	}
+	return false
+}
+
+func (x *GetDeletedTransactionsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// History messages
+type TransactionRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // create, update, delete or restore
+	Before        *Transaction           `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"` // Empty for create
+	After         *Transaction           `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	ChangedAt     int64                  `protobuf:"varint,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionRevision) Reset() {
+	*x = TransactionRevision{}
+	mi := &file_funds_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRevision) ProtoMessage() {}
+
+func (x *TransactionRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRevision.ProtoReflect.Descriptor instead.
+func (*TransactionRevision) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TransactionRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionRevision) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *TransactionRevision) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *TransactionRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TransactionRevision) GetBefore() *Transaction {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *TransactionRevision) GetAfter() *Transaction {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *TransactionRevision) GetChangedAt() int64 {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return 0
+}
+
+type GetTransactionHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionHistoryRequest) Reset() {
+	*x = GetTransactionHistoryRequest{}
+	mi := &file_funds_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionHistoryRequest) ProtoMessage() {}
+
+func (x *GetTransactionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTransactionHistoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetTransactionHistoryRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type GetTransactionHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*TransactionRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionHistoryResponse) Reset() {
+	*x = GetTransactionHistoryResponse{}
+	mi := &file_funds_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionHistoryResponse) ProtoMessage() {}
+
+func (x *GetTransactionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTransactionHistoryResponse) GetRevisions() []*TransactionRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// Batch messages
+type BatchItemError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Position of the item in the request
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`    // invalid_argument, not_found, permission_denied, failed_precondition or internal
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemError) Reset() {
+	*x = BatchItemError{}
+	mi := &file_funds_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemError) ProtoMessage() {}
+
+func (x *BatchItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemError.ProtoReflect.Descriptor instead.
+func (*BatchItemError) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BatchItemError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchItemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BatchCreateTransactionsRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	UserUid       string                      `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Items         []*CreateTransactionRequest `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                                      // user_uid of the items is ignored
+	AllOrNothing  bool                        `protobuf:"varint,3,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"` // Roll back the whole batch if any item fails
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateTransactionsRequest) Reset() {
+	*x = BatchCreateTransactionsRequest{}
+	mi := &file_funds_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTransactionsRequest) ProtoMessage() {}
+
+func (x *BatchCreateTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchCreateTransactionsRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *BatchCreateTransactionsRequest) GetItems() []*CreateTransactionRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchCreateTransactionsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BatchCreateTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Errors        []*BatchItemError      `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Committed     bool                   `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"` // False when nothing was applied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateTransactionsResponse) Reset() {
+	*x = BatchCreateTransactionsResponse{}
+	mi := &file_funds_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTransactionsResponse) ProtoMessage() {}
+
+func (x *BatchCreateTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchCreateTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *BatchCreateTransactionsResponse) GetErrors() []*BatchItemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *BatchCreateTransactionsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+// TransactionPatch changes only the fields that are set
+type TransactionPatch struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId      *int32                 `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"` // Must have the same type as the transaction
+	Title           *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	ShiftDays       int32                  `protobuf:"varint,4,opt,name=shift_days,json=shiftDays,proto3" json:"shift_days,omitempty"`                   // Moves transaction_date by the given number of days
+	ExpectedVersion int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Optional, 0 skips the version check
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransactionPatch) Reset() {
+	*x = TransactionPatch{}
+	mi := &file_funds_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionPatch) ProtoMessage() {}
+
+func (x *TransactionPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionPatch.ProtoReflect.Descriptor instead.
+func (*TransactionPatch) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TransactionPatch) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionPatch) GetCategoryId() int32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *TransactionPatch) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *TransactionPatch) GetShiftDays() int32 {
+	if x != nil {
+		return x.ShiftDays
+	}
+	return 0
+}
+
+func (x *TransactionPatch) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type BatchUpdateTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Items         []*TransactionPatch    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	AllOrNothing  bool                   `protobuf:"varint,3,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTransactionsRequest) Reset() {
+	*x = BatchUpdateTransactionsRequest{}
+	mi := &file_funds_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTransactionsRequest) ProtoMessage() {}
+
+func (x *BatchUpdateTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchUpdateTransactionsRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *BatchUpdateTransactionsRequest) GetItems() []*TransactionPatch {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchUpdateTransactionsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BatchUpdateTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Errors        []*BatchItemError      `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Committed     bool                   `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTransactionsResponse) Reset() {
+	*x = BatchUpdateTransactionsResponse{}
+	mi := &file_funds_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTransactionsResponse) ProtoMessage() {}
+
+func (x *BatchUpdateTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BatchUpdateTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *BatchUpdateTransactionsResponse) GetErrors() []*BatchItemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *BatchUpdateTransactionsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type BatchDeleteTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Ids           []int64                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	AllOrNothing  bool                   `protobuf:"varint,3,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteTransactionsRequest) Reset() {
+	*x = BatchDeleteTransactionsRequest{}
+	mi := &file_funds_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteTransactionsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BatchDeleteTransactionsRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *BatchDeleteTransactionsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteTransactionsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BatchDeleteTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedIds    []int64                `protobuf:"varint,1,rep,packed,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	Errors        []*BatchItemError      `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Committed     bool                   `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteTransactionsResponse) Reset() {
+	*x = BatchDeleteTransactionsResponse{}
+	mi := &file_funds_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteTransactionsResponse) ProtoMessage() {}
+
+func (x *BatchDeleteTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BatchDeleteTransactionsResponse) GetDeletedIds() []int64 {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+func (x *BatchDeleteTransactionsResponse) GetErrors() []*BatchItemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *BatchDeleteTransactionsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+// Balance messages
+type UserBalance struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserUid           string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	TotalBalance      float64                `protobuf:"fixed64,2,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	TotalIncome       float64                `protobuf:"fixed64,3,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
+	TotalExpense      float64                `protobuf:"fixed64,4,opt,name=total_expense,json=totalExpense,proto3" json:"total_expense,omitempty"`
+	LastTransactionAt int64                  `protobuf:"varint,5,opt,name=last_transaction_at,json=lastTransactionAt,proto3" json:"last_transaction_at,omitempty"`
+	UpdatedAt         int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserBalance) Reset() {
+	*x = UserBalance{}
+	mi := &file_funds_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBalance) ProtoMessage() {}
+
+func (x *UserBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBalance.ProtoReflect.Descriptor instead.
+func (*UserBalance) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UserBalance) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *UserBalance) GetTotalBalance() float64 {
+	if x != nil {
+		return x.TotalBalance
+	}
+	return 0
+}
+
+func (x *UserBalance) GetTotalIncome() float64 {
+	if x != nil {
+		return x.TotalIncome
+	}
+	return 0
+}
+
+func (x *UserBalance) GetTotalExpense() float64 {
+	if x != nil {
+		return x.TotalExpense
+	}
+	return 0
+}
+
+func (x *UserBalance) GetLastTransactionAt() int64 {
+	if x != nil {
+		return x.LastTransactionAt
+	}
+	return 0
+}
+
+func (x *UserBalance) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetUserBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalanceRequest) Reset() {
+	*x = GetUserBalanceRequest{}
+	mi := &file_funds_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceRequest) ProtoMessage() {}
+
+func (x *GetUserBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetUserBalanceRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type GetUserBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       *UserBalance           `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalanceResponse) Reset() {
+	*x = GetUserBalanceResponse{}
+	mi := &file_funds_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceResponse) ProtoMessage() {}
+
+func (x *GetUserBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetUserBalanceResponse) GetBalance() *UserBalance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type GetBalanceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD format
+	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD format, inclusive
+	Granularity   string                 `protobuf:"bytes,4,opt,name=granularity,proto3" json:"granularity,omitempty"`           // day, week, month or year, day by default
+	Period        *Period                `protobuf:"bytes,5,opt,name=period,proto3" json:"period,omitempty"`                     // Optional, overrides date_from and date_to
+	Calendar      *Calendar              `protobuf:"bytes,6,opt,name=calendar,proto3" json:"calendar,omitempty"`                 // Evaluates period and week buckets in the user's calendar
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceHistoryRequest) Reset() {
+	*x = GetBalanceHistoryRequest{}
+	mi := &file_funds_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceHistoryRequest) ProtoMessage() {}
+
+func (x *GetBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetBalanceHistoryRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetBalanceHistoryRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetBalanceHistoryRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetBalanceHistoryRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetBalanceHistoryRequest) GetPeriod() *Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *GetBalanceHistoryRequest) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type BalanceHistoryPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Period         string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`                                         // Bucket start, YYYY-MM-DD format
+	OpeningBalance float64                `protobuf:"fixed64,2,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"` // Balance before the first day of the bucket
+	ClosingBalance float64                `protobuf:"fixed64,3,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"` // Balance after the last day of the bucket
+	Income         float64                `protobuf:"fixed64,4,opt,name=income,proto3" json:"income,omitempty"`
+	Expense        float64                `protobuf:"fixed64,5,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BalanceHistoryPoint) Reset() {
+	*x = BalanceHistoryPoint{}
+	mi := &file_funds_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistoryPoint) ProtoMessage() {}
+
+func (x *BalanceHistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistoryPoint.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryPoint) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BalanceHistoryPoint) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *BalanceHistoryPoint) GetOpeningBalance() float64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *BalanceHistoryPoint) GetClosingBalance() float64 {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return 0
+}
+
+func (x *BalanceHistoryPoint) GetIncome() float64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *BalanceHistoryPoint) GetExpense() float64 {
+	if x != nil {
+		return x.Expense
+	}
+	return 0
+}
+
+type GetBalanceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*BalanceHistoryPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	Granularity   string                 `protobuf:"bytes,2,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        string                 `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceHistoryResponse) Reset() {
+	*x = GetBalanceHistoryResponse{}
+	mi := &file_funds_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceHistoryResponse) ProtoMessage() {}
+
+func (x *GetBalanceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetBalanceHistoryResponse) GetPoints() []*BalanceHistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetBalanceHistoryResponse) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetBalanceHistoryResponse) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetBalanceHistoryResponse) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+// Report messages
+type GetSpendingSummaryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserUid         string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	DateFrom        string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                       // YYYY-MM-DD format
+	DateTo          string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                             // YYYY-MM-DD format, inclusive
+	GroupBy         []string               `protobuf:"bytes,4,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`                          // category, type, day, week, month, year, tag, member
+	Type            string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`                                               // Optional filter: income or expense
+	ComparePrevious bool                   `protobuf:"varint,6,opt,name=compare_previous,json=comparePrevious,proto3" json:"compare_previous,omitempty"` // Compare with the preceding period: previous months or years for month and year buckets, whole weeks for week buckets, otherwise the same number of days
+	LedgerId        int64                  `protobuf:"varint,7,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`                      // Optional, summarizes the transactions of all ledger members instead of the user's
+	Period          *Period                `protobuf:"bytes,8,opt,name=period,proto3" json:"period,omitempty"`                                           // Optional, overrides date_from and date_to
+	Calendar        *Calendar              `protobuf:"bytes,9,opt,name=calendar,proto3" json:"calendar,omitempty"`                                       // Evaluates period and week buckets in the user's calendar
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSpendingSummaryRequest) Reset() {
+	*x = GetSpendingSummaryRequest{}
+	mi := &file_funds_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendingSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingSummaryRequest) ProtoMessage() {}
+
+func (x *GetSpendingSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetSpendingSummaryRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetSpendingSummaryRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetSpendingSummaryRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetSpendingSummaryRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *GetSpendingSummaryRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetSpendingSummaryRequest) GetComparePrevious() bool {
+	if x != nil {
+		return x.ComparePrevious
+	}
+	return false
+}
+
+func (x *GetSpendingSummaryRequest) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *GetSpendingSummaryRequest) GetPeriod() *Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *GetSpendingSummaryRequest) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type SpendingSummaryBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Period        string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"` // Bucket start, YYYY-MM-DD format
+	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	Total         float64                `protobuf:"fixed64,6,opt,name=total,proto3" json:"total,omitempty"`
+	Count         int64                  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	PreviousTotal float64                `protobuf:"fixed64,8,opt,name=previous_total,json=previousTotal,proto3" json:"previous_total,omitempty"`
+	Delta         float64                `protobuf:"fixed64,9,opt,name=delta,proto3" json:"delta,omitempty"`
+	DeltaPercent  float64                `protobuf:"fixed64,10,opt,name=delta_percent,json=deltaPercent,proto3" json:"delta_percent,omitempty"`
+	UserUid       string                 `protobuf:"bytes,11,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"` // Member who made the transactions, set when grouped by member
+	CategoryCode  string                 `protobuf:"bytes,12,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingSummaryBucket) Reset() {
+	*x = SpendingSummaryBucket{}
+	mi := &file_funds_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingSummaryBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingSummaryBucket) ProtoMessage() {}
+
+func (x *SpendingSummaryBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingSummaryBucket.ProtoReflect.Descriptor instead.
+func (*SpendingSummaryBucket) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SpendingSummaryBucket) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SpendingSummaryBucket) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *SpendingSummaryBucket) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SpendingSummaryBucket) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SpendingSummaryBucket) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SpendingSummaryBucket) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SpendingSummaryBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SpendingSummaryBucket) GetPreviousTotal() float64 {
+	if x != nil {
+		return x.PreviousTotal
+	}
+	return 0
+}
+
+func (x *SpendingSummaryBucket) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *SpendingSummaryBucket) GetDeltaPercent() float64 {
+	if x != nil {
+		return x.DeltaPercent
+	}
+	return 0
+}
+
+func (x *SpendingSummaryBucket) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *SpendingSummaryBucket) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+type GetSpendingSummaryResponse struct {
+	state                protoimpl.MessageState   `protogen:"open.v1"`
+	Buckets              []*SpendingSummaryBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	TotalIncome          float64                  `protobuf:"fixed64,2,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
+	TotalExpense         float64                  `protobuf:"fixed64,3,opt,name=total_expense,json=totalExpense,proto3" json:"total_expense,omitempty"`
+	PreviousTotalIncome  float64                  `protobuf:"fixed64,4,opt,name=previous_total_income,json=previousTotalIncome,proto3" json:"previous_total_income,omitempty"`
+	PreviousTotalExpense float64                  `protobuf:"fixed64,5,opt,name=previous_total_expense,json=previousTotalExpense,proto3" json:"previous_total_expense,omitempty"`
+	DateFrom             string                   `protobuf:"bytes,6,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo               string                   `protobuf:"bytes,7,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetSpendingSummaryResponse) Reset() {
+	*x = GetSpendingSummaryResponse{}
+	mi := &file_funds_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendingSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingSummaryResponse) ProtoMessage() {}
+
+func (x *GetSpendingSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendingSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetSpendingSummaryResponse) GetBuckets() []*SpendingSummaryBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetSpendingSummaryResponse) GetTotalIncome() float64 {
+	if x != nil {
+		return x.TotalIncome
+	}
+	return 0
+}
+
+func (x *GetSpendingSummaryResponse) GetTotalExpense() float64 {
+	if x != nil {
+		return x.TotalExpense
+	}
+	return 0
+}
+
+func (x *GetSpendingSummaryResponse) GetPreviousTotalIncome() float64 {
+	if x != nil {
+		return x.PreviousTotalIncome
+	}
+	return 0
+}
+
+func (x *GetSpendingSummaryResponse) GetPreviousTotalExpense() float64 {
+	if x != nil {
+		return x.PreviousTotalExpense
+	}
+	return 0
+}
+
+func (x *GetSpendingSummaryResponse) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetSpendingSummaryResponse) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+// Attachment messages
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,3,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"` // Bytes
+	HasThumbnail  bool                   `protobuf:"varint,7,opt,name=has_thumbnail,json=hasThumbnail,proto3" json:"has_thumbnail,omitempty"`
+	Checksum      string                 `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"` // SHA-256 of the content, hex encoded
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_funds_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Attachment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *Attachment) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetHasThumbnail() bool {
+	if x != nil {
+		return x.HasThumbnail
+	}
+	return false
+}
+
+func (x *Attachment) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AttachmentUploadInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"` // Declared size in bytes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentUploadInfo) Reset() {
+	*x = AttachmentUploadInfo{}
+	mi := &file_funds_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentUploadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentUploadInfo) ProtoMessage() {}
+
+func (x *AttachmentUploadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentUploadInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentUploadInfo) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AttachmentUploadInfo) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *AttachmentUploadInfo) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *AttachmentUploadInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentUploadInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentUploadInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// The first message carries info, the following ones carry chunks of the file
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_funds_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *AttachmentUploadInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *AttachmentUploadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_funds_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Thumbnail     bool                   `protobuf:"varint,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"` // Download the JPEG thumbnail instead of the original
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_funds_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DownloadAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DownloadAttachmentRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetThumbnail() bool {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return false
+}
+
+type AttachmentDownloadInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentDownloadInfo) Reset() {
+	*x = AttachmentDownloadInfo{}
+	mi := &file_funds_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentDownloadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentDownloadInfo) ProtoMessage() {}
+
+func (x *AttachmentDownloadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentDownloadInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentDownloadInfo) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AttachmentDownloadInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentDownloadInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentDownloadInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// The first message carries info, the following ones carry chunks of the file
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAttachmentResponse_Info
+	//	*DownloadAttachmentResponse_Chunk
+	Payload       isDownloadAttachmentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_funds_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DownloadAttachmentResponse) GetPayload() isDownloadAttachmentResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetInfo() *AttachmentDownloadInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Payload interface {
+	isDownloadAttachmentResponse_Payload()
+}
+
+type DownloadAttachmentResponse_Info struct {
+	Info *AttachmentDownloadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Info) isDownloadAttachmentResponse_Payload() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Payload() {}
+
+type GetTransactionAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionAttachmentsRequest) Reset() {
+	*x = GetTransactionAttachmentsRequest{}
+	mi := &file_funds_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionAttachmentsRequest) ProtoMessage() {}
+
+func (x *GetTransactionAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetTransactionAttachmentsRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *GetTransactionAttachmentsRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type GetTransactionAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionAttachmentsResponse) Reset() {
+	*x = GetTransactionAttachmentsResponse{}
+	mi := &file_funds_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionAttachmentsResponse) ProtoMessage() {}
+
+func (x *GetTransactionAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTransactionAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_funds_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteAttachmentRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_funds_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteAttachmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetAttachmentUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentUsageRequest) Reset() {
+	*x = GetAttachmentUsageRequest{}
+	mi := &file_funds_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentUsageRequest) ProtoMessage() {}
+
+func (x *GetAttachmentUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentUsageRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetAttachmentUsageRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type GetAttachmentUsageResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UsedBytes           int64                  `protobuf:"varint,1,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	QuotaBytes          int64                  `protobuf:"varint,2,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	Count               int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	MaxFileSize         int64                  `protobuf:"varint,4,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	AllowedContentTypes []string               `protobuf:"bytes,5,rep,name=allowed_content_types,json=allowedContentTypes,proto3" json:"allowed_content_types,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetAttachmentUsageResponse) Reset() {
+	*x = GetAttachmentUsageResponse{}
+	mi := &file_funds_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentUsageResponse) ProtoMessage() {}
+
+func (x *GetAttachmentUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentUsageResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetAttachmentUsageResponse) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *GetAttachmentUsageResponse) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *GetAttachmentUsageResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetAttachmentUsageResponse) GetMaxFileSize() int64 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *GetAttachmentUsageResponse) GetAllowedContentTypes() []string {
+	if x != nil {
+		return x.AllowedContentTypes
+	}
+	return nil
+}
+
+// Debt messages
+type Debt struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid          string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Kind             string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // loan, credit_card, borrowed or lent
+	Title            string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Counterparty     string                 `protobuf:"bytes,5,opt,name=counterparty,proto3" json:"counterparty,omitempty"` // Bank or person
+	Principal        float64                `protobuf:"fixed64,6,opt,name=principal,proto3" json:"principal,omitempty"`
+	InterestRate     float64                `protobuf:"fixed64,7,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"` // Annual, percent
+	TermMonths       int32                  `protobuf:"varint,8,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`        // 0 for debts without a fixed term
+	StartDate        string                 `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`            // YYYY-MM-DD format
+	MonthlyPayment   float64                `protobuf:"fixed64,10,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
+	RemainingBalance float64                `protobuf:"fixed64,11,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	PaidPrincipal    float64                `protobuf:"fixed64,12,opt,name=paid_principal,json=paidPrincipal,proto3" json:"paid_principal,omitempty"`
+	PaidInterest     float64                `protobuf:"fixed64,13,opt,name=paid_interest,json=paidInterest,proto3" json:"paid_interest,omitempty"`
+	PaymentsCount    int32                  `protobuf:"varint,14,opt,name=payments_count,json=paymentsCount,proto3" json:"payments_count,omitempty"`
+	PayoffDate       string                 `protobuf:"bytes,15,opt,name=payoff_date,json=payoffDate,proto3" json:"payoff_date,omitempty"` // Projected, YYYY-MM-DD format, empty if the debt is never paid off at the current payment
+	Status           string                 `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`                           // active or paid_off
+	CreatedAt        int64                  `protobuf:"varint,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastPaymentAt    string                 `protobuf:"bytes,19,opt,name=last_payment_at,json=lastPaymentAt,proto3" json:"last_payment_at,omitempty"` // YYYY-MM-DD format
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Debt) Reset() {
+	*x = Debt{}
+	mi := &file_funds_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Debt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Debt) ProtoMessage() {}
+
+func (x *Debt) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Debt.ProtoReflect.Descriptor instead.
+func (*Debt) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Debt) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Debt) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *Debt) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Debt) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Debt) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *Debt) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *Debt) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *Debt) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *Debt) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Debt) GetMonthlyPayment() float64 {
+	if x != nil {
+		return x.MonthlyPayment
+	}
+	return 0
+}
+
+func (x *Debt) GetRemainingBalance() float64 {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return 0
+}
+
+func (x *Debt) GetPaidPrincipal() float64 {
+	if x != nil {
+		return x.PaidPrincipal
+	}
+	return 0
+}
+
+func (x *Debt) GetPaidInterest() float64 {
+	if x != nil {
+		return x.PaidInterest
+	}
+	return 0
+}
+
+func (x *Debt) GetPaymentsCount() int32 {
+	if x != nil {
+		return x.PaymentsCount
+	}
+	return 0
+}
+
+func (x *Debt) GetPayoffDate() string {
+	if x != nil {
+		return x.PayoffDate
+	}
+	return ""
+}
+
+func (x *Debt) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Debt) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Debt) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Debt) GetLastPaymentAt() string {
+	if x != nil {
+		return x.LastPaymentAt
+	}
+	return ""
+}
+
+type DebtPayment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DebtId         int64                  `protobuf:"varint,2,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
+	TransactionId  int64                  `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	PrincipalPart  float64                `protobuf:"fixed64,5,opt,name=principal_part,json=principalPart,proto3" json:"principal_part,omitempty"`
+	InterestPart   float64                `protobuf:"fixed64,6,opt,name=interest_part,json=interestPart,proto3" json:"interest_part,omitempty"`
+	RemainingAfter float64                `protobuf:"fixed64,7,opt,name=remaining_after,json=remainingAfter,proto3" json:"remaining_after,omitempty"` // Remaining principal right after the payment
+	PaidAt         string                 `protobuf:"bytes,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`                           // Transaction date, YYYY-MM-DD format
+	CreatedAt      int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DebtPayment) Reset() {
+	*x = DebtPayment{}
+	mi := &file_funds_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtPayment) ProtoMessage() {}
+
+func (x *DebtPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtPayment.ProtoReflect.Descriptor instead.
+func (*DebtPayment) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DebtPayment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DebtPayment) GetDebtId() int64 {
+	if x != nil {
+		return x.DebtId
+	}
+	return 0
+}
+
+func (x *DebtPayment) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *DebtPayment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DebtPayment) GetPrincipalPart() float64 {
+	if x != nil {
+		return x.PrincipalPart
+	}
+	return 0
+}
+
+func (x *DebtPayment) GetInterestPart() float64 {
+	if x != nil {
+		return x.InterestPart
+	}
+	return 0
+}
+
+func (x *DebtPayment) GetRemainingAfter() float64 {
+	if x != nil {
+		return x.RemainingAfter
+	}
+	return 0
+}
+
+func (x *DebtPayment) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+func (x *DebtPayment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AmortizationEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD format
+	Payment       float64                `protobuf:"fixed64,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	Principal     float64                `protobuf:"fixed64,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest      float64                `protobuf:"fixed64,5,opt,name=interest,proto3" json:"interest,omitempty"`
+	Remaining     float64                `protobuf:"fixed64,6,opt,name=remaining,proto3" json:"remaining,omitempty"` // Remaining principal after the payment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmortizationEntry) Reset() {
+	*x = AmortizationEntry{}
+	mi := &file_funds_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmortizationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmortizationEntry) ProtoMessage() {}
+
+func (x *AmortizationEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmortizationEntry.ProtoReflect.Descriptor instead.
+func (*AmortizationEntry) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AmortizationEntry) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *AmortizationEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AmortizationEntry) GetPayment() float64 {
+	if x != nil {
+		return x.Payment
+	}
+	return 0
+}
+
+func (x *AmortizationEntry) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *AmortizationEntry) GetInterest() float64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *AmortizationEntry) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+type CreateDebtRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserUid        string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Kind           string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Counterparty   string                 `protobuf:"bytes,4,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Principal      float64                `protobuf:"fixed64,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	InterestRate   float64                `protobuf:"fixed64,6,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths     int32                  `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	StartDate      string                 `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                  // YYYY-MM-DD format
+	MonthlyPayment float64                `protobuf:"fixed64,9,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"` // Optional, the annuity payment is used for debts with a term
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateDebtRequest) Reset() {
+	*x = CreateDebtRequest{}
+	mi := &file_funds_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDebtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDebtRequest) ProtoMessage() {}
+
+func (x *CreateDebtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDebtRequest.ProtoReflect.Descriptor instead.
+func (*CreateDebtRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateDebtRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *CreateDebtRequest) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *CreateDebtRequest) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *CreateDebtRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetMonthlyPayment() float64 {
+	if x != nil {
+		return x.MonthlyPayment
+	}
+	return 0
+}
+
+type CreateDebtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debt          *Debt                  `protobuf:"bytes,1,opt,name=debt,proto3" json:"debt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDebtResponse) Reset() {
+	*x = CreateDebtResponse{}
+	mi := &file_funds_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDebtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDebtResponse) ProtoMessage() {}
+
+func (x *CreateDebtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDebtResponse.ProtoReflect.Descriptor instead.
+func (*CreateDebtResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateDebtResponse) GetDebt() *Debt {
+	if x != nil {
+		return x.Debt
+	}
+	return nil
+}
+
+type GetDebtByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDebtByIdRequest) Reset() {
+	*x = GetDebtByIdRequest{}
+	mi := &file_funds_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebtByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebtByIdRequest) ProtoMessage() {}
+
+func (x *GetDebtByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebtByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetDebtByIdRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetDebtByIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetDebtByIdRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type GetDebtByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debt          *Debt                  `protobuf:"bytes,1,opt,name=debt,proto3" json:"debt,omitempty"`
+	Payments      []*DebtPayment         `protobuf:"bytes,2,rep,name=payments,proto3" json:"payments,omitempty"`
+	Schedule      []*AmortizationEntry   `protobuf:"bytes,3,rep,name=schedule,proto3" json:"schedule,omitempty"` // Projected from the remaining balance
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDebtByIdResponse) Reset() {
+	*x = GetDebtByIdResponse{}
+	mi := &file_funds_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebtByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebtByIdResponse) ProtoMessage() {}
+
+func (x *GetDebtByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebtByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetDebtByIdResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetDebtByIdResponse) GetDebt() *Debt {
+	if x != nil {
+		return x.Debt
+	}
+	return nil
+}
+
+func (x *GetDebtByIdResponse) GetPayments() []*DebtPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *GetDebtByIdResponse) GetSchedule() []*AmortizationEntry {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GetUserDebtsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserUid        string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	IncludePaidOff bool                   `protobuf:"varint,2,opt,name=include_paid_off,json=includePaidOff,proto3" json:"include_paid_off,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUserDebtsRequest) Reset() {
+	*x = GetUserDebtsRequest{}
+	mi := &file_funds_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDebtsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDebtsRequest) ProtoMessage() {}
+
+func (x *GetUserDebtsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDebtsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDebtsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetUserDebtsRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetUserDebtsRequest) GetIncludePaidOff() bool {
+	if x != nil {
+		return x.IncludePaidOff
+	}
+	return false
+}
+
+type GetUserDebtsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debts         []*Debt                `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDebtsResponse) Reset() {
+	*x = GetUserDebtsResponse{}
+	mi := &file_funds_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDebtsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDebtsResponse) ProtoMessage() {}
+
+func (x *GetUserDebtsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDebtsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserDebtsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetUserDebtsResponse) GetDebts() []*Debt {
+	if x != nil {
+		return x.Debts
+	}
+	return nil
+}
+
+type DeleteDebtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDebtRequest) Reset() {
+	*x = DeleteDebtRequest{}
+	mi := &file_funds_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDebtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDebtRequest) ProtoMessage() {}
+
+func (x *DeleteDebtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDebtRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDebtRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteDebtRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteDebtRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type DeleteDebtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDebtResponse) Reset() {
+	*x = DeleteDebtResponse{}
+	mi := &file_funds_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDebtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDebtResponse) ProtoMessage() {}
+
+func (x *DeleteDebtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDebtResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDebtResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteDebtResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// LinkDebtPaymentRequest marks an existing transaction as a payment of the debt.
+// Liabilities are paid with expense transactions, money lent is returned with income transactions.
+type LinkDebtPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DebtId        int64                  `protobuf:"varint,1,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	TransactionId int64                  `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkDebtPaymentRequest) Reset() {
+	*x = LinkDebtPaymentRequest{}
+	mi := &file_funds_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkDebtPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkDebtPaymentRequest) ProtoMessage() {}
+
+func (x *LinkDebtPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkDebtPaymentRequest.ProtoReflect.Descriptor instead.
+func (*LinkDebtPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *LinkDebtPaymentRequest) GetDebtId() int64 {
+	if x != nil {
+		return x.DebtId
+	}
+	return 0
+}
+
+func (x *LinkDebtPaymentRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *LinkDebtPaymentRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type LinkDebtPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *DebtPayment           `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Debt          *Debt                  `protobuf:"bytes,2,opt,name=debt,proto3" json:"debt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkDebtPaymentResponse) Reset() {
+	*x = LinkDebtPaymentResponse{}
+	mi := &file_funds_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkDebtPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkDebtPaymentResponse) ProtoMessage() {}
+
+func (x *LinkDebtPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkDebtPaymentResponse.ProtoReflect.Descriptor instead.
+func (*LinkDebtPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LinkDebtPaymentResponse) GetPayment() *DebtPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *LinkDebtPaymentResponse) GetDebt() *Debt {
+	if x != nil {
+		return x.Debt
+	}
+	return nil
+}
+
+type UnlinkDebtPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DebtId        int64                  `protobuf:"varint,1,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	PaymentId     int64                  `protobuf:"varint,3,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkDebtPaymentRequest) Reset() {
+	*x = UnlinkDebtPaymentRequest{}
+	mi := &file_funds_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkDebtPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkDebtPaymentRequest) ProtoMessage() {}
+
+func (x *UnlinkDebtPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkDebtPaymentRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkDebtPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UnlinkDebtPaymentRequest) GetDebtId() int64 {
+	if x != nil {
+		return x.DebtId
+	}
+	return 0
+}
+
+func (x *UnlinkDebtPaymentRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *UnlinkDebtPaymentRequest) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+type UnlinkDebtPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debt          *Debt                  `protobuf:"bytes,1,opt,name=debt,proto3" json:"debt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkDebtPaymentResponse) Reset() {
+	*x = UnlinkDebtPaymentResponse{}
+	mi := &file_funds_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkDebtPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkDebtPaymentResponse) ProtoMessage() {}
+
+func (x *UnlinkDebtPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkDebtPaymentResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkDebtPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UnlinkDebtPaymentResponse) GetDebt() *Debt {
+	if x != nil {
+		return x.Debt
+	}
+	return nil
+}
+
+type GetDebtSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDebtSummaryRequest) Reset() {
+	*x = GetDebtSummaryRequest{}
+	mi := &file_funds_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebtSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebtSummaryRequest) ProtoMessage() {}
+
+func (x *GetDebtSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebtSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetDebtSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetDebtSummaryRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type GetDebtSummaryResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalOwed       float64                `protobuf:"fixed64,1,opt,name=total_owed,json=totalOwed,proto3" json:"total_owed,omitempty"`                   // Remaining balance of loans, credit cards and borrowed money
+	TotalLent       float64                `protobuf:"fixed64,2,opt,name=total_lent,json=totalLent,proto3" json:"total_lent,omitempty"`                   // Remaining balance of money lent to others
+	MonthlyPayments float64                `protobuf:"fixed64,3,opt,name=monthly_payments,json=monthlyPayments,proto3" json:"monthly_payments,omitempty"` // Monthly payments of active liabilities
+	ActiveCount     int32                  `protobuf:"varint,4,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
+	DebtFreeDate    string                 `protobuf:"bytes,5,opt,name=debt_free_date,json=debtFreeDate,proto3" json:"debt_free_date,omitempty"` // Latest projected payoff date of active liabilities
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetDebtSummaryResponse) Reset() {
+	*x = GetDebtSummaryResponse{}
+	mi := &file_funds_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebtSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebtSummaryResponse) ProtoMessage() {}
+
+func (x *GetDebtSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebtSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetDebtSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetDebtSummaryResponse) GetTotalOwed() float64 {
+	if x != nil {
+		return x.TotalOwed
+	}
+	return 0
+}
+
+func (x *GetDebtSummaryResponse) GetTotalLent() float64 {
+	if x != nil {
+		return x.TotalLent
+	}
+	return 0
+}
+
+func (x *GetDebtSummaryResponse) GetMonthlyPayments() float64 {
+	if x != nil {
+		return x.MonthlyPayments
+	}
+	return 0
+}
+
+func (x *GetDebtSummaryResponse) GetActiveCount() int32 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
+}
+
+func (x *GetDebtSummaryResponse) GetDebtFreeDate() string {
+	if x != nil {
+		return x.DebtFreeDate
+	}
+	return ""
+}
+
+// Ledger messages
+type Ledger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerUid      string                 `protobuf:"bytes,3,opt,name=owner_uid,json=ownerUid,proto3" json:"owner_uid,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // Role of the requesting user: owner, editor or viewer
+	MembersCount  int32                  `protobuf:"varint,5,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	Members       []*LedgerMember        `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"` // Populated by GetLedgerById
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ledger) Reset() {
+	*x = Ledger{}
+	mi := &file_funds_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ledger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ledger) ProtoMessage() {}
+
+func (x *Ledger) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ledger.ProtoReflect.Descriptor instead.
+func (*Ledger) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Ledger) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Ledger) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ledger) GetOwnerUid() string {
+	if x != nil {
+		return x.OwnerUid
+	}
+	return ""
+}
+
+func (x *Ledger) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Ledger) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *Ledger) GetMembers() []*LedgerMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Ledger) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Ledger) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type LedgerMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId      int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedAt      int64                  `protobuf:"varint,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerMember) Reset() {
+	*x = LedgerMember{}
+	mi := &file_funds_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerMember) ProtoMessage() {}
+
+func (x *LedgerMember) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerMember.ProtoReflect.Descriptor instead.
+func (*LedgerMember) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *LedgerMember) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *LedgerMember) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *LedgerMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LedgerMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *LedgerMember) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+type CreateLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"` // Username of the creator, who becomes the owner
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLedgerRequest) Reset() {
+	*x = CreateLedgerRequest{}
+	mi := &file_funds_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLedgerRequest) ProtoMessage() {}
+
+func (x *CreateLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLedgerRequest.ProtoReflect.Descriptor instead.
+func (*CreateLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateLedgerRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *CreateLedgerRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateLedgerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ledger        *Ledger                `protobuf:"bytes,1,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLedgerResponse) Reset() {
+	*x = CreateLedgerResponse{}
+	mi := &file_funds_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLedgerResponse) ProtoMessage() {}
+
+func (x *CreateLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLedgerResponse.ProtoReflect.Descriptor instead.
+func (*CreateLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateLedgerResponse) GetLedger() *Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+type GetLedgerByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerByIdRequest) Reset() {
+	*x = GetLedgerByIdRequest{}
+	mi := &file_funds_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerByIdRequest) ProtoMessage() {}
+
+func (x *GetLedgerByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerByIdRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetLedgerByIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetLedgerByIdRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type GetLedgerByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ledger        *Ledger                `protobuf:"bytes,1,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerByIdResponse) Reset() {
+	*x = GetLedgerByIdResponse{}
+	mi := &file_funds_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerByIdResponse) ProtoMessage() {}
+
+func (x *GetLedgerByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerByIdResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetLedgerByIdResponse) GetLedger() *Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+type GetUserLedgersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLedgersRequest) Reset() {
+	*x = GetUserLedgersRequest{}
+	mi := &file_funds_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLedgersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLedgersRequest) ProtoMessage() {}
+
+func (x *GetUserLedgersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLedgersRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLedgersRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetUserLedgersRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type GetUserLedgersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ledgers       []*Ledger              `protobuf:"bytes,1,rep,name=ledgers,proto3" json:"ledgers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLedgersResponse) Reset() {
+	*x = GetUserLedgersResponse{}
+	mi := &file_funds_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLedgersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLedgersResponse) ProtoMessage() {}
+
+func (x *GetUserLedgersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLedgersResponse.ProtoReflect.Descriptor instead.
+func (*GetUserLedgersResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetUserLedgersResponse) GetLedgers() []*Ledger {
+	if x != nil {
+		return x.Ledgers
+	}
+	return nil
+}
+
+type DeleteLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLedgerRequest) Reset() {
+	*x = DeleteLedgerRequest{}
+	mi := &file_funds_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLedgerRequest) ProtoMessage() {}
+
+func (x *DeleteLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLedgerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeleteLedgerRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteLedgerRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type DeleteLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLedgerResponse) Reset() {
+	*x = DeleteLedgerResponse{}
+	mi := &file_funds_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLedgerResponse) ProtoMessage() {}
+
+func (x *DeleteLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLedgerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DeleteLedgerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// AddLedgerMemberRequest adds an existing user, the caller resolves the username with user-service
+type AddLedgerMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId      int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	MemberUid     string                 `protobuf:"bytes,3,opt,name=member_uid,json=memberUid,proto3" json:"member_uid,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"` // editor or viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLedgerMemberRequest) Reset() {
+	*x = AddLedgerMemberRequest{}
+	mi := &file_funds_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLedgerMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLedgerMemberRequest) ProtoMessage() {}
+
+func (x *AddLedgerMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLedgerMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddLedgerMemberRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AddLedgerMemberRequest) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *AddLedgerMemberRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *AddLedgerMemberRequest) GetMemberUid() string {
+	if x != nil {
+		return x.MemberUid
+	}
+	return ""
+}
+
+func (x *AddLedgerMemberRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddLedgerMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddLedgerMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *LedgerMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLedgerMemberResponse) Reset() {
+	*x = AddLedgerMemberResponse{}
+	mi := &file_funds_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLedgerMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLedgerMemberResponse) ProtoMessage() {}
+
+func (x *AddLedgerMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLedgerMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddLedgerMemberResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AddLedgerMemberResponse) GetMember() *LedgerMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateLedgerMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId      int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	MemberUid     string                 `protobuf:"bytes,3,opt,name=member_uid,json=memberUid,proto3" json:"member_uid,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // editor or viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLedgerMemberRoleRequest) Reset() {
+	*x = UpdateLedgerMemberRoleRequest{}
+	mi := &file_funds_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLedgerMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLedgerMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateLedgerMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLedgerMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLedgerMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateLedgerMemberRoleRequest) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *UpdateLedgerMemberRoleRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *UpdateLedgerMemberRoleRequest) GetMemberUid() string {
+	if x != nil {
+		return x.MemberUid
+	}
+	return ""
+}
+
+func (x *UpdateLedgerMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateLedgerMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *LedgerMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLedgerMemberRoleResponse) Reset() {
+	*x = UpdateLedgerMemberRoleResponse{}
+	mi := &file_funds_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLedgerMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLedgerMemberRoleResponse) ProtoMessage() {}
+
+func (x *UpdateLedgerMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLedgerMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLedgerMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateLedgerMemberRoleResponse) GetMember() *LedgerMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// RemoveLedgerMemberRequest removes a member by the owner, members may also remove themselves
+type RemoveLedgerMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId      int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	MemberUid     string                 `protobuf:"bytes,3,opt,name=member_uid,json=memberUid,proto3" json:"member_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLedgerMemberRequest) Reset() {
+	*x = RemoveLedgerMemberRequest{}
+	mi := &file_funds_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLedgerMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLedgerMemberRequest) ProtoMessage() {}
+
+func (x *RemoveLedgerMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLedgerMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLedgerMemberRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RemoveLedgerMemberRequest) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *RemoveLedgerMemberRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *RemoveLedgerMemberRequest) GetMemberUid() string {
+	if x != nil {
+		return x.MemberUid
+	}
+	return ""
+}
+
+type RemoveLedgerMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLedgerMemberResponse) Reset() {
+	*x = RemoveLedgerMemberResponse{}
+	mi := &file_funds_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLedgerMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLedgerMemberResponse) ProtoMessage() {}
+
+func (x *RemoveLedgerMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLedgerMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveLedgerMemberResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RemoveLedgerMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetLedgerTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId      int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	ExactTotal    bool                   `protobuf:"varint,5,opt,name=exact_total,json=exactTotal,proto3" json:"exact_total,omitempty"` // Count all transactions, by default counting stops at a cap
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerTransactionsRequest) Reset() {
+	*x = GetLedgerTransactionsRequest{}
+	mi := &file_funds_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerTransactionsRequest) ProtoMessage() {}
+
+func (x *GetLedgerTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetLedgerTransactionsRequest) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *GetLedgerTransactionsRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *GetLedgerTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetLedgerTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetLedgerTransactionsRequest) GetExactTotal() bool {
+	if x != nil {
+		return x.ExactTotal
+	}
+	return false
+}
+
+type GetLedgerTransactionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Transactions   []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Total          int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	TotalEstimated bool                   `protobuf:"varint,3,opt,name=total_estimated,json=totalEstimated,proto3" json:"total_estimated,omitempty"` // total is a lower bound
+	HasMore        bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                      // There are transactions after this page
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLedgerTransactionsResponse) Reset() {
+	*x = GetLedgerTransactionsResponse{}
+	mi := &file_funds_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerTransactionsResponse) ProtoMessage() {}
+
+func (x *GetLedgerTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetLedgerTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetLedgerTransactionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetLedgerTransactionsResponse) GetTotalEstimated() bool {
+	if x != nil {
+		return x.TotalEstimated
+	}
+	return false
+}
+
+func (x *GetLedgerTransactionsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type GetLedgerBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId      int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerBalanceRequest) Reset() {
+	*x = GetLedgerBalanceRequest{}
+	mi := &file_funds_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerBalanceRequest) ProtoMessage() {}
+
+func (x *GetLedgerBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetLedgerBalanceRequest) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *GetLedgerBalanceRequest) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+type LedgerMemberBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // Empty for former members
+	Income        float64                `protobuf:"fixed64,4,opt,name=income,proto3" json:"income,omitempty"`
+	Expense       float64                `protobuf:"fixed64,5,opt,name=expense,proto3" json:"expense,omitempty"`
+	Balance       float64                `protobuf:"fixed64,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerMemberBalance) Reset() {
+	*x = LedgerMemberBalance{}
+	mi := &file_funds_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerMemberBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerMemberBalance) ProtoMessage() {}
+
+func (x *LedgerMemberBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerMemberBalance.ProtoReflect.Descriptor instead.
+func (*LedgerMemberBalance) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *LedgerMemberBalance) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *LedgerMemberBalance) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LedgerMemberBalance) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *LedgerMemberBalance) GetIncome() float64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *LedgerMemberBalance) GetExpense() float64 {
+	if x != nil {
+		return x.Expense
+	}
+	return 0
+}
+
+func (x *LedgerMemberBalance) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type GetLedgerBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId      int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	TotalIncome   float64                `protobuf:"fixed64,2,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
+	TotalExpense  float64                `protobuf:"fixed64,3,opt,name=total_expense,json=totalExpense,proto3" json:"total_expense,omitempty"`
+	Balance       float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	Members       []*LedgerMemberBalance `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserTransactionsRequest) Reset() {
-	*x = GetUserTransactionsRequest{}
-	mi := &file_funds_service_proto_msgTypes[12]
+func (x *GetLedgerBalanceResponse) Reset() {
+	*x = GetLedgerBalanceResponse{}
+	mi := &file_funds_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserTransactionsRequest) String() string {
+func (x *GetLedgerBalanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTransactionsRequest) ProtoMessage() {}
+func (*GetLedgerBalanceResponse) ProtoMessage() {}
 
-func (x *GetUserTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[12]
+func (x *GetLedgerBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,55 +6807,71 @@ func (x *GetUserTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetLedgerBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetUserTransactionsRequest) GetUserUid() string {
+func (x *GetLedgerBalanceResponse) GetLedgerId() int64 {
 	if x != nil {
-		return x.UserUid
+		return x.LedgerId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetUserTransactionsRequest) GetLimit() int32 {
+func (x *GetLedgerBalanceResponse) GetTotalIncome() float64 {
 	if x != nil {
-		return x.Limit
+		return x.TotalIncome
 	}
 	return 0
 }
 
-func (x *GetUserTransactionsRequest) GetOffset() int32 {
+func (x *GetLedgerBalanceResponse) GetTotalExpense() float64 {
 	if x != nil {
-		return x.Offset
+		return x.TotalExpense
 	}
 	return 0
 }
 
-type GetUserTransactionsResponse struct {
+func (x *GetLedgerBalanceResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetLedgerBalanceResponse) GetMembers() []*LedgerMemberBalance {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetLedgerSettlementRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	LedgerId      int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // Optional, YYYY-MM-DD format
+	DateTo        string                 `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // Optional, YYYY-MM-DD format, inclusive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserTransactionsResponse) Reset() {
-	*x = GetUserTransactionsResponse{}
-	mi := &file_funds_service_proto_msgTypes[13]
+func (x *GetLedgerSettlementRequest) Reset() {
+	*x = GetLedgerSettlementRequest{}
+	mi := &file_funds_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserTransactionsResponse) String() string {
+func (x *GetLedgerSettlementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTransactionsResponse) ProtoMessage() {}
+func (*GetLedgerSettlementRequest) ProtoMessage() {}
 
-func (x *GetUserTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[13]
+func (x *GetLedgerSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,50 +6882,65 @@ func (x *GetUserTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetLedgerSettlementRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetUserTransactionsResponse) GetTransactions() []*Transaction {
+func (x *GetLedgerSettlementRequest) GetLedgerId() int64 {
 	if x != nil {
-		return x.Transactions
+		return x.LedgerId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetUserTransactionsResponse) GetTotal() int64 {
+func (x *GetLedgerSettlementRequest) GetUserUid() string {
 	if x != nil {
-		return x.Total
+		return x.UserUid
 	}
-	return 0
+	return ""
 }
 
-type GetUserTransactionsByPeriodRequest struct {
+func (x *GetLedgerSettlementRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetLedgerSettlementRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+type SettlementShare struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
-	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // Number of days from today
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Paid          float64                `protobuf:"fixed64,3,opt,name=paid,proto3" json:"paid,omitempty"`
+	Share         float64                `protobuf:"fixed64,4,opt,name=share,proto3" json:"share,omitempty"`
+	Net           float64                `protobuf:"fixed64,5,opt,name=net,proto3" json:"net,omitempty"` // Positive when the others owe the member
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserTransactionsByPeriodRequest) Reset() {
-	*x = GetUserTransactionsByPeriodRequest{}
-	mi := &file_funds_service_proto_msgTypes[14]
+func (x *SettlementShare) Reset() {
+	*x = SettlementShare{}
+	mi := &file_funds_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserTransactionsByPeriodRequest) String() string {
+func (x *SettlementShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTransactionsByPeriodRequest) ProtoMessage() {}
+func (*SettlementShare) ProtoMessage() {}
 
-func (x *GetUserTransactionsByPeriodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[14]
+func (x *SettlementShare) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,62 +6951,72 @@ func (x *GetUserTransactionsByPeriodRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTransactionsByPeriodRequest.ProtoReflect.Descriptor instead.
-func (*GetUserTransactionsByPeriodRequest) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SettlementShare.ProtoReflect.Descriptor instead.
+func (*SettlementShare) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetUserTransactionsByPeriodRequest) GetUserUid() string {
+func (x *SettlementShare) GetUserUid() string {
 	if x != nil {
 		return x.UserUid
 	}
 	return ""
 }
 
-func (x *GetUserTransactionsByPeriodRequest) GetDays() int32 {
+func (x *SettlementShare) GetUsername() string {
 	if x != nil {
-		return x.Days
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SettlementShare) GetPaid() float64 {
+	if x != nil {
+		return x.Paid
 	}
 	return 0
 }
 
-func (x *GetUserTransactionsByPeriodRequest) GetLimit() int32 {
+func (x *SettlementShare) GetShare() float64 {
 	if x != nil {
-		return x.Limit
+		return x.Share
 	}
 	return 0
 }
 
-func (x *GetUserTransactionsByPeriodRequest) GetOffset() int32 {
+func (x *SettlementShare) GetNet() float64 {
 	if x != nil {
-		return x.Offset
+		return x.Net
 	}
 	return 0
 }
 
-type GetUserTransactionsByPeriodResponse struct {
+type SettlementTransfer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	FromUid       string                 `protobuf:"bytes,1,opt,name=from_uid,json=fromUid,proto3" json:"from_uid,omitempty"`
+	FromUsername  string                 `protobuf:"bytes,2,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`
+	ToUid         string                 `protobuf:"bytes,3,opt,name=to_uid,json=toUid,proto3" json:"to_uid,omitempty"`
+	ToUsername    string                 `protobuf:"bytes,4,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserTransactionsByPeriodResponse) Reset() {
-	*x = GetUserTransactionsByPeriodResponse{}
-	mi := &file_funds_service_proto_msgTypes[15]
+func (x *SettlementTransfer) Reset() {
+	*x = SettlementTransfer{}
+	mi := &file_funds_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserTransactionsByPeriodResponse) String() string {
+func (x *SettlementTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTransactionsByPeriodResponse) ProtoMessage() {}
+func (*SettlementTransfer) ProtoMessage() {}
 
-func (x *GetUserTransactionsByPeriodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[15]
+func (x *SettlementTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,54 +7027,75 @@ func (x *GetUserTransactionsByPeriodResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTransactionsByPeriodResponse.ProtoReflect.Descriptor instead.
-func (*GetUserTransactionsByPeriodResponse) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SettlementTransfer.ProtoReflect.Descriptor instead.
+func (*SettlementTransfer) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetUserTransactionsByPeriodResponse) GetTransactions() []*Transaction {
+func (x *SettlementTransfer) GetFromUid() string {
 	if x != nil {
-		return x.Transactions
+		return x.FromUid
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserTransactionsByPeriodResponse) GetTotal() int64 {
+func (x *SettlementTransfer) GetFromUsername() string {
 	if x != nil {
-		return x.Total
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *SettlementTransfer) GetToUid() string {
+	if x != nil {
+		return x.ToUid
+	}
+	return ""
+}
+
+func (x *SettlementTransfer) GetToUsername() string {
+	if x != nil {
+		return x.ToUsername
+	}
+	return ""
+}
+
+func (x *SettlementTransfer) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-type UpdateTransactionRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CategoryId      int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Amount          float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Title           string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	TransactionDate string                 `protobuf:"bytes,7,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
-	UserUid         string                 `protobuf:"bytes,8,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// GetLedgerSettlementResponse splits the expenses equally and lists who owes whom
+type GetLedgerSettlementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId      int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	TotalExpense  float64                `protobuf:"fixed64,4,opt,name=total_expense,json=totalExpense,proto3" json:"total_expense,omitempty"`
+	SharePerUser  float64                `protobuf:"fixed64,5,opt,name=share_per_user,json=sharePerUser,proto3" json:"share_per_user,omitempty"`
+	Shares        []*SettlementShare     `protobuf:"bytes,6,rep,name=shares,proto3" json:"shares,omitempty"`
+	Transfers     []*SettlementTransfer  `protobuf:"bytes,7,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTransactionRequest) Reset() {
-	*x = UpdateTransactionRequest{}
-	mi := &file_funds_service_proto_msgTypes[16]
+func (x *GetLedgerSettlementResponse) Reset() {
+	*x = GetLedgerSettlementResponse{}
+	mi := &file_funds_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTransactionRequest) String() string {
+func (x *GetLedgerSettlementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTransactionRequest) ProtoMessage() {}
+func (*GetLedgerSettlementResponse) ProtoMessage() {}
 
-func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[16]
+func (x *GetLedgerSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,89 +7106,200 @@ func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetLedgerSettlementResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *UpdateTransactionRequest) GetId() int64 {
+func (x *GetLedgerSettlementResponse) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *GetLedgerSettlementResponse) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetLedgerSettlementResponse) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetLedgerSettlementResponse) GetTotalExpense() float64 {
+	if x != nil {
+		return x.TotalExpense
+	}
+	return 0
+}
+
+func (x *GetLedgerSettlementResponse) GetSharePerUser() float64 {
+	if x != nil {
+		return x.SharePerUser
+	}
+	return 0
+}
+
+func (x *GetLedgerSettlementResponse) GetShares() []*SettlementShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *GetLedgerSettlementResponse) GetTransfers() []*SettlementTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+// Budget messages
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryCode  string                 `protobuf:"bytes,4,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,5,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // Name in the first requested locale that has a translation
+	Month         string                 `protobuf:"bytes,6,opt,name=month,proto3" json:"month,omitempty"`                                   // YYYY-MM format
+	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`                               // Spending limit for the month
+	Source        string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`                                 // manual or plan
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_funds_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *Budget) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateTransactionRequest) GetCategoryId() int32 {
+func (x *Budget) GetUserUid() string {
+	if x != nil {
+		return x.UserUid
+	}
+	return ""
+}
+
+func (x *Budget) GetCategoryId() int32 {
 	if x != nil {
 		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *UpdateTransactionRequest) GetType() string {
+func (x *Budget) GetCategoryCode() string {
 	if x != nil {
-		return x.Type
+		return x.CategoryCode
 	}
 	return ""
 }
 
-func (x *UpdateTransactionRequest) GetAmount() float64 {
+func (x *Budget) GetCategoryName() string {
 	if x != nil {
-		return x.Amount
+		return x.CategoryName
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateTransactionRequest) GetTitle() string {
+func (x *Budget) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *Budget) GetAmount() float64 {
 	if x != nil {
-		return x.Title
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTransactionRequest) GetDescription() string {
+func (x *Budget) GetSource() string {
 	if x != nil {
-		return x.Description
+		return x.Source
 	}
 	return ""
 }
 
-func (x *UpdateTransactionRequest) GetTransactionDate() string {
+func (x *Budget) GetCreatedAt() int64 {
 	if x != nil {
-		return x.TransactionDate
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTransactionRequest) GetUserUid() string {
+func (x *Budget) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.UserUid
+		return x.UpdatedAt
 	}
-	return ""
+	return 0
 }
 
-type UpdateTransactionResponse struct {
+type BudgetItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Active expense category
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTransactionResponse) Reset() {
-	*x = UpdateTransactionResponse{}
-	mi := &file_funds_service_proto_msgTypes[17]
+func (x *BudgetItem) Reset() {
+	*x = BudgetItem{}
+	mi := &file_funds_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTransactionResponse) String() string {
+func (x *BudgetItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTransactionResponse) ProtoMessage() {}
+func (*BudgetItem) ProtoMessage() {}
 
-func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[17]
+func (x *BudgetItem) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,41 +7310,53 @@ func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTransactionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use BudgetItem.ProtoReflect.Descriptor instead.
+func (*BudgetItem) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *UpdateTransactionResponse) GetTransaction() *Transaction {
+func (x *BudgetItem) GetCategoryId() int32 {
 	if x != nil {
-		return x.Transaction
+		return x.CategoryId
 	}
-	return nil
+	return 0
 }
 
-type DeleteTransactionRequest struct {
+func (x *BudgetItem) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// SetBudgetsRequest creates or updates the budgets of the month in one transaction
+type SetBudgetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM format
+	Items         []*BudgetItem          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Replace       bool                   `protobuf:"varint,4,opt,name=replace,proto3" json:"replace,omitempty"`                                 // Delete the budgets of the month for categories that are not in items
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`                                    // manual or plan, manual by default
+	ReplaceSource string                 `protobuf:"bytes,6,opt,name=replace_source,json=replaceSource,proto3" json:"replace_source,omitempty"` // Delete and overwrite only the budgets with this source, empty - all
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTransactionRequest) Reset() {
-	*x = DeleteTransactionRequest{}
-	mi := &file_funds_service_proto_msgTypes[18]
+func (x *SetBudgetsRequest) Reset() {
+	*x = SetBudgetsRequest{}
+	mi := &file_funds_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTransactionRequest) String() string {
+func (x *SetBudgetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTransactionRequest) ProtoMessage() {}
+func (*SetBudgetsRequest) ProtoMessage() {}
 
-func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[18]
+func (x *SetBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,97 +7367,76 @@ func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*SetBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *DeleteTransactionRequest) GetId() int64 {
+func (x *SetBudgetsRequest) GetUserUid() string {
 	if x != nil {
-		return x.Id
+		return x.UserUid
 	}
-	return 0
+	return ""
 }
 
-func (x *DeleteTransactionRequest) GetUserUid() string {
+func (x *SetBudgetsRequest) GetMonth() string {
 	if x != nil {
-		return x.UserUid
+		return x.Month
 	}
 	return ""
 }
 
-type DeleteTransactionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteTransactionResponse) Reset() {
-	*x = DeleteTransactionResponse{}
-	mi := &file_funds_service_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteTransactionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SetBudgetsRequest) GetItems() []*BudgetItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
 }
 
-func (*DeleteTransactionResponse) ProtoMessage() {}
-
-func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[19]
+func (x *SetBudgetsRequest) GetReplace() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Replace
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use DeleteTransactionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{19}
+func (x *SetBudgetsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
 }
 
-func (x *DeleteTransactionResponse) GetSuccess() bool {
+func (x *SetBudgetsRequest) GetReplaceSource() string {
 	if x != nil {
-		return x.Success
+		return x.ReplaceSource
 	}
-	return false
+	return ""
 }
 
-// Balance messages
-type UserBalance struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	UserUid           string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
-	TotalBalance      float64                `protobuf:"fixed64,2,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
-	TotalIncome       float64                `protobuf:"fixed64,3,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
-	TotalExpense      float64                `protobuf:"fixed64,4,opt,name=total_expense,json=totalExpense,proto3" json:"total_expense,omitempty"`
-	LastTransactionAt int64                  `protobuf:"varint,5,opt,name=last_transaction_at,json=lastTransactionAt,proto3" json:"last_transaction_at,omitempty"`
-	UpdatedAt         int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type SetBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"` // All budgets of the month
+	Kept          []*Budget              `protobuf:"bytes,2,rep,name=kept,proto3" json:"kept,omitempty"`       // Budgets of items left unchanged, because their source differs from replace_source
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserBalance) Reset() {
-	*x = UserBalance{}
-	mi := &file_funds_service_proto_msgTypes[20]
+func (x *SetBudgetsResponse) Reset() {
+	*x = SetBudgetsResponse{}
+	mi := &file_funds_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserBalance) String() string {
+func (x *SetBudgetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserBalance) ProtoMessage() {}
+func (*SetBudgetsResponse) ProtoMessage() {}
 
-func (x *UserBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[20]
+func (x *SetBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,75 +7447,49 @@ func (x *UserBalance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserBalance.ProtoReflect.Descriptor instead.
-func (*UserBalance) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *UserBalance) GetUserUid() string {
-	if x != nil {
-		return x.UserUid
-	}
-	return ""
-}
-
-func (x *UserBalance) GetTotalBalance() float64 {
-	if x != nil {
-		return x.TotalBalance
-	}
-	return 0
-}
-
-func (x *UserBalance) GetTotalIncome() float64 {
-	if x != nil {
-		return x.TotalIncome
-	}
-	return 0
-}
-
-func (x *UserBalance) GetTotalExpense() float64 {
-	if x != nil {
-		return x.TotalExpense
-	}
-	return 0
+// Deprecated: Use SetBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*SetBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *UserBalance) GetLastTransactionAt() int64 {
+func (x *SetBudgetsResponse) GetBudgets() []*Budget {
 	if x != nil {
-		return x.LastTransactionAt
+		return x.Budgets
 	}
-	return 0
+	return nil
 }
 
-func (x *UserBalance) GetUpdatedAt() int64 {
+func (x *SetBudgetsResponse) GetKept() []*Budget {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Kept
 	}
-	return 0
+	return nil
 }
 
-type GetUserBalanceRequest struct {
+type GetUserBudgetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`     // YYYY-MM format
+	Locales       []string               `protobuf:"bytes,3,rep,name=locales,proto3" json:"locales,omitempty"` // Preferred locales of category names
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserBalanceRequest) Reset() {
-	*x = GetUserBalanceRequest{}
-	mi := &file_funds_service_proto_msgTypes[21]
+func (x *GetUserBudgetsRequest) Reset() {
+	*x = GetUserBudgetsRequest{}
+	mi := &file_funds_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserBalanceRequest) String() string {
+func (x *GetUserBudgetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserBalanceRequest) ProtoMessage() {}
+func (*GetUserBudgetsRequest) ProtoMessage() {}
 
-func (x *GetUserBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[21]
+func (x *GetUserBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,40 +7500,54 @@ func (x *GetUserBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserBalanceRequest.ProtoReflect.Descriptor instead.
-func (*GetUserBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetUserBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetUserBalanceRequest) GetUserUid() string {
+func (x *GetUserBudgetsRequest) GetUserUid() string {
 	if x != nil {
 		return x.UserUid
 	}
 	return ""
 }
 
-type GetUserBalanceResponse struct {
+func (x *GetUserBudgetsRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *GetUserBudgetsRequest) GetLocales() []string {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+type GetUserBudgetsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Balance       *UserBalance           `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserBalanceResponse) Reset() {
-	*x = GetUserBalanceResponse{}
-	mi := &file_funds_service_proto_msgTypes[22]
+func (x *GetUserBudgetsResponse) Reset() {
+	*x = GetUserBudgetsResponse{}
+	mi := &file_funds_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserBalanceResponse) String() string {
+func (x *GetUserBudgetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserBalanceResponse) ProtoMessage() {}
+func (*GetUserBudgetsResponse) ProtoMessage() {}
 
-func (x *GetUserBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_funds_service_proto_msgTypes[22]
+func (x *GetUserBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funds_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,14 +7558,14 @@ func (x *GetUserBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserBalanceResponse.ProtoReflect.Descriptor instead.
-func (*GetUserBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_funds_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetUserBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_funds_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetUserBalanceResponse) GetBalance() *UserBalance {
+func (x *GetUserBudgetsResponse) GetBudgets() []*Budget {
 	if x != nil {
-		return x.Balance
+		return x.Budgets
 	}
 	return nil
 }
@@ -1352,29 +7574,73 @@ var File_funds_service_proto protoreflect.FileDescriptor
 
 const file_funds_service_proto_rawDesc = "" +
 	"\n" +
-	"\x13funds_service.proto\x12\rfunds_service\"u\n" +
+	"\x13funds_service.proto\x12\rfunds_service\"\xd9\x02\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
 	"\x04icon\x18\x04 \x01(\tR\x04icon\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"\x19\n" +
-	"\x17GetAllCategoriesRequest\"S\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x12\n" +
+	"\x04code\x18\x06 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\x12\x1f\n" +
+	"\varchived_at\x18\b \x01(\x03R\n" +
+	"archivedAt\x12M\n" +
+	"\ftranslations\x18\t \x03(\v2).funds_service.Category.TranslationsEntryR\ftranslations\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"^\n" +
+	"\x17GetAllCategoriesRequest\x12\x18\n" +
+	"\alocales\x18\x01 \x03(\tR\alocales\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"S\n" +
 	"\x18GetAllCategoriesResponse\x127\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x17.funds_service.CategoryR\n" +
-	"categories\"0\n" +
+	"categories\"J\n" +
 	"\x1aGetCategoriesByTypeRequest\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\"V\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
+	"\alocales\x18\x02 \x03(\tR\alocales\"V\n" +
 	"\x1bGetCategoriesByTypeResponse\x127\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x17.funds_service.CategoryR\n" +
-	"categories\"(\n" +
+	"categories\"B\n" +
 	"\x16GetCategoryByIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"N\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\alocales\x18\x02 \x03(\tR\alocales\"N\n" +
 	"\x17GetCategoryByIdResponse\x123\n" +
-	"\bcategory\x18\x01 \x01(\v2\x17.funds_service.CategoryR\bcategory\"\xdb\x02\n" +
+	"\bcategory\x18\x01 \x01(\v2\x17.funds_service.CategoryR\bcategory\"\x84\x02\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x12Z\n" +
+	"\ftranslations\x18\x05 \x03(\v26.funds_service.CreateCategoryRequest.TranslationsEntryR\ftranslations\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"M\n" +
+	"\x16CreateCategoryResponse\x123\n" +
+	"\bcategory\x18\x01 \x01(\v2\x17.funds_service.CategoryR\bcategory\"\x88\x02\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x03 \x01(\tH\x01R\x04icon\x88\x01\x01\x12Z\n" +
+	"\ftranslations\x18\x04 \x03(\v26.funds_service.UpdateCategoryRequest.TranslationsEntryR\ftranslations\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_icon\"M\n" +
+	"\x16UpdateCategoryResponse\x123\n" +
+	"\bcategory\x18\x01 \x01(\v2\x17.funds_service.CategoryR\bcategory\"(\n" +
+	"\x16ArchiveCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"N\n" +
+	"\x17ArchiveCategoryResponse\x123\n" +
+	"\bcategory\x18\x01 \x01(\v2\x17.funds_service.CategoryR\bcategory\"(\n" +
+	"\x16RestoreCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"N\n" +
+	"\x17RestoreCategoryResponse\x123\n" +
+	"\bcategory\x18\x01 \x01(\v2\x17.funds_service.CategoryR\bcategory\"\xc5\x03\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1f\n" +
@@ -1390,7 +7656,12 @@ const file_funds_service_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\n" +
 	" \x01(\x03R\tupdatedAt\x123\n" +
-	"\bcategory\x18\v \x01(\v2\x17.funds_service.CategoryR\bcategory\"\xe5\x01\n" +
+	"\bcategory\x18\v \x01(\v2\x17.funds_service.CategoryR\bcategory\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\r \x01(\x03R\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x03R\aversion\x12\x1b\n" +
+	"\tledger_id\x18\x0f \x01(\x03R\bledgerId\"\x96\x02\n" +
 	"\x18CreateTransactionRequest\x12\x19\n" +
 	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x05R\n" +
@@ -1399,28 +7670,54 @@ const file_funds_service_proto_rawDesc = "" +
 	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x14\n" +
 	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x12)\n" +
-	"\x10transaction_date\x18\a \x01(\tR\x0ftransactionDate\"Y\n" +
+	"\x10transaction_date\x18\a \x01(\tR\x0ftransactionDate\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1b\n" +
+	"\tledger_id\x18\t \x01(\x03R\bledgerId\"Y\n" +
 	"\x19CreateTransactionResponse\x12<\n" +
 	"\vtransaction\x18\x01 \x01(\v2\x1a.funds_service.TransactionR\vtransaction\"+\n" +
 	"\x19GetTransactionByIdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"Z\n" +
 	"\x1aGetTransactionByIdResponse\x12<\n" +
-	"\vtransaction\x18\x01 \x01(\v2\x1a.funds_service.TransactionR\vtransaction\"e\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1a.funds_service.TransactionR\vtransaction\"\x86\x01\n" +
 	"\x1aGetUserTransactionsRequest\x12\x19\n" +
 	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"s\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x1f\n" +
+	"\vexact_total\x18\x04 \x01(\bR\n" +
+	"exactTotal\"\xb7\x01\n" +
 	"\x1bGetUserTransactionsResponse\x12>\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x1a.funds_service.TransactionR\ftransactions\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\x81\x01\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12'\n" +
+	"\x0ftotal_estimated\x18\x03 \x01(\bR\x0etotalEstimated\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"~\n" +
+	"\x06Period\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1b\n" +
+	"\tdate_from\x18\x03 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x04 \x01(\tR\x06dateTo\x12\x12\n" +
+	"\x04days\x18\x05 \x01(\x05R\x04days\"d\n" +
+	"\bCalendar\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x02 \x01(\x05R\tweekStart\x12\x1d\n" +
+	"\n" +
+	"salary_day\x18\x03 \x01(\x05R\tsalaryDay\"\x86\x02\n" +
 	"\"GetUserTransactionsByPeriodRequest\x12\x19\n" +
 	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x12\n" +
 	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"{\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12-\n" +
+	"\x06period\x18\x05 \x01(\v2\x15.funds_service.PeriodR\x06period\x123\n" +
+	"\bcalendar\x18\x06 \x01(\v2\x17.funds_service.CalendarR\bcalendar\x12\x1f\n" +
+	"\vexact_total\x18\a \x01(\bR\n" +
+	"exactTotal\"\xf5\x01\n" +
 	"#GetUserTransactionsByPeriodResponse\x12>\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x1a.funds_service.TransactionR\ftransactions\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\xf5\x01\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x1b\n" +
+	"\tdate_from\x18\x03 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x04 \x01(\tR\x06dateTo\x12'\n" +
+	"\x0ftotal_estimated\x18\x05 \x01(\bR\x0etotalEstimated\x12\x19\n" +
+	"\bhas_more\x18\x06 \x01(\bR\ahasMore\"\xb4\x02\n" +
 	"\x18UpdateTransactionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x05R\n" +
@@ -1430,14 +7727,88 @@ const file_funds_service_proto_rawDesc = "" +
 	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x12)\n" +
 	"\x10transaction_date\x18\a \x01(\tR\x0ftransactionDate\x12\x19\n" +
-	"\buser_uid\x18\b \x01(\tR\auserUid\"Y\n" +
+	"\buser_uid\x18\b \x01(\tR\auserUid\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12)\n" +
+	"\x10expected_version\x18\n" +
+	" \x01(\x03R\x0fexpectedVersion\"Y\n" +
 	"\x19UpdateTransactionResponse\x12<\n" +
-	"\vtransaction\x18\x01 \x01(\v2\x1a.funds_service.TransactionR\vtransaction\"E\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1a.funds_service.TransactionR\vtransaction\"p\n" +
 	"\x18DeleteTransactionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
-	"\buser_uid\x18\x02 \x01(\tR\auserUid\"5\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"5\n" +
 	"\x19DeleteTransactionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe4\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\x19RestoreTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\"Z\n" +
+	"\x1aRestoreTransactionResponse\x12<\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1a.funds_service.TransactionR\vtransaction\"\x89\x01\n" +
+	"\x1dGetDeletedTransactionsRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x1f\n" +
+	"\vexact_total\x18\x04 \x01(\bR\n" +
+	"exactTotal\"\xba\x01\n" +
+	"\x1eGetDeletedTransactionsResponse\x12>\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1a.funds_service.TransactionR\ftransactions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12'\n" +
+	"\x0ftotal_estimated\x18\x03 \x01(\bR\x0etotalEstimated\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"\x88\x02\n" +
+	"\x13TransactionRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x03 \x01(\tR\tchangedBy\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x122\n" +
+	"\x06before\x18\x05 \x01(\v2\x1a.funds_service.TransactionR\x06before\x120\n" +
+	"\x05after\x18\x06 \x01(\v2\x1a.funds_service.TransactionR\x05after\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\a \x01(\x03R\tchangedAt\"I\n" +
+	"\x1cGetTransactionHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\"a\n" +
+	"\x1dGetTransactionHistoryResponse\x12@\n" +
+	"\trevisions\x18\x01 \x03(\v2\".funds_service.TransactionRevisionR\trevisions\"T\n" +
+	"\x0eBatchItemError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa0\x01\n" +
+	"\x1eBatchCreateTransactionsRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12=\n" +
+	"\x05items\x18\x02 \x03(\v2'.funds_service.CreateTransactionRequestR\x05items\x12$\n" +
+	"\x0eall_or_nothing\x18\x03 \x01(\bR\fallOrNothing\"\xb6\x01\n" +
+	"\x1fBatchCreateTransactionsResponse\x12>\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1a.funds_service.TransactionR\ftransactions\x125\n" +
+	"\x06errors\x18\x02 \x03(\v2\x1d.funds_service.BatchItemErrorR\x06errors\x12\x1c\n" +
+	"\tcommitted\x18\x03 \x01(\bR\tcommitted\"\xc7\x01\n" +
+	"\x10TransactionPatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\vcategory_id\x18\x02 \x01(\x05H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x01R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"shift_days\x18\x04 \x01(\x05R\tshiftDays\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersionB\x0e\n" +
+	"\f_category_idB\b\n" +
+	"\x06_title\"\x98\x01\n" +
+	"\x1eBatchUpdateTransactionsRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x125\n" +
+	"\x05items\x18\x02 \x03(\v2\x1f.funds_service.TransactionPatchR\x05items\x12$\n" +
+	"\x0eall_or_nothing\x18\x03 \x01(\bR\fallOrNothing\"\xb6\x01\n" +
+	"\x1fBatchUpdateTransactionsResponse\x12>\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1a.funds_service.TransactionR\ftransactions\x125\n" +
+	"\x06errors\x18\x02 \x03(\v2\x1d.funds_service.BatchItemErrorR\x06errors\x12\x1c\n" +
+	"\tcommitted\x18\x03 \x01(\bR\tcommitted\"s\n" +
+	"\x1eBatchDeleteTransactionsRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\x12$\n" +
+	"\x0eall_or_nothing\x18\x03 \x01(\bR\fallOrNothing\"\x97\x01\n" +
+	"\x1fBatchDeleteTransactionsResponse\x12\x1f\n" +
+	"\vdeleted_ids\x18\x01 \x03(\x03R\n" +
+	"deletedIds\x125\n" +
+	"\x06errors\x18\x02 \x03(\v2\x1d.funds_service.BatchItemErrorR\x06errors\x12\x1c\n" +
+	"\tcommitted\x18\x03 \x01(\bR\tcommitted\"\xe4\x01\n" +
 	"\vUserBalance\x12\x19\n" +
 	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12#\n" +
 	"\rtotal_balance\x18\x02 \x01(\x01R\ftotalBalance\x12!\n" +
@@ -1449,18 +7820,420 @@ const file_funds_service_proto_rawDesc = "" +
 	"\x15GetUserBalanceRequest\x12\x19\n" +
 	"\buser_uid\x18\x01 \x01(\tR\auserUid\"N\n" +
 	"\x16GetUserBalanceResponse\x124\n" +
-	"\abalance\x18\x01 \x01(\v2\x1a.funds_service.UserBalanceR\abalance2\xba\b\n" +
+	"\abalance\x18\x01 \x01(\v2\x1a.funds_service.UserBalanceR\abalance\"\xf1\x01\n" +
+	"\x18GetBalanceHistoryRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\x12 \n" +
+	"\vgranularity\x18\x04 \x01(\tR\vgranularity\x12-\n" +
+	"\x06period\x18\x05 \x01(\v2\x15.funds_service.PeriodR\x06period\x123\n" +
+	"\bcalendar\x18\x06 \x01(\v2\x17.funds_service.CalendarR\bcalendar\"\xb1\x01\n" +
+	"\x13BalanceHistoryPoint\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12'\n" +
+	"\x0fopening_balance\x18\x02 \x01(\x01R\x0eopeningBalance\x12'\n" +
+	"\x0fclosing_balance\x18\x03 \x01(\x01R\x0eclosingBalance\x12\x16\n" +
+	"\x06income\x18\x04 \x01(\x01R\x06income\x12\x18\n" +
+	"\aexpense\x18\x05 \x01(\x01R\aexpense\"\xaf\x01\n" +
+	"\x19GetBalanceHistoryResponse\x12:\n" +
+	"\x06points\x18\x01 \x03(\v2\".funds_service.BalanceHistoryPointR\x06points\x12 \n" +
+	"\vgranularity\x18\x02 \x01(\tR\vgranularity\x12\x1b\n" +
+	"\tdate_from\x18\x03 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x04 \x01(\tR\x06dateTo\"\xc7\x02\n" +
+	"\x19GetSpendingSummaryRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\x12\x19\n" +
+	"\bgroup_by\x18\x04 \x03(\tR\agroupBy\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12)\n" +
+	"\x10compare_previous\x18\x06 \x01(\bR\x0fcomparePrevious\x12\x1b\n" +
+	"\tledger_id\x18\a \x01(\x03R\bledgerId\x12-\n" +
+	"\x06period\x18\b \x01(\v2\x15.funds_service.PeriodR\x06period\x123\n" +
+	"\bcalendar\x18\t \x01(\v2\x17.funds_service.CalendarR\bcalendar\"\xe9\x02\n" +
+	"\x15SpendingSummaryBucket\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x01R\x05total\x12\x14\n" +
+	"\x05count\x18\a \x01(\x03R\x05count\x12%\n" +
+	"\x0eprevious_total\x18\b \x01(\x01R\rpreviousTotal\x12\x14\n" +
+	"\x05delta\x18\t \x01(\x01R\x05delta\x12#\n" +
+	"\rdelta_percent\x18\n" +
+	" \x01(\x01R\fdeltaPercent\x12\x19\n" +
+	"\buser_uid\x18\v \x01(\tR\auserUid\x12#\n" +
+	"\rcategory_code\x18\f \x01(\tR\fcategoryCode\"\xc4\x02\n" +
+	"\x1aGetSpendingSummaryResponse\x12>\n" +
+	"\abuckets\x18\x01 \x03(\v2$.funds_service.SpendingSummaryBucketR\abuckets\x12!\n" +
+	"\ftotal_income\x18\x02 \x01(\x01R\vtotalIncome\x12#\n" +
+	"\rtotal_expense\x18\x03 \x01(\x01R\ftotalExpense\x122\n" +
+	"\x15previous_total_income\x18\x04 \x01(\x01R\x13previousTotalIncome\x124\n" +
+	"\x16previous_total_expense\x18\x05 \x01(\x01R\x14previousTotalExpense\x12\x1b\n" +
+	"\tdate_from\x18\x06 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\a \x01(\tR\x06dateTo\"\x92\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x19\n" +
+	"\buser_uid\x18\x03 \x01(\tR\auserUid\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12#\n" +
+	"\rhas_thumbnail\x18\a \x01(\bR\fhasThumbnail\x12\x1a\n" +
+	"\bchecksum\x18\b \x01(\tR\bchecksum\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\"\xac\x01\n" +
+	"\x14AttachmentUploadInfo\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\"w\n" +
+	"\x17UploadAttachmentRequest\x129\n" +
+	"\x04info\x18\x01 \x01(\v2#.funds_service.AttachmentUploadInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"U\n" +
+	"\x18UploadAttachmentResponse\x129\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x19.funds_service.AttachmentR\n" +
+	"attachment\"d\n" +
+	"\x19DownloadAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1c\n" +
+	"\tthumbnail\x18\x03 \x01(\bR\tthumbnail\"l\n" +
+	"\x16AttachmentDownloadInfo\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"|\n" +
+	"\x1aDownloadAttachmentResponse\x12;\n" +
+	"\x04info\x18\x01 \x01(\v2%.funds_service.AttachmentDownloadInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"d\n" +
+	" GetTransactionAttachmentsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\"`\n" +
+	"!GetTransactionAttachmentsResponse\x12;\n" +
+	"\vattachments\x18\x01 \x03(\v2\x19.funds_service.AttachmentR\vattachments\"D\n" +
+	"\x17DeleteAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\"4\n" +
+	"\x18DeleteAttachmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"6\n" +
+	"\x19GetAttachmentUsageRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\"\xca\x01\n" +
+	"\x1aGetAttachmentUsageResponse\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x01 \x01(\x03R\tusedBytes\x12\x1f\n" +
+	"\vquota_bytes\x18\x02 \x01(\x03R\n" +
+	"quotaBytes\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\"\n" +
+	"\rmax_file_size\x18\x04 \x01(\x03R\vmaxFileSize\x122\n" +
+	"\x15allowed_content_types\x18\x05 \x03(\tR\x13allowedContentTypes\"\xea\x04\n" +
+	"\x04Debt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\"\n" +
+	"\fcounterparty\x18\x05 \x01(\tR\fcounterparty\x12\x1c\n" +
+	"\tprincipal\x18\x06 \x01(\x01R\tprincipal\x12#\n" +
+	"\rinterest_rate\x18\a \x01(\x01R\finterestRate\x12\x1f\n" +
+	"\vterm_months\x18\b \x01(\x05R\n" +
+	"termMonths\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\t \x01(\tR\tstartDate\x12'\n" +
+	"\x0fmonthly_payment\x18\n" +
+	" \x01(\x01R\x0emonthlyPayment\x12+\n" +
+	"\x11remaining_balance\x18\v \x01(\x01R\x10remainingBalance\x12%\n" +
+	"\x0epaid_principal\x18\f \x01(\x01R\rpaidPrincipal\x12#\n" +
+	"\rpaid_interest\x18\r \x01(\x01R\fpaidInterest\x12%\n" +
+	"\x0epayments_count\x18\x0e \x01(\x05R\rpaymentsCount\x12\x1f\n" +
+	"\vpayoff_date\x18\x0f \x01(\tR\n" +
+	"payoffDate\x12\x16\n" +
+	"\x06status\x18\x10 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\x03R\tupdatedAt\x12&\n" +
+	"\x0flast_payment_at\x18\x13 \x01(\tR\rlastPaymentAt\"\xa2\x02\n" +
+	"\vDebtPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\adebt_id\x18\x02 \x01(\x03R\x06debtId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eprincipal_part\x18\x05 \x01(\x01R\rprincipalPart\x12#\n" +
+	"\rinterest_part\x18\x06 \x01(\x01R\finterestPart\x12'\n" +
+	"\x0fremaining_after\x18\a \x01(\x01R\x0eremainingAfter\x12\x17\n" +
+	"\apaid_at\x18\b \x01(\tR\x06paidAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\"\xb1\x01\n" +
+	"\x11AmortizationEntry\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x18\n" +
+	"\apayment\x18\x03 \x01(\x01R\apayment\x12\x1c\n" +
+	"\tprincipal\x18\x04 \x01(\x01R\tprincipal\x12\x1a\n" +
+	"\binterest\x18\x05 \x01(\x01R\binterest\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x01R\tremaining\"\xa8\x02\n" +
+	"\x11CreateDebtRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\"\n" +
+	"\fcounterparty\x18\x04 \x01(\tR\fcounterparty\x12\x1c\n" +
+	"\tprincipal\x18\x05 \x01(\x01R\tprincipal\x12#\n" +
+	"\rinterest_rate\x18\x06 \x01(\x01R\finterestRate\x12\x1f\n" +
+	"\vterm_months\x18\a \x01(\x05R\n" +
+	"termMonths\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\b \x01(\tR\tstartDate\x12'\n" +
+	"\x0fmonthly_payment\x18\t \x01(\x01R\x0emonthlyPayment\"=\n" +
+	"\x12CreateDebtResponse\x12'\n" +
+	"\x04debt\x18\x01 \x01(\v2\x13.funds_service.DebtR\x04debt\"?\n" +
+	"\x12GetDebtByIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\"\xb4\x01\n" +
+	"\x13GetDebtByIdResponse\x12'\n" +
+	"\x04debt\x18\x01 \x01(\v2\x13.funds_service.DebtR\x04debt\x126\n" +
+	"\bpayments\x18\x02 \x03(\v2\x1a.funds_service.DebtPaymentR\bpayments\x12<\n" +
+	"\bschedule\x18\x03 \x03(\v2 .funds_service.AmortizationEntryR\bschedule\"Z\n" +
+	"\x13GetUserDebtsRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12(\n" +
+	"\x10include_paid_off\x18\x02 \x01(\bR\x0eincludePaidOff\"A\n" +
+	"\x14GetUserDebtsResponse\x12)\n" +
+	"\x05debts\x18\x01 \x03(\v2\x13.funds_service.DebtR\x05debts\">\n" +
+	"\x11DeleteDebtRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\".\n" +
+	"\x12DeleteDebtResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"s\n" +
+	"\x16LinkDebtPaymentRequest\x12\x17\n" +
+	"\adebt_id\x18\x01 \x01(\x03R\x06debtId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\x03R\rtransactionId\"x\n" +
+	"\x17LinkDebtPaymentResponse\x124\n" +
+	"\apayment\x18\x01 \x01(\v2\x1a.funds_service.DebtPaymentR\apayment\x12'\n" +
+	"\x04debt\x18\x02 \x01(\v2\x13.funds_service.DebtR\x04debt\"m\n" +
+	"\x18UnlinkDebtPaymentRequest\x12\x17\n" +
+	"\adebt_id\x18\x01 \x01(\x03R\x06debtId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x03 \x01(\x03R\tpaymentId\"D\n" +
+	"\x19UnlinkDebtPaymentResponse\x12'\n" +
+	"\x04debt\x18\x01 \x01(\v2\x13.funds_service.DebtR\x04debt\"2\n" +
+	"\x15GetDebtSummaryRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\"\xca\x01\n" +
+	"\x16GetDebtSummaryResponse\x12\x1d\n" +
+	"\n" +
+	"total_owed\x18\x01 \x01(\x01R\ttotalOwed\x12\x1d\n" +
+	"\n" +
+	"total_lent\x18\x02 \x01(\x01R\ttotalLent\x12)\n" +
+	"\x10monthly_payments\x18\x03 \x01(\x01R\x0fmonthlyPayments\x12!\n" +
+	"\factive_count\x18\x04 \x01(\x05R\vactiveCount\x12$\n" +
+	"\x0edebt_free_date\x18\x05 \x01(\tR\fdebtFreeDate\"\xf7\x01\n" +
+	"\x06Ledger\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\towner_uid\x18\x03 \x01(\tR\bownerUid\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12#\n" +
+	"\rmembers_count\x18\x05 \x01(\x05R\fmembersCount\x125\n" +
+	"\amembers\x18\x06 \x03(\v2\x1b.funds_service.LedgerMemberR\amembers\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"\x93\x01\n" +
+	"\fLedgerMember\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1b\n" +
+	"\tjoined_at\x18\x05 \x01(\x03R\bjoinedAt\"`\n" +
+	"\x13CreateLedgerRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"E\n" +
+	"\x14CreateLedgerResponse\x12-\n" +
+	"\x06ledger\x18\x01 \x01(\v2\x15.funds_service.LedgerR\x06ledger\"A\n" +
+	"\x14GetLedgerByIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\"F\n" +
+	"\x15GetLedgerByIdResponse\x12-\n" +
+	"\x06ledger\x18\x01 \x01(\v2\x15.funds_service.LedgerR\x06ledger\"2\n" +
+	"\x15GetUserLedgersRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\"I\n" +
+	"\x16GetUserLedgersResponse\x12/\n" +
+	"\aledgers\x18\x01 \x03(\v2\x15.funds_service.LedgerR\aledgers\"@\n" +
+	"\x13DeleteLedgerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\"0\n" +
+	"\x14DeleteLedgerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9f\x01\n" +
+	"\x16AddLedgerMemberRequest\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1d\n" +
+	"\n" +
+	"member_uid\x18\x03 \x01(\tR\tmemberUid\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\"N\n" +
+	"\x17AddLedgerMemberResponse\x123\n" +
+	"\x06member\x18\x01 \x01(\v2\x1b.funds_service.LedgerMemberR\x06member\"\x8a\x01\n" +
+	"\x1dUpdateLedgerMemberRoleRequest\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1d\n" +
+	"\n" +
+	"member_uid\x18\x03 \x01(\tR\tmemberUid\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"U\n" +
+	"\x1eUpdateLedgerMemberRoleResponse\x123\n" +
+	"\x06member\x18\x01 \x01(\v2\x1b.funds_service.LedgerMemberR\x06member\"r\n" +
+	"\x19RemoveLedgerMemberRequest\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1d\n" +
+	"\n" +
+	"member_uid\x18\x03 \x01(\tR\tmemberUid\"6\n" +
+	"\x1aRemoveLedgerMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa5\x01\n" +
+	"\x1cGetLedgerTransactionsRequest\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x1f\n" +
+	"\vexact_total\x18\x05 \x01(\bR\n" +
+	"exactTotal\"\xb9\x01\n" +
+	"\x1dGetLedgerTransactionsResponse\x12>\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1a.funds_service.TransactionR\ftransactions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12'\n" +
+	"\x0ftotal_estimated\x18\x03 \x01(\bR\x0etotalEstimated\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"Q\n" +
+	"\x17GetLedgerBalanceRequest\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\"\xac\x01\n" +
+	"\x13LedgerMemberBalance\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x16\n" +
+	"\x06income\x18\x04 \x01(\x01R\x06income\x12\x18\n" +
+	"\aexpense\x18\x05 \x01(\x01R\aexpense\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\x01R\abalance\"\xd7\x01\n" +
+	"\x18GetLedgerBalanceResponse\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12!\n" +
+	"\ftotal_income\x18\x02 \x01(\x01R\vtotalIncome\x12#\n" +
+	"\rtotal_expense\x18\x03 \x01(\x01R\ftotalExpense\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\x01R\abalance\x12<\n" +
+	"\amembers\x18\x05 \x03(\v2\".funds_service.LedgerMemberBalanceR\amembers\"\x8a\x01\n" +
+	"\x1aGetLedgerSettlementRequest\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1b\n" +
+	"\tdate_from\x18\x03 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x04 \x01(\tR\x06dateTo\"\x84\x01\n" +
+	"\x0fSettlementShare\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04paid\x18\x03 \x01(\x01R\x04paid\x12\x14\n" +
+	"\x05share\x18\x04 \x01(\x01R\x05share\x12\x10\n" +
+	"\x03net\x18\x05 \x01(\x01R\x03net\"\xa4\x01\n" +
+	"\x12SettlementTransfer\x12\x19\n" +
+	"\bfrom_uid\x18\x01 \x01(\tR\afromUid\x12#\n" +
+	"\rfrom_username\x18\x02 \x01(\tR\ffromUsername\x12\x15\n" +
+	"\x06to_uid\x18\x03 \x01(\tR\x05toUid\x12\x1f\n" +
+	"\vto_username\x18\x04 \x01(\tR\n" +
+	"toUsername\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\"\xb4\x02\n" +
+	"\x1bGetLedgerSettlementResponse\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\x12#\n" +
+	"\rtotal_expense\x18\x04 \x01(\x01R\ftotalExpense\x12$\n" +
+	"\x0eshare_per_user\x18\x05 \x01(\x01R\fsharePerUser\x126\n" +
+	"\x06shares\x18\x06 \x03(\v2\x1e.funds_service.SettlementShareR\x06shares\x12?\n" +
+	"\ttransfers\x18\a \x03(\v2!.funds_service.SettlementTransferR\ttransfers\"\xa2\x02\n" +
+	"\x06Budget\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_code\x18\x04 \x01(\tR\fcategoryCode\x12#\n" +
+	"\rcategory_name\x18\x05 \x01(\tR\fcategoryName\x12\x14\n" +
+	"\x05month\x18\x06 \x01(\tR\x05month\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06source\x18\b \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\"E\n" +
+	"\n" +
+	"BudgetItem\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\xce\x01\n" +
+	"\x11SetBudgetsRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\x12/\n" +
+	"\x05items\x18\x03 \x03(\v2\x19.funds_service.BudgetItemR\x05items\x12\x18\n" +
+	"\areplace\x18\x04 \x01(\bR\areplace\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12%\n" +
+	"\x0ereplace_source\x18\x06 \x01(\tR\rreplaceSource\"p\n" +
+	"\x12SetBudgetsResponse\x12/\n" +
+	"\abudgets\x18\x01 \x03(\v2\x15.funds_service.BudgetR\abudgets\x12)\n" +
+	"\x04kept\x18\x02 \x03(\v2\x15.funds_service.BudgetR\x04kept\"b\n" +
+	"\x15GetUserBudgetsRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\x12\x18\n" +
+	"\alocales\x18\x03 \x03(\tR\alocales\"I\n" +
+	"\x16GetUserBudgetsResponse\x12/\n" +
+	"\abudgets\x18\x01 \x03(\v2\x15.funds_service.BudgetR\abudgets2\xd3\x12\n" +
 	"\fFundsService\x12f\n" +
 	"\x11CreateTransaction\x12'.funds_service.CreateTransactionRequest\x1a(.funds_service.CreateTransactionResponse\x12i\n" +
 	"\x12GetTransactionById\x12(.funds_service.GetTransactionByIdRequest\x1a).funds_service.GetTransactionByIdResponse\x12l\n" +
 	"\x13GetUserTransactions\x12).funds_service.GetUserTransactionsRequest\x1a*.funds_service.GetUserTransactionsResponse\x12\x84\x01\n" +
 	"\x1bGetUserTransactionsByPeriod\x121.funds_service.GetUserTransactionsByPeriodRequest\x1a2.funds_service.GetUserTransactionsByPeriodResponse\x12f\n" +
 	"\x11UpdateTransaction\x12'.funds_service.UpdateTransactionRequest\x1a(.funds_service.UpdateTransactionResponse\x12f\n" +
-	"\x11DeleteTransaction\x12'.funds_service.DeleteTransactionRequest\x1a(.funds_service.DeleteTransactionResponse\x12c\n" +
+	"\x11DeleteTransaction\x12'.funds_service.DeleteTransactionRequest\x1a(.funds_service.DeleteTransactionResponse\x12i\n" +
+	"\x12RestoreTransaction\x12(.funds_service.RestoreTransactionRequest\x1a).funds_service.RestoreTransactionResponse\x12u\n" +
+	"\x16GetDeletedTransactions\x12,.funds_service.GetDeletedTransactionsRequest\x1a-.funds_service.GetDeletedTransactionsResponse\x12r\n" +
+	"\x15GetTransactionHistory\x12+.funds_service.GetTransactionHistoryRequest\x1a,.funds_service.GetTransactionHistoryResponse\x12x\n" +
+	"\x17BatchCreateTransactions\x12-.funds_service.BatchCreateTransactionsRequest\x1a..funds_service.BatchCreateTransactionsResponse\x12x\n" +
+	"\x17BatchUpdateTransactions\x12-.funds_service.BatchUpdateTransactionsRequest\x1a..funds_service.BatchUpdateTransactionsResponse\x12x\n" +
+	"\x17BatchDeleteTransactions\x12-.funds_service.BatchDeleteTransactionsRequest\x1a..funds_service.BatchDeleteTransactionsResponse\x12c\n" +
 	"\x10GetAllCategories\x12&.funds_service.GetAllCategoriesRequest\x1a'.funds_service.GetAllCategoriesResponse\x12l\n" +
 	"\x13GetCategoriesByType\x12).funds_service.GetCategoriesByTypeRequest\x1a*.funds_service.GetCategoriesByTypeResponse\x12`\n" +
 	"\x0fGetCategoryById\x12%.funds_service.GetCategoryByIdRequest\x1a&.funds_service.GetCategoryByIdResponse\x12]\n" +
-	"\x0eGetUserBalance\x12$.funds_service.GetUserBalanceRequest\x1a%.funds_service.GetUserBalanceResponseBCZAgithub.com/cg-2025-crutch/backend/funds-service/internal/grpc/genb\x06proto3"
+	"\x0eCreateCategory\x12$.funds_service.CreateCategoryRequest\x1a%.funds_service.CreateCategoryResponse\x12]\n" +
+	"\x0eUpdateCategory\x12$.funds_service.UpdateCategoryRequest\x1a%.funds_service.UpdateCategoryResponse\x12`\n" +
+	"\x0fArchiveCategory\x12%.funds_service.ArchiveCategoryRequest\x1a&.funds_service.ArchiveCategoryResponse\x12`\n" +
+	"\x0fRestoreCategory\x12%.funds_service.RestoreCategoryRequest\x1a&.funds_service.RestoreCategoryResponse\x12]\n" +
+	"\x0eGetUserBalance\x12$.funds_service.GetUserBalanceRequest\x1a%.funds_service.GetUserBalanceResponse\x12f\n" +
+	"\x11GetBalanceHistory\x12'.funds_service.GetBalanceHistoryRequest\x1a(.funds_service.GetBalanceHistoryResponse\x12i\n" +
+	"\x12GetSpendingSummary\x12(.funds_service.GetSpendingSummaryRequest\x1a).funds_service.GetSpendingSummaryResponse2\xb7\x04\n" +
+	"\x11AttachmentService\x12e\n" +
+	"\x10UploadAttachment\x12&.funds_service.UploadAttachmentRequest\x1a'.funds_service.UploadAttachmentResponse(\x01\x12k\n" +
+	"\x12DownloadAttachment\x12(.funds_service.DownloadAttachmentRequest\x1a).funds_service.DownloadAttachmentResponse0\x01\x12~\n" +
+	"\x19GetTransactionAttachments\x12/.funds_service.GetTransactionAttachmentsRequest\x1a0.funds_service.GetTransactionAttachmentsResponse\x12c\n" +
+	"\x10DeleteAttachment\x12&.funds_service.DeleteAttachmentRequest\x1a'.funds_service.DeleteAttachmentResponse\x12i\n" +
+	"\x12GetAttachmentUsage\x12(.funds_service.GetAttachmentUsageRequest\x1a).funds_service.GetAttachmentUsageResponse2\x8b\x05\n" +
+	"\vDebtService\x12Q\n" +
+	"\n" +
+	"CreateDebt\x12 .funds_service.CreateDebtRequest\x1a!.funds_service.CreateDebtResponse\x12T\n" +
+	"\vGetDebtById\x12!.funds_service.GetDebtByIdRequest\x1a\".funds_service.GetDebtByIdResponse\x12W\n" +
+	"\fGetUserDebts\x12\".funds_service.GetUserDebtsRequest\x1a#.funds_service.GetUserDebtsResponse\x12Q\n" +
+	"\n" +
+	"DeleteDebt\x12 .funds_service.DeleteDebtRequest\x1a!.funds_service.DeleteDebtResponse\x12`\n" +
+	"\x0fLinkDebtPayment\x12%.funds_service.LinkDebtPaymentRequest\x1a&.funds_service.LinkDebtPaymentResponse\x12f\n" +
+	"\x11UnlinkDebtPayment\x12'.funds_service.UnlinkDebtPaymentRequest\x1a(.funds_service.UnlinkDebtPaymentResponse\x12]\n" +
+	"\x0eGetDebtSummary\x12$.funds_service.GetDebtSummaryRequest\x1a%.funds_service.GetDebtSummaryResponse2\x87\b\n" +
+	"\rLedgerService\x12W\n" +
+	"\fCreateLedger\x12\".funds_service.CreateLedgerRequest\x1a#.funds_service.CreateLedgerResponse\x12Z\n" +
+	"\rGetLedgerById\x12#.funds_service.GetLedgerByIdRequest\x1a$.funds_service.GetLedgerByIdResponse\x12]\n" +
+	"\x0eGetUserLedgers\x12$.funds_service.GetUserLedgersRequest\x1a%.funds_service.GetUserLedgersResponse\x12W\n" +
+	"\fDeleteLedger\x12\".funds_service.DeleteLedgerRequest\x1a#.funds_service.DeleteLedgerResponse\x12`\n" +
+	"\x0fAddLedgerMember\x12%.funds_service.AddLedgerMemberRequest\x1a&.funds_service.AddLedgerMemberResponse\x12u\n" +
+	"\x16UpdateLedgerMemberRole\x12,.funds_service.UpdateLedgerMemberRoleRequest\x1a-.funds_service.UpdateLedgerMemberRoleResponse\x12i\n" +
+	"\x12RemoveLedgerMember\x12(.funds_service.RemoveLedgerMemberRequest\x1a).funds_service.RemoveLedgerMemberResponse\x12r\n" +
+	"\x15GetLedgerTransactions\x12+.funds_service.GetLedgerTransactionsRequest\x1a,.funds_service.GetLedgerTransactionsResponse\x12c\n" +
+	"\x10GetLedgerBalance\x12&.funds_service.GetLedgerBalanceRequest\x1a'.funds_service.GetLedgerBalanceResponse\x12l\n" +
+	"\x13GetLedgerSettlement\x12).funds_service.GetLedgerSettlementRequest\x1a*.funds_service.GetLedgerSettlementResponse2\xc1\x01\n" +
+	"\rBudgetService\x12Q\n" +
+	"\n" +
+	"SetBudgets\x12 .funds_service.SetBudgetsRequest\x1a!.funds_service.SetBudgetsResponse\x12]\n" +
+	"\x0eGetUserBudgets\x12$.funds_service.GetUserBudgetsRequest\x1a%.funds_service.GetUserBudgetsResponseBCZAgithub.com/cg-2025-crutch/backend/funds-service/internal/grpc/genb\x06proto3"
 
 var (
 	file_funds_service_proto_rawDescOnce sync.Once
@@ -1474,7 +8247,7 @@ func file_funds_service_proto_rawDescGZIP() []byte {
 	return file_funds_service_proto_rawDescData
 }
 
-var file_funds_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_funds_service_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
 var file_funds_service_proto_goTypes = []any{
 	(*Category)(nil),                            // 0: funds_service.Category
 	(*GetAllCategoriesRequest)(nil),             // 1: funds_service.GetAllCategoriesRequest
@@ -1483,59 +8256,279 @@ var file_funds_service_proto_goTypes = []any{
 	(*GetCategoriesByTypeResponse)(nil),         // 4: funds_service.GetCategoriesByTypeResponse
 	(*GetCategoryByIdRequest)(nil),              // 5: funds_service.GetCategoryByIdRequest
 	(*GetCategoryByIdResponse)(nil),             // 6: funds_service.GetCategoryByIdResponse
-	(*Transaction)(nil),                         // 7: funds_service.Transaction
-	(*CreateTransactionRequest)(nil),            // 8: funds_service.CreateTransactionRequest
-	(*CreateTransactionResponse)(nil),           // 9: funds_service.CreateTransactionResponse
-	(*GetTransactionByIdRequest)(nil),           // 10: funds_service.GetTransactionByIdRequest
-	(*GetTransactionByIdResponse)(nil),          // 11: funds_service.GetTransactionByIdResponse
-	(*GetUserTransactionsRequest)(nil),          // 12: funds_service.GetUserTransactionsRequest
-	(*GetUserTransactionsResponse)(nil),         // 13: funds_service.GetUserTransactionsResponse
-	(*GetUserTransactionsByPeriodRequest)(nil),  // 14: funds_service.GetUserTransactionsByPeriodRequest
-	(*GetUserTransactionsByPeriodResponse)(nil), // 15: funds_service.GetUserTransactionsByPeriodResponse
-	(*UpdateTransactionRequest)(nil),            // 16: funds_service.UpdateTransactionRequest
-	(*UpdateTransactionResponse)(nil),           // 17: funds_service.UpdateTransactionResponse
-	(*DeleteTransactionRequest)(nil),            // 18: funds_service.DeleteTransactionRequest
-	(*DeleteTransactionResponse)(nil),           // 19: funds_service.DeleteTransactionResponse
-	(*UserBalance)(nil),                         // 20: funds_service.UserBalance
-	(*GetUserBalanceRequest)(nil),               // 21: funds_service.GetUserBalanceRequest
-	(*GetUserBalanceResponse)(nil),              // 22: funds_service.GetUserBalanceResponse
+	(*CreateCategoryRequest)(nil),               // 7: funds_service.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),              // 8: funds_service.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),               // 9: funds_service.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),              // 10: funds_service.UpdateCategoryResponse
+	(*ArchiveCategoryRequest)(nil),              // 11: funds_service.ArchiveCategoryRequest
+	(*ArchiveCategoryResponse)(nil),             // 12: funds_service.ArchiveCategoryResponse
+	(*RestoreCategoryRequest)(nil),              // 13: funds_service.RestoreCategoryRequest
+	(*RestoreCategoryResponse)(nil),             // 14: funds_service.RestoreCategoryResponse
+	(*Transaction)(nil),                         // 15: funds_service.Transaction
+	(*CreateTransactionRequest)(nil),            // 16: funds_service.CreateTransactionRequest
+	(*CreateTransactionResponse)(nil),           // 17: funds_service.CreateTransactionResponse
+	(*GetTransactionByIdRequest)(nil),           // 18: funds_service.GetTransactionByIdRequest
+	(*GetTransactionByIdResponse)(nil),          // 19: funds_service.GetTransactionByIdResponse
+	(*GetUserTransactionsRequest)(nil),          // 20: funds_service.GetUserTransactionsRequest
+	(*GetUserTransactionsResponse)(nil),         // 21: funds_service.GetUserTransactionsResponse
+	(*Period)(nil),                              // 22: funds_service.Period
+	(*Calendar)(nil),                            // 23: funds_service.Calendar
+	(*GetUserTransactionsByPeriodRequest)(nil),  // 24: funds_service.GetUserTransactionsByPeriodRequest
+	(*GetUserTransactionsByPeriodResponse)(nil), // 25: funds_service.GetUserTransactionsByPeriodResponse
+	(*UpdateTransactionRequest)(nil),            // 26: funds_service.UpdateTransactionRequest
+	(*UpdateTransactionResponse)(nil),           // 27: funds_service.UpdateTransactionResponse
+	(*DeleteTransactionRequest)(nil),            // 28: funds_service.DeleteTransactionRequest
+	(*DeleteTransactionResponse)(nil),           // 29: funds_service.DeleteTransactionResponse
+	(*RestoreTransactionRequest)(nil),           // 30: funds_service.RestoreTransactionRequest
+	(*RestoreTransactionResponse)(nil),          // 31: funds_service.RestoreTransactionResponse
+	(*GetDeletedTransactionsRequest)(nil),       // 32: funds_service.GetDeletedTransactionsRequest
+	(*GetDeletedTransactionsResponse)(nil),      // 33: funds_service.GetDeletedTransactionsResponse
+	(*TransactionRevision)(nil),                 // 34: funds_service.TransactionRevision
+	(*GetTransactionHistoryRequest)(nil),        // 35: funds_service.GetTransactionHistoryRequest
+	(*GetTransactionHistoryResponse)(nil),       // 36: funds_service.GetTransactionHistoryResponse
+	(*BatchItemError)(nil),                      // 37: funds_service.BatchItemError
+	(*BatchCreateTransactionsRequest)(nil),      // 38: funds_service.BatchCreateTransactionsRequest
+	(*BatchCreateTransactionsResponse)(nil),     // 39: funds_service.BatchCreateTransactionsResponse
+	(*TransactionPatch)(nil),                    // 40: funds_service.TransactionPatch
+	(*BatchUpdateTransactionsRequest)(nil),      // 41: funds_service.BatchUpdateTransactionsRequest
+	(*BatchUpdateTransactionsResponse)(nil),     // 42: funds_service.BatchUpdateTransactionsResponse
+	(*BatchDeleteTransactionsRequest)(nil),      // 43: funds_service.BatchDeleteTransactionsRequest
+	(*BatchDeleteTransactionsResponse)(nil),     // 44: funds_service.BatchDeleteTransactionsResponse
+	(*UserBalance)(nil),                         // 45: funds_service.UserBalance
+	(*GetUserBalanceRequest)(nil),               // 46: funds_service.GetUserBalanceRequest
+	(*GetUserBalanceResponse)(nil),              // 47: funds_service.GetUserBalanceResponse
+	(*GetBalanceHistoryRequest)(nil),            // 48: funds_service.GetBalanceHistoryRequest
+	(*BalanceHistoryPoint)(nil),                 // 49: funds_service.BalanceHistoryPoint
+	(*GetBalanceHistoryResponse)(nil),           // 50: funds_service.GetBalanceHistoryResponse
+	(*GetSpendingSummaryRequest)(nil),           // 51: funds_service.GetSpendingSummaryRequest
+	(*SpendingSummaryBucket)(nil),               // 52: funds_service.SpendingSummaryBucket
+	(*GetSpendingSummaryResponse)(nil),          // 53: funds_service.GetSpendingSummaryResponse
+	(*Attachment)(nil),                          // 54: funds_service.Attachment
+	(*AttachmentUploadInfo)(nil),                // 55: funds_service.AttachmentUploadInfo
+	(*UploadAttachmentRequest)(nil),             // 56: funds_service.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),            // 57: funds_service.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),           // 58: funds_service.DownloadAttachmentRequest
+	(*AttachmentDownloadInfo)(nil),              // 59: funds_service.AttachmentDownloadInfo
+	(*DownloadAttachmentResponse)(nil),          // 60: funds_service.DownloadAttachmentResponse
+	(*GetTransactionAttachmentsRequest)(nil),    // 61: funds_service.GetTransactionAttachmentsRequest
+	(*GetTransactionAttachmentsResponse)(nil),   // 62: funds_service.GetTransactionAttachmentsResponse
+	(*DeleteAttachmentRequest)(nil),             // 63: funds_service.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),            // 64: funds_service.DeleteAttachmentResponse
+	(*GetAttachmentUsageRequest)(nil),           // 65: funds_service.GetAttachmentUsageRequest
+	(*GetAttachmentUsageResponse)(nil),          // 66: funds_service.GetAttachmentUsageResponse
+	(*Debt)(nil),                                // 67: funds_service.Debt
+	(*DebtPayment)(nil),                         // 68: funds_service.DebtPayment
+	(*AmortizationEntry)(nil),                   // 69: funds_service.AmortizationEntry
+	(*CreateDebtRequest)(nil),                   // 70: funds_service.CreateDebtRequest
+	(*CreateDebtResponse)(nil),                  // 71: funds_service.CreateDebtResponse
+	(*GetDebtByIdRequest)(nil),                  // 72: funds_service.GetDebtByIdRequest
+	(*GetDebtByIdResponse)(nil),                 // 73: funds_service.GetDebtByIdResponse
+	(*GetUserDebtsRequest)(nil),                 // 74: funds_service.GetUserDebtsRequest
+	(*GetUserDebtsResponse)(nil),                // 75: funds_service.GetUserDebtsResponse
+	(*DeleteDebtRequest)(nil),                   // 76: funds_service.DeleteDebtRequest
+	(*DeleteDebtResponse)(nil),                  // 77: funds_service.DeleteDebtResponse
+	(*LinkDebtPaymentRequest)(nil),              // 78: funds_service.LinkDebtPaymentRequest
+	(*LinkDebtPaymentResponse)(nil),             // 79: funds_service.LinkDebtPaymentResponse
+	(*UnlinkDebtPaymentRequest)(nil),            // 80: funds_service.UnlinkDebtPaymentRequest
+	(*UnlinkDebtPaymentResponse)(nil),           // 81: funds_service.UnlinkDebtPaymentResponse
+	(*GetDebtSummaryRequest)(nil),               // 82: funds_service.GetDebtSummaryRequest
+	(*GetDebtSummaryResponse)(nil),              // 83: funds_service.GetDebtSummaryResponse
+	(*Ledger)(nil),                              // 84: funds_service.Ledger
+	(*LedgerMember)(nil),                        // 85: funds_service.LedgerMember
+	(*CreateLedgerRequest)(nil),                 // 86: funds_service.CreateLedgerRequest
+	(*CreateLedgerResponse)(nil),                // 87: funds_service.CreateLedgerResponse
+	(*GetLedgerByIdRequest)(nil),                // 88: funds_service.GetLedgerByIdRequest
+	(*GetLedgerByIdResponse)(nil),               // 89: funds_service.GetLedgerByIdResponse
+	(*GetUserLedgersRequest)(nil),               // 90: funds_service.GetUserLedgersRequest
+	(*GetUserLedgersResponse)(nil),              // 91: funds_service.GetUserLedgersResponse
+	(*DeleteLedgerRequest)(nil),                 // 92: funds_service.DeleteLedgerRequest
+	(*DeleteLedgerResponse)(nil),                // 93: funds_service.DeleteLedgerResponse
+	(*AddLedgerMemberRequest)(nil),              // 94: funds_service.AddLedgerMemberRequest
+	(*AddLedgerMemberResponse)(nil),             // 95: funds_service.AddLedgerMemberResponse
+	(*UpdateLedgerMemberRoleRequest)(nil),       // 96: funds_service.UpdateLedgerMemberRoleRequest
+	(*UpdateLedgerMemberRoleResponse)(nil),      // 97: funds_service.UpdateLedgerMemberRoleResponse
+	(*RemoveLedgerMemberRequest)(nil),           // 98: funds_service.RemoveLedgerMemberRequest
+	(*RemoveLedgerMemberResponse)(nil),          // 99: funds_service.RemoveLedgerMemberResponse
+	(*GetLedgerTransactionsRequest)(nil),        // 100: funds_service.GetLedgerTransactionsRequest
+	(*GetLedgerTransactionsResponse)(nil),       // 101: funds_service.GetLedgerTransactionsResponse
+	(*GetLedgerBalanceRequest)(nil),             // 102: funds_service.GetLedgerBalanceRequest
+	(*LedgerMemberBalance)(nil),                 // 103: funds_service.LedgerMemberBalance
+	(*GetLedgerBalanceResponse)(nil),            // 104: funds_service.GetLedgerBalanceResponse
+	(*GetLedgerSettlementRequest)(nil),          // 105: funds_service.GetLedgerSettlementRequest
+	(*SettlementShare)(nil),                     // 106: funds_service.SettlementShare
+	(*SettlementTransfer)(nil),                  // 107: funds_service.SettlementTransfer
+	(*GetLedgerSettlementResponse)(nil),         // 108: funds_service.GetLedgerSettlementResponse
+	(*Budget)(nil),                              // 109: funds_service.Budget
+	(*BudgetItem)(nil),                          // 110: funds_service.BudgetItem
+	(*SetBudgetsRequest)(nil),                   // 111: funds_service.SetBudgetsRequest
+	(*SetBudgetsResponse)(nil),                  // 112: funds_service.SetBudgetsResponse
+	(*GetUserBudgetsRequest)(nil),               // 113: funds_service.GetUserBudgetsRequest
+	(*GetUserBudgetsResponse)(nil),              // 114: funds_service.GetUserBudgetsResponse
+	nil,                                         // 115: funds_service.Category.TranslationsEntry
+	nil,                                         // 116: funds_service.CreateCategoryRequest.TranslationsEntry
+	nil,                                         // 117: funds_service.UpdateCategoryRequest.TranslationsEntry
 }
 var file_funds_service_proto_depIdxs = []int32{
-	0,  // 0: funds_service.GetAllCategoriesResponse.categories:type_name -> funds_service.Category
-	0,  // 1: funds_service.GetCategoriesByTypeResponse.categories:type_name -> funds_service.Category
-	0,  // 2: funds_service.GetCategoryByIdResponse.category:type_name -> funds_service.Category
-	0,  // 3: funds_service.Transaction.category:type_name -> funds_service.Category
-	7,  // 4: funds_service.CreateTransactionResponse.transaction:type_name -> funds_service.Transaction
-	7,  // 5: funds_service.GetTransactionByIdResponse.transaction:type_name -> funds_service.Transaction
-	7,  // 6: funds_service.GetUserTransactionsResponse.transactions:type_name -> funds_service.Transaction
-	7,  // 7: funds_service.GetUserTransactionsByPeriodResponse.transactions:type_name -> funds_service.Transaction
-	7,  // 8: funds_service.UpdateTransactionResponse.transaction:type_name -> funds_service.Transaction
-	20, // 9: funds_service.GetUserBalanceResponse.balance:type_name -> funds_service.UserBalance
-	8,  // 10: funds_service.FundsService.CreateTransaction:input_type -> funds_service.CreateTransactionRequest
-	10, // 11: funds_service.FundsService.GetTransactionById:input_type -> funds_service.GetTransactionByIdRequest
-	12, // 12: funds_service.FundsService.GetUserTransactions:input_type -> funds_service.GetUserTransactionsRequest
-	14, // 13: funds_service.FundsService.GetUserTransactionsByPeriod:input_type -> funds_service.GetUserTransactionsByPeriodRequest
-	16, // 14: funds_service.FundsService.UpdateTransaction:input_type -> funds_service.UpdateTransactionRequest
-	18, // 15: funds_service.FundsService.DeleteTransaction:input_type -> funds_service.DeleteTransactionRequest
-	1,  // 16: funds_service.FundsService.GetAllCategories:input_type -> funds_service.GetAllCategoriesRequest
-	3,  // 17: funds_service.FundsService.GetCategoriesByType:input_type -> funds_service.GetCategoriesByTypeRequest
-	5,  // 18: funds_service.FundsService.GetCategoryById:input_type -> funds_service.GetCategoryByIdRequest
-	21, // 19: funds_service.FundsService.GetUserBalance:input_type -> funds_service.GetUserBalanceRequest
-	9,  // 20: funds_service.FundsService.CreateTransaction:output_type -> funds_service.CreateTransactionResponse
-	11, // 21: funds_service.FundsService.GetTransactionById:output_type -> funds_service.GetTransactionByIdResponse
-	13, // 22: funds_service.FundsService.GetUserTransactions:output_type -> funds_service.GetUserTransactionsResponse
-	15, // 23: funds_service.FundsService.GetUserTransactionsByPeriod:output_type -> funds_service.GetUserTransactionsByPeriodResponse
-	17, // 24: funds_service.FundsService.UpdateTransaction:output_type -> funds_service.UpdateTransactionResponse
-	19, // 25: funds_service.FundsService.DeleteTransaction:output_type -> funds_service.DeleteTransactionResponse
-	2,  // 26: funds_service.FundsService.GetAllCategories:output_type -> funds_service.GetAllCategoriesResponse
-	4,  // 27: funds_service.FundsService.GetCategoriesByType:output_type -> funds_service.GetCategoriesByTypeResponse
-	6,  // 28: funds_service.FundsService.GetCategoryById:output_type -> funds_service.GetCategoryByIdResponse
-	22, // 29: funds_service.FundsService.GetUserBalance:output_type -> funds_service.GetUserBalanceResponse
-	20, // [20:30] is the sub-list for method output_type
-	10, // [10:20] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	115, // 0: funds_service.Category.translations:type_name -> funds_service.Category.TranslationsEntry
+	0,   // 1: funds_service.GetAllCategoriesResponse.categories:type_name -> funds_service.Category
+	0,   // 2: funds_service.GetCategoriesByTypeResponse.categories:type_name -> funds_service.Category
+	0,   // 3: funds_service.GetCategoryByIdResponse.category:type_name -> funds_service.Category
+	116, // 4: funds_service.CreateCategoryRequest.translations:type_name -> funds_service.CreateCategoryRequest.TranslationsEntry
+	0,   // 5: funds_service.CreateCategoryResponse.category:type_name -> funds_service.Category
+	117, // 6: funds_service.UpdateCategoryRequest.translations:type_name -> funds_service.UpdateCategoryRequest.TranslationsEntry
+	0,   // 7: funds_service.UpdateCategoryResponse.category:type_name -> funds_service.Category
+	0,   // 8: funds_service.ArchiveCategoryResponse.category:type_name -> funds_service.Category
+	0,   // 9: funds_service.RestoreCategoryResponse.category:type_name -> funds_service.Category
+	0,   // 10: funds_service.Transaction.category:type_name -> funds_service.Category
+	15,  // 11: funds_service.CreateTransactionResponse.transaction:type_name -> funds_service.Transaction
+	15,  // 12: funds_service.GetTransactionByIdResponse.transaction:type_name -> funds_service.Transaction
+	15,  // 13: funds_service.GetUserTransactionsResponse.transactions:type_name -> funds_service.Transaction
+	22,  // 14: funds_service.GetUserTransactionsByPeriodRequest.period:type_name -> funds_service.Period
+	23,  // 15: funds_service.GetUserTransactionsByPeriodRequest.calendar:type_name -> funds_service.Calendar
+	15,  // 16: funds_service.GetUserTransactionsByPeriodResponse.transactions:type_name -> funds_service.Transaction
+	15,  // 17: funds_service.UpdateTransactionResponse.transaction:type_name -> funds_service.Transaction
+	15,  // 18: funds_service.RestoreTransactionResponse.transaction:type_name -> funds_service.Transaction
+	15,  // 19: funds_service.GetDeletedTransactionsResponse.transactions:type_name -> funds_service.Transaction
+	15,  // 20: funds_service.TransactionRevision.before:type_name -> funds_service.Transaction
+	15,  // 21: funds_service.TransactionRevision.after:type_name -> funds_service.Transaction
+	34,  // 22: funds_service.GetTransactionHistoryResponse.revisions:type_name -> funds_service.TransactionRevision
+	16,  // 23: funds_service.BatchCreateTransactionsRequest.items:type_name -> funds_service.CreateTransactionRequest
+	15,  // 24: funds_service.BatchCreateTransactionsResponse.transactions:type_name -> funds_service.Transaction
+	37,  // 25: funds_service.BatchCreateTransactionsResponse.errors:type_name -> funds_service.BatchItemError
+	40,  // 26: funds_service.BatchUpdateTransactionsRequest.items:type_name -> funds_service.TransactionPatch
+	15,  // 27: funds_service.BatchUpdateTransactionsResponse.transactions:type_name -> funds_service.Transaction
+	37,  // 28: funds_service.BatchUpdateTransactionsResponse.errors:type_name -> funds_service.BatchItemError
+	37,  // 29: funds_service.BatchDeleteTransactionsResponse.errors:type_name -> funds_service.BatchItemError
+	45,  // 30: funds_service.GetUserBalanceResponse.balance:type_name -> funds_service.UserBalance
+	22,  // 31: funds_service.GetBalanceHistoryRequest.period:type_name -> funds_service.Period
+	23,  // 32: funds_service.GetBalanceHistoryRequest.calendar:type_name -> funds_service.Calendar
+	49,  // 33: funds_service.GetBalanceHistoryResponse.points:type_name -> funds_service.BalanceHistoryPoint
+	22,  // 34: funds_service.GetSpendingSummaryRequest.period:type_name -> funds_service.Period
+	23,  // 35: funds_service.GetSpendingSummaryRequest.calendar:type_name -> funds_service.Calendar
+	52,  // 36: funds_service.GetSpendingSummaryResponse.buckets:type_name -> funds_service.SpendingSummaryBucket
+	55,  // 37: funds_service.UploadAttachmentRequest.info:type_name -> funds_service.AttachmentUploadInfo
+	54,  // 38: funds_service.UploadAttachmentResponse.attachment:type_name -> funds_service.Attachment
+	59,  // 39: funds_service.DownloadAttachmentResponse.info:type_name -> funds_service.AttachmentDownloadInfo
+	54,  // 40: funds_service.GetTransactionAttachmentsResponse.attachments:type_name -> funds_service.Attachment
+	67,  // 41: funds_service.CreateDebtResponse.debt:type_name -> funds_service.Debt
+	67,  // 42: funds_service.GetDebtByIdResponse.debt:type_name -> funds_service.Debt
+	68,  // 43: funds_service.GetDebtByIdResponse.payments:type_name -> funds_service.DebtPayment
+	69,  // 44: funds_service.GetDebtByIdResponse.schedule:type_name -> funds_service.AmortizationEntry
+	67,  // 45: funds_service.GetUserDebtsResponse.debts:type_name -> funds_service.Debt
+	68,  // 46: funds_service.LinkDebtPaymentResponse.payment:type_name -> funds_service.DebtPayment
+	67,  // 47: funds_service.LinkDebtPaymentResponse.debt:type_name -> funds_service.Debt
+	67,  // 48: funds_service.UnlinkDebtPaymentResponse.debt:type_name -> funds_service.Debt
+	85,  // 49: funds_service.Ledger.members:type_name -> funds_service.LedgerMember
+	84,  // 50: funds_service.CreateLedgerResponse.ledger:type_name -> funds_service.Ledger
+	84,  // 51: funds_service.GetLedgerByIdResponse.ledger:type_name -> funds_service.Ledger
+	84,  // 52: funds_service.GetUserLedgersResponse.ledgers:type_name -> funds_service.Ledger
+	85,  // 53: funds_service.AddLedgerMemberResponse.member:type_name -> funds_service.LedgerMember
+	85,  // 54: funds_service.UpdateLedgerMemberRoleResponse.member:type_name -> funds_service.LedgerMember
+	15,  // 55: funds_service.GetLedgerTransactionsResponse.transactions:type_name -> funds_service.Transaction
+	103, // 56: funds_service.GetLedgerBalanceResponse.members:type_name -> funds_service.LedgerMemberBalance
+	106, // 57: funds_service.GetLedgerSettlementResponse.shares:type_name -> funds_service.SettlementShare
+	107, // 58: funds_service.GetLedgerSettlementResponse.transfers:type_name -> funds_service.SettlementTransfer
+	110, // 59: funds_service.SetBudgetsRequest.items:type_name -> funds_service.BudgetItem
+	109, // 60: funds_service.SetBudgetsResponse.budgets:type_name -> funds_service.Budget
+	109, // 61: funds_service.SetBudgetsResponse.kept:type_name -> funds_service.Budget
+	109, // 62: funds_service.GetUserBudgetsResponse.budgets:type_name -> funds_service.Budget
+	16,  // 63: funds_service.FundsService.CreateTransaction:input_type -> funds_service.CreateTransactionRequest
+	18,  // 64: funds_service.FundsService.GetTransactionById:input_type -> funds_service.GetTransactionByIdRequest
+	20,  // 65: funds_service.FundsService.GetUserTransactions:input_type -> funds_service.GetUserTransactionsRequest
+	24,  // 66: funds_service.FundsService.GetUserTransactionsByPeriod:input_type -> funds_service.GetUserTransactionsByPeriodRequest
+	26,  // 67: funds_service.FundsService.UpdateTransaction:input_type -> funds_service.UpdateTransactionRequest
+	28,  // 68: funds_service.FundsService.DeleteTransaction:input_type -> funds_service.DeleteTransactionRequest
+	30,  // 69: funds_service.FundsService.RestoreTransaction:input_type -> funds_service.RestoreTransactionRequest
+	32,  // 70: funds_service.FundsService.GetDeletedTransactions:input_type -> funds_service.GetDeletedTransactionsRequest
+	35,  // 71: funds_service.FundsService.GetTransactionHistory:input_type -> funds_service.GetTransactionHistoryRequest
+	38,  // 72: funds_service.FundsService.BatchCreateTransactions:input_type -> funds_service.BatchCreateTransactionsRequest
+	41,  // 73: funds_service.FundsService.BatchUpdateTransactions:input_type -> funds_service.BatchUpdateTransactionsRequest
+	43,  // 74: funds_service.FundsService.BatchDeleteTransactions:input_type -> funds_service.BatchDeleteTransactionsRequest
+	1,   // 75: funds_service.FundsService.GetAllCategories:input_type -> funds_service.GetAllCategoriesRequest
+	3,   // 76: funds_service.FundsService.GetCategoriesByType:input_type -> funds_service.GetCategoriesByTypeRequest
+	5,   // 77: funds_service.FundsService.GetCategoryById:input_type -> funds_service.GetCategoryByIdRequest
+	7,   // 78: funds_service.FundsService.CreateCategory:input_type -> funds_service.CreateCategoryRequest
+	9,   // 79: funds_service.FundsService.UpdateCategory:input_type -> funds_service.UpdateCategoryRequest
+	11,  // 80: funds_service.FundsService.ArchiveCategory:input_type -> funds_service.ArchiveCategoryRequest
+	13,  // 81: funds_service.FundsService.RestoreCategory:input_type -> funds_service.RestoreCategoryRequest
+	46,  // 82: funds_service.FundsService.GetUserBalance:input_type -> funds_service.GetUserBalanceRequest
+	48,  // 83: funds_service.FundsService.GetBalanceHistory:input_type -> funds_service.GetBalanceHistoryRequest
+	51,  // 84: funds_service.FundsService.GetSpendingSummary:input_type -> funds_service.GetSpendingSummaryRequest
+	56,  // 85: funds_service.AttachmentService.UploadAttachment:input_type -> funds_service.UploadAttachmentRequest
+	58,  // 86: funds_service.AttachmentService.DownloadAttachment:input_type -> funds_service.DownloadAttachmentRequest
+	61,  // 87: funds_service.AttachmentService.GetTransactionAttachments:input_type -> funds_service.GetTransactionAttachmentsRequest
+	63,  // 88: funds_service.AttachmentService.DeleteAttachment:input_type -> funds_service.DeleteAttachmentRequest
+	65,  // 89: funds_service.AttachmentService.GetAttachmentUsage:input_type -> funds_service.GetAttachmentUsageRequest
+	70,  // 90: funds_service.DebtService.CreateDebt:input_type -> funds_service.CreateDebtRequest
+	72,  // 91: funds_service.DebtService.GetDebtById:input_type -> funds_service.GetDebtByIdRequest
+	74,  // 92: funds_service.DebtService.GetUserDebts:input_type -> funds_service.GetUserDebtsRequest
+	76,  // 93: funds_service.DebtService.DeleteDebt:input_type -> funds_service.DeleteDebtRequest
+	78,  // 94: funds_service.DebtService.LinkDebtPayment:input_type -> funds_service.LinkDebtPaymentRequest
+	80,  // 95: funds_service.DebtService.UnlinkDebtPayment:input_type -> funds_service.UnlinkDebtPaymentRequest
+	82,  // 96: funds_service.DebtService.GetDebtSummary:input_type -> funds_service.GetDebtSummaryRequest
+	86,  // 97: funds_service.LedgerService.CreateLedger:input_type -> funds_service.CreateLedgerRequest
+	88,  // 98: funds_service.LedgerService.GetLedgerById:input_type -> funds_service.GetLedgerByIdRequest
+	90,  // 99: funds_service.LedgerService.GetUserLedgers:input_type -> funds_service.GetUserLedgersRequest
+	92,  // 100: funds_service.LedgerService.DeleteLedger:input_type -> funds_service.DeleteLedgerRequest
+	94,  // 101: funds_service.LedgerService.AddLedgerMember:input_type -> funds_service.AddLedgerMemberRequest
+	96,  // 102: funds_service.LedgerService.UpdateLedgerMemberRole:input_type -> funds_service.UpdateLedgerMemberRoleRequest
+	98,  // 103: funds_service.LedgerService.RemoveLedgerMember:input_type -> funds_service.RemoveLedgerMemberRequest
+	100, // 104: funds_service.LedgerService.GetLedgerTransactions:input_type -> funds_service.GetLedgerTransactionsRequest
+	102, // 105: funds_service.LedgerService.GetLedgerBalance:input_type -> funds_service.GetLedgerBalanceRequest
+	105, // 106: funds_service.LedgerService.GetLedgerSettlement:input_type -> funds_service.GetLedgerSettlementRequest
+	111, // 107: funds_service.BudgetService.SetBudgets:input_type -> funds_service.SetBudgetsRequest
+	113, // 108: funds_service.BudgetService.GetUserBudgets:input_type -> funds_service.GetUserBudgetsRequest
+	17,  // 109: funds_service.FundsService.CreateTransaction:output_type -> funds_service.CreateTransactionResponse
+	19,  // 110: funds_service.FundsService.GetTransactionById:output_type -> funds_service.GetTransactionByIdResponse
+	21,  // 111: funds_service.FundsService.GetUserTransactions:output_type -> funds_service.GetUserTransactionsResponse
+	25,  // 112: funds_service.FundsService.GetUserTransactionsByPeriod:output_type -> funds_service.GetUserTransactionsByPeriodResponse
+	27,  // 113: funds_service.FundsService.UpdateTransaction:output_type -> funds_service.UpdateTransactionResponse
+	29,  // 114: funds_service.FundsService.DeleteTransaction:output_type -> funds_service.DeleteTransactionResponse
+	31,  // 115: funds_service.FundsService.RestoreTransaction:output_type -> funds_service.RestoreTransactionResponse
+	33,  // 116: funds_service.FundsService.GetDeletedTransactions:output_type -> funds_service.GetDeletedTransactionsResponse
+	36,  // 117: funds_service.FundsService.GetTransactionHistory:output_type -> funds_service.GetTransactionHistoryResponse
+	39,  // 118: funds_service.FundsService.BatchCreateTransactions:output_type -> funds_service.BatchCreateTransactionsResponse
+	42,  // 119: funds_service.FundsService.BatchUpdateTransactions:output_type -> funds_service.BatchUpdateTransactionsResponse
+	44,  // 120: funds_service.FundsService.BatchDeleteTransactions:output_type -> funds_service.BatchDeleteTransactionsResponse
+	2,   // 121: funds_service.FundsService.GetAllCategories:output_type -> funds_service.GetAllCategoriesResponse
+	4,   // 122: funds_service.FundsService.GetCategoriesByType:output_type -> funds_service.GetCategoriesByTypeResponse
+	6,   // 123: funds_service.FundsService.GetCategoryById:output_type -> funds_service.GetCategoryByIdResponse
+	8,   // 124: funds_service.FundsService.CreateCategory:output_type -> funds_service.CreateCategoryResponse
+	10,  // 125: funds_service.FundsService.UpdateCategory:output_type -> funds_service.UpdateCategoryResponse
+	12,  // 126: funds_service.FundsService.ArchiveCategory:output_type -> funds_service.ArchiveCategoryResponse
+	14,  // 127: funds_service.FundsService.RestoreCategory:output_type -> funds_service.RestoreCategoryResponse
+	47,  // 128: funds_service.FundsService.GetUserBalance:output_type -> funds_service.GetUserBalanceResponse
+	50,  // 129: funds_service.FundsService.GetBalanceHistory:output_type -> funds_service.GetBalanceHistoryResponse
+	53,  // 130: funds_service.FundsService.GetSpendingSummary:output_type -> funds_service.GetSpendingSummaryResponse
+	57,  // 131: funds_service.AttachmentService.UploadAttachment:output_type -> funds_service.UploadAttachmentResponse
+	60,  // 132: funds_service.AttachmentService.DownloadAttachment:output_type -> funds_service.DownloadAttachmentResponse
+	62,  // 133: funds_service.AttachmentService.GetTransactionAttachments:output_type -> funds_service.GetTransactionAttachmentsResponse
+	64,  // 134: funds_service.AttachmentService.DeleteAttachment:output_type -> funds_service.DeleteAttachmentResponse
+	66,  // 135: funds_service.AttachmentService.GetAttachmentUsage:output_type -> funds_service.GetAttachmentUsageResponse
+	71,  // 136: funds_service.DebtService.CreateDebt:output_type -> funds_service.CreateDebtResponse
+	73,  // 137: funds_service.DebtService.GetDebtById:output_type -> funds_service.GetDebtByIdResponse
+	75,  // 138: funds_service.DebtService.GetUserDebts:output_type -> funds_service.GetUserDebtsResponse
+	77,  // 139: funds_service.DebtService.DeleteDebt:output_type -> funds_service.DeleteDebtResponse
+	79,  // 140: funds_service.DebtService.LinkDebtPayment:output_type -> funds_service.LinkDebtPaymentResponse
+	81,  // 141: funds_service.DebtService.UnlinkDebtPayment:output_type -> funds_service.UnlinkDebtPaymentResponse
+	83,  // 142: funds_service.DebtService.GetDebtSummary:output_type -> funds_service.GetDebtSummaryResponse
+	87,  // 143: funds_service.LedgerService.CreateLedger:output_type -> funds_service.CreateLedgerResponse
+	89,  // 144: funds_service.LedgerService.GetLedgerById:output_type -> funds_service.GetLedgerByIdResponse
+	91,  // 145: funds_service.LedgerService.GetUserLedgers:output_type -> funds_service.GetUserLedgersResponse
+	93,  // 146: funds_service.LedgerService.DeleteLedger:output_type -> funds_service.DeleteLedgerResponse
+	95,  // 147: funds_service.LedgerService.AddLedgerMember:output_type -> funds_service.AddLedgerMemberResponse
+	97,  // 148: funds_service.LedgerService.UpdateLedgerMemberRole:output_type -> funds_service.UpdateLedgerMemberRoleResponse
+	99,  // 149: funds_service.LedgerService.RemoveLedgerMember:output_type -> funds_service.RemoveLedgerMemberResponse
+	101, // 150: funds_service.LedgerService.GetLedgerTransactions:output_type -> funds_service.GetLedgerTransactionsResponse
+	104, // 151: funds_service.LedgerService.GetLedgerBalance:output_type -> funds_service.GetLedgerBalanceResponse
+	108, // 152: funds_service.LedgerService.GetLedgerSettlement:output_type -> funds_service.GetLedgerSettlementResponse
+	112, // 153: funds_service.BudgetService.SetBudgets:output_type -> funds_service.SetBudgetsResponse
+	114, // 154: funds_service.BudgetService.GetUserBudgets:output_type -> funds_service.GetUserBudgetsResponse
+	109, // [109:155] is the sub-list for method output_type
+	63,  // [63:109] is the sub-list for method input_type
+	63,  // [63:63] is the sub-list for extension type_name
+	63,  // [63:63] is the sub-list for extension extendee
+	0,   // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_funds_service_proto_init() }
@@ -1543,15 +8536,25 @@ func file_funds_service_proto_init() {
 	if File_funds_service_proto != nil {
 		return
 	}
+	file_funds_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_funds_service_proto_msgTypes[40].OneofWrappers = []any{}
+	file_funds_service_proto_msgTypes[56].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_funds_service_proto_msgTypes[60].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Info)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_funds_service_proto_rawDesc), len(file_funds_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   118,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   5,
 		},
 		GoTypes:           file_funds_service_proto_goTypes,
 		DependencyIndexes: file_funds_service_proto_depIdxs,
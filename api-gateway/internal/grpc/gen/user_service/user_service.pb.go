@@ -206,6 +206,10 @@ type User struct {
 	Age           int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
 	Salary        float64                `protobuf:"fixed64,6,opt,name=salary,proto3" json:"salary,omitempty"`
 	WorkSphereId  int64                  `protobuf:"varint,7,opt,name=work_sphere_id,json=workSphereId,proto3" json:"work_sphere_id,omitempty"`
+	Timezone      string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`                              // IANA name, periods like "this month" are evaluated in this zone
+	WeekStart     int32                  `protobuf:"varint,9,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`          // ISO weekday the week starts on: 1 - Monday, 7 - Sunday
+	SalaryDay     int32                  `protobuf:"varint,10,opt,name=salary_day,json=salaryDay,proto3" json:"salary_day,omitempty"`         // Day of month the salary arrives, 0 - not set
+	IncomeSource  string                 `protobuf:"bytes,11,opt,name=income_source,json=incomeSource,proto3" json:"income_source,omitempty"` // Income analytics is based on: "profile" salary or actual "transactions"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -289,6 +293,34 @@ func (x *User) GetWorkSphereId() int64 {
 	return 0
 }
 
+func (x *User) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *User) GetWeekStart() int32 {
+	if x != nil {
+		return x.WeekStart
+	}
+	return 0
+}
+
+func (x *User) GetSalaryDay() int32 {
+	if x != nil {
+		return x.SalaryDay
+	}
+	return 0
+}
+
+func (x *User) GetIncomeSource() string {
+	if x != nil {
+		return x.IncomeSource
+	}
+	return ""
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -298,6 +330,10 @@ type CreateUserRequest struct {
 	Age           int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
 	Salary        float64                `protobuf:"fixed64,6,opt,name=salary,proto3" json:"salary,omitempty"`
 	WorkSphereId  int64                  `protobuf:"varint,7,opt,name=work_sphere_id,json=workSphereId,proto3" json:"work_sphere_id,omitempty"`
+	Timezone      string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`                     // UTC by default
+	WeekStart     int32                  `protobuf:"varint,9,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"` // Monday by default
+	SalaryDay     int32                  `protobuf:"varint,10,opt,name=salary_day,json=salaryDay,proto3" json:"salary_day,omitempty"`
+	IncomeSource  string                 `protobuf:"bytes,11,opt,name=income_source,json=incomeSource,proto3" json:"income_source,omitempty"` // "profile" by default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -381,6 +417,34 @@ func (x *CreateUserRequest) GetWorkSphereId() int64 {
 	return 0
 }
 
+func (x *CreateUserRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetWeekStart() int32 {
+	if x != nil {
+		return x.WeekStart
+	}
+	return 0
+}
+
+func (x *CreateUserRequest) GetSalaryDay() int32 {
+	if x != nil {
+		return x.SalaryDay
+	}
+	return 0
+}
+
+func (x *CreateUserRequest) GetIncomeSource() string {
+	if x != nil {
+		return x.IncomeSource
+	}
+	return ""
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -434,6 +498,10 @@ type UpdateUserRequest struct {
 	Age           int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
 	Salary        float64                `protobuf:"fixed64,6,opt,name=salary,proto3" json:"salary,omitempty"`
 	WorkSphereId  int64                  `protobuf:"varint,7,opt,name=work_sphere_id,json=workSphereId,proto3" json:"work_sphere_id,omitempty"`
+	Timezone      *string                `protobuf:"bytes,8,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"` // Calendar preferences are kept when not set
+	WeekStart     *int32                 `protobuf:"varint,9,opt,name=week_start,json=weekStart,proto3,oneof" json:"week_start,omitempty"`
+	SalaryDay     *int32                 `protobuf:"varint,10,opt,name=salary_day,json=salaryDay,proto3,oneof" json:"salary_day,omitempty"`
+	IncomeSource  *string                `protobuf:"bytes,11,opt,name=income_source,json=incomeSource,proto3,oneof" json:"income_source,omitempty"` // Kept when not set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -517,6 +585,34 @@ func (x *UpdateUserRequest) GetWorkSphereId() int64 {
 	return 0
 }
 
+func (x *UpdateUserRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetWeekStart() int32 {
+	if x != nil && x.WeekStart != nil {
+		return *x.WeekStart
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetSalaryDay() int32 {
+	if x != nil && x.SalaryDay != nil {
+		return *x.SalaryDay
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetIncomeSource() string {
+	if x != nil && x.IncomeSource != nil {
+		return *x.IncomeSource
+	}
+	return ""
+}
+
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -805,7 +901,7 @@ const file_user_service_proto_rawDesc = "" +
 	"\x12GetUserByIdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
 	"\x13GetUserByIdResponse\x12&\n" +
-	"\x04user\x18\x01 \x01(\v2\x12.user_service.UserR\x04user\"\xc2\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.user_service.UserR\x04user\"\xc1\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
@@ -815,7 +911,14 @@ const file_user_service_proto_rawDesc = "" +
 	"secondName\x12\x10\n" +
 	"\x03age\x18\x05 \x01(\x05R\x03age\x12\x16\n" +
 	"\x06salary\x18\x06 \x01(\x01R\x06salary\x12$\n" +
-	"\x0ework_sphere_id\x18\a \x01(\x03R\fworkSphereId\"\xdb\x01\n" +
+	"\x0ework_sphere_id\x18\a \x01(\x03R\fworkSphereId\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\t \x01(\x05R\tweekStart\x12\x1d\n" +
+	"\n" +
+	"salary_day\x18\n" +
+	" \x01(\x05R\tsalaryDay\x12#\n" +
+	"\rincome_source\x18\v \x01(\tR\fincomeSource\"\xda\x02\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
@@ -825,9 +928,16 @@ const file_user_service_proto_rawDesc = "" +
 	"secondName\x12\x10\n" +
 	"\x03age\x18\x05 \x01(\x05R\x03age\x12\x16\n" +
 	"\x06salary\x18\x06 \x01(\x01R\x06salary\x12$\n" +
-	"\x0ework_sphere_id\x18\a \x01(\x03R\fworkSphereId\"<\n" +
+	"\x0ework_sphere_id\x18\a \x01(\x03R\fworkSphereId\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\t \x01(\x05R\tweekStart\x12\x1d\n" +
+	"\n" +
+	"salary_day\x18\n" +
+	" \x01(\x05R\tsalaryDay\x12#\n" +
+	"\rincome_source\x18\v \x01(\tR\fincomeSource\"<\n" +
 	"\x12CreateUserResponse\x12&\n" +
-	"\x04user\x18\x01 \x01(\v2\x12.user_service.UserR\x04user\"\xcf\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.user_service.UserR\x04user\"\x9f\x03\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
@@ -837,7 +947,18 @@ const file_user_service_proto_rawDesc = "" +
 	"secondName\x12\x10\n" +
 	"\x03age\x18\x05 \x01(\x05R\x03age\x12\x16\n" +
 	"\x06salary\x18\x06 \x01(\x01R\x06salary\x12$\n" +
-	"\x0ework_sphere_id\x18\a \x01(\x03R\fworkSphereId\"<\n" +
+	"\x0ework_sphere_id\x18\a \x01(\x03R\fworkSphereId\x12\x1f\n" +
+	"\btimezone\x18\b \x01(\tH\x00R\btimezone\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"week_start\x18\t \x01(\x05H\x01R\tweekStart\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"salary_day\x18\n" +
+	" \x01(\x05H\x02R\tsalaryDay\x88\x01\x01\x12(\n" +
+	"\rincome_source\x18\v \x01(\tH\x03R\fincomeSource\x88\x01\x01B\v\n" +
+	"\t_timezoneB\r\n" +
+	"\v_week_startB\r\n" +
+	"\v_salary_dayB\x10\n" +
+	"\x0e_income_source\"<\n" +
 	"\x12UpdateUserResponse\x12&\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.user_service.UserR\x04user\"F\n" +
 	"\fLoginRequest\x12\x1a\n" +
@@ -925,6 +1046,7 @@ func file_user_service_proto_init() {
 	if File_user_service_proto != nil {
 		return
 	}
+	file_user_service_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -20,4 +20,14 @@ func (h *AnalyticsHandler) RegisterRoutes(router fiber.Router) {
 
 	// All routes require authentication
 	analytics.Get("/recommendations", h.GetRecommendations)
+	analytics.Get("/history", h.GetRecommendationHistory)
+	analytics.Get("/history/trends", h.GetCategoryTrend)
+	analytics.Get("/forecast", h.GetForecast)
+	analytics.Get("/anomalies", h.GetAnomalies)
+	analytics.Get("/subscriptions", h.GetDetectedSubscriptions)
+	analytics.Get("/health", h.GetHealthScore)
+	analytics.Get("/peers", h.GetPeerComparison)
+	analytics.Post("/scenario", h.SimulateScenario)
+	analytics.Post("/budget-plan", h.GenerateBudgetPlan)
+	analytics.Post("/budget-plan/accept", h.AcceptBudgetPlan)
 }
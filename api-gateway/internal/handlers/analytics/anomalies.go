@@ -0,0 +1,42 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetAnomalies godoc
+// @Summary Получить необычные операции
+// @Description Возвращает операции, отмеченные как необычные при создании или изменении, с объяснением. Операция сравнивается с операциями пользователя за последние полгода по медиане и медианному отклонению: large_amount - сумма намного больше обычной для категории (с учетом будних и выходных дней), large_for_title - намного больше обычной для того же получателя, duplicate - та же сумма и название внесены несколькими минутами раньше, new_category - первая операция в категории
+// @Tags analytics
+// @Produce json
+// @Param limit query int false "Число последних отмеченных операций, не больше 200" default(20)
+// @Success 200 {object} map[string]interface{} "Необычные операции"
+// @Failure 400 {object} map[string]interface{} "Неверный лимит"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/anomalies [get]
+func (h *AnalyticsHandler) GetAnomalies(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GetAnomalies(ctx, &analytics_pb.GetAnomaliesReq{
+		UserUid: userID,
+		Limit:   int32(c.QueryInt("limit", 0)),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"anomalies": resp.Anomalies,
+	})
+}
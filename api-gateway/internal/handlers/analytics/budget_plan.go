@@ -0,0 +1,104 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GenerateBudgetPlanRequest struct {
+	Strategy      string  `json:"strategy" example:"50_30_20"`
+	SavingsTarget float64 `json:"savings_target" example:"25000"`
+}
+
+type AcceptBudgetPlanRequest struct {
+	PlanId string `json:"plan_id" example:"3f0c1a9e-5b7d-4c2e-9a61-2d8e4f7b1c05"`
+}
+
+// GenerateBudgetPlan godoc
+// @Summary Составить план бюджета на следующий месяц
+// @Description Предлагает сумму бюджета по каждой категории расходов на следующий календарный месяц. Суммы считаются от средних расходов за последние полные месяцы и рекомендуемых диапазонов для зарплаты. Обязательные платежи (fixed: жилье, коммунальные услуги, кредиты, связь) не сокращаются, по кредитам закладываются не меньше ежемесячных платежей по долгам. Остальные категории делятся на нужды (needs) и желания (wants) и сокращаются не ниже рекомендуемого минимума, чтобы выполнить цель по сбережениям. strategy: balanced (по умолчанию) - пропорциональное сокращение, 50_30_20 - нужды с обязательными платежами до 50% дохода, желания до 30%, сбережения от 20%, zero_based - каждый рубль распределен, остаток уходит в сбережения. savings_target - сколько отложить за месяц, 0 - 10% дохода. План действует сутки, принять его можно через POST /analytics/budget-plan/accept; новый план заменяет предыдущий
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Param request body GenerateBudgetPlanRequest true "Стратегия и цель по сбережениям"
+// @Success 200 {object} map[string]interface{} "План бюджета"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса, неизвестная стратегия или цель не меньше дохода"
+// @Failure 404 {object} map[string]interface{} "Пользователь не найден"
+// @Failure 409 {object} map[string]interface{} "Доход неизвестен или нет расходов для плана"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/budget-plan [post]
+func (h *AnalyticsHandler) GenerateBudgetPlan(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req GenerateBudgetPlanRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GenerateBudgetPlan(ctx, &analytics_pb.GenerateBudgetPlanReq{
+		UserUid:       userID,
+		Strategy:      req.Strategy,
+		SavingsTarget: req.SavingsTarget,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"plan": resp,
+	})
+}
+
+// AcceptBudgetPlan godoc
+// @Summary Принять план бюджета
+// @Description Создает бюджеты на месяц плана из последнего предложенного плана. Бюджеты ранее принятого плана по категориям, которых нет в новом плане, удаляются. Бюджеты, заданные вручную, не удаляются и не перезаписываются, даже если категория есть в плане, - они возвращаются в kept. Возвращает все бюджеты месяца
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Param request body AcceptBudgetPlanRequest true "Идентификатор плана"
+// @Success 200 {object} map[string]interface{} "Созданные бюджеты"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 404 {object} map[string]interface{} "План не найден, истек или заменен новым"
+// @Failure 409 {object} map[string]interface{} "Категория из плана перенесена в архив"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/budget-plan/accept [post]
+func (h *AnalyticsHandler) AcceptBudgetPlan(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req AcceptBudgetPlanRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.AcceptBudgetPlan(ctx, &analytics_pb.AcceptBudgetPlanReq{
+		UserUid: userID,
+		PlanId:  req.PlanId,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"accepted": resp,
+	})
+}
@@ -0,0 +1,55 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReplayDeadLettersRequest struct {
+	Topic string `json:"topic" example:"analytics.dlq"`
+	Limit int32  `json:"limit" example:"100"`
+}
+
+// ReplayDeadLetters godoc
+// @Summary Вернуть события аналитики из dead-letter топика
+// @Description События, которые не удалось обработать после всех повторов, попадают в dead-letter топик (например analytics.dlq) с причиной ошибки в заголовках. Метод отправляет их обратно в исходные топики, например после восстановления user-service. topic - dead-letter топик, пустой - все топики сервиса. limit - сколько сообщений отправить, по умолчанию 100, не больше 1000. Каждое сообщение возвращается один раз. Доступно только администраторам
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body ReplayDeadLettersRequest false "Топик и число сообщений"
+// @Success 200 {object} map[string]interface{} "Сколько сообщений возвращено"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса, неизвестный топик или limit вне диапазона"
+// @Failure 403 {object} map[string]interface{} "Нет прав администратора"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /admin/analytics/dlq/replay [post]
+func (h *AnalyticsHandler) ReplayDeadLetters(c *fiber.Ctx) error {
+	var req ReplayDeadLettersRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid request body",
+			})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.ReplayDeadLetters(ctx, &analytics_pb.ReplayDeadLettersReq{
+		Topic: req.Topic,
+		Limit: req.Limit,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"replayed": resp.Replayed,
+	})
+}
@@ -0,0 +1,40 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetForecast godoc
+// @Summary Получить прогноз расходов на конец месяца
+// @Description Прогнозирует расходы по категориям на конец текущего календарного месяца в часовом поясе пользователя: по темпу трат с начала месяца, уже внесенным будущим расходам и тратам в оставшиеся дни прошлых месяцев. Для каждой категории возвращается 80% интервал и вероятность превысить ее бюджет на месяц (PUT /funds/budgets), а если бюджет не задан - рекомендуемый максимум; для всех расходов - вероятность превысить зарплату. Когда превышение становится вероятным в середине месяца, пользователь получает push-уведомление
+// @Tags analytics
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Прогноз расходов"
+// @Failure 404 {object} map[string]interface{} "Пользователь не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/forecast [get]
+func (h *AnalyticsHandler) GetForecast(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GetForecast(ctx, &analytics_pb.GetForecastReq{
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"forecast": resp,
+	})
+}
@@ -2,6 +2,7 @@ package analytics
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
@@ -11,25 +12,65 @@ import (
 
 // GetRecommendations godoc
 // @Summary Получить рекомендации пользователя
-// @Description Получает финансовые рекомендации для пользователя на основе его транзакций и категорий расходов
+// @Description Получает финансовые рекомендации для пользователя на основе его транзакций и категорий расходов, а также оценку долговой нагрузки (доля платежей по долгам от зарплаты). С ledger_id рекомендации считаются для общего бюджета: по сумме зарплат участников и расходам всех участников. По умолчанию используются расходы за последние 30 дней, с period рекомендации считаются за выбранный период в часовом поясе пользователя. Зарплата берется из профиля или, если в профиле выбран income_source=transactions, из среднего фактического дохода по операциям (income_source в ответе). В income - фактический доход за прошлые полные месяцы, стабильность дохода, норма сбережений и на сколько месяцев расходов хватит баланса
 // @Tags analytics
 // @Accept json
 // @Produce json
+// @Param ledger_id query int false "ID общего бюджета"
+// @Param period query string false "Период: days, week, month, quarter, salary_month, custom"
+// @Param period_offset query int false "Смещение периода: 0 - текущий, -1 - предыдущий" default(0)
+// @Param days query int false "Длина окна для period=days" default(30)
+// @Param from query string false "Начало периода (YYYY-MM-DD) для period=custom"
+// @Param to query string false "Конец периода включительно (YYYY-MM-DD) для period=custom"
 // @Success 200 {object} map[string]interface{} "Рекомендации пользователя"
+// @Failure 400 {object} map[string]interface{} "Неверный ID бюджета или период"
+// @Failure 403 {object} map[string]interface{} "Пользователь не участник бюджета"
+// @Failure 404 {object} map[string]interface{} "Рекомендации еще не рассчитаны или бюджет не найден"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Security BearerAuth
 // @Router /analytics/recommendations [get]
 func (h *AnalyticsHandler) GetRecommendations(c *fiber.Ctx) error {
 	userID := c.Locals(middleware.UserIDKey).(string)
 
+	var ledgerID int64
+	if raw := c.Query("ledger_id"); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid ledger id",
+			})
+		}
+		ledgerID = id
+	}
+
+	var period *analytics_pb.Period
+	if kind := c.Query("period"); kind != "" {
+		offset, err := strconv.Atoi(c.Query("period_offset", "0"))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid period offset",
+			})
+		}
+
+		period = &analytics_pb.Period{
+			Kind:     kind,
+			Offset:   int32(offset),
+			Days:     int32(c.QueryInt("days", 30)),
+			DateFrom: c.Query("from"),
+			DateTo:   c.Query("to"),
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
 	defer cancel()
 
 	resp, err := h.clients.AnalyticsService.GetRecommendations(ctx, &analytics_pb.GetRecommendationsReq{
-		UserUid: userID,
+		UserUid:  userID,
+		LedgerId: ledgerID,
+		Period:   period,
 	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -47,5 +88,12 @@ func (h *AnalyticsHandler) GetRecommendations(c *fiber.Ctx) error {
 		"overall_message":  resp.OverallMessage,
 		"recommendations":  resp.Recommendations,
 		"calculated_at":    resp.CalculatedAt,
+		"debt":             resp.Debt,
+		"ledger_id":        resp.LedgerId,
+		"period_from":      resp.PeriodFrom,
+		"period_to":        resp.PeriodTo,
+		"rules_version":    resp.RulesVersion,
+		"income_source":    resp.IncomeSource,
+		"income":           resp.Income,
 	})
 }
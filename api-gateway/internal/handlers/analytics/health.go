@@ -0,0 +1,43 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetHealthScore godoc
+// @Summary Получить оценку финансового здоровья
+// @Description Сводит норму сбережений, соблюдение рекомендаций по категориям, долговую нагрузку, разброс расходов по месяцам и финансовую подушку в одну оценку от 0 до 100. Для каждого показателя возвращаются значение, оценка, вес и пояснение; показатели без данных не учитываются, а веса остальных пересчитываются. Также возвращаются до трех действий, которые сильнее всего поднимут оценку, и оценки за прошлые месяцы
+// @Tags analytics
+// @Produce json
+// @Param months query int false "Число последних месяцев истории, включая текущий, не больше 24" default(6)
+// @Success 200 {object} map[string]interface{} "Оценка финансового здоровья"
+// @Failure 400 {object} map[string]interface{} "Неверное число месяцев"
+// @Failure 404 {object} map[string]interface{} "Пользователь не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/health [get]
+func (h *AnalyticsHandler) GetHealthScore(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GetHealthScore(ctx, &analytics_pb.GetHealthScoreReq{
+		UserUid: userID,
+		Months:  int32(c.QueryInt("months", 0)),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"health": resp,
+	})
+}
@@ -0,0 +1,76 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetRecommendationHistory godoc
+// @Summary Получить историю рекомендаций
+// @Description Возвращает рекомендации пользователя по месяцам, чтобы видеть, улучшается ли ситуация. За месяц берутся последние рассчитанные рекомендации с концом периода в этом месяце, месяцы без рекомендаций пропускаются. Рекомендации общих бюджетов в историю не попадают
+// @Tags analytics
+// @Produce json
+// @Param months query int false "Число последних месяцев, включая текущий, не больше 24" default(6)
+// @Success 200 {object} map[string]interface{} "Рекомендации по месяцам"
+// @Failure 400 {object} map[string]interface{} "Неверное число месяцев"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/history [get]
+func (h *AnalyticsHandler) GetRecommendationHistory(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GetRecommendationHistory(ctx, &analytics_pb.GetRecommendationHistoryReq{
+		UserUid: userID,
+		Months:  int32(c.QueryInt("months", 0)),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"snapshots": resp.Snapshots,
+	})
+}
+
+// GetCategoryTrend godoc
+// @Summary Получить динамику расходов по категориям
+// @Description Возвращает процент от зарплаты и статус каждой категории по месяцам и направление изменения за последний месяц: improving - доля снизилась хотя бы на 0.5 п.п., worsening - выросла, stable - изменилась меньше, new - данных только за один месяц
+// @Tags analytics
+// @Produce json
+// @Param months query int false "Число последних месяцев, включая текущий, не больше 24" default(6)
+// @Param category query string false "Код категории, по умолчанию все категории"
+// @Success 200 {object} map[string]interface{} "Динамика по категориям"
+// @Failure 400 {object} map[string]interface{} "Неверное число месяцев"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/history/trends [get]
+func (h *AnalyticsHandler) GetCategoryTrend(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GetCategoryTrend(ctx, &analytics_pb.GetCategoryTrendReq{
+		UserUid:      userID,
+		Months:       int32(c.QueryInt("months", 0)),
+		CategoryCode: c.Query("category"),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"trends": resp.Trends,
+	})
+}
@@ -0,0 +1,40 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetPeerComparison godoc
+// @Summary Сравнить расходы с похожими пользователями
+// @Description Сравнивает расходы за последние 30 дней по категориям с пользователями того же диапазона зарплаты, возраста и сферы работы. Если в такой группе мало людей, используется более широкая: без сферы работы (salary_age), затем только по зарплате (salary). Для каждой категории возвращаются медиана группы, доля тративших и процент пользователей, потративших меньше. Данные других пользователей не раскрываются: группы пересчитываются раз в час, хранятся только процентили, группы меньше 20 человек и категории, в которых тратили меньше 5 человек, не публикуются
+// @Tags analytics
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Сравнение с похожими пользователями"
+// @Failure 404 {object} map[string]interface{} "Пользователь не найден или недостаточно похожих пользователей"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/peers [get]
+func (h *AnalyticsHandler) GetPeerComparison(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GetPeerComparison(ctx, &analytics_pb.GetPeerComparisonReq{
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"comparison": resp,
+	})
+}
@@ -0,0 +1,35 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetRecomputeMetrics godoc
+// @Summary Получить метрики пересчета аналитики
+// @Description Аналитика пересчитывается не на каждое событие, а после паузы в событиях пользователя, но не позже заданной задержки после первого из них. Возвращает счетчики по всем репликам: событий (events), событий, присоединившихся к уже запланированному пересчету (coalesced), выполненных пересчетов и неудачных попыток, пересчетов, отброшенных после всех повторов (dropped), а также число пользователей в очереди и в обработке. Доступно только администраторам
+// @Tags admin
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Метрики пересчета"
+// @Failure 403 {object} map[string]interface{} "Нет прав администратора"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /admin/analytics/recompute/metrics [get]
+func (h *AnalyticsHandler) GetRecomputeMetrics(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GetRecomputeMetrics(ctx, &analytics_pb.GetRecomputeMetricsReq{})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"metrics": resp,
+	})
+}
@@ -0,0 +1,144 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RuleThresholds struct {
+	CriticalMultiplier  float64 `json:"critical_multiplier" example:"1.2"`
+	WarningMultiplier   float64 `json:"warning_multiplier" example:"1"`
+	ExcellentMultiplier float64 `json:"excellent_multiplier" example:"1"`
+}
+
+type RuleCategoryRange struct {
+	CategoryCode string  `json:"category_code" validate:"required" example:"groceries"`
+	MinPercent   float64 `json:"min_percent" example:"35"`
+	MaxPercent   float64 `json:"max_percent" example:"45"`
+}
+
+type SalaryBracket struct {
+	MinSalary  float64             `json:"min_salary" example:"0"`
+	MaxSalary  float64             `json:"max_salary" example:"20000"`
+	Categories []RuleCategoryRange `json:"categories"`
+}
+
+type UpdateRulesRequest struct {
+	BaseVersion int64           `json:"base_version" validate:"required" example:"3"`
+	Thresholds  RuleThresholds  `json:"thresholds"`
+	Brackets    []SalaryBracket `json:"brackets" validate:"required"`
+}
+
+// RegisterAdminRoutes registers recommendation rules management and monitoring routes, the router must be restricted to admins
+func (h *AnalyticsHandler) RegisterAdminRoutes(router fiber.Router) {
+	router.Get("/analytics/rules", h.GetRules)
+	router.Put("/analytics/rules", h.UpdateRules)
+	router.Get("/analytics/recompute/metrics", h.GetRecomputeMetrics)
+	router.Post("/analytics/dlq/replay", h.ReplayDeadLetters)
+}
+
+// GetRules godoc
+// @Summary Получить правила рекомендаций
+// @Description Возвращает диапазоны зарплат с рекомендуемыми долями расходов по категориям и пороги статусов. Без version возвращает действующие правила, с version - одну из прошлых версий, например указанную в rules_version рекомендаций. Доступно только администраторам
+// @Tags admin
+// @Produce json
+// @Param version query int false "Версия правил, по умолчанию действующая"
+// @Success 200 {object} map[string]interface{} "Правила рекомендаций"
+// @Failure 400 {object} map[string]interface{} "Неверная версия"
+// @Failure 403 {object} map[string]interface{} "Нет прав администратора"
+// @Failure 404 {object} map[string]interface{} "Версия правил не найдена"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /admin/analytics/rules [get]
+func (h *AnalyticsHandler) GetRules(c *fiber.Ctx) error {
+	version := c.QueryInt("version", 0)
+	if version < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid version",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GetRecommendationRules(ctx, &analytics_pb.GetRecommendationRulesReq{
+		Version: int64(version),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"rules": resp,
+	})
+}
+
+// UpdateRules godoc
+// @Summary Изменить правила рекомендаций
+// @Description Сохраняет правила новой версией. Диапазоны зарплат должны идти подряд с нуля без пропусков и пересечений, max_salary 0 допустим только у последнего диапазона. base_version - версия, от которой сделано изменение: если правила за это время уже изменили, вернется 409. Рекомендации, рассчитанные по прежним правилам, обновятся при следующем пересчете. Доступно только администраторам
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body UpdateRulesRequest true "Новые правила"
+// @Success 200 {object} map[string]interface{} "Правила сохранены"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса или правила не прошли проверку"
+// @Failure 403 {object} map[string]interface{} "Нет прав администратора"
+// @Failure 409 {object} map[string]interface{} "Правила уже изменили после base_version"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /admin/analytics/rules [put]
+func (h *AnalyticsHandler) UpdateRules(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req UpdateRulesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	brackets := make([]*analytics_pb.SalaryBracket, 0, len(req.Brackets))
+	for _, b := range req.Brackets {
+		bracket := &analytics_pb.SalaryBracket{
+			MinSalary: b.MinSalary,
+			MaxSalary: b.MaxSalary,
+		}
+		for _, cr := range b.Categories {
+			bracket.Categories = append(bracket.Categories, &analytics_pb.RuleCategoryRange{
+				CategoryCode: cr.CategoryCode,
+				MinPercent:   cr.MinPercent,
+				MaxPercent:   cr.MaxPercent,
+			})
+		}
+		brackets = append(brackets, bracket)
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.UpdateRecommendationRules(ctx, &analytics_pb.UpdateRecommendationRulesReq{
+		BaseVersion: req.BaseVersion,
+		UpdatedBy:   userID,
+		Thresholds: &analytics_pb.RuleThresholds{
+			CriticalMultiplier:  req.Thresholds.CriticalMultiplier,
+			WarningMultiplier:   req.Thresholds.WarningMultiplier,
+			ExcellentMultiplier: req.Thresholds.ExcellentMultiplier,
+		},
+		Brackets: brackets,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"rules": resp,
+	})
+}
@@ -0,0 +1,87 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ScenarioCategoryAdjustment struct {
+	CategoryCode  string  `json:"category_code" example:"restaurants"`
+	PercentChange float64 `json:"percent_change" example:"-30"`
+	AmountChange  float64 `json:"amount_change" example:"0"`
+}
+
+type ScenarioRecurringExpense struct {
+	CategoryCode  string  `json:"category_code" example:"entertainment"`
+	Title         string  `json:"title" example:"Онлайн-кинотеатр"`
+	MonthlyAmount float64 `json:"monthly_amount" example:"599"`
+}
+
+type SimulateScenarioRequest struct {
+	Salary            float64                      `json:"salary" example:"120000"`
+	Categories        []ScenarioCategoryAdjustment `json:"categories"`
+	RecurringExpenses []ScenarioRecurringExpense   `json:"recurring_expenses"`
+}
+
+// SimulateScenario godoc
+// @Summary Смоделировать изменение бюджета
+// @Description Показывает, что будет, если изменить зарплату или расходы: рекомендации за последние 30 дней, оценка финансового здоровья и прогноз на текущий месяц пересчитываются с изменениями и сравниваются с текущими. Ничего не сохраняется. salary - новая месячная зарплата, 0 - без изменений; при смене диапазона зарплаты salary_bracket_changed равен true. categories - изменения расходов по кодам категорий: percent_change в процентах (не меньше -100), затем amount_change в рублях за месяц. recurring_expenses - новые регулярные расходы с суммой в месяц. В прогнозе уже потраченное не меняется: процент применяется к оставшейся части месяца, amount_change - пропорционально оставшимся дням, новый регулярный расход учитывается целиком. В categories ответа только изменившиеся категории, simulated - рекомендации после изменений целиком
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Param request body SimulateScenarioRequest true "Изменения зарплаты и расходов"
+// @Success 200 {object} map[string]interface{} "Разница между текущей аналитикой и аналитикой после изменений"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса, пустой сценарий или неизвестная категория"
+// @Failure 404 {object} map[string]interface{} "Пользователь не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/scenario [post]
+func (h *AnalyticsHandler) SimulateScenario(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req SimulateScenarioRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	pbReq := &analytics_pb.SimulateScenarioReq{
+		UserUid:           userID,
+		Salary:            req.Salary,
+		Categories:        make([]*analytics_pb.CategoryAdjustment, 0, len(req.Categories)),
+		RecurringExpenses: make([]*analytics_pb.RecurringExpense, 0, len(req.RecurringExpenses)),
+	}
+	for _, a := range req.Categories {
+		pbReq.Categories = append(pbReq.Categories, &analytics_pb.CategoryAdjustment{
+			CategoryCode:  a.CategoryCode,
+			PercentChange: a.PercentChange,
+			AmountChange:  a.AmountChange,
+		})
+	}
+	for _, e := range req.RecurringExpenses {
+		pbReq.RecurringExpenses = append(pbReq.RecurringExpenses, &analytics_pb.RecurringExpense{
+			CategoryCode:  e.CategoryCode,
+			Title:         e.Title,
+			MonthlyAmount: e.MonthlyAmount,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.SimulateScenario(ctx, pbReq)
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"scenario": resp,
+	})
+}
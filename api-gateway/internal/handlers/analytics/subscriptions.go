@@ -0,0 +1,40 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	analytics_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/analytics_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetDetectedSubscriptions godoc
+// @Summary Получить найденные подписки
+// @Description Находит регулярные платежи в расходах за последние 400 дней: расходы с одним названием и близкой суммой, которые повторяются раз в неделю (weekly), месяц (monthly) или год (yearly). Для каждой подписки возвращаются стоимость в месяц, дата следующего списания и изменения цены. Если очередное списание пропущено, подписка получает статус possibly_cancelled и не учитывается в общей стоимости
+// @Tags analytics
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Найденные подписки"
+// @Failure 404 {object} map[string]interface{} "Пользователь не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /analytics/subscriptions [get]
+func (h *AnalyticsHandler) GetDetectedSubscriptions(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AnalyticsService.GetDetectedSubscriptions(ctx, &analytics_pb.GetDetectedSubscriptionsReq{
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"subscriptions": resp,
+	})
+}
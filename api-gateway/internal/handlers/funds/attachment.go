@@ -0,0 +1,314 @@
+package funds
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+	"time"
+
+	funds_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/funds_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// attachmentChunkSize is the size of the chunks streamed to funds-service on upload
+const attachmentChunkSize = 64 * 1024
+
+// UploadAttachment godoc
+// @Summary Прикрепить файл к транзакции
+// @Description Загружает чек или документ к транзакции. Тип файла определяется по содержимому и должен входить в список разрешенных; для изображений создается миниатюра. Размер файла и суммарный объем вложений пользователя ограничены
+// @Tags funds
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "ID транзакции"
+// @Param file formData file true "Файл вложения"
+// @Success 201 {object} map[string]interface{} "Вложение успешно загружено"
+// @Failure 400 {object} map[string]interface{} "Неверный запрос или недопустимый тип файла"
+// @Failure 403 {object} map[string]interface{} "Транзакция принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Транзакция не найдена"
+// @Failure 409 {object} map[string]interface{} "Транзакция находится в корзине"
+// @Failure 413 {object} map[string]interface{} "Файл слишком большой или превышена квота"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/transactions/{id}/attachments [post]
+func (h *FundsHandler) UploadAttachment(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	transactionIDStr := c.Params("id")
+	transactionID, err := strconv.ParseInt(transactionIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid transaction id",
+		})
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "file is required",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "failed to read file",
+		})
+	}
+	defer file.Close()
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	stream, err := h.clients.AttachmentService.UploadAttachment(ctx)
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	err = stream.Send(&funds_pb.UploadAttachmentRequest{
+		Payload: &funds_pb.UploadAttachmentRequest_Info{
+			Info: &funds_pb.AttachmentUploadInfo{
+				TransactionId: transactionID,
+				UserUid:       userID,
+				FileName:      fileHeader.Filename,
+				ContentType:   fileHeader.Header.Get("Content-Type"),
+				Size:          fileHeader.Size,
+			},
+		},
+	})
+
+	buf := make([]byte, attachmentChunkSize)
+	for err == nil {
+		var n int
+		n, err = file.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&funds_pb.UploadAttachmentRequest{
+				Payload: &funds_pb.UploadAttachmentRequest_Chunk{Chunk: buf[:n]},
+			}); sendErr != nil {
+				err = sendErr
+			}
+		}
+	}
+
+	// Send fails with io.EOF once the server has rejected the upload, the real error comes from CloseAndRecv
+	if !errors.Is(err, io.EOF) {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fmt.Sprintf("failed to upload file: %v", err),
+		})
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"attachment": resp.Attachment,
+	})
+}
+
+// GetTransactionAttachments godoc
+// @Summary Получить вложения транзакции
+// @Description Получает список файлов, прикрепленных к транзакции
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID транзакции"
+// @Success 200 {object} map[string]interface{} "Список вложений"
+// @Failure 400 {object} map[string]interface{} "Неверный ID транзакции"
+// @Failure 403 {object} map[string]interface{} "Транзакция принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Транзакция не найдена"
+// @Failure 409 {object} map[string]interface{} "Транзакция находится в корзине"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/transactions/{id}/attachments [get]
+func (h *FundsHandler) GetTransactionAttachments(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	transactionIDStr := c.Params("id")
+	transactionID, err := strconv.ParseInt(transactionIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid transaction id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AttachmentService.GetTransactionAttachments(ctx, &funds_pb.GetTransactionAttachmentsRequest{
+		TransactionId: transactionID,
+		UserUid:       userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"attachments": resp.Attachments,
+	})
+}
+
+// DownloadAttachment godoc
+// @Summary Скачать вложение
+// @Description Возвращает содержимое вложения. При thumbnail=true возвращает JPEG-миниатюру изображения
+// @Tags funds
+// @Produce octet-stream
+// @Param id path int true "ID вложения"
+// @Param thumbnail query bool false "Скачать миниатюру" default(false)
+// @Success 200 {file} file "Содержимое файла"
+// @Failure 400 {object} map[string]interface{} "Неверный ID вложения"
+// @Failure 403 {object} map[string]interface{} "Вложение принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Вложение или миниатюра не найдены"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/attachments/{id} [get]
+func (h *FundsHandler) DownloadAttachment(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	attachmentIDStr := c.Params("id")
+	attachmentID, err := strconv.ParseInt(attachmentIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid attachment id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	stream, err := h.clients.AttachmentService.DownloadAttachment(ctx, &funds_pb.DownloadAttachmentRequest{
+		Id:        attachmentID,
+		UserUid:   userID,
+		Thumbnail: c.QueryBool("thumbnail", false),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	// Errors of server streams arrive with the first Recv
+	first, err := stream.Recv()
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	info := first.GetInfo()
+	if info == nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "attachment info is missing",
+		})
+	}
+
+	var content bytes.Buffer
+	content.Grow(int(info.Size))
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		content.Write(msg.GetChunk())
+	}
+
+	c.Set(fiber.HeaderContentType, info.ContentType)
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{
+		"filename": info.FileName,
+	}))
+
+	return c.Status(fiber.StatusOK).Send(content.Bytes())
+}
+
+// DeleteAttachment godoc
+// @Summary Удалить вложение
+// @Description Удаляет вложение и его миниатюру, освобождая место в квоте пользователя
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID вложения"
+// @Success 200 {object} map[string]interface{} "Вложение успешно удалено"
+// @Failure 400 {object} map[string]interface{} "Неверный ID вложения"
+// @Failure 403 {object} map[string]interface{} "Вложение принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Вложение не найдено"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/attachments/{id} [delete]
+func (h *FundsHandler) DeleteAttachment(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	attachmentIDStr := c.Params("id")
+	attachmentID, err := strconv.ParseInt(attachmentIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid attachment id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AttachmentService.DeleteAttachment(ctx, &funds_pb.DeleteAttachmentRequest{
+		Id:      attachmentID,
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": resp.Success,
+	})
+}
+
+// GetAttachmentUsage godoc
+// @Summary Получить использование хранилища вложений
+// @Description Возвращает занятый объем, квоту пользователя, максимальный размер файла и разрешенные типы файлов
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Использование хранилища"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/attachments/usage [get]
+func (h *FundsHandler) GetAttachmentUsage(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.AttachmentService.GetAttachmentUsage(ctx, &funds_pb.GetAttachmentUsageRequest{
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"used_bytes":            resp.UsedBytes,
+		"quota_bytes":           resp.QuotaBytes,
+		"count":                 resp.Count,
+		"max_file_size":         resp.MaxFileSize,
+		"allowed_content_types": resp.AllowedContentTypes,
+	})
+}
@@ -38,3 +38,62 @@ func (h *FundsHandler) GetUserBalance(c *fiber.Ctx) error {
 		"balance": resp.Balance,
 	})
 }
+
+// GetBalanceHistory godoc
+// @Summary Получить историю баланса
+// @Description Возвращает временной ряд баланса для графика капитала: баланс на начало и конец каждого дня, недели, месяца или года, а также доходы и расходы за этот интервал. Ряд строится по ежедневным снимкам баланса в часовом поясе пользователя. По умолчанию - последние 30 дней по дням
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param granularity query string false "Интервал: day, week, month, year" default(day)
+// @Param period query string false "Период: days, week, month, quarter, salary_month, custom"
+// @Param period_offset query int false "Смещение периода: 0 - текущий, -1 - предыдущий" default(0)
+// @Param days query int false "Длина окна для period=days" default(30)
+// @Param from query string false "Начало периода (YYYY-MM-DD)"
+// @Param to query string false "Конец периода включительно (YYYY-MM-DD)"
+// @Success 200 {object} map[string]interface{} "История баланса"
+// @Failure 400 {object} map[string]interface{} "Неверные параметры запроса"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/balance/history [get]
+func (h *FundsHandler) GetBalanceHistory(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	period, err := periodFromQuery(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	calendar, err := h.userCalendar(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	resp, err := h.clients.FundsService.GetBalanceHistory(ctx, &funds_pb.GetBalanceHistoryRequest{
+		UserUid:     userID,
+		DateFrom:    c.Query("from"),
+		DateTo:      c.Query("to"),
+		Granularity: c.Query("granularity", "day"),
+		Period:      period,
+		Calendar:    calendar,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"granularity": resp.Granularity,
+		"date_from":   resp.DateFrom,
+		"date_to":     resp.DateTo,
+		"points":      resp.Points,
+	})
+}
@@ -0,0 +1,209 @@
+package funds
+
+import (
+	"context"
+	"time"
+
+	funds_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/funds_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BatchCreateTransactionsRequest struct {
+	Items        []CreateTransactionRequest `json:"items" validate:"required"`
+	AllOrNothing bool                       `json:"all_or_nothing" example:"false"`
+}
+
+type TransactionPatchRequest struct {
+	Id              int64   `json:"id" validate:"required" example:"1"`
+	CategoryId      *int32  `json:"category_id,omitempty" example:"3"`
+	Title           *string `json:"title,omitempty" example:"Продукты"`
+	ShiftDays       int32   `json:"shift_days" example:"-1"`
+	ExpectedVersion int64   `json:"expected_version" example:"2"`
+}
+
+type BatchUpdateTransactionsRequest struct {
+	Items        []TransactionPatchRequest `json:"items" validate:"required"`
+	AllOrNothing bool                      `json:"all_or_nothing" example:"false"`
+}
+
+type BatchDeleteTransactionsRequest struct {
+	Ids          []int64 `json:"ids" validate:"required" example:"1,2,3"`
+	AllOrNothing bool    `json:"all_or_nothing" example:"false"`
+}
+
+// batchStatus returns 200 when every item was applied, 207 when some items failed
+// and 422 when nothing was applied
+func batchStatus(committed bool, errorsCount int) int {
+	switch {
+	case !committed:
+		return fiber.StatusUnprocessableEntity
+	case errorsCount > 0:
+		return fiber.StatusMultiStatus
+	default:
+		return fiber.StatusOK
+	}
+}
+
+// BatchCreateTransactions godoc
+// @Summary Создать транзакции пакетом
+// @Description Создает до 500 транзакций в одной транзакции БД с одним обновлением баланса. Ошибки возвращаются по каждому элементу; при all_or_nothing=true любая ошибка отменяет весь пакет
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param request body BatchCreateTransactionsRequest true "Транзакции"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
+// @Success 200 {object} map[string]interface{} "Все транзакции созданы"
+// @Success 207 {object} map[string]interface{} "Часть транзакций создана"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 422 {object} map[string]interface{} "Ни одна транзакция не создана"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/transactions/batch [post]
+func (h *FundsHandler) BatchCreateTransactions(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req BatchCreateTransactionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	items := make([]*funds_pb.CreateTransactionRequest, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, &funds_pb.CreateTransactionRequest{
+			CategoryId:      item.CategoryId,
+			Type:            item.Type,
+			Amount:          item.Amount,
+			Title:           item.Title,
+			Description:     item.Description,
+			TransactionDate: item.TransactionDate,
+			Tags:            item.Tags,
+			LedgerId:        item.LedgerId,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.BatchCreateTransactions(ctx, &funds_pb.BatchCreateTransactionsRequest{
+		UserUid:      userID,
+		Items:        items,
+		AllOrNothing: req.AllOrNothing,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(batchStatus(resp.Committed, len(resp.Errors))).JSON(fiber.Map{
+		"transactions": resp.Transactions,
+		"errors":       resp.Errors,
+		"committed":    resp.Committed,
+	})
+}
+
+// BatchUpdateTransactions godoc
+// @Summary Изменить транзакции пакетом
+// @Description Меняет категорию, название или сдвигает дату у нескольких транзакций в одной транзакции БД. Ошибки возвращаются по каждому элементу; при all_or_nothing=true любая ошибка отменяет весь пакет
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param request body BatchUpdateTransactionsRequest true "Изменения транзакций"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
+// @Success 200 {object} map[string]interface{} "Все транзакции изменены"
+// @Success 207 {object} map[string]interface{} "Часть транзакций изменена"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 422 {object} map[string]interface{} "Ни одна транзакция не изменена"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/transactions/batch [patch]
+func (h *FundsHandler) BatchUpdateTransactions(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req BatchUpdateTransactionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	items := make([]*funds_pb.TransactionPatch, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, &funds_pb.TransactionPatch{
+			Id:              item.Id,
+			CategoryId:      item.CategoryId,
+			Title:           item.Title,
+			ShiftDays:       item.ShiftDays,
+			ExpectedVersion: item.ExpectedVersion,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.BatchUpdateTransactions(ctx, &funds_pb.BatchUpdateTransactionsRequest{
+		UserUid:      userID,
+		Items:        items,
+		AllOrNothing: req.AllOrNothing,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(batchStatus(resp.Committed, len(resp.Errors))).JSON(fiber.Map{
+		"transactions": resp.Transactions,
+		"errors":       resp.Errors,
+		"committed":    resp.Committed,
+	})
+}
+
+// BatchDeleteTransactions godoc
+// @Summary Удалить транзакции пакетом
+// @Description Перемещает несколько транзакций в корзину в одной транзакции БД с одним обновлением баланса. Ошибки возвращаются по каждому элементу; при all_or_nothing=true любая ошибка отменяет весь пакет
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param request body BatchDeleteTransactionsRequest true "ID транзакций"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
+// @Success 200 {object} map[string]interface{} "Все транзакции удалены"
+// @Success 207 {object} map[string]interface{} "Часть транзакций удалена"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 422 {object} map[string]interface{} "Ни одна транзакция не удалена"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/transactions/batch/delete [post]
+func (h *FundsHandler) BatchDeleteTransactions(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req BatchDeleteTransactionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.BatchDeleteTransactions(ctx, &funds_pb.BatchDeleteTransactionsRequest{
+		UserUid:      userID,
+		Ids:          req.Ids,
+		AllOrNothing: req.AllOrNothing,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(batchStatus(resp.Committed, len(resp.Errors))).JSON(fiber.Map{
+		"deleted_ids": resp.DeletedIds,
+		"errors":      resp.Errors,
+		"committed":   resp.Committed,
+	})
+}
@@ -0,0 +1,109 @@
+package funds
+
+import (
+	"context"
+	"time"
+
+	funds_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/funds_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BudgetItemRequest struct {
+	CategoryId int32   `json:"category_id" example:"5"`
+	Amount     float64 `json:"amount" example:"15000"`
+}
+
+type SetBudgetsRequest struct {
+	Month   string              `json:"month" example:"2025-02"` // YYYY-MM
+	Items   []BudgetItemRequest `json:"items"`
+	Replace bool                `json:"replace" example:"false"` // Удалить бюджеты месяца по категориям, которых нет в items
+}
+
+// GetUserBudgets godoc
+// @Summary Получить бюджеты на месяц
+// @Description Возвращает бюджеты пользователя по категориям расходов на месяц. Поле source показывает, задан бюджет вручную (manual) или создан из принятого плана бюджета (plan). Названия категорий возвращаются на языке из заголовка Accept-Language
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param month query string true "Месяц в формате YYYY-MM"
+// @Param Accept-Language header string false "Предпочитаемые языки названий, например en-US,en;q=0.9"
+// @Success 200 {object} map[string]interface{} "Бюджеты на месяц"
+// @Failure 400 {object} map[string]interface{} "Неверный формат месяца"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/budgets [get]
+func (h *FundsHandler) GetUserBudgets(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.BudgetService.GetUserBudgets(ctx, &funds_pb.GetUserBudgetsRequest{
+		UserUid: userID,
+		Month:   c.Query("month"),
+		Locales: acceptLanguages(c),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"budgets": resp.Budgets,
+	})
+}
+
+// SetBudgets godoc
+// @Summary Задать бюджеты на месяц
+// @Description Задает суммы бюджетов по категориям расходов на месяц, существующие бюджеты этих категорий перезаписываются. С replace=true бюджеты месяца по категориям, которых нет в items, удаляются. Возвращает все бюджеты месяца
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param request body SetBudgetsRequest true "Бюджеты на месяц"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
+// @Success 200 {object} map[string]interface{} "Бюджеты на месяц"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса, месяц, сумма или категория не является категорией расходов"
+// @Failure 404 {object} map[string]interface{} "Категория не найдена"
+// @Failure 409 {object} map[string]interface{} "Категория в архиве"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/budgets [put]
+func (h *FundsHandler) SetBudgets(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req SetBudgetsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	items := make([]*funds_pb.BudgetItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, &funds_pb.BudgetItem{
+			CategoryId: item.CategoryId,
+			Amount:     item.Amount,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.BudgetService.SetBudgets(ctx, &funds_pb.SetBudgetsRequest{
+		UserUid: userID,
+		Month:   req.Month,
+		Items:   items,
+		Replace: req.Replace,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"budgets": resp.Budgets,
+	})
+}
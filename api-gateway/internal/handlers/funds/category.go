@@ -11,10 +11,12 @@ import (
 
 // GetAllCategories godoc
 // @Summary Получить все категории
-// @Description Получает список всех категорий транзакций
+// @Description Получает список всех категорий транзакций. Названия возвращаются на языке из заголовка Accept-Language, при отсутствии перевода - на русском. Поле code - стабильный идентификатор категории, не зависящий от языка
 // @Tags funds
 // @Accept json
 // @Produce json
+// @Param Accept-Language header string false "Предпочитаемые языки названий, например en-US,en;q=0.9"
+// @Param include_archived query bool false "Включить архивные категории" default(false)
 // @Success 200 {object} map[string]interface{} "Список категорий"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Security BearerAuth
@@ -23,9 +25,12 @@ func (h *FundsHandler) GetAllCategories(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
 	defer cancel()
 
-	resp, err := h.clients.FundsService.GetAllCategories(ctx, &funds_pb.GetAllCategoriesRequest{})
+	resp, err := h.clients.FundsService.GetAllCategories(ctx, &funds_pb.GetAllCategoriesRequest{
+		Locales:         acceptLanguages(c),
+		IncludeArchived: c.QueryBool("include_archived", false),
+	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -37,11 +42,12 @@ func (h *FundsHandler) GetAllCategories(c *fiber.Ctx) error {
 
 // GetCategoriesByType godoc
 // @Summary Получить категории по типу
-// @Description Получает список категорий по типу (income или expense)
+// @Description Получает список активных категорий по типу (income или expense) с названиями на языке из заголовка Accept-Language
 // @Tags funds
 // @Accept json
 // @Produce json
 // @Param type path string true "Тип категории (income или expense)"
+// @Param Accept-Language header string false "Предпочитаемые языки названий, например en-US,en;q=0.9"
 // @Success 200 {object} map[string]interface{} "Список категорий"
 // @Failure 400 {object} map[string]interface{} "Неверный тип категории"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
@@ -59,10 +65,11 @@ func (h *FundsHandler) GetCategoriesByType(c *fiber.Ctx) error {
 	defer cancel()
 
 	resp, err := h.clients.FundsService.GetCategoriesByType(ctx, &funds_pb.GetCategoriesByTypeRequest{
-		Type: categoryType,
+		Type:    categoryType,
+		Locales: acceptLanguages(c),
 	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -74,14 +81,16 @@ func (h *FundsHandler) GetCategoriesByType(c *fiber.Ctx) error {
 
 // GetCategoryById godoc
 // @Summary Получить категорию по ID
-// @Description Получает информацию о категории по ее ID
+// @Description Получает информацию о категории по ее ID с названием на языке из заголовка Accept-Language
 // @Tags funds
 // @Accept json
 // @Produce json
 // @Param id path int true "ID категории"
+// @Param Accept-Language header string false "Предпочитаемые языки названий, например en-US,en;q=0.9"
 // @Success 200 {object} map[string]interface{} "Информация о категории"
 // @Failure 400 {object} map[string]interface{} "Неверный ID категории"
 // @Failure 404 {object} map[string]interface{} "Категория не найдена"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Security BearerAuth
 // @Router /funds/categories/{id} [get]
 func (h *FundsHandler) GetCategoryById(c *fiber.Ctx) error {
@@ -97,11 +106,12 @@ func (h *FundsHandler) GetCategoryById(c *fiber.Ctx) error {
 	defer cancel()
 
 	resp, err := h.clients.FundsService.GetCategoryById(ctx, &funds_pb.GetCategoryByIdRequest{
-		Id: int32(categoryID),
+		Id:      int32(categoryID),
+		Locales: acceptLanguages(c),
 	})
 	if err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-			"error": "category not found",
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
 		})
 	}
 
@@ -0,0 +1,203 @@
+package funds
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	funds_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/funds_service"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreateCategoryRequest struct {
+	Code         string            `json:"code" validate:"required" example:"pets"`
+	Name         string            `json:"name" validate:"required" example:"Домашние животные"`
+	Type         string            `json:"type" validate:"required,oneof=income expense" example:"expense"`
+	Icon         string            `json:"icon" example:"🐾"`
+	Translations map[string]string `json:"translations" example:"en:Pets"`
+}
+
+type UpdateCategoryRequest struct {
+	Name         *string           `json:"name" example:"Питомцы"`
+	Icon         *string           `json:"icon" example:"🐶"`
+	Translations map[string]string `json:"translations" example:"en:Pets"`
+}
+
+// RegisterAdminRoutes registers category management routes, the router must be restricted to admins
+func (h *FundsHandler) RegisterAdminRoutes(router fiber.Router) {
+	router.Post("/categories", h.CreateCategory)
+	router.Put("/categories/:id", h.UpdateCategory)
+	router.Post("/categories/:id/archive", h.ArchiveCategory)
+	router.Post("/categories/:id/restore", h.RestoreCategory)
+}
+
+// CreateCategory godoc
+// @Summary Создать категорию
+// @Description Создает системную категорию транзакций. code - стабильный идентификатор из латинских строчных букв, цифр и подчеркиваний, name - название на русском, translations - названия на других языках. Доступно только администраторам
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body CreateCategoryRequest true "Данные категории"
+// @Success 201 {object} map[string]interface{} "Категория успешно создана"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 403 {object} map[string]interface{} "Нет прав администратора"
+// @Failure 409 {object} map[string]interface{} "Категория с таким кодом уже существует"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /admin/categories [post]
+func (h *FundsHandler) CreateCategory(c *fiber.Ctx) error {
+	var req CreateCategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.CreateCategory(ctx, &funds_pb.CreateCategoryRequest{
+		Code:         req.Code,
+		Name:         req.Name,
+		Type:         req.Type,
+		Icon:         req.Icon,
+		Translations: req.Translations,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"category": resp.Category,
+	})
+}
+
+// UpdateCategory godoc
+// @Summary Обновить категорию
+// @Description Обновляет название на русском, иконку и переводы категории. Переданные переводы добавляются или заменяются, пустое название удаляет перевод. Код и тип категории не меняются. Доступно только администраторам
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "ID категории"
+// @Param request body UpdateCategoryRequest true "Изменяемые поля категории"
+// @Success 200 {object} map[string]interface{} "Категория успешно обновлена"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 403 {object} map[string]interface{} "Нет прав администратора"
+// @Failure 404 {object} map[string]interface{} "Категория не найдена"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /admin/categories/{id} [put]
+func (h *FundsHandler) UpdateCategory(c *fiber.Ctx) error {
+	categoryID, err := strconv.ParseInt(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid category id",
+		})
+	}
+
+	var req UpdateCategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.UpdateCategory(ctx, &funds_pb.UpdateCategoryRequest{
+		Id:           int32(categoryID),
+		Name:         req.Name,
+		Icon:         req.Icon,
+		Translations: req.Translations,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"category": resp.Category,
+	})
+}
+
+// ArchiveCategory godoc
+// @Summary Архивировать категорию
+// @Description Архивирует категорию: она пропадает из списков и не может использоваться в новых транзакциях, существующие транзакции сохраняются. Доступно только администраторам
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "ID категории"
+// @Success 200 {object} map[string]interface{} "Категория архивирована"
+// @Failure 400 {object} map[string]interface{} "Неверный ID категории"
+// @Failure 403 {object} map[string]interface{} "Нет прав администратора"
+// @Failure 404 {object} map[string]interface{} "Категория не найдена"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /admin/categories/{id}/archive [post]
+func (h *FundsHandler) ArchiveCategory(c *fiber.Ctx) error {
+	categoryID, err := strconv.ParseInt(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid category id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.ArchiveCategory(ctx, &funds_pb.ArchiveCategoryRequest{
+		Id: int32(categoryID),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"category": resp.Category,
+	})
+}
+
+// RestoreCategory godoc
+// @Summary Восстановить категорию из архива
+// @Description Возвращает архивную категорию в списки и снова разрешает ее использование в транзакциях. Доступно только администраторам
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "ID категории"
+// @Success 200 {object} map[string]interface{} "Категория восстановлена"
+// @Failure 400 {object} map[string]interface{} "Неверный ID категории"
+// @Failure 403 {object} map[string]interface{} "Нет прав администратора"
+// @Failure 404 {object} map[string]interface{} "Категория не найдена"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /admin/categories/{id}/restore [post]
+func (h *FundsHandler) RestoreCategory(c *fiber.Ctx) error {
+	categoryID, err := strconv.ParseInt(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid category id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.RestoreCategory(ctx, &funds_pb.RestoreCategoryRequest{
+		Id: int32(categoryID),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"category": resp.Category,
+	})
+}
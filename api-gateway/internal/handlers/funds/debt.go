@@ -0,0 +1,337 @@
+package funds
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	funds_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/funds_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreateDebtRequest struct {
+	Kind           string  `json:"kind" validate:"required,oneof=loan credit_card borrowed lent" example:"loan"`
+	Title          string  `json:"title" validate:"required" example:"Ипотека"`
+	Counterparty   string  `json:"counterparty" example:"Сбербанк"`
+	Principal      float64 `json:"principal" validate:"required,gt=0" example:"3000000"`
+	InterestRate   float64 `json:"interest_rate" example:"12.5"` // Годовая ставка, %
+	TermMonths     int32   `json:"term_months" example:"240"`
+	StartDate      string  `json:"start_date" validate:"required" example:"2025-01-15"` // YYYY-MM-DD
+	MonthlyPayment float64 `json:"monthly_payment" example:"0"`                         // 0 - рассчитать аннуитетный платеж
+}
+
+type LinkDebtPaymentRequest struct {
+	TransactionId int64 `json:"transaction_id" validate:"required" example:"42"`
+}
+
+// CreateDebt godoc
+// @Summary Создать долг
+// @Description Добавляет кредит, кредитную карту, долг перед знакомым (borrowed) или деньги, одолженные другому человеку (lent). Для долгов со сроком ежемесячный платеж по умолчанию рассчитывается как аннуитетный
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param request body CreateDebtRequest true "Данные долга"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
+// @Success 201 {object} map[string]interface{} "Долг успешно создан"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 409 {object} map[string]interface{} "Ключ идемпотентности использован с другим запросом"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/debts [post]
+func (h *FundsHandler) CreateDebt(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req CreateDebtRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.DebtService.CreateDebt(ctx, &funds_pb.CreateDebtRequest{
+		UserUid:        userID,
+		Kind:           req.Kind,
+		Title:          req.Title,
+		Counterparty:   req.Counterparty,
+		Principal:      req.Principal,
+		InterestRate:   req.InterestRate,
+		TermMonths:     req.TermMonths,
+		StartDate:      req.StartDate,
+		MonthlyPayment: req.MonthlyPayment,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"debt": resp.Debt,
+	})
+}
+
+// GetUserDebts godoc
+// @Summary Получить долги пользователя
+// @Description Получает долги пользователя с остатком, выплаченными процентами и прогнозируемой датой погашения
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param include_paid_off query bool false "Включить погашенные долги" default(false)
+// @Success 200 {object} map[string]interface{} "Список долгов"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/debts [get]
+func (h *FundsHandler) GetUserDebts(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.DebtService.GetUserDebts(ctx, &funds_pb.GetUserDebtsRequest{
+		UserUid:        userID,
+		IncludePaidOff: c.QueryBool("include_paid_off", false),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"debts": resp.Debts,
+	})
+}
+
+// GetDebtSummary godoc
+// @Summary Получить сводку по долгам
+// @Description Возвращает общий остаток долгов, сумму одолженных денег, ежемесячные платежи и прогнозируемую дату полного погашения
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Сводка по долгам"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/debts/summary [get]
+func (h *FundsHandler) GetDebtSummary(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.DebtService.GetDebtSummary(ctx, &funds_pb.GetDebtSummaryRequest{
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"total_owed":       resp.TotalOwed,
+		"total_lent":       resp.TotalLent,
+		"monthly_payments": resp.MonthlyPayments,
+		"active_count":     resp.ActiveCount,
+		"debt_free_date":   resp.DebtFreeDate,
+	})
+}
+
+// GetDebtById godoc
+// @Summary Получить долг по ID
+// @Description Получает долг, его платежи с разбивкой на проценты и основной долг, а также график платежей, рассчитанный от текущего остатка
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID долга"
+// @Success 200 {object} map[string]interface{} "Долг, платежи и график погашения"
+// @Failure 400 {object} map[string]interface{} "Неверный ID долга"
+// @Failure 403 {object} map[string]interface{} "Долг принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Долг не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/debts/{id} [get]
+func (h *FundsHandler) GetDebtById(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	debtIDStr := c.Params("id")
+	debtID, err := strconv.ParseInt(debtIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid debt id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.DebtService.GetDebtById(ctx, &funds_pb.GetDebtByIdRequest{
+		Id:      debtID,
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"debt":     resp.Debt,
+		"payments": resp.Payments,
+		"schedule": resp.Schedule,
+	})
+}
+
+// DeleteDebt godoc
+// @Summary Удалить долг
+// @Description Удаляет долг и привязки платежей к нему. Сами транзакции платежей сохраняются
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID долга"
+// @Success 200 {object} map[string]interface{} "Долг успешно удален"
+// @Failure 400 {object} map[string]interface{} "Неверный ID долга"
+// @Failure 403 {object} map[string]interface{} "Долг принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Долг не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/debts/{id} [delete]
+func (h *FundsHandler) DeleteDebt(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	debtIDStr := c.Params("id")
+	debtID, err := strconv.ParseInt(debtIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid debt id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.DebtService.DeleteDebt(ctx, &funds_pb.DeleteDebtRequest{
+		Id:      debtID,
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": resp.Success,
+	})
+}
+
+// LinkDebtPayment godoc
+// @Summary Привязать платеж к долгу
+// @Description Отмечает существующую транзакцию как платеж по долгу и делит ее сумму на проценты и основной долг. Кредиты и займы погашаются расходами, одолженные деньги возвращаются доходами
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID долга"
+// @Param request body LinkDebtPaymentRequest true "Транзакция платежа"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
+// @Success 201 {object} map[string]interface{} "Платеж привязан"
+// @Failure 400 {object} map[string]interface{} "Неверный запрос или тип транзакции не подходит для долга"
+// @Failure 403 {object} map[string]interface{} "Долг или транзакция принадлежат другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Долг или транзакция не найдены"
+// @Failure 409 {object} map[string]interface{} "Долг погашен, транзакция удалена или уже привязана"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/debts/{id}/payments [post]
+func (h *FundsHandler) LinkDebtPayment(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	debtIDStr := c.Params("id")
+	debtID, err := strconv.ParseInt(debtIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid debt id",
+		})
+	}
+
+	var req LinkDebtPaymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.DebtService.LinkDebtPayment(ctx, &funds_pb.LinkDebtPaymentRequest{
+		DebtId:        debtID,
+		UserUid:       userID,
+		TransactionId: req.TransactionId,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"payment": resp.Payment,
+		"debt":    resp.Debt,
+	})
+}
+
+// UnlinkDebtPayment godoc
+// @Summary Отвязать платеж от долга
+// @Description Убирает привязку платежа к долгу и возвращает его основную часть в остаток долга. Транзакция сохраняется
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID долга"
+// @Param paymentId path int true "ID платежа"
+// @Success 200 {object} map[string]interface{} "Платеж отвязан"
+// @Failure 400 {object} map[string]interface{} "Неверный ID долга или платежа"
+// @Failure 403 {object} map[string]interface{} "Долг принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Долг или платеж не найдены"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/debts/{id}/payments/{paymentId} [delete]
+func (h *FundsHandler) UnlinkDebtPayment(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	debtIDStr := c.Params("id")
+	debtID, err := strconv.ParseInt(debtIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid debt id",
+		})
+	}
+
+	paymentIDStr := c.Params("paymentId")
+	paymentID, err := strconv.ParseInt(paymentIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid payment id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.DebtService.UnlinkDebtPayment(ctx, &funds_pb.UnlinkDebtPaymentRequest{
+		DebtId:    debtID,
+		UserUid:   userID,
+		PaymentId: paymentID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"debt": resp.Debt,
+	})
+}
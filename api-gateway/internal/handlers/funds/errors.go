@@ -0,0 +1,25 @@
+package funds
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcToHTTPStatus maps a gRPC error returned by funds-service to an HTTP status code
+func grpcToHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return fiber.StatusBadRequest
+	case codes.NotFound:
+		return fiber.StatusNotFound
+	case codes.PermissionDenied:
+		return fiber.StatusForbidden
+	case codes.FailedPrecondition, codes.AlreadyExists, codes.Aborted:
+		return fiber.StatusConflict
+	case codes.ResourceExhausted:
+		return fiber.StatusRequestEntityTooLarge
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
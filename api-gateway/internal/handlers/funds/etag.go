@@ -0,0 +1,60 @@
+package funds
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errInvalidIfMatch = errors.New("invalid If-Match header")
+
+// transactionETag builds a strong ETag from the transaction version
+func transactionETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// parseIfMatch returns the version expected by the If-Match header. A missing header or "*" yields 0,
+// which tells funds-service to skip the version check.
+func parseIfMatch(header string) (int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	tag := strings.TrimPrefix(header, "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, errInvalidIfMatch
+	}
+
+	version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errInvalidIfMatch
+	}
+
+	return version, nil
+}
+
+// etagMatches reports whether the If-None-Match header lists the given ETag
+func etagMatches(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// preconditionStatus maps a version mismatch to 412 when the client sent If-Match
+func preconditionStatus(err error, expectedVersion int64) int {
+	if expectedVersion != 0 && status.Code(err) == codes.FailedPrecondition {
+		return fiber.StatusPreconditionFailed
+	}
+
+	return grpcToHTTPStatus(err)
+}
@@ -2,6 +2,7 @@ package funds
 
 import (
 	"github.com/cg-2025-crutch/backend/api-gateway/internal/clients"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -19,13 +20,53 @@ func (h *FundsHandler) RegisterRoutes(router fiber.Router) {
 	funds := router.Group("/funds")
 
 	// All routes require authentication
+	idempotent := middleware.IdempotencyMiddleware()
+
 	// Transactions
-	funds.Post("/transactions", h.CreateTransaction)
+	funds.Post("/transactions", idempotent, h.CreateTransaction)
+	funds.Get("/transactions/trash", h.GetDeletedTransactions)
+	funds.Post("/transactions/batch", idempotent, h.BatchCreateTransactions)
+	funds.Patch("/transactions/batch", idempotent, h.BatchUpdateTransactions)
+	funds.Post("/transactions/batch/delete", idempotent, h.BatchDeleteTransactions)
+	funds.Get("/transactions/period", h.GetUserTransactionsByPeriod)
 	funds.Get("/transactions/:id", h.GetTransactionById)
 	funds.Get("/transactions", h.GetUserTransactions)
-	funds.Get("/transactions/period", h.GetUserTransactionsByPeriod)
-	funds.Put("/transactions/:id", h.UpdateTransaction)
-	funds.Delete("/transactions/:id", h.DeleteTransaction)
+	funds.Put("/transactions/:id", idempotent, h.UpdateTransaction)
+	funds.Delete("/transactions/:id", idempotent, h.DeleteTransaction)
+	funds.Post("/transactions/:id/restore", h.RestoreTransaction)
+	funds.Get("/transactions/:id/history", h.GetTransactionHistory)
+
+	// Attachments
+	funds.Post("/transactions/:id/attachments", h.UploadAttachment)
+	funds.Get("/transactions/:id/attachments", h.GetTransactionAttachments)
+	funds.Get("/attachments/usage", h.GetAttachmentUsage)
+	funds.Get("/attachments/:id", h.DownloadAttachment)
+	funds.Delete("/attachments/:id", h.DeleteAttachment)
+
+	// Debts
+	funds.Post("/debts", idempotent, h.CreateDebt)
+	funds.Get("/debts", h.GetUserDebts)
+	funds.Get("/debts/summary", h.GetDebtSummary)
+	funds.Get("/debts/:id", h.GetDebtById)
+	funds.Delete("/debts/:id", h.DeleteDebt)
+	funds.Post("/debts/:id/payments", idempotent, h.LinkDebtPayment)
+	funds.Delete("/debts/:id/payments/:paymentId", h.UnlinkDebtPayment)
+
+	// Ledgers
+	funds.Post("/ledgers", idempotent, h.CreateLedger)
+	funds.Get("/ledgers", h.GetUserLedgers)
+	funds.Get("/ledgers/:id", h.GetLedgerById)
+	funds.Delete("/ledgers/:id", h.DeleteLedger)
+	funds.Post("/ledgers/:id/members", h.AddLedgerMember)
+	funds.Put("/ledgers/:id/members/:uid", h.UpdateLedgerMemberRole)
+	funds.Delete("/ledgers/:id/members/:uid", h.RemoveLedgerMember)
+	funds.Get("/ledgers/:id/transactions", h.GetLedgerTransactions)
+	funds.Get("/ledgers/:id/balance", h.GetLedgerBalance)
+	funds.Get("/ledgers/:id/settlement", h.GetLedgerSettlement)
+
+	// Budgets
+	funds.Get("/budgets", h.GetUserBudgets)
+	funds.Put("/budgets", idempotent, h.SetBudgets)
 
 	// Categories
 	funds.Get("/categories", h.GetAllCategories)
@@ -34,4 +75,8 @@ func (h *FundsHandler) RegisterRoutes(router fiber.Router) {
 
 	// Balance
 	funds.Get("/balance", h.GetUserBalance)
+	funds.Get("/balance/history", h.GetBalanceHistory)
+
+	// Reports
+	funds.Get("/reports/summary", h.GetSpendingSummary)
 }
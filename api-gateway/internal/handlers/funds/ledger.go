@@ -0,0 +1,519 @@
+package funds
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	funds_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/funds_service"
+	user_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/user_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateLedgerRequest struct {
+	Name string `json:"name" validate:"required" example:"Семейный бюджет"`
+}
+
+type AddLedgerMemberRequest struct {
+	Username string `json:"username" validate:"required" example:"maria"`
+	Role     string `json:"role" validate:"required,oneof=editor viewer" example:"editor"`
+}
+
+type UpdateLedgerMemberRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=editor viewer" example:"viewer"`
+}
+
+// CreateLedger godoc
+// @Summary Создать общий бюджет
+// @Description Создает общий бюджет, например семейный. Создатель становится его владельцем
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param request body CreateLedgerRequest true "Данные бюджета"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
+// @Success 201 {object} map[string]interface{} "Бюджет успешно создан"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 409 {object} map[string]interface{} "Ключ идемпотентности использован с другим запросом"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers [post]
+func (h *FundsHandler) CreateLedger(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	var req CreateLedgerRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	// Участники бюджета видят друг друга по username, поэтому сохраняем его и для владельца
+	userResp, err := h.clients.UserService.GetUserById(ctx, &user_pb.GetUserByIdRequest{
+		Id: userID,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	resp, err := h.clients.LedgerService.CreateLedger(ctx, &funds_pb.CreateLedgerRequest{
+		UserUid:  userID,
+		Username: userResp.User.Username,
+		Name:     req.Name,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"ledger": resp.Ledger,
+	})
+}
+
+// GetUserLedgers godoc
+// @Summary Получить общие бюджеты пользователя
+// @Description Получает общие бюджеты, в которых состоит пользователь, с его ролью в каждом
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Список бюджетов"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers [get]
+func (h *FundsHandler) GetUserLedgers(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.LedgerService.GetUserLedgers(ctx, &funds_pb.GetUserLedgersRequest{
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"ledgers": resp.Ledgers,
+	})
+}
+
+// GetLedgerById godoc
+// @Summary Получить общий бюджет по ID
+// @Description Получает общий бюджет с участниками и их ролями
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бюджета"
+// @Success 200 {object} map[string]interface{} "Бюджет и участники"
+// @Failure 400 {object} map[string]interface{} "Неверный ID бюджета"
+// @Failure 403 {object} map[string]interface{} "Пользователь не участник бюджета"
+// @Failure 404 {object} map[string]interface{} "Бюджет не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers/{id} [get]
+func (h *FundsHandler) GetLedgerById(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ledgerID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid ledger id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.LedgerService.GetLedgerById(ctx, &funds_pb.GetLedgerByIdRequest{
+		Id:      ledgerID,
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"ledger": resp.Ledger,
+	})
+}
+
+// DeleteLedger godoc
+// @Summary Удалить общий бюджет
+// @Description Удаляет общий бюджет. Доступно только владельцу. Транзакции бюджета остаются личными транзакциями оплативших их участников
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бюджета"
+// @Success 200 {object} map[string]interface{} "Бюджет успешно удален"
+// @Failure 400 {object} map[string]interface{} "Неверный ID бюджета"
+// @Failure 403 {object} map[string]interface{} "Пользователь не владелец бюджета"
+// @Failure 404 {object} map[string]interface{} "Бюджет не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers/{id} [delete]
+func (h *FundsHandler) DeleteLedger(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ledgerID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid ledger id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.LedgerService.DeleteLedger(ctx, &funds_pb.DeleteLedgerRequest{
+		Id:      ledgerID,
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": resp.Success,
+	})
+}
+
+// AddLedgerMember godoc
+// @Summary Пригласить участника в общий бюджет
+// @Description Добавляет пользователя в бюджет по username. Доступно только владельцу. Редактор добавляет свои транзакции в бюджет, наблюдатель только просматривает его
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бюджета"
+// @Param request body AddLedgerMemberRequest true "Username и роль участника"
+// @Success 201 {object} map[string]interface{} "Участник добавлен"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 403 {object} map[string]interface{} "Пользователь не владелец бюджета"
+// @Failure 404 {object} map[string]interface{} "Бюджет или пользователь не найден"
+// @Failure 409 {object} map[string]interface{} "Пользователь уже участник бюджета"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers/{id}/members [post]
+func (h *FundsHandler) AddLedgerMember(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ledgerID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid ledger id",
+		})
+	}
+
+	var req AddLedgerMemberRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	if req.Username == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "username is required",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	userResp, err := h.clients.UserService.GetUserByUsername(ctx, &user_pb.GetUserByUsernameRequest{
+		Username: req.Username,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "user not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	resp, err := h.clients.LedgerService.AddLedgerMember(ctx, &funds_pb.AddLedgerMemberRequest{
+		LedgerId:  ledgerID,
+		UserUid:   userID,
+		MemberUid: userResp.User.Id,
+		Username:  userResp.User.Username,
+		Role:      req.Role,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"member": resp.Member,
+	})
+}
+
+// UpdateLedgerMemberRole godoc
+// @Summary Изменить роль участника общего бюджета
+// @Description Меняет роль участника на editor или viewer. Доступно только владельцу, роль владельца изменить нельзя
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бюджета"
+// @Param uid path string true "UID участника"
+// @Param request body UpdateLedgerMemberRoleRequest true "Новая роль"
+// @Success 200 {object} map[string]interface{} "Роль изменена"
+// @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 403 {object} map[string]interface{} "Пользователь не владелец бюджета"
+// @Failure 404 {object} map[string]interface{} "Бюджет или участник не найден"
+// @Failure 409 {object} map[string]interface{} "Роль владельца изменить нельзя"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers/{id}/members/{uid} [put]
+func (h *FundsHandler) UpdateLedgerMemberRole(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ledgerID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid ledger id",
+		})
+	}
+
+	var req UpdateLedgerMemberRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.LedgerService.UpdateLedgerMemberRole(ctx, &funds_pb.UpdateLedgerMemberRoleRequest{
+		LedgerId:  ledgerID,
+		UserUid:   userID,
+		MemberUid: c.Params("uid"),
+		Role:      req.Role,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"member": resp.Member,
+	})
+}
+
+// RemoveLedgerMember godoc
+// @Summary Исключить участника из общего бюджета
+// @Description Владелец может исключить любого участника, остальные участники могут выйти из бюджета сами. Транзакции участника остаются в бюджете
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бюджета"
+// @Param uid path string true "UID участника"
+// @Success 200 {object} map[string]interface{} "Участник исключен"
+// @Failure 400 {object} map[string]interface{} "Неверный ID бюджета"
+// @Failure 403 {object} map[string]interface{} "Недостаточно прав"
+// @Failure 404 {object} map[string]interface{} "Бюджет или участник не найден"
+// @Failure 409 {object} map[string]interface{} "Владелец не может покинуть бюджет"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers/{id}/members/{uid} [delete]
+func (h *FundsHandler) RemoveLedgerMember(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ledgerID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid ledger id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.LedgerService.RemoveLedgerMember(ctx, &funds_pb.RemoveLedgerMemberRequest{
+		LedgerId:  ledgerID,
+		UserUid:   userID,
+		MemberUid: c.Params("uid"),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": resp.Success,
+	})
+}
+
+// GetLedgerTransactions godoc
+// @Summary Получить транзакции общего бюджета
+// @Description Получает транзакции всех участников бюджета с пагинацией. user_uid транзакции - участник, который ее оплатил. Без exact_total подсчет останавливается на 1000 записях после страницы: тогда total_estimated=true и total - нижняя граница
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бюджета"
+// @Param limit query int false "Лимит записей" default(50)
+// @Param offset query int false "Смещение" default(0)
+// @Param exact_total query bool false "Точно посчитать общее количество транзакций" default(false)
+// @Success 200 {object} map[string]interface{} "Список транзакций"
+// @Failure 400 {object} map[string]interface{} "Неверный ID бюджета"
+// @Failure 403 {object} map[string]interface{} "Пользователь не участник бюджета"
+// @Failure 404 {object} map[string]interface{} "Бюджет не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers/{id}/transactions [get]
+func (h *FundsHandler) GetLedgerTransactions(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ledgerID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid ledger id",
+		})
+	}
+
+	limit := c.QueryInt("limit", 50)
+	offset := c.QueryInt("offset", 0)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.LedgerService.GetLedgerTransactions(ctx, &funds_pb.GetLedgerTransactionsRequest{
+		LedgerId:   ledgerID,
+		UserUid:    userID,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+		ExactTotal: c.QueryBool("exact_total", false),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"transactions":    resp.Transactions,
+		"total":           resp.Total,
+		"total_estimated": resp.TotalEstimated,
+		"has_more":        resp.HasMore,
+	})
+}
+
+// GetLedgerBalance godoc
+// @Summary Получить баланс общего бюджета
+// @Description Возвращает доходы, расходы и баланс бюджета в целом и по каждому участнику
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бюджета"
+// @Success 200 {object} map[string]interface{} "Баланс бюджета"
+// @Failure 400 {object} map[string]interface{} "Неверный ID бюджета"
+// @Failure 403 {object} map[string]interface{} "Пользователь не участник бюджета"
+// @Failure 404 {object} map[string]interface{} "Бюджет не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers/{id}/balance [get]
+func (h *FundsHandler) GetLedgerBalance(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ledgerID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid ledger id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.LedgerService.GetLedgerBalance(ctx, &funds_pb.GetLedgerBalanceRequest{
+		LedgerId: ledgerID,
+		UserUid:  userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"ledger_id":     resp.LedgerId,
+		"total_income":  resp.TotalIncome,
+		"total_expense": resp.TotalExpense,
+		"balance":       resp.Balance,
+		"members":       resp.Members,
+	})
+}
+
+// GetLedgerSettlement godoc
+// @Summary Рассчитать взаиморасчеты по общему бюджету
+// @Description Делит расходы бюджета за период поровну между участниками и показывает, кто кому сколько должен перевести. В расчете участвуют владелец, редакторы и все, кто оплачивал расходы
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID бюджета"
+// @Param from query string false "Начало периода (YYYY-MM-DD), по умолчанию с начала ведения бюджета"
+// @Param to query string false "Конец периода включительно (YYYY-MM-DD)"
+// @Success 200 {object} map[string]interface{} "Доли участников и переводы"
+// @Failure 400 {object} map[string]interface{} "Неверные параметры запроса"
+// @Failure 403 {object} map[string]interface{} "Пользователь не участник бюджета"
+// @Failure 404 {object} map[string]interface{} "Бюджет не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/ledgers/{id}/settlement [get]
+func (h *FundsHandler) GetLedgerSettlement(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	ledgerID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid ledger id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.LedgerService.GetLedgerSettlement(ctx, &funds_pb.GetLedgerSettlementRequest{
+		LedgerId: ledgerID,
+		UserUid:  userID,
+		DateFrom: c.Query("from"),
+		DateTo:   c.Query("to"),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"ledger_id":      resp.LedgerId,
+		"date_from":      resp.DateFrom,
+		"date_to":        resp.DateTo,
+		"total_expense":  resp.TotalExpense,
+		"share_per_user": resp.SharePerUser,
+		"shares":         resp.Shares,
+		"transfers":      resp.Transfers,
+	})
+}
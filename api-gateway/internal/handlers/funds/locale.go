@@ -0,0 +1,62 @@
+package funds
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const maxAcceptLanguages = 10
+
+// acceptLanguages returns the language tags of the Accept-Language header ordered by quality.
+// Tags with q=0 and the wildcard are skipped, funds-service falls back to the default names itself.
+func acceptLanguages(c *fiber.Ctx) []string {
+	header := c.Get(fiber.HeaderAcceptLanguage)
+	if header == "" {
+		return nil
+	}
+
+	type language struct {
+		tag     string
+		quality float64
+	}
+
+	var languages []language
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			q, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = q
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		languages = append(languages, language{tag: tag, quality: quality})
+		if len(languages) == maxAcceptLanguages {
+			break
+		}
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+
+	tags := make([]string, 0, len(languages))
+	for _, l := range languages {
+		tags = append(tags, l.tag)
+	}
+
+	return tags
+}
@@ -0,0 +1,49 @@
+package funds
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	funds_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/funds_service"
+	user_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/user_service"
+	"github.com/gofiber/fiber/v2"
+)
+
+// periodFromQuery reads the period selector from the period, period_offset, days, from and to
+// query parameters. It returns nil when the period parameter is not set.
+func periodFromQuery(c *fiber.Ctx) (*funds_pb.Period, error) {
+	kind := c.Query("period")
+	if kind == "" {
+		return nil, nil
+	}
+
+	offset, err := strconv.Atoi(c.Query("period_offset", "0"))
+	if err != nil {
+		return nil, errors.New("invalid period offset")
+	}
+
+	return &funds_pb.Period{
+		Kind:     kind,
+		Offset:   int32(offset),
+		Days:     int32(c.QueryInt("days", 30)),
+		DateFrom: c.Query("from"),
+		DateTo:   c.Query("to"),
+	}, nil
+}
+
+// userCalendar returns the user's time zone and week and salary day preferences periods are evaluated with
+func (h *FundsHandler) userCalendar(ctx context.Context, userID string) (*funds_pb.Calendar, error) {
+	resp, err := h.clients.UserService.GetUserById(ctx, &user_pb.GetUserByIdRequest{
+		Id: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &funds_pb.Calendar{
+		Timezone:  resp.User.Timezone,
+		WeekStart: resp.User.WeekStart,
+		SalaryDay: resp.User.SalaryDay,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package funds
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	funds_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/funds_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetSpendingSummary godoc
+// @Summary Получить сводку по транзакциям
+// @Description Агрегирует транзакции пользователя за период по категориям, типу, дням/неделям/месяцам/годам и тегам. При compare=true считает изменения относительно предыдущего периода: с group_by=month или year - относительно предыдущих календарных месяцев или лет (март сравнивается с февралем), с group_by=week - со сдвигом на целые недели, иначе - относительно предыдущего периода той же длины. С ledger_id сводка строится по транзакциям всех участников общего бюджета, а group_by=member разбивает ее по участникам. Период и недели считаются в часовом поясе пользователя с учетом первого дня недели и дня зарплаты из профиля
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param period query string false "Период: days, week, month, quarter, salary_month, custom. Без period и from/to - текущий месяц"
+// @Param period_offset query int false "Смещение периода: 0 - текущий, -1 - предыдущий" default(0)
+// @Param days query int false "Длина окна для period=days" default(30)
+// @Param from query string false "Начало периода (YYYY-MM-DD) для period=custom или без period"
+// @Param to query string false "Конец периода включительно (YYYY-MM-DD) для period=custom или без period, по умолчанию сегодня"
+// @Param group_by query string false "Измерения через запятую: category, type, day, week, month, year, tag, member" default(category)
+// @Param type query string false "Фильтр по типу (income или expense)"
+// @Param compare query bool false "Сравнить с предыдущим периодом" default(false)
+// @Param ledger_id query int false "ID общего бюджета"
+// @Success 200 {object} map[string]interface{} "Сводка по транзакциям"
+// @Failure 400 {object} map[string]interface{} "Неверные параметры запроса"
+// @Failure 403 {object} map[string]interface{} "Пользователь не участник бюджета"
+// @Failure 404 {object} map[string]interface{} "Бюджет не найден"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/reports/summary [get]
+func (h *FundsHandler) GetSpendingSummary(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	period, err := periodFromQuery(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	// Явные from/to без period сохраняют прежнее поведение, иначе по умолчанию берется текущий месяц
+	var dateFrom, dateTo string
+	if period == nil {
+		if c.Query("from") == "" && c.Query("to") == "" {
+			period = &funds_pb.Period{Kind: "month"}
+		} else {
+			now := time.Now()
+			dateFrom = c.Query("from", time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).Format("2006-01-02"))
+			dateTo = c.Query("to", now.Format("2006-01-02"))
+		}
+	}
+
+	transactionType := c.Query("type")
+	if transactionType != "" && transactionType != "income" && transactionType != "expense" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "type must be 'income' or 'expense'",
+		})
+	}
+
+	var ledgerID int64
+	if raw := c.Query("ledger_id"); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid ledger id",
+			})
+		}
+		ledgerID = id
+	}
+
+	var groupBy []string
+	for _, dim := range strings.Split(c.Query("group_by", "category"), ",") {
+		if dim = strings.TrimSpace(dim); dim != "" {
+			groupBy = append(groupBy, dim)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	calendar, err := h.userCalendar(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	resp, err := h.clients.FundsService.GetSpendingSummary(ctx, &funds_pb.GetSpendingSummaryRequest{
+		UserUid:         userID,
+		DateFrom:        dateFrom,
+		DateTo:          dateTo,
+		GroupBy:         groupBy,
+		Type:            transactionType,
+		ComparePrevious: c.QueryBool("compare", false),
+		LedgerId:        ledgerID,
+		Period:          period,
+		Calendar:        calendar,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"date_from":              resp.DateFrom,
+		"date_to":                resp.DateTo,
+		"total_income":           resp.TotalIncome,
+		"total_expense":          resp.TotalExpense,
+		"previous_total_income":  resp.PreviousTotalIncome,
+		"previous_total_expense": resp.PreviousTotalExpense,
+		"buckets":                resp.Buckets,
+	})
+}
@@ -11,23 +11,29 @@ import (
 )
 
 type CreateTransactionRequest struct {
-	CategoryId      int32   `json:"category_id" validate:"required" example:"1"`
-	Type            string  `json:"type" validate:"required,oneof=income expense" example:"income"`
-	Amount          float64 `json:"amount" validate:"required,gt=0" example:"1000.50"`
-	Title           string  `json:"title" validate:"required" example:"Зарплата"`
-	Description     string  `json:"description" example:"Месячная зарплата"`
-	TransactionDate string  `json:"transaction_date" validate:"required" example:"2025-12-06"` // YYYY-MM-DD
+	CategoryId      int32    `json:"category_id" validate:"required" example:"1"`
+	Type            string   `json:"type" validate:"required,oneof=income expense" example:"income"`
+	Amount          float64  `json:"amount" validate:"required,gt=0" example:"1000.50"`
+	Title           string   `json:"title" validate:"required" example:"Зарплата"`
+	Description     string   `json:"description" example:"Месячная зарплата"`
+	TransactionDate string   `json:"transaction_date" validate:"required" example:"2025-12-06"` // YYYY-MM-DD
+	Tags            []string `json:"tags" example:"работа,бонус"`
+	LedgerId        int64    `json:"ledger_id" example:"0"` // Общий бюджет, 0 - личная транзакция
 }
 
 // CreateTransaction godoc
 // @Summary Создать транзакцию
-// @Description Создает новую финансовую транзакцию для пользователя
+// @Description Создает новую финансовую транзакцию для пользователя. С ledger_id транзакция попадает в общий бюджет и остается за пользователем как за оплатившим участником
 // @Tags funds
 // @Accept json
 // @Produce json
 // @Param request body CreateTransactionRequest true "Данные транзакции"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
 // @Success 201 {object} map[string]interface{} "Транзакция успешно создана"
 // @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 403 {object} map[string]interface{} "Пользователь не участник бюджета или только просматривает его"
+// @Failure 404 {object} map[string]interface{} "Общий бюджет не найден"
+// @Failure 409 {object} map[string]interface{} "Ключ идемпотентности использован с другим запросом"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Security BearerAuth
 // @Router /funds/transactions [post]
@@ -52,9 +58,11 @@ func (h *FundsHandler) CreateTransaction(c *fiber.Ctx) error {
 		Title:           req.Title,
 		Description:     req.Description,
 		TransactionDate: req.TransactionDate,
+		Tags:            req.Tags,
+		LedgerId:        req.LedgerId,
 	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -66,12 +74,14 @@ func (h *FundsHandler) CreateTransaction(c *fiber.Ctx) error {
 
 // GetTransactionById godoc
 // @Summary Получить транзакцию по ID
-// @Description Получает информацию о транзакции по ее ID
+// @Description Получает информацию о транзакции по ее ID. Версия транзакции возвращается в заголовке ETag
 // @Tags funds
 // @Accept json
 // @Produce json
 // @Param id path int true "ID транзакции"
+// @Param If-None-Match header string false "ETag ранее полученной версии транзакции"
 // @Success 200 {object} map[string]interface{} "Информация о транзакции"
+// @Success 304 "Транзакция не изменилась"
 // @Failure 400 {object} map[string]interface{} "Неверный ID транзакции"
 // @Failure 404 {object} map[string]interface{} "Транзакция не найдена"
 // @Security BearerAuth
@@ -97,6 +107,13 @@ func (h *FundsHandler) GetTransactionById(c *fiber.Ctx) error {
 		})
 	}
 
+	etag := transactionETag(resp.Transaction.GetVersion())
+	c.Set(fiber.HeaderETag, etag)
+
+	if etagMatches(c.Get(fiber.HeaderIfNoneMatch), etag) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"transaction": resp.Transaction,
 	})
@@ -104,12 +121,13 @@ func (h *FundsHandler) GetTransactionById(c *fiber.Ctx) error {
 
 // GetUserTransactions godoc
 // @Summary Получить транзакции пользователя
-// @Description Получает список всех транзакций пользователя с пагинацией
+// @Description Получает список всех транзакций пользователя с пагинацией. Без exact_total подсчет останавливается на 1000 записях после страницы: тогда total_estimated=true и total - нижняя граница. has_more показывает, есть ли следующая страница
 // @Tags funds
 // @Accept json
 // @Produce json
 // @Param limit query int false "Лимит транзакций" default(10)
 // @Param offset query int false "Смещение" default(0)
+// @Param exact_total query bool false "Точно посчитать общее количество транзакций" default(false)
 // @Success 200 {object} map[string]interface{} "Список транзакций"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Security BearerAuth
@@ -124,9 +142,10 @@ func (h *FundsHandler) GetUserTransactions(c *fiber.Ctx) error {
 	defer cancel()
 
 	resp, err := h.clients.FundsService.GetUserTransactions(ctx, &funds_pb.GetUserTransactionsRequest{
-		UserUid: userID,
-		Limit:   int32(limit),
-		Offset:  int32(offset),
+		UserUid:    userID,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+		ExactTotal: c.QueryBool("exact_total", false),
 	})
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -135,21 +154,29 @@ func (h *FundsHandler) GetUserTransactions(c *fiber.Ctx) error {
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"transactions": resp.Transactions,
-		"total":        resp.Total,
+		"transactions":    resp.Transactions,
+		"total":           resp.Total,
+		"total_estimated": resp.TotalEstimated,
+		"has_more":        resp.HasMore,
 	})
 }
 
 // GetUserTransactionsByPeriod godoc
 // @Summary Получить транзакции за период
-// @Description Получает транзакции пользователя за указанное количество дней
+// @Description Получает транзакции пользователя за указанное количество дней или за период (неделя, месяц, квартал, зарплатный месяц, произвольный диапазон). Даты считаются в часовом поясе пользователя. Без exact_total подсчет останавливается на 1000 записях после страницы: тогда total_estimated=true и total - нижняя граница. has_more показывает, есть ли следующая страница
 // @Tags funds
 // @Accept json
 // @Produce json
 // @Param days query int false "Количество дней" default(30)
+// @Param period query string false "Период: days, week, month, quarter, salary_month, custom"
+// @Param period_offset query int false "Смещение периода: 0 - текущий, -1 - предыдущий" default(0)
+// @Param from query string false "Начало периода (YYYY-MM-DD) для period=custom"
+// @Param to query string false "Конец периода включительно (YYYY-MM-DD) для period=custom"
 // @Param limit query int false "Лимит транзакций" default(10)
 // @Param offset query int false "Смещение" default(0)
+// @Param exact_total query bool false "Точно посчитать общее количество транзакций" default(false)
 // @Success 200 {object} map[string]interface{} "Список транзакций за период"
+// @Failure 400 {object} map[string]interface{} "Неверный период"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Security BearerAuth
 // @Router /funds/transactions/period [get]
@@ -160,46 +187,74 @@ func (h *FundsHandler) GetUserTransactionsByPeriod(c *fiber.Ctx) error {
 	limit := c.QueryInt("limit", 10)
 	offset := c.QueryInt("offset", 0)
 
+	period, err := periodFromQuery(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
 	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
 	defer cancel()
 
+	calendar, err := h.userCalendar(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
 	resp, err := h.clients.FundsService.GetUserTransactionsByPeriod(ctx, &funds_pb.GetUserTransactionsByPeriodRequest{
-		UserUid: userID,
-		Days:    int32(days),
-		Limit:   int32(limit),
-		Offset:  int32(offset),
+		UserUid:    userID,
+		Days:       int32(days),
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+		Period:     period,
+		Calendar:   calendar,
+		ExactTotal: c.QueryBool("exact_total", false),
 	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"transactions": resp.Transactions,
-		"total":        resp.Total,
+		"transactions":    resp.Transactions,
+		"total":           resp.Total,
+		"total_estimated": resp.TotalEstimated,
+		"has_more":        resp.HasMore,
+		"date_from":       resp.DateFrom,
+		"date_to":         resp.DateTo,
 	})
 }
 
 type UpdateTransactionRequest struct {
-	CategoryId      int32   `json:"category_id" example:"2"`
-	Type            string  `json:"type" example:"expense"`
-	Amount          float64 `json:"amount" example:"500.00"`
-	Title           string  `json:"title" example:"Продукты"`
-	Description     string  `json:"description" example:"Покупка продуктов"`
-	TransactionDate string  `json:"transaction_date" example:"2025-12-06"`
+	CategoryId      int32    `json:"category_id" example:"2"`
+	Type            string   `json:"type" example:"expense"`
+	Amount          float64  `json:"amount" example:"500.00"`
+	Title           string   `json:"title" example:"Продукты"`
+	Description     string   `json:"description" example:"Покупка продуктов"`
+	TransactionDate string   `json:"transaction_date" example:"2025-12-06"`
+	Tags            []string `json:"tags" example:"еда"`
 }
 
 // UpdateTransaction godoc
 // @Summary Обновить транзакцию
-// @Description Обновляет информацию о транзакции
+// @Description Обновляет информацию о транзакции. С заголовком If-Match обновление применяется, только если транзакция не менялась с указанной версии
 // @Tags funds
 // @Accept json
 // @Produce json
 // @Param id path int true "ID транзакции"
 // @Param request body UpdateTransactionRequest true "Обновленные данные транзакции"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
+// @Param If-Match header string false "ETag версии транзакции, которую изменяет клиент"
 // @Success 200 {object} map[string]interface{} "Транзакция успешно обновлена"
 // @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 403 {object} map[string]interface{} "Транзакция принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Транзакция не найдена"
+// @Failure 409 {object} map[string]interface{} "Транзакция находится в корзине или ключ идемпотентности использован с другим запросом"
+// @Failure 412 {object} map[string]interface{} "Версия транзакции не совпадает с If-Match"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Security BearerAuth
 // @Router /funds/transactions/{id} [put]
@@ -221,6 +276,13 @@ func (h *FundsHandler) UpdateTransaction(c *fiber.Ctx) error {
 		})
 	}
 
+	expectedVersion, err := parseIfMatch(c.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
 	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
 	defer cancel()
 
@@ -233,13 +295,17 @@ func (h *FundsHandler) UpdateTransaction(c *fiber.Ctx) error {
 		Description:     req.Description,
 		TransactionDate: req.TransactionDate,
 		UserUid:         userID,
+		Tags:            req.Tags,
+		ExpectedVersion: expectedVersion,
 	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(preconditionStatus(err, expectedVersion)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
 
+	c.Set(fiber.HeaderETag, transactionETag(resp.Transaction.GetVersion()))
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"transaction": resp.Transaction,
 	})
@@ -247,13 +313,19 @@ func (h *FundsHandler) UpdateTransaction(c *fiber.Ctx) error {
 
 // DeleteTransaction godoc
 // @Summary Удалить транзакцию
-// @Description Удаляет транзакцию по ее ID
+// @Description Перемещает транзакцию в корзину и откатывает ее влияние на баланс. Транзакцию можно восстановить, пока она не удалена окончательно по истечении срока хранения. С заголовком If-Match удаление применяется, только если транзакция не менялась с указанной версии
 // @Tags funds
 // @Accept json
 // @Produce json
 // @Param id path int true "ID транзакции"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
+// @Param If-Match header string false "ETag версии транзакции, которую изменяет клиент"
 // @Success 200 {object} map[string]interface{} "Транзакция успешно удалена"
 // @Failure 400 {object} map[string]interface{} "Неверный ID транзакции"
+// @Failure 403 {object} map[string]interface{} "Транзакция принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Транзакция не найдена"
+// @Failure 409 {object} map[string]interface{} "Транзакция уже в корзине или ключ идемпотентности использован с другим запросом"
+// @Failure 412 {object} map[string]interface{} "Версия транзакции не совпадает с If-Match"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Security BearerAuth
 // @Router /funds/transactions/{id} [delete]
@@ -268,15 +340,23 @@ func (h *FundsHandler) DeleteTransaction(c *fiber.Ctx) error {
 		})
 	}
 
+	expectedVersion, err := parseIfMatch(c.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
 	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
 	defer cancel()
 
 	resp, err := h.clients.FundsService.DeleteTransaction(ctx, &funds_pb.DeleteTransactionRequest{
-		Id:      transactionID,
-		UserUid: userID,
+		Id:              transactionID,
+		UserUid:         userID,
+		ExpectedVersion: expectedVersion,
 	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(preconditionStatus(err, expectedVersion)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -0,0 +1,140 @@
+package funds
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	funds_pb "github.com/cg-2025-crutch/backend/api-gateway/internal/grpc/gen/funds_service"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetDeletedTransactions godoc
+// @Summary Получить корзину транзакций
+// @Description Получает список удаленных транзакций пользователя, которые еще можно восстановить. Без exact_total подсчет останавливается на 1000 записях после страницы: тогда total_estimated=true и total - нижняя граница. has_more показывает, есть ли следующая страница
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param limit query int false "Лимит транзакций" default(10)
+// @Param offset query int false "Смещение" default(0)
+// @Param exact_total query bool false "Точно посчитать общее количество удаленных транзакций" default(false)
+// @Success 200 {object} map[string]interface{} "Список удаленных транзакций"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/transactions/trash [get]
+func (h *FundsHandler) GetDeletedTransactions(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	limit := c.QueryInt("limit", 10)
+	offset := c.QueryInt("offset", 0)
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.GetDeletedTransactions(ctx, &funds_pb.GetDeletedTransactionsRequest{
+		UserUid:    userID,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+		ExactTotal: c.QueryBool("exact_total", false),
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"transactions":    resp.Transactions,
+		"total":           resp.Total,
+		"total_estimated": resp.TotalEstimated,
+		"has_more":        resp.HasMore,
+	})
+}
+
+// RestoreTransaction godoc
+// @Summary Восстановить транзакцию
+// @Description Восстанавливает транзакцию из корзины и заново применяет ее к балансу
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID транзакции"
+// @Success 200 {object} map[string]interface{} "Транзакция успешно восстановлена"
+// @Failure 400 {object} map[string]interface{} "Неверный ID транзакции"
+// @Failure 403 {object} map[string]interface{} "Транзакция принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Транзакция не найдена"
+// @Failure 409 {object} map[string]interface{} "Транзакция не находится в корзине"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/transactions/{id}/restore [post]
+func (h *FundsHandler) RestoreTransaction(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	transactionIDStr := c.Params("id")
+	transactionID, err := strconv.ParseInt(transactionIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid transaction id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.RestoreTransaction(ctx, &funds_pb.RestoreTransactionRequest{
+		Id:      transactionID,
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"transaction": resp.Transaction,
+	})
+}
+
+// GetTransactionHistory godoc
+// @Summary Получить историю изменений транзакции
+// @Description Получает ревизии транзакции: кто и когда ее создал, изменил, удалил или восстановил, с состоянием до и после изменения
+// @Tags funds
+// @Accept json
+// @Produce json
+// @Param id path int true "ID транзакции"
+// @Success 200 {object} map[string]interface{} "История изменений транзакции"
+// @Failure 400 {object} map[string]interface{} "Неверный ID транзакции"
+// @Failure 403 {object} map[string]interface{} "Транзакция принадлежит другому пользователю"
+// @Failure 404 {object} map[string]interface{} "Транзакция не найдена"
+// @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
+// @Security BearerAuth
+// @Router /funds/transactions/{id}/history [get]
+func (h *FundsHandler) GetTransactionHistory(c *fiber.Ctx) error {
+	userID := c.Locals(middleware.UserIDKey).(string)
+
+	transactionIDStr := c.Params("id")
+	transactionID, err := strconv.ParseInt(transactionIDStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid transaction id",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.FundsService.GetTransactionHistory(ctx, &funds_pb.GetTransactionHistoryRequest{
+		Id:      transactionID,
+		UserUid: userID,
+	})
+	if err != nil {
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"revisions": resp.Revisions,
+	})
+}
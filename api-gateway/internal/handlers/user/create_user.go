@@ -16,17 +16,23 @@ type CreateUserRequest struct {
 	Age          int32   `json:"age" validate:"required,min=18" example:"25"`
 	Salary       float64 `json:"salary" validate:"required,min=0" example:"50000"`
 	WorkSphereId int64   `json:"work_sphere_id" validate:"required" example:"1"`
+	Timezone     string  `json:"timezone" example:"Europe/Moscow"`
+	WeekStart    int32   `json:"week_start" example:"1"`
+	SalaryDay    int32   `json:"salary_day" example:"10"`
+	IncomeSource string  `json:"income_source" example:"profile"`
 }
 
 // CreateUser godoc
 // @Summary Регистрация нового пользователя
-// @Description Создает нового пользователя в системе
+// @Description Создает нового пользователя в системе. Часовой пояс (по умолчанию UTC), первый день недели (1 - понедельник, 7 - воскресенье) и день зарплаты задают календарь, в котором считаются периоды отчетов и аналитики. income_source задает, от чего считаются рекомендации: profile (по умолчанию) - зарплата из профиля, transactions - средний фактический доход по операциям
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param request body CreateUserRequest true "Данные нового пользователя"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор запроса с тем же ключом вернет исходный ответ"
 // @Success 201 {object} map[string]interface{} "Пользователь успешно создан"
 // @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
+// @Failure 409 {object} map[string]interface{} "Имя пользователя занято или ключ идемпотентности использован с другим запросом"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Router /users/register [post]
 func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
@@ -48,9 +54,13 @@ func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 		Age:          req.Age,
 		Salary:       req.Salary,
 		WorkSphereId: req.WorkSphereId,
+		Timezone:     req.Timezone,
+		WeekStart:    req.WeekStart,
+		SalaryDay:    req.SalaryDay,
+		IncomeSource: req.IncomeSource,
 	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
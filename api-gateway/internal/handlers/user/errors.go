@@ -0,0 +1,19 @@
+package user
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcToHTTPStatus maps a gRPC error returned by user-service to an HTTP status code
+func grpcToHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return fiber.StatusBadRequest
+	case codes.AlreadyExists, codes.Aborted:
+		return fiber.StatusConflict
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
@@ -16,11 +16,15 @@ type UpdateUserRequest struct {
 	Age          int32   `json:"age" example:"26"`
 	Salary       float64 `json:"salary" example:"55000"`
 	WorkSphereId int64   `json:"work_sphere_id" example:"2"`
+	Timezone     *string `json:"timezone" example:"Asia/Yekaterinburg"`
+	WeekStart    *int32  `json:"week_start" example:"7"`
+	SalaryDay    *int32  `json:"salary_day" example:"25"`
+	IncomeSource *string `json:"income_source" example:"transactions"`
 }
 
 // UpdateUser godoc
 // @Summary Обновить профиль пользователя
-// @Description Обновляет информацию о пользователе (только свой профиль)
+// @Description Обновляет информацию о пользователе (только свой профиль). Не переданные timezone, week_start, salary_day и income_source остаются без изменений. income_source задает, от чего считаются рекомендации: profile - зарплата из профиля, transactions - средний фактический доход по операциям
 // @Tags users
 // @Accept json
 // @Produce json
@@ -29,6 +33,7 @@ type UpdateUserRequest struct {
 // @Success 200 {object} map[string]interface{} "Пользователь успешно обновлен"
 // @Failure 400 {object} map[string]interface{} "Неверный формат запроса"
 // @Failure 403 {object} map[string]interface{} "Можно обновлять только свой профиль"
+// @Failure 409 {object} map[string]interface{} "Имя пользователя занято"
 // @Failure 500 {object} map[string]interface{} "Внутренняя ошибка сервера"
 // @Security BearerAuth
 // @Router /users/{id} [put]
@@ -66,9 +71,13 @@ func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
 		Age:          req.Age,
 		Salary:       req.Salary,
 		WorkSphereId: req.WorkSphereId,
+		Timezone:     req.Timezone,
+		WeekStart:    req.WeekStart,
+		SalaryDay:    req.SalaryDay,
+		IncomeSource: req.IncomeSource,
 	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(grpcToHTTPStatus(err)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"github.com/cg-2025-crutch/backend/api-gateway/internal/clients"
+	"github.com/cg-2025-crutch/backend/api-gateway/internal/middleware"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -19,7 +20,7 @@ func NewUserHandler(clients *clients.GRPCClients) *UserHandler {
 func (h *UserHandler) RegisterPublicRoutes(router fiber.Router) {
 	users := router.Group("/users")
 
-	users.Post("/register", h.CreateUser)
+	users.Post("/register", middleware.IdempotencyMiddleware(), h.CreateUser)
 	users.Post("/login", h.Login)
 }
 
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminMiddleware allows the request only for the listed users. It must run after AuthMiddleware.
+func AdminMiddleware(userIDs []string) fiber.Handler {
+	admins := make(map[string]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			admins[id] = struct{}{}
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		userID, _ := c.Locals(UserIDKey).(string)
+		if _, ok := admins[userID]; !ok {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "admin access required",
+			})
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	idempotencyMetadataKey = "idempotency-key"
+	maxIdempotencyKeyLen   = 255
+)
+
+// IdempotencyMiddleware forwards the Idempotency-Key header to the backend services as gRPC metadata
+func IdempotencyMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Get(IdempotencyKeyHeader)
+		if key == "" {
+			return c.Next()
+		}
+
+		if len(key) > maxIdempotencyKeyLen {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "idempotency key is too long",
+			})
+		}
+
+		c.SetUserContext(metadata.AppendToOutgoingContext(c.UserContext(), idempotencyMetadataKey, key))
+
+		return c.Next()
+	}
+}
@@ -50,6 +50,7 @@ func Run(mainCtx context.Context) error {
 		AppName:      "API Gateway",
 		ServerHeader: "Fiber",
 		ErrorHandler: customErrorHandler,
+		BodyLimit:    conf.Server.BodyLimit,
 	})
 
 	// Global middleware
@@ -57,8 +58,9 @@ func Run(mainCtx context.Context) error {
 	app.Use(logger.New())
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     "*",
-		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders:     "Origin, Content-Type, Accept, Authorization",
+		AllowMethods:     "GET,POST,PUT,PATCH,DELETE,OPTIONS",
+		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, Idempotency-Key, If-Match, If-None-Match",
+		ExposeHeaders:    "ETag",
 		AllowCredentials: false,
 	}))
 
@@ -94,6 +96,11 @@ func Run(mainCtx context.Context) error {
 	notificationsHandler.RegisterRoutes(protected)
 	analyticsHandler.RegisterRoutes(protected)
 
+	// Admin routes - only for users listed in the admin config
+	admin := protected.Group("/admin", middleware.AdminMiddleware(conf.Admin.UserIDs))
+	fundsHandler.RegisterAdminRoutes(admin)
+	analyticsHandler.RegisterAdminRoutes(admin)
+
 	// Start server
 	addr := fmt.Sprintf("%s:%s", conf.Server.Host, conf.Server.Port)
 	zapLogger.Info("Starting API Gateway", zap.String("address", addr))
@@ -0,0 +1,332 @@
+// Command bench measures the latency of the transaction queries before and after the
+// transactions table is partitioned.
+//
+// It needs a dedicated database: the schema is migrated up to the last version before
+// partitioning, seeded with generated transactions and measured, then the partitioning
+// migration is applied and the same queries are measured again. Before partitioning the
+// lists count their totals exactly, after it they stop counting at a cap.
+//
+// The connection settings and the migrations directory are read like in the service:
+//
+//	DB_HOST=localhost DB_MIGRATIONS_DIR=./migrations go run ./cmd/bench -rows 5000000 -reset
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/config"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/postgres"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// versionBeforePartitioning is the last migration applied to the unpartitioned table
+const versionBeforePartitioning = 20251224100000
+
+const seedChunk = 250000
+
+type options struct {
+	rows       int
+	users      int
+	months     int
+	iterations int
+	reset      bool
+
+	migrateTimeout time.Duration
+}
+
+// scenario is a single query measured in both phases
+type scenario struct {
+	name string
+	run  func(ctx context.Context, repo repository.FundsRepositorer, userUID string, exactTotal bool) error
+}
+
+type latency struct {
+	p50, p95, max time.Duration
+}
+
+func main() {
+	var opts options
+	flag.IntVar(&opts.rows, "rows", 2000000, "number of generated transactions")
+	flag.IntVar(&opts.users, "users", 1000, "number of users the transactions are spread across")
+	flag.IntVar(&opts.months, "months", 36, "number of months back the transaction dates are spread across")
+	flag.IntVar(&opts.iterations, "iterations", 50, "number of runs of every query")
+	flag.BoolVar(&opts.reset, "reset", false, "drop everything in the database before seeding")
+	flag.DurationVar(&opts.migrateTimeout, "migrate-timeout", time.Hour, "timeout of the partitioning migration")
+	flag.Parse()
+
+	if err := run(context.Background(), opts); err != nil {
+		log.Fatalf("bench: %v", err)
+	}
+}
+
+func run(ctx context.Context, opts options) error {
+	if opts.rows <= 0 || opts.users <= 0 || opts.months <= 0 || opts.iterations <= 0 {
+		return fmt.Errorf("rows, users, months and iterations must be positive")
+	}
+
+	conf, err := config.New()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	db, err := postgres.NewPostgresDB(ctx, conf.Postgres)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := prepareDatabase(ctx, db, opts.reset); err != nil {
+		return err
+	}
+
+	migrator, err := postgres.NewMigrator(conf.Postgres)
+	if err != nil {
+		return err
+	}
+	if err := migrator.UpTo(ctx, versionBeforePartitioning); err != nil {
+		return err
+	}
+
+	log.Printf("seeding %d transactions of %d users", opts.rows, opts.users)
+	start := time.Now()
+	if err := seed(ctx, db, opts); err != nil {
+		return err
+	}
+	log.Printf("seeded in %s", time.Since(start).Round(time.Millisecond))
+
+	userUIDs, err := sampleUsers(ctx, db, opts.users)
+	if err != nil {
+		return err
+	}
+
+	repo := repository.NewRepository(db.Pool)
+	scenarios := benchScenarios()
+
+	log.Printf("measuring the unpartitioned table")
+	before, err := measure(ctx, repo, scenarios, userUIDs, opts.iterations, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("partitioning the transactions table")
+	start = time.Now()
+	if err := migrator.Up(ctx, opts.migrateTimeout); err != nil {
+		return err
+	}
+	log.Printf("partitioned in %s", time.Since(start).Round(time.Millisecond))
+
+	log.Printf("measuring the partitioned table")
+	after, err := measure(ctx, repo, scenarios, userUIDs, opts.iterations, false)
+	if err != nil {
+		return err
+	}
+
+	report(scenarios, before, after)
+
+	return nil
+}
+
+// prepareDatabase refuses to touch a database that already has the schema unless reset is set
+func prepareDatabase(ctx context.Context, db *postgres.PostgresDB, reset bool) error {
+	var exists bool
+	if err := db.Pool.QueryRow(ctx, `SELECT to_regclass('goose_db_version') IS NOT NULL`).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check the database: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+	if !reset {
+		return fmt.Errorf("the database is not empty, run with -reset to drop its contents")
+	}
+
+	log.Printf("dropping the database contents")
+	_, err := db.Pool.Exec(ctx, `
+		DROP SCHEMA IF EXISTS transactions_archive CASCADE;
+		DROP SCHEMA public CASCADE;
+		CREATE SCHEMA public;
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to reset the database: %w", err)
+	}
+
+	return nil
+}
+
+// seed generates transactions in chunks. Users get deterministic ids, so sampleUsers can
+// select them without scanning the table. Every tenth transaction is an income.
+func seed(ctx context.Context, db *postgres.PostgresDB, opts options) error {
+	query := `
+		WITH cats AS (
+			SELECT array_agg(id) FILTER (WHERE type = 'income') AS income,
+			       array_agg(id) FILTER (WHERE type = 'expense') AS expense
+			FROM categories
+		),
+		src AS (
+			SELECT g, random() < 0.1 AS income
+			FROM generate_series($1::bigint, $2::bigint) AS g
+		)
+		INSERT INTO transactions (user_uid, category_id, type, amount, title, transaction_date, created_at, updated_at)
+		SELECT md5('bench-user-' || (src.g % $3))::uuid,
+		       CASE WHEN src.income THEN cats.income[1 + floor(random() * array_length(cats.income, 1))::int]
+		            ELSE cats.expense[1 + floor(random() * array_length(cats.expense, 1))::int] END,
+		       CASE WHEN src.income THEN 'income' ELSE 'expense' END,
+		       round((1 + random() * 5000)::numeric, 2),
+		       'bench transaction',
+		       CURRENT_DATE - floor(random() * $4::int)::int,
+		       NOW(),
+		       NOW()
+		FROM src, cats
+	`
+
+	days := opts.months * 30
+	for from := 0; from < opts.rows; from += seedChunk {
+		to := min(from+seedChunk, opts.rows) - 1
+		if _, err := db.Pool.Exec(ctx, query, from, to, opts.users, days); err != nil {
+			return fmt.Errorf("failed to seed transactions: %w", err)
+		}
+		log.Printf("seeded %d/%d", to+1, opts.rows)
+	}
+
+	if _, err := db.Pool.Exec(ctx, `ANALYZE transactions`); err != nil {
+		return fmt.Errorf("failed to analyze transactions: %w", err)
+	}
+
+	return nil
+}
+
+// sampleUsers returns the ids of the generated users
+func sampleUsers(ctx context.Context, db *postgres.PostgresDB, users int) ([]string, error) {
+	rows, err := db.Pool.Query(ctx, `SELECT md5('bench-user-' || g)::uuid::text FROM generate_series(0, $1 - 1) AS g`, users)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	defer rows.Close()
+
+	var userUIDs []string
+	for rows.Next() {
+		var userUID string
+		if err := rows.Scan(&userUID); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		userUIDs = append(userUIDs, userUID)
+	}
+
+	return userUIDs, rows.Err()
+}
+
+func benchScenarios() []scenario {
+	month := func() (time.Time, time.Time) {
+		now := time.Now()
+		from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(0, 1, -1)
+	}
+
+	return []scenario{
+		{
+			name: "transactions, first page",
+			run: func(ctx context.Context, repo repository.FundsRepositorer, userUID string, exactTotal bool) error {
+				_, _, err := repo.GetUserTransactions(ctx, userUID, 50, 0, exactTotal)
+				return err
+			},
+		},
+		{
+			name: "transactions, page at offset 1000",
+			run: func(ctx context.Context, repo repository.FundsRepositorer, userUID string, exactTotal bool) error {
+				_, _, err := repo.GetUserTransactions(ctx, userUID, 50, 1000, exactTotal)
+				return err
+			},
+		},
+		{
+			name: "transactions of the current month",
+			run: func(ctx context.Context, repo repository.FundsRepositorer, userUID string, exactTotal bool) error {
+				from, to := month()
+				_, _, err := repo.GetUserTransactionsByPeriod(ctx, userUID, from, to, 50, 0, exactTotal)
+				return err
+			},
+		},
+		{
+			name: "summary of the current month by category",
+			run: func(ctx context.Context, repo repository.FundsRepositorer, userUID string, _ bool) error {
+				from, to := month()
+				_, err := repo.GetSpendingSummary(ctx, models.SpendingSummaryInput{
+					UserUID:         userUID,
+					DateFrom:        from,
+					DateTo:          to,
+					GroupBy:         []string{models.GroupByCategory},
+					ComparePrevious: true,
+					WeekStart:       time.Monday,
+				})
+				return err
+			},
+		},
+		{
+			name: "summary of a year by month",
+			run: func(ctx context.Context, repo repository.FundsRepositorer, userUID string, _ bool) error {
+				_, to := month()
+				_, err := repo.GetSpendingSummary(ctx, models.SpendingSummaryInput{
+					UserUID:   userUID,
+					DateFrom:  to.AddDate(-1, 0, 1),
+					DateTo:    to,
+					GroupBy:   []string{models.GroupByMonth},
+					WeekStart: time.Monday,
+				})
+				return err
+			},
+		},
+	}
+}
+
+// measure runs every scenario for random users and returns the latencies in the scenario order
+func measure(ctx context.Context, repo repository.FundsRepositorer, scenarios []scenario, userUIDs []string, iterations int, exactTotal bool) ([]latency, error) {
+	results := make([]latency, 0, len(scenarios))
+	for _, s := range scenarios {
+		durations := make([]time.Duration, 0, iterations)
+		for i := 0; i < iterations; i++ {
+			userUID := userUIDs[rand.IntN(len(userUIDs))]
+
+			start := time.Now()
+			if err := s.run(ctx, repo, userUID, exactTotal); err != nil {
+				return nil, fmt.Errorf("%s: %w", s.name, err)
+			}
+			durations = append(durations, time.Since(start))
+		}
+
+		slices.Sort(durations)
+		results = append(results, latency{
+			p50: percentile(durations, 0.50),
+			p95: percentile(durations, 0.95),
+			max: durations[len(durations)-1],
+		})
+	}
+
+	return results, nil
+}
+
+// percentile expects sorted durations
+func percentile(durations []time.Duration, p float64) time.Duration {
+	return durations[int(float64(len(durations)-1)*p)]
+}
+
+func report(scenarios []scenario, before, after []latency) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "query\tbefore p50\tbefore p95\tbefore max\tafter p50\tafter p95\tafter max")
+	for i, s := range scenarios {
+		b, a := before[i], after[i]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", s.name,
+			round(b.p50), round(b.p95), round(b.max), round(a.p50), round(a.p95), round(a.max))
+	}
+	w.Flush()
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(10 * time.Microsecond)
+}
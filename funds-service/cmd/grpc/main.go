@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	_ "time/tzdata" // The runtime image has no zoneinfo, periods are resolved in the user's time zone
 
 	"github.com/cg-2025-crutch/backend/funds-service/internal/run"
 )
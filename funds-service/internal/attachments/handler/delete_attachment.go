@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	if err := h.service.DeleteAttachment(ctx, req.Id, req.UserUid); err != nil {
+		return nil, attachmentError(err, "failed to delete attachment")
+	}
+
+	return &pb.DeleteAttachmentResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"errors"
+	"io"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) DownloadAttachment(req *pb.DownloadAttachmentRequest, stream grpc.ServerStreamingServer[pb.DownloadAttachmentResponse]) error {
+	content, err := h.service.OpenAttachment(stream.Context(), req.Id, req.UserUid, req.Thumbnail)
+	if err != nil {
+		return attachmentError(err, "failed to download attachment")
+	}
+	defer content.Body.Close()
+
+	err = stream.Send(&pb.DownloadAttachmentResponse{
+		Payload: &pb.DownloadAttachmentResponse_Info{
+			Info: &pb.AttachmentDownloadInfo{
+				FileName:    content.FileName,
+				ContentType: content.ContentType,
+				Size:        content.Size,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := content.Body.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&pb.DownloadAttachmentResponse{
+				Payload: &pb.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read attachment: %v", err)
+		}
+	}
+}
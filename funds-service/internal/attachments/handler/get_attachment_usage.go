@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetAttachmentUsage(ctx context.Context, req *pb.GetAttachmentUsageRequest) (*pb.GetAttachmentUsageResponse, error) {
+	usage, err := h.service.GetAttachmentUsage(ctx, req.UserUid)
+	if err != nil {
+		return nil, attachmentError(err, "failed to get attachment usage")
+	}
+
+	return &pb.GetAttachmentUsageResponse{
+		UsedBytes:           usage.UsedBytes,
+		QuotaBytes:          usage.QuotaBytes,
+		Count:               int32(usage.Count),
+		MaxFileSize:         usage.MaxFileSize,
+		AllowedContentTypes: usage.AllowedContentTypes,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetTransactionAttachments(ctx context.Context, req *pb.GetTransactionAttachmentsRequest) (*pb.GetTransactionAttachmentsResponse, error) {
+	attachments, err := h.service.GetTransactionAttachments(ctx, req.TransactionId, req.UserUid)
+	if err != nil {
+		return nil, attachmentError(err, "failed to get transaction attachments")
+	}
+
+	pbAttachments := make([]*pb.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		pbAttachments = append(pbAttachments, h.attachmentToProto(a))
+	}
+
+	return &pb.GetTransactionAttachmentsResponse{
+		Attachments: pbAttachments,
+	}, nil
+}
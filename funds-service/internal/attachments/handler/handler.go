@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/attachments/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/attachments/service"
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chunkSize is the size of the file chunks sent in download streams
+const chunkSize = 64 * 1024
+
+type GRPCHandler struct {
+	pb.UnimplementedAttachmentServiceServer
+	service service.AttachmentsServicer
+}
+
+func NewGRPCHandler(service service.AttachmentsServicer) *GRPCHandler {
+	return &GRPCHandler{
+		service: service,
+	}
+}
+
+func (h *GRPCHandler) attachmentToProto(a *models.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:            a.ID,
+		TransactionId: a.TransactionID,
+		UserUid:       a.UserUID,
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+		HasThumbnail:  a.ThumbnailKey != nil,
+		Checksum:      a.Checksum,
+		CreatedAt:     a.CreatedAt.Unix(),
+	}
+}
+
+// attachmentError maps repository and service errors to gRPC status codes
+func attachmentError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrTransactionNotFound), errors.Is(err, repository.ErrAttachmentNotFound),
+		errors.Is(err, service.ErrThumbnailNotAvailable), errors.Is(err, service.ErrAttachmentBlobNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTransactionNotOwned), errors.Is(err, repository.ErrAttachmentNotOwned):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTransactionDeleted):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, service.ErrEmptyFile), errors.Is(err, service.ErrContentTypeNotAllowed),
+		errors.Is(err, service.ErrInvalidImage):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, service.ErrFileTooLarge), errors.Is(err, repository.ErrQuotaExceeded):
+		return status.Errorf(codes.ResourceExhausted, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
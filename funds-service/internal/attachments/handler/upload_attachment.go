@@ -0,0 +1,66 @@
+package handler
+
+import (
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) UploadAttachment(stream grpc.ClientStreamingServer[pb.UploadAttachmentRequest, pb.UploadAttachmentResponse]) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to receive attachment info: %v", err)
+	}
+
+	info := first.GetInfo()
+	if info == nil {
+		return status.Errorf(codes.InvalidArgument, "first message must contain attachment info")
+	}
+
+	if info.TransactionId <= 0 || info.UserUid == "" {
+		return status.Errorf(codes.InvalidArgument, "transaction_id and user_uid are required")
+	}
+
+	attachment, err := h.service.UploadAttachment(stream.Context(), models.UploadAttachmentInput{
+		TransactionID: info.TransactionId,
+		UserUID:       info.UserUid,
+		FileName:      info.FileName,
+		ContentType:   info.ContentType,
+		Content:       &chunkReader{stream: stream},
+	})
+	if err != nil {
+		return attachmentError(err, "failed to upload attachment")
+	}
+
+	return stream.SendAndClose(&pb.UploadAttachmentResponse{
+		Attachment: h.attachmentToProto(attachment),
+	})
+}
+
+// chunkReader exposes the chunks of an upload stream as an io.Reader
+type chunkReader struct {
+	stream grpc.ClientStreamingServer[pb.UploadAttachmentRequest, pb.UploadAttachmentResponse]
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			// io.EOF from the stream marks the end of the file
+			return 0, err
+		}
+
+		if req.GetInfo() != nil {
+			return 0, status.Errorf(codes.InvalidArgument, "attachment info must be sent only once")
+		}
+		r.buf = req.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
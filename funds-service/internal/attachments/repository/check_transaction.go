@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// checkTransaction verifies that the transaction exists, belongs to the user and is not in the trash
+func checkTransaction(row pgx.Row, userUID string) error {
+	var (
+		ownerUID  string
+		deletedAt *time.Time
+	)
+
+	if err := row.Scan(&ownerUID, &deletedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTransactionNotFound
+		}
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if ownerUID != userUID {
+		return ErrTransactionNotOwned
+	}
+
+	if deletedAt != nil {
+		return ErrTransactionDeleted
+	}
+
+	return nil
+}
+
+const transactionOwnerQuery = `SELECT user_uid, deleted_at FROM transactions WHERE id = $1`
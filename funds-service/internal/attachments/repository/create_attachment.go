@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// CreateAttachment stores attachment metadata. Uploads of the same user are serialized
+// with an advisory lock, so concurrent uploads cannot exceed the quota together.
+func (r *AttachmentsRepository) CreateAttachment(ctx context.Context, attachment *models.Attachment, quota int64) (*models.Attachment, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, attachment.UserUID); err != nil {
+		return nil, fmt.Errorf("failed to lock user attachments: %w", err)
+	}
+
+	// FOR SHARE keeps the transaction from being purged until the attachment is stored
+	row := tx.QueryRow(ctx, transactionOwnerQuery+` FOR SHARE`, attachment.TransactionID)
+	if err := checkTransaction(row, attachment.UserUID); err != nil {
+		return nil, err
+	}
+
+	var used int64
+	err = tx.QueryRow(ctx, `SELECT COALESCE(SUM(size_bytes), 0) FROM attachments WHERE user_uid = $1`, attachment.UserUID).Scan(&used)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment usage: %w", err)
+	}
+
+	if used+attachment.Size > quota {
+		return nil, ErrQuotaExceeded
+	}
+
+	query := `
+		INSERT INTO attachments (transaction_id, user_uid, file_name, content_type, size_bytes,
+		                         storage_key, thumbnail_key, checksum)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + attachmentColumns
+
+	created, err := scanAttachment(tx.QueryRow(ctx, query,
+		attachment.TransactionID,
+		attachment.UserUID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.StorageKey,
+		attachment.ThumbnailKey,
+		attachment.Checksum,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return created, nil
+}
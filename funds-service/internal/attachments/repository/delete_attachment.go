@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// DeleteAttachment removes attachment metadata and returns the deleted row,
+// so the caller can remove its blobs
+func (r *AttachmentsRepository) DeleteAttachment(ctx context.Context, id int64, userUID string) (*models.Attachment, error) {
+	query := `DELETE FROM attachments WHERE id = $1 AND user_uid = $2 RETURNING ` + attachmentColumns
+
+	attachment, err := scanAttachment(r.db.QueryRow(ctx, query, id, userUID))
+	if err == nil {
+		return attachment, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	// Nothing was deleted, tell a missing attachment from a foreign one
+	if _, err := r.GetAttachmentById(ctx, id, userUID); err != nil {
+		return nil, err
+	}
+
+	return nil, ErrAttachmentNotFound
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *AttachmentsRepository) GetAttachmentById(ctx context.Context, id int64, userUID string) (*models.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM attachments WHERE id = $1`
+
+	attachment, err := scanAttachment(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	// Verify that the attachment belongs to the user
+	if attachment.UserUID != userUID {
+		return nil, ErrAttachmentNotOwned
+	}
+
+	return attachment, nil
+}
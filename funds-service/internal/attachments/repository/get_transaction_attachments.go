@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (r *AttachmentsRepository) GetTransactionAttachments(ctx context.Context, transactionID int64, userUID string) ([]*models.Attachment, error) {
+	if err := checkTransaction(r.db.QueryRow(ctx, transactionOwnerQuery, transactionID), userUID); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + attachmentColumns + `
+		FROM attachments
+		WHERE transaction_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction attachments: %w", err)
+	}
+	defer rows.Close()
+
+	attachments := make([]*models.Attachment, 0)
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read attachments: %w", err)
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r *AttachmentsRepository) GetUserUsage(ctx context.Context, userUID string) (int64, int64, error) {
+	query := `SELECT COALESCE(SUM(size_bytes), 0), COUNT(*) FROM attachments WHERE user_uid = $1`
+
+	var usedBytes, count int64
+	if err := r.db.QueryRow(ctx, query, userUID).Scan(&usedBytes, &count); err != nil {
+		return 0, 0, fmt.Errorf("failed to get attachment usage: %w", err)
+	}
+
+	return usedBytes, count, nil
+}
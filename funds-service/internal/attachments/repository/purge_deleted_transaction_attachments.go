@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// PurgeDeletedTransactionAttachments removes attachments of transactions that were moved
+// to the trash before the given moment and returns them, so the caller can remove their blobs
+func (r *AttachmentsRepository) PurgeDeletedTransactionAttachments(ctx context.Context, before time.Time) ([]*models.Attachment, error) {
+	query := `
+		DELETE FROM attachments a
+		USING transactions t
+		WHERE t.id = a.transaction_id
+		  AND t.deleted_at IS NOT NULL
+		  AND t.deleted_at < $1
+		RETURNING a.id, a.transaction_id, a.user_uid, a.file_name, a.content_type, a.size_bytes,
+		          a.storage_key, a.thumbnail_key, a.checksum, a.created_at
+	`
+
+	rows, err := r.db.Query(ctx, query, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge attachments: %w", err)
+	}
+	defer rows.Close()
+
+	attachments := make([]*models.Attachment, 0)
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read purged attachments: %w", err)
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrTransactionNotOwned = errors.New("transaction does not belong to user")
+	ErrTransactionDeleted  = errors.New("transaction is deleted")
+	ErrAttachmentNotFound  = errors.New("attachment not found")
+	ErrAttachmentNotOwned  = errors.New("attachment does not belong to user")
+	ErrQuotaExceeded       = errors.New("attachment storage quota exceeded")
+)
+
+type AttachmentsRepositorer interface {
+	CreateAttachment(ctx context.Context, attachment *models.Attachment, quota int64) (*models.Attachment, error)
+	GetAttachmentById(ctx context.Context, id int64, userUID string) (*models.Attachment, error)
+	GetTransactionAttachments(ctx context.Context, transactionID int64, userUID string) ([]*models.Attachment, error)
+	DeleteAttachment(ctx context.Context, id int64, userUID string) (*models.Attachment, error)
+	GetUserUsage(ctx context.Context, userUID string) (usedBytes, count int64, err error)
+	PurgeDeletedTransactionAttachments(ctx context.Context, before time.Time) ([]*models.Attachment, error)
+}
+
+type AttachmentsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) AttachmentsRepositorer {
+	return &AttachmentsRepository{db: db}
+}
+
+const attachmentColumns = `id, transaction_id, user_uid, file_name, content_type, size_bytes,
+		       storage_key, thumbnail_key, checksum, created_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAttachment(row rowScanner) (*models.Attachment, error) {
+	var attachment models.Attachment
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.TransactionID,
+		&attachment.UserUID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.StorageKey,
+		&attachment.ThumbnailKey,
+		&attachment.Checksum,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachment, nil
+}
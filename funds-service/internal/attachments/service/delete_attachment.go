@@ -0,0 +1,14 @@
+package service
+
+import "context"
+
+func (s *AttachmentsService) DeleteAttachment(ctx context.Context, id int64, userUID string) error {
+	attachment, err := s.repo.DeleteAttachment(ctx, id, userUID)
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobs(ctx, attachment)
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *AttachmentsService) GetAttachmentUsage(ctx context.Context, userUID string) (*models.AttachmentUsage, error) {
+	usedBytes, count, err := s.repo.GetUserUsage(ctx, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AttachmentUsage{
+		UsedBytes:           usedBytes,
+		QuotaBytes:          s.cfg.UserQuota,
+		Count:               count,
+		MaxFileSize:         s.cfg.MaxFileSize,
+		AllowedContentTypes: s.cfg.AllowedTypes,
+	}, nil
+}
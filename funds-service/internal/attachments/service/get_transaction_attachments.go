@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *AttachmentsService) GetTransactionAttachments(ctx context.Context, transactionID int64, userUID string) ([]*models.Attachment, error) {
+	return s.repo.GetTransactionAttachments(ctx, transactionID, userUID)
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/blob"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *AttachmentsService) OpenAttachment(ctx context.Context, id int64, userUID string, thumbnail bool) (*models.AttachmentContent, error) {
+	attachment, err := s.repo.GetAttachmentById(ctx, id, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, fileName, contentType := attachment.StorageKey, attachment.FileName, attachment.ContentType
+	if thumbnail {
+		if attachment.ThumbnailKey == nil {
+			return nil, ErrThumbnailNotAvailable
+		}
+		key, contentType = *attachment.ThumbnailKey, thumbnailContentType
+		fileName = strings.TrimSuffix(fileName, path.Ext(fileName)) + "_thumb.jpg"
+	}
+
+	body, size, err := s.store.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			return nil, ErrAttachmentBlobNotFound
+		}
+		return nil, err
+	}
+
+	return &models.AttachmentContent{
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		Body:        body,
+	}, nil
+}
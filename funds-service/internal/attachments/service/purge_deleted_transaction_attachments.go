@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// PurgeDeletedTransactionAttachments removes attachments of transactions that are about
+// to be purged from the trash, together with their blobs
+func (s *AttachmentsService) PurgeDeletedTransactionAttachments(ctx context.Context, before time.Time) (int64, error) {
+	attachments, err := s.repo.PurgeDeletedTransactionAttachments(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, attachment := range attachments {
+		s.deleteBlobs(ctx, attachment)
+	}
+
+	return int64(len(attachments)), nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/attachments/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/config"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/blob"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+var (
+	ErrEmptyFile              = errors.New("file is empty")
+	ErrFileTooLarge           = errors.New("file is too large")
+	ErrContentTypeNotAllowed  = errors.New("content type is not allowed")
+	ErrInvalidImage           = errors.New("image cannot be decoded")
+	ErrThumbnailNotAvailable  = errors.New("attachment has no thumbnail")
+	ErrAttachmentBlobNotFound = errors.New("attachment content is missing")
+)
+
+type AttachmentsServicer interface {
+	UploadAttachment(ctx context.Context, input models.UploadAttachmentInput) (*models.Attachment, error)
+	OpenAttachment(ctx context.Context, id int64, userUID string, thumbnail bool) (*models.AttachmentContent, error)
+	GetTransactionAttachments(ctx context.Context, transactionID int64, userUID string) ([]*models.Attachment, error)
+	DeleteAttachment(ctx context.Context, id int64, userUID string) error
+	GetAttachmentUsage(ctx context.Context, userUID string) (*models.AttachmentUsage, error)
+	PurgeDeletedTransactionAttachments(ctx context.Context, before time.Time) (int64, error)
+}
+
+type AttachmentsService struct {
+	repo  repository.AttachmentsRepositorer
+	store blob.Store
+	cfg   config.AttachmentsConfig
+}
+
+func NewService(repo repository.AttachmentsRepositorer, store blob.Store, cfg config.AttachmentsConfig) AttachmentsServicer {
+	return &AttachmentsService{
+		repo:  repo,
+		store: store,
+		cfg:   cfg,
+	}
+}
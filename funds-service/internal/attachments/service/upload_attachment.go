@@ -0,0 +1,125 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/attachments/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/google/uuid"
+)
+
+const thumbnailContentType = "image/jpeg"
+
+// UploadAttachment validates the file, stores its blobs and then its metadata.
+// Blobs are removed again if the metadata cannot be stored.
+func (s *AttachmentsService) UploadAttachment(ctx context.Context, input models.UploadAttachmentInput) (*models.Attachment, error) {
+	// Read one byte past the limit to detect oversized files without buffering them whole
+	content, err := io.ReadAll(io.LimitReader(input.Content, s.cfg.MaxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	if len(content) == 0 {
+		return nil, ErrEmptyFile
+	}
+	if int64(len(content)) > s.cfg.MaxFileSize {
+		return nil, ErrFileTooLarge
+	}
+
+	// The declared content type is not trusted, the sniffed one is stored
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(content))
+	if err != nil || !slices.Contains(s.cfg.AllowedTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s", ErrContentTypeNotAllowed, contentType)
+	}
+
+	usedBytes, _, err := s.repo.GetUserUsage(ctx, input.UserUID)
+	if err != nil {
+		return nil, err
+	}
+	// Fail fast before writing blobs, the repository checks the quota again under a lock
+	if usedBytes+int64(len(content)) > s.cfg.UserQuota {
+		return nil, repository.ErrQuotaExceeded
+	}
+
+	var thumbnail []byte
+	if strings.HasPrefix(contentType, "image/") {
+		if thumbnail, err = makeThumbnail(content, s.cfg.ThumbnailSize); err != nil {
+			return nil, err
+		}
+	}
+
+	checksum := sha256.Sum256(content)
+	attachment := &models.Attachment{
+		TransactionID: input.TransactionID,
+		UserUID:       input.UserUID,
+		FileName:      sanitizeFileName(input.FileName),
+		ContentType:   contentType,
+		Size:          int64(len(content)),
+		StorageKey:    fmt.Sprintf("users/%s/%s", input.UserUID, uuid.NewString()),
+		Checksum:      hex.EncodeToString(checksum[:]),
+	}
+
+	if err := s.store.Put(ctx, attachment.StorageKey, bytes.NewReader(content), attachment.Size, contentType); err != nil {
+		return nil, err
+	}
+
+	if thumbnail != nil {
+		thumbnailKey := attachment.StorageKey + "-thumb"
+		if err := s.store.Put(ctx, thumbnailKey, bytes.NewReader(thumbnail), int64(len(thumbnail)), thumbnailContentType); err != nil {
+			s.deleteBlobs(ctx, attachment)
+			return nil, err
+		}
+		attachment.ThumbnailKey = &thumbnailKey
+	}
+
+	created, err := s.repo.CreateAttachment(ctx, attachment, s.cfg.UserQuota)
+	if err != nil {
+		s.deleteBlobs(ctx, attachment)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// deleteBlobs removes the blobs of an attachment. Failures are only logged,
+// a leftover blob is harmless once its metadata is gone.
+func (s *AttachmentsService) deleteBlobs(ctx context.Context, attachment *models.Attachment) {
+	l := log.FromContext(ctx)
+
+	keys := []string{attachment.StorageKey}
+	if attachment.ThumbnailKey != nil {
+		keys = append(keys, *attachment.ThumbnailKey)
+	}
+
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			l.Errorf("attachments: failed to delete blob %s: %v", key, err)
+		}
+	}
+}
+
+// sanitizeFileName drops any directory part and limits the name to the column size
+func sanitizeFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" || name == "" {
+		name = "attachment"
+	}
+
+	runes := []rune(name)
+	if len(runes) > 255 {
+		name = string(runes[:255])
+	}
+
+	return name
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) GetUserBudgets(ctx context.Context, req *pb.GetUserBudgetsRequest) (*pb.GetUserBudgetsResponse, error) {
+	if req.UserUid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_uid is required")
+	}
+
+	month, err := parseMonth(req.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := h.service.GetUserBudgets(ctx, req.UserUid, month, req.Locales)
+	if err != nil {
+		return nil, budgetError(err, "failed to get user budgets")
+	}
+
+	return &pb.GetUserBudgetsResponse{
+		Budgets: h.budgetsToProto(budgets),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/budgets/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/budgets/service"
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GRPCHandler struct {
+	pb.UnimplementedBudgetServiceServer
+	service service.BudgetsServicer
+}
+
+func NewGRPCHandler(service service.BudgetsServicer) *GRPCHandler {
+	return &GRPCHandler{
+		service: service,
+	}
+}
+
+func (h *GRPCHandler) budgetToProto(b *models.Budget) *pb.Budget {
+	return &pb.Budget{
+		Id:           b.ID,
+		UserUid:      b.UserUID,
+		CategoryId:   b.CategoryID,
+		CategoryCode: b.CategoryCode,
+		CategoryName: b.CategoryName,
+		Month:        b.Month.Format("2006-01"),
+		Amount:       b.Amount,
+		Source:       b.Source,
+		CreatedAt:    b.CreatedAt.Unix(),
+		UpdatedAt:    b.UpdatedAt.Unix(),
+	}
+}
+
+func (h *GRPCHandler) budgetsToProto(budgets []*models.Budget) []*pb.Budget {
+	pbBudgets := make([]*pb.Budget, 0, len(budgets))
+	for _, b := range budgets {
+		pbBudgets = append(pbBudgets, h.budgetToProto(b))
+	}
+	return pbBudgets
+}
+
+// parseMonth parses a YYYY-MM month into its first day
+func parseMonth(month string) (time.Time, error) {
+	t, err := time.Parse("2006-01", month)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid month format, expected YYYY-MM: %v", err)
+	}
+	return t, nil
+}
+
+// budgetError maps repository errors to gRPC status codes
+func budgetError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrCategoryNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrCategoryNotExpense):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrCategoryArchived):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBudgetItems limits one request to a reasonable number of categories
+const maxBudgetItems = 100
+
+func (h *GRPCHandler) SetBudgets(ctx context.Context, req *pb.SetBudgetsRequest) (*pb.SetBudgetsResponse, error) {
+	if req.UserUid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_uid is required")
+	}
+
+	month, err := parseMonth(req.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Items) > maxBudgetItems {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d budgets can be set at once", maxBudgetItems)
+	}
+
+	source := req.Source
+	switch source {
+	case "":
+		source = models.BudgetSourceManual
+	case models.BudgetSourceManual, models.BudgetSourcePlan:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "source must be manual or plan")
+	}
+
+	switch req.ReplaceSource {
+	case "", models.BudgetSourceManual, models.BudgetSourcePlan:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "replace_source must be manual or plan")
+	}
+
+	items := make([]models.BudgetItem, 0, len(req.Items))
+	seen := make(map[int32]struct{}, len(req.Items))
+	for _, item := range req.Items {
+		if item.CategoryId <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "category_id is required")
+		}
+		if item.Amount < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "amount must not be negative")
+		}
+		if _, ok := seen[item.CategoryId]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate budget for category %d", item.CategoryId)
+		}
+		seen[item.CategoryId] = struct{}{}
+
+		items = append(items, models.BudgetItem{
+			CategoryID: item.CategoryId,
+			Amount:     item.Amount,
+		})
+	}
+
+	result, err := h.service.SetBudgets(ctx, models.SetBudgetsInput{
+		UserUID:       req.UserUid,
+		Month:         month,
+		Items:         items,
+		Replace:       req.Replace,
+		ReplaceSource: req.ReplaceSource,
+		Source:        source,
+	})
+	if err != nil {
+		return nil, budgetError(err, "failed to set budgets")
+	}
+
+	return &pb.SetBudgetsResponse{
+		Budgets: h.budgetsToProto(result.Budgets),
+		Kept:    h.budgetsToProto(result.Kept),
+	}, nil
+}
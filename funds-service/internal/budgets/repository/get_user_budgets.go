@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (r *BudgetsRepository) GetUserBudgets(ctx context.Context, userUID string, month time.Time, locales []string) ([]*models.Budget, error) {
+	budgets, err := getBudgets(ctx, r.db, userUID, month, locales)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user budgets: %w", err)
+	}
+
+	return budgets, nil
+}
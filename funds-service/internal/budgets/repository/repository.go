@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrCategoryNotFound   = errors.New("category not found")
+	ErrCategoryArchived   = errors.New("category is archived")
+	ErrCategoryNotExpense = errors.New("budgets can only be set for expense categories")
+)
+
+type BudgetsRepositorer interface {
+	SetBudgets(ctx context.Context, input models.SetBudgetsInput) (*models.SetBudgetsResult, error)
+	GetUserBudgets(ctx context.Context, userUID string, month time.Time, locales []string) ([]*models.Budget, error)
+}
+
+type BudgetsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) BudgetsRepositorer {
+	return &BudgetsRepository{db: db}
+}
+
+// querier is implemented by both the pool and pgx.Tx
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// budgetsQuery selects the budgets of a user and month with the category name in the first
+// of the preferred locales ($1) that has a translation
+const budgetsQuery = `
+	SELECT b.id, b.user_uid, b.category_id, c.code, COALESCE(tr.name, c.name), b.month,
+	       b.amount, b.source, b.created_at, b.updated_at
+	FROM budgets b
+	JOIN categories c ON c.id = b.category_id
+	LEFT JOIN LATERAL (
+		SELECT ct.name
+		FROM category_translations ct
+		WHERE ct.category_id = c.id AND ct.locale = ANY($1::text[])
+		ORDER BY array_position($1::text[], ct.locale)
+		LIMIT 1
+	) tr ON TRUE
+	WHERE b.user_uid = $2 AND b.month = $3
+	ORDER BY b.amount DESC, b.category_id
+`
+
+func getBudgets(ctx context.Context, q querier, userUID string, month time.Time, locales []string) ([]*models.Budget, error) {
+	rows, err := q.Query(ctx, budgetsQuery, locales, userUID, month)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	budgets := make([]*models.Budget, 0)
+	for rows.Next() {
+		var b models.Budget
+		err := rows.Scan(
+			&b.ID,
+			&b.UserUID,
+			&b.CategoryID,
+			&b.CategoryCode,
+			&b.CategoryName,
+			&b.Month,
+			&b.Amount,
+			&b.Source,
+			&b.CreatedAt,
+			&b.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, &b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
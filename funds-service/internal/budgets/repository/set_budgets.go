@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// SetBudgets creates or updates the budgets of the month. With Replace the budgets of the month
+// for categories that are not in the input are deleted, so the month ends up with exactly the input.
+// ReplaceSource keeps the budgets of other sources, both from deletion and from being overwritten,
+// e.g. an accepted plan replaces the budgets of a previous plan but not the ones the user set by hand.
+func (r *BudgetsRepository) SetBudgets(ctx context.Context, input models.SetBudgetsInput) (*models.SetBudgetsResult, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	categoryIDs := make([]int32, 0, len(input.Items))
+	for _, item := range input.Items {
+		categoryIDs = append(categoryIDs, item.CategoryID)
+	}
+
+	rows, err := tx.Query(ctx, `SELECT id, type, archived_at FROM categories WHERE id = ANY($1)`, categoryIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	found := make(map[int32]struct{}, len(categoryIDs))
+	for rows.Next() {
+		var (
+			id         int32
+			kind       string
+			archivedAt *time.Time
+		)
+		if err := rows.Scan(&id, &kind, &archivedAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		if kind != "expense" {
+			rows.Close()
+			return nil, fmt.Errorf("%w: category %d", ErrCategoryNotExpense, id)
+		}
+		if archivedAt != nil {
+			rows.Close()
+			return nil, fmt.Errorf("%w: category %d", ErrCategoryArchived, id)
+		}
+		found[id] = struct{}{}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read categories: %w", err)
+	}
+	for _, id := range categoryIDs {
+		if _, ok := found[id]; !ok {
+			return nil, fmt.Errorf("%w: category %d", ErrCategoryNotFound, id)
+		}
+	}
+
+	if input.Replace {
+		query := `
+			DELETE FROM budgets
+			WHERE user_uid = $1 AND month = $2 AND category_id <> ALL($3)
+			  AND ($4 = '' OR source = $4)
+		`
+		if _, err := tx.Exec(ctx, query, input.UserUID, input.Month, categoryIDs, input.ReplaceSource); err != nil {
+			return nil, fmt.Errorf("failed to delete budgets: %w", err)
+		}
+	}
+
+	upsertQuery := `
+		INSERT INTO budgets (user_uid, category_id, month, amount, source)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_uid, month, category_id) DO UPDATE
+		SET amount = EXCLUDED.amount, source = EXCLUDED.source, updated_at = NOW()
+		WHERE $6 = '' OR budgets.source = $6
+	`
+	kept := make(map[int32]struct{})
+	for _, item := range input.Items {
+		tag, err := tx.Exec(ctx, upsertQuery, input.UserUID, item.CategoryID, input.Month, item.Amount, input.Source, input.ReplaceSource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set budget: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			kept[item.CategoryID] = struct{}{}
+		}
+	}
+
+	budgets, err := getBudgets(ctx, tx, input.UserUID, input.Month, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budgets: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	result := &models.SetBudgetsResult{
+		Budgets: budgets,
+		Kept:    make([]*models.Budget, 0, len(kept)),
+	}
+	for _, b := range budgets {
+		if _, ok := kept[b.CategoryID]; ok {
+			result.Kept = append(result.Kept, b)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *BudgetsService) GetUserBudgets(ctx context.Context, userUID string, month time.Time, locales []string) ([]*models.Budget, error) {
+	return s.repo.GetUserBudgets(ctx, userUID, month, locales)
+}
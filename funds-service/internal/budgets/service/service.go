@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/budgets/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+type BudgetsServicer interface {
+	SetBudgets(ctx context.Context, input models.SetBudgetsInput) (*models.SetBudgetsResult, error)
+	GetUserBudgets(ctx context.Context, userUID string, month time.Time, locales []string) ([]*models.Budget, error)
+}
+
+type BudgetsService struct {
+	repo repository.BudgetsRepositorer
+}
+
+func NewService(repo repository.BudgetsRepositorer) BudgetsServicer {
+	return &BudgetsService{
+		repo: repo,
+	}
+}
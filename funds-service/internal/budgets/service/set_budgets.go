@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *BudgetsService) SetBudgets(ctx context.Context, input models.SetBudgetsInput) (*models.SetBudgetsResult, error) {
+	return s.repo.SetBudgets(ctx, input)
+}
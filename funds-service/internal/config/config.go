@@ -9,10 +9,16 @@ import (
 )
 
 type AppConfig struct {
-	Server   ServerConfig
-	Logger   LoggerConfig
-	Postgres PostgresConfig
-	Kafka    KafkaConfig
+	Server      ServerConfig
+	Logger      LoggerConfig
+	Postgres    PostgresConfig
+	Kafka       KafkaConfig
+	Trash       TrashConfig
+	Idempotency IdempotencyConfig
+	Blob        BlobConfig
+	Attachments AttachmentsConfig
+	Snapshots   SnapshotsConfig
+	Partitions  PartitionsConfig
 }
 
 type ServerConfig struct {
@@ -44,6 +50,50 @@ type KafkaConfig struct {
 	ConnDeadline time.Duration `env:"KAFKA_CONN_DEADLINE" envDefault:"10s"`
 }
 
+type TrashConfig struct {
+	Retention     time.Duration `env:"TRASH_RETENTION" envDefault:"720h"`
+	PurgeInterval time.Duration `env:"TRASH_PURGE_INTERVAL" envDefault:"1h"`
+}
+
+type SnapshotsConfig struct {
+	RefreshInterval time.Duration `env:"BALANCE_SNAPSHOT_INTERVAL" envDefault:"1h"`
+}
+
+// PartitionsConfig controls the maintenance of the monthly transactions partitions
+type PartitionsConfig struct {
+	Interval    time.Duration `env:"TRANSACTIONS_PARTITION_INTERVAL" envDefault:"24h"`
+	MonthsAhead int           `env:"TRANSACTIONS_PARTITION_MONTHS_AHEAD" envDefault:"3"`
+	// ArchiveAfterMonths is the number of full months kept in the table, 0 disables archival
+	ArchiveAfterMonths int `env:"TRANSACTIONS_ARCHIVE_AFTER_MONTHS" envDefault:"0"`
+}
+
+type IdempotencyConfig struct {
+	TTL             time.Duration `env:"IDEMPOTENCY_TTL" envDefault:"24h"`
+	CleanupInterval time.Duration `env:"IDEMPOTENCY_CLEANUP_INTERVAL" envDefault:"1h"`
+}
+
+type BlobConfig struct {
+	Backend  string   `env:"BLOB_BACKEND" envDefault:"local"` // local or s3
+	LocalDir string   `env:"BLOB_LOCAL_DIR" envDefault:"./data/attachments"`
+	S3       S3Config `envPrefix:"S3_"`
+}
+
+type S3Config struct {
+	Endpoint  string `env:"ENDPOINT"`
+	AccessKey string `env:"ACCESS_KEY"`
+	SecretKey string `env:"SECRET_KEY"`
+	Bucket    string `env:"BUCKET" envDefault:"attachments"`
+	Region    string `env:"REGION"`
+	UseSSL    bool   `env:"USE_SSL" envDefault:"false"`
+}
+
+type AttachmentsConfig struct {
+	MaxFileSize   int64    `env:"ATTACHMENT_MAX_FILE_SIZE" envDefault:"10485760"`
+	UserQuota     int64    `env:"ATTACHMENT_USER_QUOTA" envDefault:"104857600"`
+	AllowedTypes  []string `env:"ATTACHMENT_ALLOWED_TYPES" envDefault:"image/jpeg,image/png,image/webp,application/pdf" envSeparator:","`
+	ThumbnailSize int      `env:"ATTACHMENT_THUMBNAIL_SIZE" envDefault:"256"`
+}
+
 func New() (AppConfig, error) {
 	var cfg AppConfig
 	_ = godotenv.Load(".env")
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) CreateDebt(ctx context.Context, req *pb.CreateDebtRequest) (*pb.CreateDebtResponse, error) {
+	if req.UserUid == "" || req.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_uid and title are required")
+	}
+
+	switch req.Kind {
+	case models.DebtKindLoan, models.DebtKindCreditCard, models.DebtKindBorrowed, models.DebtKindLent:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "kind must be loan, credit_card, borrowed or lent")
+	}
+
+	if req.Principal <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "principal must be positive")
+	}
+
+	if req.InterestRate < 0 || req.InterestRate > 1000 {
+		return nil, status.Errorf(codes.InvalidArgument, "interest_rate must be between 0 and 1000")
+	}
+
+	if req.TermMonths < 0 || req.TermMonths > 600 {
+		return nil, status.Errorf(codes.InvalidArgument, "term_months must be between 0 and 600")
+	}
+
+	if req.MonthlyPayment < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "monthly_payment must not be negative")
+	}
+
+	// A loan is defined by its term, the payment is derived from it
+	if req.Kind == models.DebtKindLoan && req.TermMonths == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "term_months is required for loans")
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_date format, expected YYYY-MM-DD: %v", err)
+	}
+
+	debt, err := h.service.CreateDebt(ctx, models.CreateDebtInput{
+		UserUID:        req.UserUid,
+		Kind:           req.Kind,
+		Title:          req.Title,
+		Counterparty:   req.Counterparty,
+		Principal:      req.Principal,
+		InterestRate:   req.InterestRate,
+		TermMonths:     req.TermMonths,
+		StartDate:      startDate,
+		MonthlyPayment: req.MonthlyPayment,
+	})
+	if err != nil {
+		return nil, debtError(err, "failed to create debt")
+	}
+
+	return &pb.CreateDebtResponse{
+		Debt: h.debtToProto(debt),
+	}, nil
+}
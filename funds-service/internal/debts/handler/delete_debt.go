@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) DeleteDebt(ctx context.Context, req *pb.DeleteDebtRequest) (*pb.DeleteDebtResponse, error) {
+	if err := h.service.DeleteDebt(ctx, req.Id, req.UserUid); err != nil {
+		return nil, debtError(err, "failed to delete debt")
+	}
+
+	return &pb.DeleteDebtResponse{
+		Success: true,
+	}, nil
+}
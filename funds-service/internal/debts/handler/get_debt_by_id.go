@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetDebtById(ctx context.Context, req *pb.GetDebtByIdRequest) (*pb.GetDebtByIdResponse, error) {
+	details, err := h.service.GetDebtById(ctx, req.Id, req.UserUid)
+	if err != nil {
+		return nil, debtError(err, "failed to get debt")
+	}
+
+	pbPayments := make([]*pb.DebtPayment, 0, len(details.Payments))
+	for _, p := range details.Payments {
+		pbPayments = append(pbPayments, h.paymentToProto(p))
+	}
+
+	pbSchedule := make([]*pb.AmortizationEntry, 0, len(details.Schedule))
+	for _, e := range details.Schedule {
+		pbSchedule = append(pbSchedule, h.scheduleEntryToProto(e))
+	}
+
+	return &pb.GetDebtByIdResponse{
+		Debt:     h.debtToProto(details.Debt),
+		Payments: pbPayments,
+		Schedule: pbSchedule,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetDebtSummary(ctx context.Context, req *pb.GetDebtSummaryRequest) (*pb.GetDebtSummaryResponse, error) {
+	summary, err := h.service.GetDebtSummary(ctx, req.UserUid)
+	if err != nil {
+		return nil, debtError(err, "failed to get debt summary")
+	}
+
+	resp := &pb.GetDebtSummaryResponse{
+		TotalOwed:       summary.TotalOwed,
+		TotalLent:       summary.TotalLent,
+		MonthlyPayments: summary.MonthlyPayments,
+		ActiveCount:     summary.ActiveCount,
+	}
+
+	if summary.DebtFreeDate != nil {
+		resp.DebtFreeDate = summary.DebtFreeDate.Format("2006-01-02")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetUserDebts(ctx context.Context, req *pb.GetUserDebtsRequest) (*pb.GetUserDebtsResponse, error) {
+	debts, err := h.service.GetUserDebts(ctx, req.UserUid, req.IncludePaidOff)
+	if err != nil {
+		return nil, debtError(err, "failed to get user debts")
+	}
+
+	pbDebts := make([]*pb.Debt, 0, len(debts))
+	for _, d := range debts {
+		pbDebts = append(pbDebts, h.debtToProto(d))
+	}
+
+	return &pb.GetUserDebtsResponse{
+		Debts: pbDebts,
+	}, nil
+}
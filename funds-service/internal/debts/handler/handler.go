@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/debts/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/debts/service"
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GRPCHandler struct {
+	pb.UnimplementedDebtServiceServer
+	service service.DebtsServicer
+}
+
+func NewGRPCHandler(service service.DebtsServicer) *GRPCHandler {
+	return &GRPCHandler{
+		service: service,
+	}
+}
+
+func (h *GRPCHandler) debtToProto(d *models.Debt) *pb.Debt {
+	debt := &pb.Debt{
+		Id:               d.ID,
+		UserUid:          d.UserUID,
+		Kind:             d.Kind,
+		Title:            d.Title,
+		Counterparty:     d.Counterparty,
+		Principal:        d.Principal,
+		InterestRate:     d.InterestRate,
+		TermMonths:       d.TermMonths,
+		StartDate:        d.StartDate.Format("2006-01-02"),
+		MonthlyPayment:   d.MonthlyPayment,
+		RemainingBalance: d.RemainingBalance(),
+		PaidPrincipal:    d.PaidPrincipal,
+		PaidInterest:     d.PaidInterest,
+		PaymentsCount:    d.PaymentsCount,
+		Status:           d.Status(),
+		CreatedAt:        d.CreatedAt.Unix(),
+		UpdatedAt:        d.UpdatedAt.Unix(),
+	}
+
+	if d.PayoffDate != nil {
+		debt.PayoffDate = d.PayoffDate.Format("2006-01-02")
+	}
+	if d.LastPaymentAt != nil {
+		debt.LastPaymentAt = d.LastPaymentAt.Format("2006-01-02")
+	}
+
+	return debt
+}
+
+func (h *GRPCHandler) paymentToProto(p *models.DebtPayment) *pb.DebtPayment {
+	return &pb.DebtPayment{
+		Id:             p.ID,
+		DebtId:         p.DebtID,
+		TransactionId:  p.TransactionID,
+		Amount:         p.Amount,
+		PrincipalPart:  p.PrincipalPart,
+		InterestPart:   p.InterestPart,
+		RemainingAfter: p.RemainingAfter,
+		PaidAt:         p.PaidAt.Format("2006-01-02"),
+		CreatedAt:      p.CreatedAt.Unix(),
+	}
+}
+
+func (h *GRPCHandler) scheduleEntryToProto(e *models.AmortizationEntry) *pb.AmortizationEntry {
+	return &pb.AmortizationEntry{
+		Number:    e.Number,
+		Date:      e.Date.Format("2006-01-02"),
+		Payment:   e.Payment,
+		Principal: e.Principal,
+		Interest:  e.Interest,
+		Remaining: e.Remaining,
+	}
+}
+
+// debtError maps repository errors to gRPC status codes
+func debtError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrDebtNotFound), errors.Is(err, repository.ErrPaymentNotFound),
+		errors.Is(err, repository.ErrTransactionNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrDebtNotOwned), errors.Is(err, repository.ErrTransactionNotOwned):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTransactionTypeMismatch):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrDebtPaidOff), errors.Is(err, repository.ErrTransactionDeleted),
+		errors.Is(err, repository.ErrTransactionAlreadyLinked):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
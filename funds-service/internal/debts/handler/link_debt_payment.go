@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) LinkDebtPayment(ctx context.Context, req *pb.LinkDebtPaymentRequest) (*pb.LinkDebtPaymentResponse, error) {
+	payment, debt, err := h.service.LinkDebtPayment(ctx, req.DebtId, req.UserUid, req.TransactionId)
+	if err != nil {
+		return nil, debtError(err, "failed to link debt payment")
+	}
+
+	return &pb.LinkDebtPaymentResponse{
+		Payment: h.paymentToProto(payment),
+		Debt:    h.debtToProto(debt),
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) UnlinkDebtPayment(ctx context.Context, req *pb.UnlinkDebtPaymentRequest) (*pb.UnlinkDebtPaymentResponse, error) {
+	debt, err := h.service.UnlinkDebtPayment(ctx, req.DebtId, req.UserUid, req.PaymentId)
+	if err != nil {
+		return nil, debtError(err, "failed to unlink debt payment")
+	}
+
+	return &pb.UnlinkDebtPaymentResponse{
+		Debt: h.debtToProto(debt),
+	}, nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (r *DebtsRepository) CreateDebt(ctx context.Context, input models.CreateDebtInput) (*models.Debt, error) {
+	query := `
+		INSERT INTO debts (user_uid, kind, title, counterparty, principal, interest_rate,
+		                   term_months, start_date, monthly_payment)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, user_uid, kind, title, counterparty, principal, interest_rate,
+		          term_months, start_date, monthly_payment, created_at, updated_at
+	`
+
+	var debt models.Debt
+	err := r.db.QueryRow(ctx, query,
+		input.UserUID,
+		input.Kind,
+		input.Title,
+		input.Counterparty,
+		input.Principal,
+		input.InterestRate,
+		input.TermMonths,
+		input.StartDate,
+		input.MonthlyPayment,
+	).Scan(
+		&debt.ID,
+		&debt.UserUID,
+		&debt.Kind,
+		&debt.Title,
+		&debt.Counterparty,
+		&debt.Principal,
+		&debt.InterestRate,
+		&debt.TermMonths,
+		&debt.StartDate,
+		&debt.MonthlyPayment,
+		&debt.CreatedAt,
+		&debt.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create debt: %w", err)
+	}
+
+	return &debt, nil
+}
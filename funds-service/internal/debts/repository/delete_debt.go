@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeleteDebt removes the debt and its payment links, the paying transactions are kept
+func (r *DebtsRepository) DeleteDebt(ctx context.Context, id int64, userUID string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM debts WHERE id = $1 AND user_uid = $2`, id, userUID)
+	if err != nil {
+		return fmt.Errorf("failed to delete debt: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		// Tell a missing debt from a foreign one
+		if _, err := r.GetDebtById(ctx, id, userUID); err != nil {
+			return err
+		}
+		return ErrDebtNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (r *DebtsRepository) GetDebtById(ctx context.Context, id int64, userUID string) (*models.Debt, error) {
+	debt, err := getDebt(ctx, r.db, id, userUID)
+	if err != nil {
+		if errors.Is(err, ErrDebtNotFound) || errors.Is(err, ErrDebtNotOwned) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get debt: %w", err)
+	}
+
+	return debt, nil
+}
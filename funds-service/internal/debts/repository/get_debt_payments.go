@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// GetDebtPayments returns payments of the debt whose transactions are not deleted, oldest first
+func (r *DebtsRepository) GetDebtPayments(ctx context.Context, debtID int64) ([]*models.DebtPayment, error) {
+	query := `
+		SELECT p.id, p.debt_id, p.transaction_id, t.amount, p.principal_part, p.interest_part,
+		       p.remaining_after, t.transaction_date, p.created_at
+		FROM debt_payments p
+		JOIN transactions t ON t.id = p.transaction_id
+		WHERE p.debt_id = $1
+		  AND t.deleted_at IS NULL
+		ORDER BY t.transaction_date, p.id
+	`
+
+	rows, err := r.db.Query(ctx, query, debtID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get debt payments: %w", err)
+	}
+	defer rows.Close()
+
+	payments := make([]*models.DebtPayment, 0)
+	for rows.Next() {
+		var payment models.DebtPayment
+		err := rows.Scan(
+			&payment.ID,
+			&payment.DebtID,
+			&payment.TransactionID,
+			&payment.Amount,
+			&payment.PrincipalPart,
+			&payment.InterestPart,
+			&payment.RemainingAfter,
+			&payment.PaidAt,
+			&payment.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan debt payment: %w", err)
+		}
+		payments = append(payments, &payment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read debt payments: %w", err)
+	}
+
+	return payments, nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (r *DebtsRepository) GetUserDebts(ctx context.Context, userUID string) ([]*models.Debt, error) {
+	query := debtQuery + `
+		WHERE d.user_uid = $1
+		GROUP BY d.id
+		ORDER BY d.start_date DESC, d.id DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, userUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user debts: %w", err)
+	}
+	defer rows.Close()
+
+	debts := make([]*models.Debt, 0)
+	for rows.Next() {
+		debt, err := scanDebt(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan debt: %w", err)
+		}
+		debts = append(debts, debt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read debts: %w", err)
+	}
+
+	return debts, nil
+}
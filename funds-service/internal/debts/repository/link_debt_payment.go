@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// LinkDebtPayment marks the transaction as a payment of the debt and splits it into
+// interest and principal. The debt row is locked, so concurrent payments see each other.
+func (r *DebtsRepository) LinkDebtPayment(ctx context.Context, debtID int64, userUID string, transactionID int64) (*models.DebtPayment, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM debts WHERE id = $1 FOR UPDATE`, debtID); err != nil {
+		return nil, fmt.Errorf("failed to lock debt: %w", err)
+	}
+
+	debt, err := getDebt(ctx, tx, debtID, userUID)
+	if err != nil {
+		if errors.Is(err, ErrDebtNotFound) || errors.Is(err, ErrDebtNotOwned) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get debt: %w", err)
+	}
+
+	if debt.Status() == models.DebtStatusPaidOff {
+		return nil, ErrDebtPaidOff
+	}
+
+	var (
+		ownerUID        string
+		transactionType string
+		amount          float64
+		transactionDate time.Time
+		deletedAt       *time.Time
+		linked          bool
+	)
+	query := `
+		SELECT t.user_uid, t.type, t.amount, t.transaction_date, t.deleted_at,
+		       EXISTS (SELECT 1 FROM debt_payments p WHERE p.transaction_id = t.id)
+		FROM transactions t
+		WHERE t.id = $1
+		FOR SHARE OF t
+	`
+	err = tx.QueryRow(ctx, query, transactionID).Scan(&ownerUID, &transactionType, &amount, &transactionDate, &deletedAt, &linked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	switch {
+	case ownerUID != userUID:
+		return nil, ErrTransactionNotOwned
+	case deletedAt != nil:
+		return nil, ErrTransactionDeleted
+	case linked:
+		return nil, ErrTransactionAlreadyLinked
+	}
+
+	// Liabilities are paid with expenses, money lent comes back as income
+	expectedType := "expense"
+	if !debt.IsLiability() {
+		expectedType = "income"
+	}
+	if transactionType != expectedType {
+		return nil, fmt.Errorf("%w: expected %s", ErrTransactionTypeMismatch, expectedType)
+	}
+
+	principal, interest := debt.SplitPayment(amount)
+	payment := &models.DebtPayment{
+		DebtID:         debtID,
+		TransactionID:  transactionID,
+		Amount:         amount,
+		PrincipalPart:  principal,
+		InterestPart:   interest,
+		RemainingAfter: models.RoundMoney(debt.RemainingBalance() - principal),
+		PaidAt:         transactionDate,
+	}
+
+	insertQuery := `
+		INSERT INTO debt_payments (debt_id, transaction_id, principal_part, interest_part, remaining_after)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	err = tx.QueryRow(ctx, insertQuery, debtID, transactionID, principal, interest, payment.RemainingAfter).Scan(&payment.ID, &payment.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link debt payment: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE debts SET updated_at = NOW() WHERE id = $1`, debtID); err != nil {
+		return nil, fmt.Errorf("failed to update debt: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return payment, nil
+}
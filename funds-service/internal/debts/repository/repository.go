@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrDebtNotFound             = errors.New("debt not found")
+	ErrDebtNotOwned             = errors.New("debt does not belong to user")
+	ErrDebtPaidOff              = errors.New("debt is already paid off")
+	ErrPaymentNotFound          = errors.New("debt payment not found")
+	ErrTransactionNotFound      = errors.New("transaction not found")
+	ErrTransactionNotOwned      = errors.New("transaction does not belong to user")
+	ErrTransactionDeleted       = errors.New("transaction is deleted")
+	ErrTransactionTypeMismatch  = errors.New("transaction type does not match debt kind")
+	ErrTransactionAlreadyLinked = errors.New("transaction is already linked to a debt")
+)
+
+type DebtsRepositorer interface {
+	CreateDebt(ctx context.Context, input models.CreateDebtInput) (*models.Debt, error)
+	GetDebtById(ctx context.Context, id int64, userUID string) (*models.Debt, error)
+	GetUserDebts(ctx context.Context, userUID string) ([]*models.Debt, error)
+	GetDebtPayments(ctx context.Context, debtID int64) ([]*models.DebtPayment, error)
+	DeleteDebt(ctx context.Context, id int64, userUID string) error
+	LinkDebtPayment(ctx context.Context, debtID int64, userUID string, transactionID int64) (*models.DebtPayment, error)
+	UnlinkDebtPayment(ctx context.Context, debtID int64, userUID string, paymentID int64) error
+}
+
+type DebtsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) DebtsRepositorer {
+	return &DebtsRepository{db: db}
+}
+
+// querier is implemented by both the pool and pgx.Tx
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// debtQuery selects debts with the totals of payments whose transactions are not deleted
+const debtQuery = `
+	SELECT d.id, d.user_uid, d.kind, d.title, d.counterparty, d.principal, d.interest_rate,
+	       d.term_months, d.start_date, d.monthly_payment, d.created_at, d.updated_at,
+	       COALESCE(SUM(p.principal_part) FILTER (WHERE t.deleted_at IS NULL), 0),
+	       COALESCE(SUM(p.interest_part) FILTER (WHERE t.deleted_at IS NULL), 0),
+	       COUNT(p.id) FILTER (WHERE t.deleted_at IS NULL),
+	       MAX(t.transaction_date) FILTER (WHERE t.deleted_at IS NULL)
+	FROM debts d
+	LEFT JOIN debt_payments p ON p.debt_id = d.id
+	LEFT JOIN transactions t ON t.id = p.transaction_id
+`
+
+func scanDebt(row pgx.Row) (*models.Debt, error) {
+	var debt models.Debt
+	err := row.Scan(
+		&debt.ID,
+		&debt.UserUID,
+		&debt.Kind,
+		&debt.Title,
+		&debt.Counterparty,
+		&debt.Principal,
+		&debt.InterestRate,
+		&debt.TermMonths,
+		&debt.StartDate,
+		&debt.MonthlyPayment,
+		&debt.CreatedAt,
+		&debt.UpdatedAt,
+		&debt.PaidPrincipal,
+		&debt.PaidInterest,
+		&debt.PaymentsCount,
+		&debt.LastPaymentAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &debt, nil
+}
+
+// getDebt returns the debt and verifies that it belongs to the user
+func getDebt(ctx context.Context, q querier, id int64, userUID string) (*models.Debt, error) {
+	debt, err := scanDebt(q.QueryRow(ctx, debtQuery+` WHERE d.id = $1 GROUP BY d.id`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDebtNotFound
+		}
+		return nil, err
+	}
+
+	if debt.UserUID != userUID {
+		return nil, ErrDebtNotOwned
+	}
+
+	return debt, nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// UnlinkDebtPayment removes the payment link, the transaction itself is kept
+func (r *DebtsRepository) UnlinkDebtPayment(ctx context.Context, debtID int64, userUID string, paymentID int64) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM debts WHERE id = $1 FOR UPDATE`, debtID); err != nil {
+		return fmt.Errorf("failed to lock debt: %w", err)
+	}
+
+	if _, err := getDebt(ctx, tx, debtID, userUID); err != nil {
+		if errors.Is(err, ErrDebtNotFound) || errors.Is(err, ErrDebtNotOwned) {
+			return err
+		}
+		return fmt.Errorf("failed to get debt: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM debt_payments WHERE id = $1 AND debt_id = $2`, paymentID, debtID)
+	if err != nil {
+		return fmt.Errorf("failed to unlink debt payment: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrPaymentNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE debts SET updated_at = NOW() WHERE id = $1`, debtID); err != nil {
+		return fmt.Errorf("failed to update debt: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
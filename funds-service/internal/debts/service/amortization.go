@@ -0,0 +1,87 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// maxScheduleEntries bounds projections of debts that are paid off very slowly
+const maxScheduleEntries = 600
+
+// annuityPayment returns the fixed monthly payment that repays the principal with interest over the term
+func annuityPayment(principal, monthlyRate float64, termMonths int32) float64 {
+	if termMonths <= 0 {
+		return 0
+	}
+	if monthlyRate == 0 {
+		return models.RoundMoney(principal / float64(termMonths))
+	}
+
+	payment := principal * monthlyRate / (1 - math.Pow(1+monthlyRate, -float64(termMonths)))
+
+	// Round up, so rounding never leaves a tail after the last payment
+	return math.Ceil(payment*100) / 100
+}
+
+// projectSchedule projects monthly payments from the remaining balance. It returns nil
+// when the debt has no regular payment or the payment does not cover the interest.
+func projectSchedule(debt *models.Debt) []*models.AmortizationEntry {
+	remaining := debt.RemainingBalance()
+	if debt.Status() == models.DebtStatusPaidOff || debt.MonthlyPayment <= 0 {
+		return nil
+	}
+
+	rate := debt.MonthlyRate()
+	dueDate := nextDueDate(debt)
+
+	schedule := make([]*models.AmortizationEntry, 0)
+	for n := int32(1); remaining >= 0.005; n++ {
+		if n > maxScheduleEntries {
+			return nil
+		}
+
+		interest := models.RoundMoney(remaining * rate)
+		if debt.MonthlyPayment <= interest {
+			return nil
+		}
+
+		principal := min(models.RoundMoney(debt.MonthlyPayment-interest), remaining)
+		remaining = models.RoundMoney(remaining - principal)
+
+		schedule = append(schedule, &models.AmortizationEntry{
+			Number:    n,
+			Date:      dueDate,
+			Payment:   models.RoundMoney(principal + interest),
+			Principal: principal,
+			Interest:  interest,
+			Remaining: remaining,
+		})
+
+		dueDate = dueDate.AddDate(0, 1, 0)
+	}
+
+	return schedule
+}
+
+// nextDueDate returns the date of the next payment: a month after the last payment,
+// or a month after the start for debts without payments
+func nextDueDate(debt *models.Debt) time.Time {
+	if debt.LastPaymentAt != nil {
+		return debt.LastPaymentAt.AddDate(0, 1, 0)
+	}
+	return debt.StartDate.AddDate(0, 1, 0)
+}
+
+// withPayoffDate sets the projected payoff date of the debt
+func withPayoffDate(debt *models.Debt, schedule []*models.AmortizationEntry) *models.Debt {
+	switch {
+	case debt.Status() == models.DebtStatusPaidOff:
+		debt.PayoffDate = debt.LastPaymentAt
+	case len(schedule) > 0:
+		debt.PayoffDate = &schedule[len(schedule)-1].Date
+	}
+
+	return debt
+}
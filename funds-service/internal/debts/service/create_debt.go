@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *DebtsService) CreateDebt(ctx context.Context, input models.CreateDebtInput) (*models.Debt, error) {
+	if input.MonthlyPayment == 0 {
+		input.MonthlyPayment = annuityPayment(input.Principal, input.InterestRate/100/12, input.TermMonths)
+	}
+
+	debt, err := s.repo.CreateDebt(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, input.UserUID)
+
+	return withPayoffDate(debt, projectSchedule(debt)), nil
+}
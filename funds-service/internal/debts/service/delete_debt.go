@@ -0,0 +1,13 @@
+package service
+
+import "context"
+
+func (s *DebtsService) DeleteDebt(ctx context.Context, id int64, userUID string) error {
+	if err := s.repo.DeleteDebt(ctx, id, userUID); err != nil {
+		return err
+	}
+
+	s.notify(ctx, userUID)
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *DebtsService) GetDebtById(ctx context.Context, id int64, userUID string) (*models.DebtDetails, error) {
+	debt, err := s.repo.GetDebtById(ctx, id, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.repo.GetDebtPayments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := projectSchedule(debt)
+
+	return &models.DebtDetails{
+		Debt:     withPayoffDate(debt, schedule),
+		Payments: payments,
+		Schedule: schedule,
+	}, nil
+}
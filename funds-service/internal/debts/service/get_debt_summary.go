@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// GetDebtSummary sums up active debts of the user. Monthly payments and the debt-free date
+// cover liabilities only, money lent to others is reported separately.
+func (s *DebtsService) GetDebtSummary(ctx context.Context, userUID string) (*models.DebtSummary, error) {
+	debts, err := s.GetUserDebts(ctx, userUID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &models.DebtSummary{}
+	neverPaidOff := false
+	for _, debt := range debts {
+		summary.ActiveCount++
+
+		if !debt.IsLiability() {
+			summary.TotalLent += debt.RemainingBalance()
+			continue
+		}
+
+		summary.TotalOwed += debt.RemainingBalance()
+		summary.MonthlyPayments += debt.MonthlyPayment
+
+		if debt.PayoffDate == nil {
+			neverPaidOff = true
+		} else if summary.DebtFreeDate == nil || debt.PayoffDate.After(*summary.DebtFreeDate) {
+			summary.DebtFreeDate = debt.PayoffDate
+		}
+	}
+
+	// A liability without a projected payoff leaves the debt-free date unknown
+	if neverPaidOff {
+		summary.DebtFreeDate = nil
+	}
+
+	summary.TotalOwed = models.RoundMoney(summary.TotalOwed)
+	summary.TotalLent = models.RoundMoney(summary.TotalLent)
+	summary.MonthlyPayments = models.RoundMoney(summary.MonthlyPayments)
+
+	return summary, nil
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *DebtsService) GetUserDebts(ctx context.Context, userUID string, includePaidOff bool) ([]*models.Debt, error) {
+	debts, err := s.repo.GetUserDebts(ctx, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.Debt, 0, len(debts))
+	for _, debt := range debts {
+		if !includePaidOff && debt.Status() == models.DebtStatusPaidOff {
+			continue
+		}
+		result = append(result, withPayoffDate(debt, projectSchedule(debt)))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *DebtsService) LinkDebtPayment(ctx context.Context, debtID int64, userUID string, transactionID int64) (*models.DebtPayment, *models.Debt, error) {
+	payment, err := s.repo.LinkDebtPayment(ctx, debtID, userUID, transactionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.notify(ctx, userUID)
+
+	debt, err := s.repo.GetDebtById(ctx, debtID, userUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return payment, withPayoffDate(debt, projectSchedule(debt)), nil
+}
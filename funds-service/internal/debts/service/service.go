@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	producer "github.com/cg-2025-crutch/backend/funds-service/internal/adapters/producers"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/debts/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+type DebtsServicer interface {
+	CreateDebt(ctx context.Context, input models.CreateDebtInput) (*models.Debt, error)
+	GetDebtById(ctx context.Context, id int64, userUID string) (*models.DebtDetails, error)
+	GetUserDebts(ctx context.Context, userUID string, includePaidOff bool) ([]*models.Debt, error)
+	DeleteDebt(ctx context.Context, id int64, userUID string) error
+	LinkDebtPayment(ctx context.Context, debtID int64, userUID string, transactionID int64) (*models.DebtPayment, *models.Debt, error)
+	UnlinkDebtPayment(ctx context.Context, debtID int64, userUID string, paymentID int64) (*models.Debt, error)
+	GetDebtSummary(ctx context.Context, userUID string) (*models.DebtSummary, error)
+}
+
+type DebtsService struct {
+	repo repository.DebtsRepositorer
+	prod producer.Producer
+}
+
+func NewService(repo repository.DebtsRepositorer, prod producer.Producer) DebtsServicer {
+	return &DebtsService{
+		repo: repo,
+		prod: prod,
+	}
+}
+
+// notify emits an analytics event after a debt change, the debt-to-income ratio depends on it.
+// The change is already stored, so a failed event is logged instead of failing the request.
+func (s *DebtsService) notify(ctx context.Context, userUID string) {
+	if err := s.prod.Produce(ctx, []byte(userUID), []byte("update")); err != nil {
+		log.FromContext(ctx).Errorf("failed to produce debt update event: %v", err)
+	}
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *DebtsService) UnlinkDebtPayment(ctx context.Context, debtID int64, userUID string, paymentID int64) (*models.Debt, error) {
+	if err := s.repo.UnlinkDebtPayment(ctx, debtID, userUID, paymentID); err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, userUID)
+
+	debt, err := s.repo.GetDebtById(ctx, debtID, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return withPayoffDate(debt, projectSchedule(debt)), nil
+}
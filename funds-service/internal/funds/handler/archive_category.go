@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) ArchiveCategory(ctx context.Context, req *pb.ArchiveCategoryRequest) (*pb.ArchiveCategoryResponse, error) {
+	category, err := h.service.ArchiveCategory(ctx, req.Id)
+	if err != nil {
+		return nil, categoryError(err, "failed to archive category")
+	}
+
+	return &pb.ArchiveCategoryResponse{
+		Category: h.categoryToProto(category),
+	}, nil
+}
+
+func (h *GRPCHandler) RestoreCategory(ctx context.Context, req *pb.RestoreCategoryRequest) (*pb.RestoreCategoryResponse, error) {
+	category, err := h.service.RestoreCategory(ctx, req.Id)
+	if err != nil {
+		return nil, categoryError(err, "failed to restore category")
+	}
+
+	return &pb.RestoreCategoryResponse{
+		Category: h.categoryToProto(category),
+	}, nil
+}
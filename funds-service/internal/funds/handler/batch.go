@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/repository"
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Codes reported in BatchItemError
+const (
+	batchCodeInvalidArgument    = "invalid_argument"
+	batchCodeNotFound           = "not_found"
+	batchCodePermissionDenied   = "permission_denied"
+	batchCodeFailedPrecondition = "failed_precondition"
+	batchCodeInternal           = "internal"
+)
+
+// batchItems tracks which request items passed validation, so that errors
+// returned by the service can be reported with their original positions
+type batchItems struct {
+	indexes []int
+	errors  []*pb.BatchItemError
+}
+
+func validateBatchSize(userUID string, n int) error {
+	if userUID == "" {
+		return status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+	if n == 0 {
+		return status.Error(codes.InvalidArgument, "batch is empty")
+	}
+	if n > models.MaxBatchSize {
+		return status.Errorf(codes.InvalidArgument, "batch must not contain more than %d items", models.MaxBatchSize)
+	}
+	return nil
+}
+
+func (b *batchItems) valid(index int) {
+	b.indexes = append(b.indexes, index)
+}
+
+func (b *batchItems) invalid(index int, message string) {
+	b.errors = append(b.errors, &pb.BatchItemError{
+		Index:   int32(index),
+		Code:    batchCodeInvalidArgument,
+		Message: message,
+	})
+}
+
+// skipService reports whether the service call should be skipped because nothing can be applied
+func (b *batchItems) skipService(allOrNothing bool) bool {
+	return len(b.indexes) == 0 || (allOrNothing && len(b.errors) > 0)
+}
+
+// merge combines validation errors with the errors returned by the service, ordered by position
+func (b *batchItems) merge(serviceErrors []models.BatchItemError) []*pb.BatchItemError {
+	result := append(make([]*pb.BatchItemError, 0, len(b.errors)+len(serviceErrors)), b.errors...)
+	for _, e := range serviceErrors {
+		result = append(result, &pb.BatchItemError{
+			Index:   int32(b.indexes[e.Index]),
+			Code:    batchItemCode(e.Err),
+			Message: e.Err.Error(),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Index < result[j].Index
+	})
+
+	return result
+}
+
+func batchItemCode(err error) string {
+	switch {
+	case errors.Is(err, repository.ErrTransactionNotFound), errors.Is(err, repository.ErrLedgerNotFound):
+		return batchCodeNotFound
+	case errors.Is(err, repository.ErrTransactionNotOwned), errors.Is(err, repository.ErrLedgerAccessDenied):
+		return batchCodePermissionDenied
+	case errors.Is(err, repository.ErrTransactionDeleted), errors.Is(err, repository.ErrVersionMismatch),
+		errors.Is(err, repository.ErrCategoryArchived):
+		return batchCodeFailedPrecondition
+	case errors.Is(err, repository.ErrCategoryNotFound), errors.Is(err, repository.ErrCategoryTypeMismatch):
+		return batchCodeInvalidArgument
+	default:
+		return batchCodeInternal
+	}
+}
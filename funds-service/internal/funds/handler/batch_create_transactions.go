@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) BatchCreateTransactions(ctx context.Context, req *pb.BatchCreateTransactionsRequest) (*pb.BatchCreateTransactionsResponse, error) {
+	if err := validateBatchSize(req.UserUid, len(req.Items)); err != nil {
+		return nil, err
+	}
+
+	var items batchItems
+	inputs := make([]models.CreateTransactionInput, 0, len(req.Items))
+	for i, item := range req.Items {
+		transactionDate, err := time.Parse("2006-01-02", item.TransactionDate)
+		if err != nil {
+			items.invalid(i, "invalid transaction date format")
+			continue
+		}
+		if item.Type != "income" && item.Type != "expense" {
+			items.invalid(i, "type must be 'income' or 'expense'")
+			continue
+		}
+		if item.Amount <= 0 {
+			items.invalid(i, "amount must be positive")
+			continue
+		}
+		if item.Title == "" {
+			items.invalid(i, "title is required")
+			continue
+		}
+
+		input := models.CreateTransactionInput{
+			UserUID:         req.UserUid,
+			CategoryID:      item.CategoryId,
+			Type:            item.Type,
+			Amount:          item.Amount,
+			Title:           item.Title,
+			Description:     item.Description,
+			TransactionDate: transactionDate,
+			Tags:            item.Tags,
+		}
+		if item.LedgerId != 0 {
+			input.LedgerID = &item.LedgerId
+		}
+
+		items.valid(i)
+		inputs = append(inputs, input)
+	}
+
+	if items.skipService(req.AllOrNothing) {
+		return &pb.BatchCreateTransactionsResponse{
+			Transactions: []*pb.Transaction{},
+			Errors:       items.merge(nil),
+		}, nil
+	}
+
+	result, err := h.service.BatchCreateTransactions(ctx, req.UserUid, inputs, req.AllOrNothing)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create transactions: %v", err)
+	}
+
+	pbTransactions := make([]*pb.Transaction, 0, len(result.Transactions))
+	for _, t := range result.Transactions {
+		pbTransactions = append(pbTransactions, h.transactionToProto(t))
+	}
+
+	return &pb.BatchCreateTransactionsResponse{
+		Transactions: pbTransactions,
+		Errors:       items.merge(result.Errors),
+		Committed:    result.Committed,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) BatchDeleteTransactions(ctx context.Context, req *pb.BatchDeleteTransactionsRequest) (*pb.BatchDeleteTransactionsResponse, error) {
+	if err := validateBatchSize(req.UserUid, len(req.Ids)); err != nil {
+		return nil, err
+	}
+
+	var items batchItems
+	ids := make([]int64, 0, len(req.Ids))
+	for i, id := range req.Ids {
+		if id <= 0 {
+			items.invalid(i, "invalid transaction id")
+			continue
+		}
+
+		items.valid(i)
+		ids = append(ids, id)
+	}
+
+	if items.skipService(req.AllOrNothing) {
+		return &pb.BatchDeleteTransactionsResponse{
+			DeletedIds: []int64{},
+			Errors:     items.merge(nil),
+		}, nil
+	}
+
+	result, err := h.service.BatchDeleteTransactions(ctx, req.UserUid, ids, req.AllOrNothing)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete transactions: %v", err)
+	}
+
+	deletedIDs := make([]int64, 0, len(result.Transactions))
+	for _, t := range result.Transactions {
+		deletedIDs = append(deletedIDs, t.ID)
+	}
+
+	return &pb.BatchDeleteTransactionsResponse{
+		DeletedIds: deletedIDs,
+		Errors:     items.merge(result.Errors),
+		Committed:  result.Committed,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) BatchUpdateTransactions(ctx context.Context, req *pb.BatchUpdateTransactionsRequest) (*pb.BatchUpdateTransactionsResponse, error) {
+	if err := validateBatchSize(req.UserUid, len(req.Items)); err != nil {
+		return nil, err
+	}
+
+	var items batchItems
+	patches := make([]models.TransactionPatch, 0, len(req.Items))
+	for i, item := range req.Items {
+		if item.Id <= 0 {
+			items.invalid(i, "invalid transaction id")
+			continue
+		}
+		if item.CategoryId == nil && item.Title == nil && item.ShiftDays == 0 {
+			items.invalid(i, "patch does not change anything")
+			continue
+		}
+		if item.Title != nil && *item.Title == "" {
+			items.invalid(i, "title must not be empty")
+			continue
+		}
+
+		items.valid(i)
+		patches = append(patches, models.TransactionPatch{
+			ID:              item.Id,
+			CategoryID:      item.CategoryId,
+			Title:           item.Title,
+			ShiftDays:       item.ShiftDays,
+			ExpectedVersion: item.ExpectedVersion,
+		})
+	}
+
+	if items.skipService(req.AllOrNothing) {
+		return &pb.BatchUpdateTransactionsResponse{
+			Transactions: []*pb.Transaction{},
+			Errors:       items.merge(nil),
+		}, nil
+	}
+
+	result, err := h.service.BatchUpdateTransactions(ctx, req.UserUid, patches, req.AllOrNothing)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update transactions: %v", err)
+	}
+
+	pbTransactions := make([]*pb.Transaction, 0, len(result.Transactions))
+	for _, t := range result.Transactions {
+		pbTransactions = append(pbTransactions, h.transactionToProto(t))
+	}
+
+	return &pb.BatchUpdateTransactionsResponse{
+		Transactions: pbTransactions,
+		Errors:       items.merge(result.Errors),
+		Committed:    result.Committed,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+const maxCategoryNameLength = 100
+
+var (
+	categoryCodePattern   = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+	categoryLocalePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})?$`)
+)
+
+// validateCategoryName checks a display name of a category
+func validateCategoryName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len([]rune(name)) > maxCategoryNameLength {
+		return fmt.Errorf("name must be at most %d characters", maxCategoryNameLength)
+	}
+	return nil
+}
+
+// normalizeTranslations lowercases locales and validates them.
+// The default locale is stored in the category itself and cannot be translated.
+func normalizeTranslations(translations map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(translations))
+	for locale, name := range translations {
+		locale = strings.ToLower(strings.TrimSpace(locale))
+		if !categoryLocalePattern.MatchString(locale) {
+			return nil, fmt.Errorf("invalid locale %q", locale)
+		}
+		if locale == models.DefaultLocale {
+			return nil, fmt.Errorf("%s is the default locale, use name instead", models.DefaultLocale)
+		}
+		if name != "" {
+			if err := validateCategoryName(name); err != nil {
+				return nil, fmt.Errorf("translation %s: %w", locale, err)
+			}
+		}
+		normalized[locale] = strings.TrimSpace(name)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	if !categoryCodePattern.MatchString(req.Code) {
+		return nil, status.Error(codes.InvalidArgument, "code must contain 2-50 lowercase letters, digits or underscores and start with a letter")
+	}
+	if err := validateCategoryName(req.Name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Type != "income" && req.Type != "expense" {
+		return nil, status.Error(codes.InvalidArgument, "type must be 'income' or 'expense'")
+	}
+
+	translations, err := normalizeTranslations(req.Translations)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	category, err := h.service.CreateCategory(ctx, models.CreateCategoryInput{
+		Code:         req.Code,
+		Name:         strings.TrimSpace(req.Name),
+		Type:         req.Type,
+		Icon:         req.Icon,
+		Translations: translations,
+	})
+	if err != nil {
+		return nil, categoryError(err, "failed to create category")
+	}
+
+	return &pb.CreateCategoryResponse{
+		Category: h.categoryToProto(category),
+	}, nil
+}
@@ -25,11 +25,16 @@ func (h *GRPCHandler) CreateTransaction(ctx context.Context, req *pb.CreateTrans
 		Title:           req.Title,
 		Description:     req.Description,
 		TransactionDate: transactionDate,
+		Tags:            req.Tags,
+	}
+
+	if req.LedgerId != 0 {
+		input.LedgerID = &req.LedgerId
 	}
 
 	transaction, err := h.service.CreateTransaction(ctx, input)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create transaction: %v", err)
+		return nil, transactionError(err, "failed to create transaction")
 	}
 
 	return &pb.CreateTransactionResponse{
@@ -4,14 +4,12 @@ import (
 	"context"
 
 	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func (h *GRPCHandler) DeleteTransaction(ctx context.Context, req *pb.DeleteTransactionRequest) (*pb.DeleteTransactionResponse, error) {
-	err := h.service.DeleteTransaction(ctx, req.Id, req.UserUid)
+	err := h.service.DeleteTransaction(ctx, req.Id, req.UserUid, req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete transaction: %v", err)
+		return nil, transactionError(err, "failed to delete transaction")
 	}
 
 	return &pb.DeleteTransactionResponse{
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// transactionError maps repository errors of a single transaction to gRPC status codes
+func transactionError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrTransactionNotFound), errors.Is(err, repository.ErrLedgerNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTransactionNotOwned), errors.Is(err, repository.ErrLedgerAccessDenied):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrCategoryNotFound), errors.Is(err, repository.ErrCategoryTypeMismatch):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTransactionDeleted), errors.Is(err, repository.ErrTransactionNotDeleted),
+		errors.Is(err, repository.ErrVersionMismatch), errors.Is(err, repository.ErrCategoryArchived):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+// categoryError maps repository errors of category management to gRPC status codes
+func categoryError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrCategoryNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrCategoryCodeExists):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func (h *GRPCHandler) GetAllCategories(ctx context.Context, req *pb.GetAllCategoriesRequest) (*pb.GetAllCategoriesResponse, error) {
-	categories, err := h.service.GetAllCategories(ctx)
+	categories, err := h.service.GetAllCategories(ctx, normalizeLocales(req.Locales), req.IncludeArchived)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get categories: %v", err)
 	}
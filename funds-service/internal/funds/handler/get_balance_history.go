@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) GetBalanceHistory(ctx context.Context, req *pb.GetBalanceHistoryRequest) (*pb.GetBalanceHistoryResponse, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+
+	granularity := req.Granularity
+	switch granularity {
+	case "":
+		granularity = models.GroupByDay
+	case models.GroupByDay, models.GroupByWeek, models.GroupByMonth, models.GroupByYear:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported granularity: %s", granularity)
+	}
+
+	cal, err := calendarFromProto(req.Calendar)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Explicit dates are a custom period, the last 30 days are shown by default
+	period := req.Period
+	if period.GetKind() == "" && (req.DateFrom != "" || req.DateTo != "") {
+		period = &pb.Period{
+			Kind:     models.PeriodCustom,
+			DateFrom: req.DateFrom,
+			DateTo:   req.DateTo,
+		}
+	}
+
+	dateFrom, dateTo, err := resolvePeriod(period, cal, models.PeriodSelector{
+		Kind: models.PeriodDays,
+		Days: 30,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	history, err := h.service.GetBalanceHistory(ctx, models.BalanceHistoryInput{
+		UserUID:     req.UserUid,
+		DateFrom:    dateFrom,
+		DateTo:      dateTo,
+		Granularity: granularity,
+		WeekStart:   cal.WeekStart,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get balance history: %v", err)
+	}
+
+	points := make([]*pb.BalanceHistoryPoint, 0, len(history.Points))
+	for _, p := range history.Points {
+		points = append(points, &pb.BalanceHistoryPoint{
+			Period:         p.Period.Format("2006-01-02"),
+			OpeningBalance: p.OpeningBalance,
+			ClosingBalance: p.ClosingBalance,
+			Income:         p.Income,
+			Expense:        p.Expense,
+		})
+	}
+
+	return &pb.GetBalanceHistoryResponse{
+		Points:      points,
+		Granularity: history.Granularity,
+		DateFrom:    history.DateFrom.Format("2006-01-02"),
+		DateTo:      history.DateTo.Format("2006-01-02"),
+	}, nil
+}
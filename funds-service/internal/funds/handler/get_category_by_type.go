@@ -9,7 +9,7 @@ import (
 )
 
 func (h *GRPCHandler) GetCategoriesByType(ctx context.Context, req *pb.GetCategoriesByTypeRequest) (*pb.GetCategoriesByTypeResponse, error) {
-	categories, err := h.service.GetCategoriesByType(ctx, req.Type)
+	categories, err := h.service.GetCategoriesByType(ctx, req.Type, normalizeLocales(req.Locales))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get categories: %v", err)
 	}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) GetDeletedTransactions(ctx context.Context, req *pb.GetDeletedTransactionsRequest) (*pb.GetDeletedTransactionsResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = 50
+	}
+
+	transactions, total, err := h.service.GetDeletedTransactions(ctx, req.UserUid, limit, req.Offset, req.ExactTotal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get deleted transactions: %v", err)
+	}
+
+	pbTransactions := make([]*pb.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		pbTransactions = append(pbTransactions, h.transactionToProto(t))
+	}
+
+	return &pb.GetDeletedTransactionsResponse{
+		Transactions:   pbTransactions,
+		Total:          total.Count,
+		TotalEstimated: total.Estimated,
+		HasMore:        total.HasMore,
+	}, nil
+}
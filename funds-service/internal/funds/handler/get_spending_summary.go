@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) GetSpendingSummary(ctx context.Context, req *pb.GetSpendingSummaryRequest) (*pb.GetSpendingSummaryResponse, error) {
+	cal, err := calendarFromProto(req.Calendar)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var dateFrom, dateTo time.Time
+	if req.Period.GetKind() != "" {
+		dateFrom, dateTo, err = resolvePeriod(req.Period, cal, models.PeriodSelector{})
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else {
+		dateFrom, err = time.Parse("2006-01-02", req.DateFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date_from format: %v", err)
+		}
+
+		dateTo, err = time.Parse("2006-01-02", req.DateTo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date_to format: %v", err)
+		}
+
+		if dateTo.Before(dateFrom) {
+			return nil, status.Error(codes.InvalidArgument, "date_to must not be before date_from")
+		}
+	}
+
+	if req.Type != "" && req.Type != "income" && req.Type != "expense" {
+		return nil, status.Error(codes.InvalidArgument, "type must be 'income' or 'expense'")
+	}
+
+	timeDims := 0
+	seen := make(map[string]bool, len(req.GroupBy))
+	for _, dim := range req.GroupBy {
+		switch dim {
+		case models.GroupByCategory, models.GroupByType, models.GroupByTag, models.GroupByMember:
+		case models.GroupByDay, models.GroupByWeek, models.GroupByMonth, models.GroupByYear:
+			timeDims++
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported group_by dimension: %s", dim)
+		}
+		if seen[dim] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate group_by dimension: %s", dim)
+		}
+		seen[dim] = true
+	}
+	if timeDims > 1 {
+		return nil, status.Error(codes.InvalidArgument, "only one of day, week, month, year can be used in group_by")
+	}
+
+	input := models.SpendingSummaryInput{
+		UserUID:         req.UserUid,
+		DateFrom:        dateFrom,
+		DateTo:          dateTo,
+		GroupBy:         req.GroupBy,
+		Type:            req.Type,
+		ComparePrevious: req.ComparePrevious,
+		WeekStart:       cal.WeekStart,
+	}
+
+	if req.LedgerId != 0 {
+		input.LedgerID = &req.LedgerId
+	}
+
+	summary, err := h.service.GetSpendingSummary(ctx, input)
+	if err != nil {
+		return nil, transactionError(err, "failed to get spending summary")
+	}
+
+	pbBuckets := make([]*pb.SpendingSummaryBucket, 0, len(summary.Buckets))
+	for _, b := range summary.Buckets {
+		pbBuckets = append(pbBuckets, h.summaryBucketToProto(b))
+	}
+
+	return &pb.GetSpendingSummaryResponse{
+		Buckets:              pbBuckets,
+		TotalIncome:          summary.TotalIncome,
+		TotalExpense:         summary.TotalExpense,
+		PreviousTotalIncome:  summary.PreviousTotalIncome,
+		PreviousTotalExpense: summary.PreviousTotalExpense,
+		DateFrom:             summary.DateFrom.Format("2006-01-02"),
+		DateTo:               summary.DateTo.Format("2006-01-02"),
+	}, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetTransactionHistory(ctx context.Context, req *pb.GetTransactionHistoryRequest) (*pb.GetTransactionHistoryResponse, error) {
+	revisions, err := h.service.GetTransactionHistory(ctx, req.Id, req.UserUid)
+	if err != nil {
+		return nil, transactionError(err, "failed to get transaction history")
+	}
+
+	pbRevisions := make([]*pb.TransactionRevision, 0, len(revisions))
+	for _, r := range revisions {
+		pbRevisions = append(pbRevisions, h.revisionToProto(r))
+	}
+
+	return &pb.GetTransactionHistoryResponse{
+		Revisions: pbRevisions,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -14,7 +15,20 @@ func (h *GRPCHandler) GetUserTransactionsByPeriod(ctx context.Context, req *pb.G
 		limit = 50
 	}
 
-	transactions, total, err := h.service.GetUserTransactionsByPeriod(ctx, req.UserUid, req.Days, limit, req.Offset)
+	cal, err := calendarFromProto(req.Calendar)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dateFrom, dateTo, err := resolvePeriod(req.Period, cal, models.PeriodSelector{
+		Kind: models.PeriodDays,
+		Days: int(req.Days),
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	transactions, total, err := h.service.GetUserTransactionsByPeriod(ctx, req.UserUid, dateFrom, dateTo, limit, req.Offset, req.ExactTotal)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get transactions: %v", err)
 	}
@@ -25,7 +39,11 @@ func (h *GRPCHandler) GetUserTransactionsByPeriod(ctx context.Context, req *pb.G
 	}
 
 	return &pb.GetUserTransactionsByPeriodResponse{
-		Transactions: pbTransactions,
-		Total:        total,
+		Transactions:   pbTransactions,
+		Total:          total.Count,
+		DateFrom:       dateFrom.Format("2006-01-02"),
+		DateTo:         dateTo.Format("2006-01-02"),
+		TotalEstimated: total.Estimated,
+		HasMore:        total.HasMore,
 	}, nil
 }
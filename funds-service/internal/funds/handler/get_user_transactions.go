@@ -14,7 +14,7 @@ func (h *GRPCHandler) GetUserTransactions(ctx context.Context, req *pb.GetUserTr
 		limit = 50
 	}
 
-	transactions, total, err := h.service.GetUserTransactions(ctx, req.UserUid, limit, req.Offset)
+	transactions, total, err := h.service.GetUserTransactions(ctx, req.UserUid, limit, req.Offset, req.ExactTotal)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get transactions: %v", err)
 	}
@@ -25,7 +25,9 @@ func (h *GRPCHandler) GetUserTransactions(ctx context.Context, req *pb.GetUserTr
 	}
 
 	return &pb.GetUserTransactionsResponse{
-		Transactions: pbTransactions,
-		Total:        total,
+		Transactions:   pbTransactions,
+		Total:          total.Count,
+		TotalEstimated: total.Estimated,
+		HasMore:        total.HasMore,
 	}, nil
 }
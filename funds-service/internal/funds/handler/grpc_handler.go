@@ -29,6 +29,16 @@ func (h *GRPCHandler) transactionToProto(t *models.Transaction) *pb.Transaction
 		TransactionDate: t.TransactionDate.Format("2006-01-02"),
 		CreatedAt:       t.CreatedAt.Unix(),
 		UpdatedAt:       t.UpdatedAt.Unix(),
+		Tags:            t.Tags,
+		Version:         t.Version,
+	}
+
+	if t.DeletedAt != nil {
+		transaction.DeletedAt = t.DeletedAt.Unix()
+	}
+
+	if t.LedgerID != nil {
+		transaction.LedgerId = *t.LedgerID
 	}
 
 	if t.Category != nil {
@@ -38,14 +48,42 @@ func (h *GRPCHandler) transactionToProto(t *models.Transaction) *pb.Transaction
 	return transaction
 }
 
+func (h *GRPCHandler) revisionToProto(r *models.TransactionRevision) *pb.TransactionRevision {
+	revision := &pb.TransactionRevision{
+		Id:            r.ID,
+		TransactionId: r.TransactionID,
+		ChangedBy:     r.ChangedBy,
+		Action:        r.Action,
+		ChangedAt:     r.ChangedAt.Unix(),
+	}
+
+	if r.Before != nil {
+		revision.Before = h.transactionToProto(r.Before)
+	}
+	if r.After != nil {
+		revision.After = h.transactionToProto(r.After)
+	}
+
+	return revision
+}
+
 func (h *GRPCHandler) categoryToProto(c *models.Category) *pb.Category {
-	return &pb.Category{
-		Id:        c.ID,
-		Name:      c.Name,
-		Type:      c.Type,
-		Icon:      c.Icon,
-		CreatedAt: c.CreatedAt.Unix(),
+	category := &pb.Category{
+		Id:           c.ID,
+		Code:         c.Code,
+		Name:         c.Name,
+		Type:         c.Type,
+		Icon:         c.Icon,
+		CreatedAt:    c.CreatedAt.Unix(),
+		UpdatedAt:    c.UpdatedAt.Unix(),
+		Translations: c.Translations,
+	}
+
+	if c.ArchivedAt != nil {
+		category.ArchivedAt = c.ArchivedAt.Unix()
 	}
+
+	return category
 }
 
 func (h *GRPCHandler) balanceToProto(b *models.UserBalance) *pb.UserBalance {
@@ -63,3 +101,25 @@ func (h *GRPCHandler) balanceToProto(b *models.UserBalance) *pb.UserBalance {
 
 	return balance
 }
+
+func (h *GRPCHandler) summaryBucketToProto(b *models.SpendingSummaryBucket) *pb.SpendingSummaryBucket {
+	bucket := &pb.SpendingSummaryBucket{
+		CategoryId:    b.CategoryID,
+		CategoryCode:  b.CategoryCode,
+		CategoryName:  b.CategoryName,
+		Type:          b.Type,
+		Tag:           b.Tag,
+		UserUid:       b.UserUID,
+		Total:         b.Total,
+		Count:         b.Count,
+		PreviousTotal: b.PreviousTotal,
+		Delta:         b.Delta,
+		DeltaPercent:  b.DeltaPercent,
+	}
+
+	if b.Period != nil {
+		bucket.Period = b.Period.Format("2006-01-02")
+	}
+
+	return bucket
+}
@@ -0,0 +1,31 @@
+package handler
+
+import "strings"
+
+// normalizeLocales lowercases the requested locales and adds the base language
+// after a regional locale, so "en-US" also falls back to "en"
+func normalizeLocales(locales []string) []string {
+	normalized := make([]string, 0, len(locales))
+	seen := make(map[string]struct{}, len(locales))
+
+	add := func(locale string) {
+		if _, ok := seen[locale]; ok {
+			return
+		}
+		seen[locale] = struct{}{}
+		normalized = append(normalized, locale)
+	}
+
+	for _, locale := range locales {
+		locale = strings.ToLower(strings.TrimSpace(strings.ReplaceAll(locale, "_", "-")))
+		if locale == "" || locale == "*" {
+			continue
+		}
+		add(locale)
+		if base, _, ok := strings.Cut(locale, "-"); ok {
+			add(base)
+		}
+	}
+
+	return normalized
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// calendarFromProto builds the user's calendar, a missing calendar means UTC and Monday weeks
+func calendarFromProto(c *pb.Calendar) (models.Calendar, error) {
+	return models.NewCalendar(c.GetTimezone(), c.GetWeekStart(), c.GetSalaryDay())
+}
+
+// periodFromProto converts a period selector, falling back to the given one when the period is not set
+func periodFromProto(p *pb.Period, fallback models.PeriodSelector) (models.PeriodSelector, error) {
+	if p == nil || p.Kind == "" {
+		return fallback, nil
+	}
+
+	selector := models.PeriodSelector{
+		Kind:   p.Kind,
+		Offset: int(p.Offset),
+		Days:   int(p.Days),
+	}
+
+	if p.DateFrom != "" {
+		dateFrom, err := time.Parse("2006-01-02", p.DateFrom)
+		if err != nil {
+			return selector, fmt.Errorf("%w: invalid date_from format: %v", models.ErrInvalidPeriod, err)
+		}
+		selector.DateFrom = dateFrom
+	}
+
+	if p.DateTo != "" {
+		dateTo, err := time.Parse("2006-01-02", p.DateTo)
+		if err != nil {
+			return selector, fmt.Errorf("%w: invalid date_to format: %v", models.ErrInvalidPeriod, err)
+		}
+		selector.DateTo = dateTo
+	}
+
+	return selector, nil
+}
+
+// resolvePeriod returns the inclusive date range of the requested period in the user's calendar
+func resolvePeriod(p *pb.Period, cal models.Calendar, fallback models.PeriodSelector) (from, to time.Time, err error) {
+	selector, err := periodFromProto(p, fallback)
+	if err != nil {
+		return from, to, err
+	}
+
+	return selector.Resolve(cal, time.Now())
+}
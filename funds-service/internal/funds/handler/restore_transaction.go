@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) RestoreTransaction(ctx context.Context, req *pb.RestoreTransactionRequest) (*pb.RestoreTransactionResponse, error) {
+	transaction, err := h.service.RestoreTransaction(ctx, req.Id, req.UserUid)
+	if err != nil {
+		return nil, transactionError(err, "failed to restore transaction")
+	}
+
+	return &pb.RestoreTransactionResponse{
+		Transaction: h.transactionToProto(transaction),
+	}, nil
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	input := models.UpdateCategoryInput{
+		ID:   req.Id,
+		Icon: req.Icon,
+	}
+
+	if req.Name != nil {
+		if err := validateCategoryName(*req.Name); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		name := strings.TrimSpace(*req.Name)
+		input.Name = &name
+	}
+
+	translations, err := normalizeTranslations(req.Translations)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	input.Translations = translations
+
+	category, err := h.service.UpdateCategory(ctx, input)
+	if err != nil {
+		return nil, categoryError(err, "failed to update category")
+	}
+
+	return &pb.UpdateCategoryResponse{
+		Category: h.categoryToProto(category),
+	}, nil
+}
@@ -25,11 +25,13 @@ func (h *GRPCHandler) UpdateTransaction(ctx context.Context, req *pb.UpdateTrans
 		Title:           req.Title,
 		Description:     req.Description,
 		TransactionDate: transactionDate,
+		Tags:            req.Tags,
+		ExpectedVersion: req.ExpectedVersion,
 	}
 
 	transaction, err := h.service.UpdateTransaction(ctx, input)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update transaction: %v", err)
+		return nil, transactionError(err, "failed to update transaction")
 	}
 
 	return &pb.UpdateTransactionResponse{
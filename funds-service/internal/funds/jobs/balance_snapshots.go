@@ -0,0 +1,48 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/config"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
+)
+
+// BalanceSnapshotsJob periodically rebuilds daily balance snapshots changed by transactions
+// and extends every series to the current date. The first run backfills existing transactions.
+type BalanceSnapshotsJob struct {
+	repo     repository.FundsRepositorer
+	interval time.Duration
+}
+
+func NewBalanceSnapshotsJob(repo repository.FundsRepositorer, cfg config.SnapshotsConfig) *BalanceSnapshotsJob {
+	return &BalanceSnapshotsJob{
+		repo:     repo,
+		interval: cfg.RefreshInterval,
+	}
+}
+
+// Run blocks until ctx is cancelled
+func (j *BalanceSnapshotsJob) Run(ctx context.Context) {
+	l := log.FromContext(ctx)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		refreshed, err := j.repo.RefreshStaleBalanceSnapshots(ctx)
+		if err != nil {
+			l.Errorf("balanceSnapshotsJob: %v", err)
+		}
+		if refreshed > 0 {
+			l.Infof("balanceSnapshotsJob: refreshed snapshots of %d users", refreshed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
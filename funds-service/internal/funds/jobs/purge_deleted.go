@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	attachmentsservice "github.com/cg-2025-crutch/backend/funds-service/internal/attachments/service"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/config"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
+)
+
+// PurgeDeletedJob periodically removes transactions that stayed in the trash longer than the retention period
+type PurgeDeletedJob struct {
+	repo        repository.FundsRepositorer
+	attachments attachmentsservice.AttachmentsServicer
+	retention   time.Duration
+	interval    time.Duration
+}
+
+func NewPurgeDeletedJob(repo repository.FundsRepositorer, attachments attachmentsservice.AttachmentsServicer, cfg config.TrashConfig) *PurgeDeletedJob {
+	return &PurgeDeletedJob{
+		repo:        repo,
+		attachments: attachments,
+		retention:   cfg.Retention,
+		interval:    cfg.PurgeInterval,
+	}
+}
+
+// Run blocks until ctx is cancelled
+func (j *PurgeDeletedJob) Run(ctx context.Context) {
+	l := log.FromContext(ctx)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		before := time.Now().Add(-j.retention)
+
+		// Attachments go first, purging transactions drops their rows but leaves the blobs behind
+		removed, err := j.attachments.PurgeDeletedTransactionAttachments(ctx, before)
+		if err != nil {
+			l.Errorf("purgeDeletedJob: %v", err)
+		} else if removed > 0 {
+			l.Infof("purgeDeletedJob: removed %d attachments", removed)
+		}
+
+		purged, err := j.repo.PurgeDeletedTransactions(ctx, before)
+		if err != nil {
+			l.Errorf("purgeDeletedJob: %v", err)
+		} else if purged > 0 {
+			l.Infof("purgeDeletedJob: purged %d transactions", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
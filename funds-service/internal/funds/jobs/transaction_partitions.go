@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/config"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
+)
+
+// TransactionPartitionsJob periodically creates the monthly partitions of the transactions table
+// ahead of time and archives the partitions older than the retention period
+type TransactionPartitionsJob struct {
+	repo               repository.FundsRepositorer
+	interval           time.Duration
+	monthsAhead        int
+	archiveAfterMonths int
+}
+
+func NewTransactionPartitionsJob(repo repository.FundsRepositorer, cfg config.PartitionsConfig) *TransactionPartitionsJob {
+	return &TransactionPartitionsJob{
+		repo:               repo,
+		interval:           cfg.Interval,
+		monthsAhead:        cfg.MonthsAhead,
+		archiveAfterMonths: cfg.ArchiveAfterMonths,
+	}
+}
+
+// Run blocks until ctx is cancelled
+func (j *TransactionPartitionsJob) Run(ctx context.Context) {
+	l := log.FromContext(ctx)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now()
+
+		created, err := j.repo.EnsureTransactionPartitions(ctx, now, j.monthsAhead)
+		if err != nil {
+			l.Errorf("transactionPartitionsJob: %v", err)
+		}
+		if len(created) > 0 {
+			l.Infof("transactionPartitionsJob: created partitions %v", created)
+		}
+
+		if j.archiveAfterMonths > 0 {
+			j.archive(ctx, now)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// archive detaches the partitions older than the retention period. Stale balance snapshots are
+// rebuilt first, the rebuild relies on the previous snapshots once the rows are archived.
+func (j *TransactionPartitionsJob) archive(ctx context.Context, now time.Time) {
+	l := log.FromContext(ctx)
+
+	if _, err := j.repo.RefreshStaleBalanceSnapshots(ctx); err != nil {
+		l.Errorf("transactionPartitionsJob: archival skipped: %v", err)
+		return
+	}
+
+	before := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -j.archiveAfterMonths, 0)
+	archived, err := j.repo.ArchiveTransactionPartitions(ctx, before)
+	if err != nil {
+		l.Errorf("transactionPartitionsJob: %v", err)
+	}
+	if len(archived) > 0 {
+		l.Infof("transactionPartitionsJob: archived partitions %v", archived)
+	}
+}
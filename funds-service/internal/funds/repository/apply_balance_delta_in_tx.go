@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// applyBalanceDeltaInTx applies aggregated income and expense changes of a batch with a single update
+func (r *FundsRepository) applyBalanceDeltaInTx(ctx context.Context, tx pgx.Tx, userUID string, incomeDelta, expenseDelta float64) error {
+	if incomeDelta == 0 && expenseDelta == 0 {
+		return nil
+	}
+
+	insertQuery := `
+		INSERT INTO user_balances (user_uid, total_balance, total_income, total_expense, last_transaction_at, updated_at)
+		VALUES ($1, 0, 0, 0, NOW(), NOW())
+		ON CONFLICT (user_uid) DO NOTHING
+	`
+	_, err := tx.Exec(ctx, insertQuery, userUID)
+	if err != nil {
+		return fmt.Errorf("failed to ensure balance record: %w", err)
+	}
+
+	updateQuery := `
+		UPDATE user_balances 
+		SET total_income = total_income + $1::numeric,
+		    total_expense = total_expense + $2::numeric,
+		    total_balance = total_balance + $1::numeric - $2::numeric,
+		    last_transaction_at = CASE WHEN $1::numeric > 0 OR $2::numeric > 0 THEN NOW() ELSE last_transaction_at END,
+		    updated_at = NOW()
+		WHERE user_uid = $3
+	`
+	_, err = tx.Exec(ctx, updateQuery, incomeDelta, expenseDelta, userUID)
+	if err != nil {
+		return fmt.Errorf("failed to update balance: %w", err)
+	}
+
+	return nil
+}
+
+// balanceDelta returns the income and expense change caused by adding (sign 1) or removing (sign -1) a transaction
+func balanceDelta(transactionType string, amount float64, sign float64) (income, expense float64) {
+	if transactionType == "income" {
+		return sign * amount, 0
+	}
+	return 0, sign * amount
+}
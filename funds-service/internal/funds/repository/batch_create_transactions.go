@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *FundsRepository) BatchCreateTransactions(ctx context.Context, userUID string, inputs []models.CreateTransactionInput, allOrNothing bool) (*models.BatchResult, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result := &models.BatchResult{
+		Transactions: make([]*models.Transaction, 0, len(inputs)),
+		Errors:       make([]models.BatchItemError, 0),
+	}
+
+	var incomeDelta, expenseDelta float64
+	for i, input := range inputs {
+		input.UserUID = userUID
+
+		var transaction *models.Transaction
+		err := runBatchItemInTx(ctx, tx, func(sp pgx.Tx) error {
+			if err := checkCategoryInTx(ctx, sp, input.CategoryID, input.Type); err != nil {
+				return err
+			}
+
+			var err error
+			transaction, err = r.insertTransactionInTx(ctx, sp, input)
+			return err
+		})
+		if err != nil {
+			result.Errors = append(result.Errors, models.BatchItemError{Index: i, Err: err})
+			continue
+		}
+
+		income, expense := balanceDelta(transaction.Type, transaction.Amount, 1)
+		incomeDelta += income
+		expenseDelta += expense
+
+		result.Transactions = append(result.Transactions, transaction)
+	}
+
+	result, err = r.finishBatchInTx(ctx, tx, userUID, result, incomeDelta, expenseDelta, allOrNothing)
+	if err != nil {
+		return nil, err
+	}
+
+	r.attachCategories(ctx, result.Transactions)
+
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *FundsRepository) BatchDeleteTransactions(ctx context.Context, userUID string, ids []int64, allOrNothing bool) (*models.BatchResult, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result := &models.BatchResult{
+		Transactions: make([]*models.Transaction, 0, len(ids)),
+		Errors:       make([]models.BatchItemError, 0),
+	}
+
+	var incomeDelta, expenseDelta float64
+	for i, id := range ids {
+		var transaction *models.Transaction
+		err := runBatchItemInTx(ctx, tx, func(sp pgx.Tx) error {
+			var err error
+			transaction, err = r.softDeleteTransactionInTx(ctx, sp, id, userUID, 0)
+			return err
+		})
+		if err != nil {
+			result.Errors = append(result.Errors, models.BatchItemError{Index: i, Err: err})
+			continue
+		}
+
+		income, expense := balanceDelta(transaction.Type, transaction.Amount, -1)
+		incomeDelta += income
+		expenseDelta += expense
+
+		result.Transactions = append(result.Transactions, transaction)
+	}
+
+	return r.finishBatchInTx(ctx, tx, userUID, result, incomeDelta, expenseDelta, allOrNothing)
+}
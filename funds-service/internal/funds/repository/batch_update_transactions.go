@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// BatchUpdateTransactions re-categorizes, retitles or shifts dates of transactions.
+// Type and amount stay the same, so the balance is not affected.
+func (r *FundsRepository) BatchUpdateTransactions(ctx context.Context, userUID string, patches []models.TransactionPatch, allOrNothing bool) (*models.BatchResult, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result := &models.BatchResult{
+		Transactions: make([]*models.Transaction, 0, len(patches)),
+		Errors:       make([]models.BatchItemError, 0),
+	}
+
+	for i, patch := range patches {
+		var transaction *models.Transaction
+		err := runBatchItemInTx(ctx, tx, func(sp pgx.Tx) error {
+			var err error
+			transaction, err = r.patchTransactionInTx(ctx, sp, userUID, patch)
+			return err
+		})
+		if err != nil {
+			result.Errors = append(result.Errors, models.BatchItemError{Index: i, Err: err})
+			continue
+		}
+
+		result.Transactions = append(result.Transactions, transaction)
+	}
+
+	result, err = r.finishBatchInTx(ctx, tx, userUID, result, 0, 0, allOrNothing)
+	if err != nil {
+		return nil, err
+	}
+
+	r.attachCategories(ctx, result.Transactions)
+
+	return result, nil
+}
+
+func (r *FundsRepository) patchTransactionInTx(ctx context.Context, tx pgx.Tx, userUID string, patch models.TransactionPatch) (*models.Transaction, error) {
+	oldTransaction, err := r.getTransactionForUpdateInTx(ctx, tx, patch.ID, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if oldTransaction.DeletedAt != nil {
+		return nil, ErrTransactionDeleted
+	}
+
+	if patch.ExpectedVersion != 0 && patch.ExpectedVersion != oldTransaction.Version {
+		return nil, ErrVersionMismatch
+	}
+
+	// Transactions keep an archived category, it just cannot be chosen again
+	if patch.CategoryID != nil && *patch.CategoryID != oldTransaction.CategoryID {
+		if err := checkCategoryInTx(ctx, tx, *patch.CategoryID, oldTransaction.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		UPDATE transactions
+		SET category_id = COALESCE($2::int, category_id),
+		    title = COALESCE($3::varchar, title),
+		    transaction_date = transaction_date + $4::int,
+		    version = version + 1,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, user_uid, category_id, type, amount, title, description, transaction_date, created_at, updated_at, tags, version, ledger_id
+	`
+
+	var transaction models.Transaction
+	err = tx.QueryRow(ctx, query, patch.ID, patch.CategoryID, patch.Title, patch.ShiftDays).Scan(
+		&transaction.ID,
+		&transaction.UserUID,
+		&transaction.CategoryID,
+		&transaction.Type,
+		&transaction.Amount,
+		&transaction.Title,
+		&transaction.Description,
+		&transaction.TransactionDate,
+		&transaction.CreatedAt,
+		&transaction.UpdatedAt,
+		&transaction.Tags,
+		&transaction.Version,
+		&transaction.LedgerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	if err := r.insertRevisionInTx(ctx, tx, transaction.ID, userUID, models.RevisionActionUpdate, oldTransaction, &transaction); err != nil {
+		return nil, err
+	}
+	transaction.Previous = oldTransaction
+
+	return &transaction, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// localizedCategoryColumns selects a category with the name in the first of the requested locales
+// that has a translation, falling back to the default name. The query must join categoryTranslationJoin.
+const localizedCategoryColumns = `c.id, c.code, COALESCE(tr.name, c.name), c.type, c.icon, c.created_at, c.updated_at, c.archived_at`
+
+// categoryTranslationJoin expects the preferred locales as the $1 text array
+const categoryTranslationJoin = `
+	LEFT JOIN LATERAL (
+		SELECT ct.name
+		FROM category_translations ct
+		WHERE ct.category_id = c.id AND ct.locale = ANY($1::text[])
+		ORDER BY array_position($1::text[], ct.locale)
+		LIMIT 1
+	) tr ON TRUE`
+
+// scanCategory scans localizedCategoryColumns
+func scanCategory(row pgx.Row) (*models.Category, error) {
+	var category models.Category
+	err := row.Scan(
+		&category.ID,
+		&category.Code,
+		&category.Name,
+		&category.Type,
+		&category.Icon,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&category.ArchivedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+// collectCategories reads all rows of localizedCategoryColumns
+func collectCategories(rows pgx.Rows) ([]*models.Category, error) {
+	defer rows.Close()
+
+	categories := make([]*models.Category, 0)
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read categories: %w", err)
+	}
+
+	return categories, nil
+}
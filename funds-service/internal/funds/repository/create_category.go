@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateCategory creates a system category together with its translations
+func (r *FundsRepository) CreateCategory(ctx context.Context, input models.CreateCategoryInput) (*models.Category, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO categories (code, name, type, icon)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (code) DO NOTHING
+		RETURNING id
+	`
+
+	var id int32
+	if err := tx.QueryRow(ctx, query, input.Code, input.Name, input.Type, input.Icon).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCategoryCodeExists
+		}
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	if err := upsertCategoryTranslationsInTx(ctx, tx, id, input.Translations); err != nil {
+		return nil, err
+	}
+
+	category, err := getManagedCategoryInTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return category, nil
+}
+
+// upsertCategoryTranslationsInTx stores translations of the category, an empty name removes the translation
+func upsertCategoryTranslationsInTx(ctx context.Context, tx pgx.Tx, categoryID int32, translations map[string]string) error {
+	for locale, name := range translations {
+		if name == "" {
+			if _, err := tx.Exec(ctx, `DELETE FROM category_translations WHERE category_id = $1 AND locale = $2`, categoryID, locale); err != nil {
+				return fmt.Errorf("failed to delete category translation: %w", err)
+			}
+			continue
+		}
+
+		query := `
+			INSERT INTO category_translations (category_id, locale, name)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (category_id, locale) DO UPDATE SET name = EXCLUDED.name
+		`
+		if _, err := tx.Exec(ctx, query, categoryID, locale, name); err != nil {
+			return fmt.Errorf("failed to save category translation: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// getManagedCategoryInTx returns the category with the default name and all translations
+func getManagedCategoryInTx(ctx context.Context, tx pgx.Tx, id int32) (*models.Category, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM categories c
+		%s
+		WHERE c.id = $2
+	`, localizedCategoryColumns, categoryTranslationJoin)
+
+	category, err := scanCategory(tx.QueryRow(ctx, query, []string{}, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, `SELECT locale, name FROM category_translations WHERE category_id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category translations: %w", err)
+	}
+	defer rows.Close()
+
+	category.Translations = make(map[string]string)
+	for rows.Next() {
+		var locale, name string
+		if err := rows.Scan(&locale, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan category translation: %w", err)
+		}
+		category.Translations[locale] = name
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read category translations: %w", err)
+	}
+
+	return category, nil
+}
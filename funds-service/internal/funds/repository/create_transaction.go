@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
 )
 
 func (r *FundsRepository) CreateTransaction(ctx context.Context, input models.CreateTransactionInput) (*models.Transaction, error) {
@@ -14,14 +15,54 @@ func (r *FundsRepository) CreateTransaction(ctx context.Context, input models.Cr
 	}
 	defer tx.Rollback(ctx)
 
+	if err := checkCategoryInTx(ctx, tx, input.CategoryID, input.Type); err != nil {
+		return nil, err
+	}
+
+	transaction, err := r.insertTransactionInTx(ctx, tx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.updateUserBalanceInTx(ctx, tx, input.UserUID, input.Type, input.Amount, true); err != nil {
+		return nil, fmt.Errorf("failed to update user balance: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Get category details
+	category, err := r.GetCategoryById(ctx, input.CategoryID, nil)
+	if err == nil {
+		transaction.Category = category
+	}
+
+	return transaction, nil
+}
+
+// insertTransactionInTx inserts the transaction and records the revision, the caller is responsible for the balance
+func (r *FundsRepository) insertTransactionInTx(ctx context.Context, tx pgx.Tx, input models.CreateTransactionInput) (*models.Transaction, error) {
+	if input.LedgerID != nil {
+		role, err := getLedgerRole(ctx, tx, *input.LedgerID, input.UserUID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Viewers only read the ledger
+		if role == models.LedgerRoleViewer {
+			return nil, ErrLedgerAccessDenied
+		}
+	}
+
 	query := `
-		INSERT INTO transactions (user_uid, category_id, type, amount, title, description, transaction_date)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, user_uid, category_id, type, amount, title, description, transaction_date, created_at, updated_at
+		INSERT INTO transactions (user_uid, category_id, type, amount, title, description, transaction_date, tags, ledger_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, user_uid, category_id, type, amount, title, description, transaction_date, created_at, updated_at, tags, version, ledger_id
 	`
 
 	var transaction models.Transaction
-	err = tx.QueryRow(ctx, query,
+	err := tx.QueryRow(ctx, query,
 		input.UserUID,
 		input.CategoryID,
 		input.Type,
@@ -29,6 +70,8 @@ func (r *FundsRepository) CreateTransaction(ctx context.Context, input models.Cr
 		input.Title,
 		input.Description,
 		input.TransactionDate,
+		normalizeTags(input.Tags),
+		input.LedgerID,
 	).Scan(
 		&transaction.ID,
 		&transaction.UserUID,
@@ -40,23 +83,16 @@ func (r *FundsRepository) CreateTransaction(ctx context.Context, input models.Cr
 		&transaction.TransactionDate,
 		&transaction.CreatedAt,
 		&transaction.UpdatedAt,
+		&transaction.Tags,
+		&transaction.Version,
+		&transaction.LedgerID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
 
-	if err := r.updateUserBalanceInTx(ctx, tx, input.UserUID, input.Type, input.Amount, true); err != nil {
-		return nil, fmt.Errorf("failed to update user balance: %w", err)
-	}
-
-	if err := tx.Commit(ctx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
-	// Get category details
-	category, err := r.GetCategoryById(ctx, input.CategoryID)
-	if err == nil {
-		transaction.Category = category
+	if err := r.insertRevisionInTx(ctx, tx, transaction.ID, input.UserUID, models.RevisionActionCreate, nil, &transaction); err != nil {
+		return nil, err
 	}
 
 	return &transaction, nil
@@ -8,49 +8,59 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
-func (r *FundsRepository) DeleteTransaction(ctx context.Context, id int64, userUID string) error {
+// DeleteTransaction moves the transaction to the trash and reverts its effect on the balance.
+// The row is removed for good by PurgeDeletedTransactions after the retention period.
+// A non-zero expectedVersion must match the current version of the transaction.
+// It returns the snapshot before deletion.
+func (r *FundsRepository) DeleteTransaction(ctx context.Context, id int64, userUID string, expectedVersion int64) (*models.Transaction, error) {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
-	var transaction models.Transaction
-	getQuery := `
-		SELECT user_uid, type, amount 
-		FROM transactions 
-		WHERE id = $1
-	`
-	err = tx.QueryRow(ctx, getQuery, id).Scan(
-		&transaction.UserUID,
-		&transaction.Type,
-		&transaction.Amount,
-	)
+	transaction, err := r.softDeleteTransactionInTx(ctx, tx, id, userUID, expectedVersion)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return fmt.Errorf("transaction not found")
-		}
-		return fmt.Errorf("failed to get transaction: %w", err)
+		return nil, err
 	}
 
-	// Verify that the transaction belongs to the user
-	if transaction.UserUID != userUID {
-		return fmt.Errorf("transaction does not belong to user")
+	if err := r.updateUserBalanceInTx(ctx, tx, transaction.UserUID, transaction.Type, transaction.Amount, false); err != nil {
+		return nil, fmt.Errorf("failed to revert balance: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	deleteQuery := `DELETE FROM transactions WHERE id = $1`
-	_, err = tx.Exec(ctx, deleteQuery, id)
+	return transaction, nil
+}
+
+// softDeleteTransactionInTx marks the transaction as deleted and records the revision.
+// It returns the snapshot before deletion, the caller is responsible for the balance.
+func (r *FundsRepository) softDeleteTransactionInTx(ctx context.Context, tx pgx.Tx, id int64, userUID string, expectedVersion int64) (*models.Transaction, error) {
+	transaction, err := r.getTransactionForUpdateInTx(ctx, tx, id, userUID)
 	if err != nil {
-		return fmt.Errorf("failed to delete transaction: %w", err)
+		return nil, err
 	}
 
-	if err := r.updateUserBalanceInTx(ctx, tx, transaction.UserUID, transaction.Type, transaction.Amount, false); err != nil {
-		return fmt.Errorf("failed to revert balance: %w", err)
+	if transaction.DeletedAt != nil {
+		return nil, ErrTransactionDeleted
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	if expectedVersion != 0 && expectedVersion != transaction.Version {
+		return nil, ErrVersionMismatch
+	}
+
+	deleted := *transaction
+	deleteQuery := `UPDATE transactions SET deleted_at = NOW(), version = version + 1 WHERE id = $1 RETURNING deleted_at, version`
+	err = tx.QueryRow(ctx, deleteQuery, id).Scan(&deleted.DeletedAt, &deleted.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete transaction: %w", err)
+	}
+
+	if err := r.insertRevisionInTx(ctx, tx, id, userUID, models.RevisionActionDelete, transaction, &deleted); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return transaction, nil
 }
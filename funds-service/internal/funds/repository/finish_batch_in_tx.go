@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// finishBatchInTx applies the aggregated balance delta and commits the batch. Nothing is committed
+// when no item succeeded or when any item failed in all-or-nothing mode.
+func (r *FundsRepository) finishBatchInTx(ctx context.Context, tx pgx.Tx, userUID string, result *models.BatchResult, incomeDelta, expenseDelta float64, allOrNothing bool) (*models.BatchResult, error) {
+	if len(result.Transactions) == 0 || (allOrNothing && len(result.Errors) > 0) {
+		result.Transactions = make([]*models.Transaction, 0)
+		return result, nil
+	}
+
+	if err := r.applyBalanceDeltaInTx(ctx, tx, userUID, incomeDelta, expenseDelta); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	result.Committed = true
+
+	return result, nil
+}
+
+// attachCategories populates categories of batch results with a single query
+func (r *FundsRepository) attachCategories(ctx context.Context, transactions []*models.Transaction) {
+	if len(transactions) == 0 {
+		return
+	}
+
+	categories, err := r.GetAllCategories(ctx, nil, true)
+	if err != nil {
+		return
+	}
+
+	byID := make(map[int32]*models.Category, len(categories))
+	for _, c := range categories {
+		byID[c.ID] = c
+	}
+
+	for _, t := range transactions {
+		t.Category = byID[t.CategoryID]
+	}
+}
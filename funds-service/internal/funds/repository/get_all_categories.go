@@ -7,34 +7,21 @@ import (
 	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
 )
 
-func (r *FundsRepository) GetAllCategories(ctx context.Context) ([]*models.Category, error) {
-	query := `
-		SELECT id, name, type, icon, created_at
-		FROM categories
-		ORDER BY type, name
-	`
+// GetAllCategories returns categories with names in the preferred locales. Archived categories
+// are only included on request, transactions may still reference them.
+func (r *FundsRepository) GetAllCategories(ctx context.Context, locales []string, includeArchived bool) ([]*models.Category, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM categories c
+		%s
+		WHERE $2::bool OR c.archived_at IS NULL
+		ORDER BY c.type, COALESCE(tr.name, c.name)
+	`, localizedCategoryColumns, categoryTranslationJoin)
 
-	rows, err := r.db.Query(ctx, query)
+	rows, err := r.db.Query(ctx, query, locales, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get categories: %w", err)
 	}
-	defer rows.Close()
 
-	categories := make([]*models.Category, 0)
-	for rows.Next() {
-		var category models.Category
-		err := rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.Type,
-			&category.Icon,
-			&category.CreatedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan category: %w", err)
-		}
-		categories = append(categories, &category)
-	}
-
-	return categories, nil
+	return collectCategories(rows)
 }
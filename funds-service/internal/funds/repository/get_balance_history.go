@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// GetBalanceHistory aggregates daily balance snapshots into buckets of the requested granularity.
+// Days before the first transaction of the user count as a zero balance, days after the last
+// snapshot are left out.
+func (r *FundsRepository) GetBalanceHistory(ctx context.Context, input models.BalanceHistoryInput) (*models.BalanceHistory, error) {
+	unit, ok := summaryTimeUnits[input.Granularity]
+	if !ok {
+		return nil, fmt.Errorf("unsupported granularity: %s", input.Granularity)
+	}
+
+	// Same shift as in week buckets of the spending summary
+	weekShift := 0
+	if input.Granularity == models.GroupByWeek {
+		weekShift = (int(time.Monday) - int(input.WeekStart) + 7) % 7
+	}
+
+	query := `
+		WITH days AS (
+			SELECT d::date AS day
+			FROM generate_series($2::date, $3::date, interval '1 day') AS d
+		)
+		SELECT (date_trunc($4::text, days.day + $5::int)::date - $5::int) AS period,
+		       (array_agg(COALESCE(s.opening_balance, 0) ORDER BY days.day))[1],
+		       (array_agg(COALESCE(s.closing_balance, 0) ORDER BY days.day DESC))[1],
+		       COALESCE(SUM(s.income), 0),
+		       COALESCE(SUM(s.expense), 0)
+		FROM days
+		LEFT JOIN balance_snapshots s ON s.user_uid = $1 AND s.snapshot_date = days.day
+		WHERE days.day <= (SELECT COALESCE(MAX(snapshot_date), CURRENT_DATE) FROM balance_snapshots WHERE user_uid = $1)
+		GROUP BY 1
+		ORDER BY 1
+	`
+
+	rows, err := r.db.Query(ctx, query, input.UserUID, input.DateFrom, input.DateTo, unit, weekShift)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance history: %w", err)
+	}
+	defer rows.Close()
+
+	points := make([]*models.BalanceHistoryPoint, 0)
+	for rows.Next() {
+		var point models.BalanceHistoryPoint
+		if err := rows.Scan(&point.Period, &point.OpeningBalance, &point.ClosingBalance, &point.Income, &point.Expense); err != nil {
+			return nil, fmt.Errorf("failed to scan balance history point: %w", err)
+		}
+		points = append(points, &point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read balance history: %w", err)
+	}
+
+	return &models.BalanceHistory{
+		Points:      points,
+		Granularity: input.Granularity,
+		DateFrom:    input.DateFrom,
+		DateTo:      input.DateTo,
+	}, nil
+}
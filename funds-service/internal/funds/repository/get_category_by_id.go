@@ -2,33 +2,29 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
 	"github.com/jackc/pgx/v5"
 )
 
-func (r *FundsRepository) GetCategoryById(ctx context.Context, id int32) (*models.Category, error) {
-	query := `
-		SELECT id, name, type, icon, created_at
-		FROM categories
-		WHERE id = $1
-	`
+// GetCategoryById returns the category with the name in the preferred locales, archived ones included
+func (r *FundsRepository) GetCategoryById(ctx context.Context, id int32, locales []string) (*models.Category, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM categories c
+		%s
+		WHERE c.id = $2
+	`, localizedCategoryColumns, categoryTranslationJoin)
 
-	var category models.Category
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&category.ID,
-		&category.Name,
-		&category.Type,
-		&category.Icon,
-		&category.CreatedAt,
-	)
+	category, err := scanCategory(r.db.QueryRow(ctx, query, locales, id))
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("category not found")
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCategoryNotFound
 		}
 		return nil, fmt.Errorf("failed to get category: %w", err)
 	}
 
-	return &category, nil
+	return category, nil
 }
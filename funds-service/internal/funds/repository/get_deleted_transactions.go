@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/postgres"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// GetDeletedTransactions returns a page of the user's trash. The total is exact only when
+// it is requested or the page is the last one, otherwise counting stops at a cap.
+func (r *FundsRepository) GetDeletedTransactions(ctx context.Context, userUID string, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error) {
+	query := `
+		SELECT t.id, t.user_uid, t.category_id, t.type, t.amount, t.title, t.description, 
+		       t.transaction_date, t.created_at, t.updated_at, t.tags, t.version, t.ledger_id, t.deleted_at,
+		       c.id, c.code, c.name, c.type, c.icon, c.created_at
+		FROM transactions t
+		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE t.user_uid = $1 AND t.deleted_at IS NOT NULL
+		ORDER BY t.deleted_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	// One extra row tells whether there is a next page
+	rows, err := r.db.Query(ctx, query, userUID, limit+1, offset)
+	if err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to get deleted transactions: %w", err)
+	}
+	defer rows.Close()
+
+	transactions := make([]*models.Transaction, 0)
+	for rows.Next() {
+		var transaction models.Transaction
+		var category models.Category
+
+		err := rows.Scan(
+			&transaction.ID,
+			&transaction.UserUID,
+			&transaction.CategoryID,
+			&transaction.Type,
+			&transaction.Amount,
+			&transaction.Title,
+			&transaction.Description,
+			&transaction.TransactionDate,
+			&transaction.CreatedAt,
+			&transaction.UpdatedAt,
+			&transaction.Tags,
+			&transaction.Version,
+			&transaction.LedgerID,
+			&transaction.DeletedAt,
+			&category.ID,
+			&category.Code,
+			&category.Name,
+			&category.Type,
+			&category.Icon,
+			&category.CreatedAt,
+		)
+		if err != nil {
+			return nil, models.PageTotal{}, fmt.Errorf("failed to scan transaction: %w", err)
+		}
+
+		transaction.Category = &category
+		transactions = append(transactions, &transaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to read deleted transactions: %w", err)
+	}
+
+	countQuery := `SELECT 1 FROM transactions WHERE user_uid = $1 AND deleted_at IS NOT NULL`
+	total, err := postgres.CountPageTotal(ctx, r.db, countQuery, []any{userUID}, limit, offset, len(transactions), exactTotal)
+	if err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to get deleted transactions count: %w", err)
+	}
+	if total.HasMore {
+		transactions = transactions[:limit]
+	}
+
+	return transactions, total, nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// rowQuerier is implemented by both the pool and pgx.Tx
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// getLedgerRole returns the role of the user in the ledger, users outside the ledger are denied access
+func getLedgerRole(ctx context.Context, q rowQuerier, ledgerID int64, userUID string) (string, error) {
+	query := `
+		SELECT (SELECT role FROM ledger_members WHERE ledger_id = l.id AND user_uid = $2)
+		FROM ledgers l
+		WHERE l.id = $1
+	`
+
+	var role *string
+	if err := q.QueryRow(ctx, query, ledgerID, userUID).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrLedgerNotFound
+		}
+		return "", fmt.Errorf("failed to get ledger role: %w", err)
+	}
+
+	if role == nil {
+		return "", ErrLedgerAccessDenied
+	}
+
+	return *role, nil
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// summaryTimeUnits maps time dimensions to date_trunc units
+var summaryTimeUnits = map[string]string{
+	models.GroupByDay:   "day",
+	models.GroupByWeek:  "week",
+	models.GroupByMonth: "month",
+	models.GroupByYear:  "year",
+}
+
+// GetSpendingSummary aggregates transactions in SQL by the requested dimensions.
+// Rows of the previous period are shifted forward onto the current one, so their
+// time buckets line up with the buckets of the current period.
+// A ledger summary covers the transactions of all members and is available to every member.
+func (r *FundsRepository) GetSpendingSummary(ctx context.Context, input models.SpendingSummaryInput) (*models.SpendingSummary, error) {
+	scopeColumn, scope := "user_uid", any(input.UserUID)
+	if input.LedgerID != nil {
+		if _, err := getLedgerRole(ctx, r.db, *input.LedgerID, input.UserUID); err != nil {
+			return nil, err
+		}
+		scopeColumn, scope = "ledger_id", *input.LedgerID
+	}
+
+	// An empty previous period selects no rows
+	prev := previousPeriod{from: input.DateFrom, to: input.DateFrom.AddDate(0, 0, -1)}
+	if input.ComparePrevious {
+		prev = comparedPeriod(input.DateFrom, input.DateTo, input.GroupBy)
+	}
+
+	args := []any{scope, input.DateFrom, input.DateTo, input.Type, prev.from, prev.to, prev.months, prev.days}
+
+	var (
+		columns []string
+		joins   []string
+		scans   []func(b *models.SpendingSummaryBucket) []any
+	)
+
+	for _, dim := range input.GroupBy {
+		switch dim {
+		case models.GroupByCategory:
+			columns = append(columns, "src.category_id", "c.code", "c.name")
+			joins = append(joins, "JOIN categories c ON c.id = src.category_id")
+			scans = append(scans, func(b *models.SpendingSummaryBucket) []any {
+				return []any{&b.CategoryID, &b.CategoryCode, &b.CategoryName}
+			})
+		case models.GroupByType:
+			columns = append(columns, "src.type")
+			scans = append(scans, func(b *models.SpendingSummaryBucket) []any {
+				return []any{&b.Type}
+			})
+		case models.GroupByTag:
+			columns = append(columns, "COALESCE(tg.tag, '')")
+			joins = append(joins, "LEFT JOIN LATERAL unnest(src.tags) AS tg(tag) ON TRUE")
+			scans = append(scans, func(b *models.SpendingSummaryBucket) []any {
+				return []any{&b.Tag}
+			})
+		case models.GroupByMember:
+			columns = append(columns, "src.user_uid::text")
+			scans = append(scans, func(b *models.SpendingSummaryBucket) []any {
+				return []any{&b.UserUID}
+			})
+		default:
+			unit, ok := summaryTimeUnits[dim]
+			if !ok {
+				return nil, fmt.Errorf("unsupported group by dimension: %s", dim)
+			}
+			column := fmt.Sprintf("date_trunc('%s', src.bucket_date)::date", unit)
+			if dim == models.GroupByWeek {
+				// date_trunc starts weeks on Monday, other week starts are shifted onto it and back
+				args = append(args, (int(time.Monday)-int(input.WeekStart)+7)%7)
+				column = fmt.Sprintf("(date_trunc('week', src.bucket_date + $%[1]d::int)::date - $%[1]d::int)", len(args))
+			}
+			columns = append(columns, column)
+			scans = append(scans, func(b *models.SpendingSummaryBucket) []any {
+				b.Period = new(time.Time)
+				return []any{b.Period}
+			})
+		}
+	}
+
+	var selectList, groupBy string
+	if len(columns) > 0 {
+		selectList = strings.Join(columns, ", ") + ","
+		groupBy = "GROUP BY " + strings.Join(columns, ", ") + "\n\t\tORDER BY " + strings.Join(columns, ", ")
+	}
+
+	query := fmt.Sprintf(`
+		WITH src AS (
+			SELECT t.user_uid, t.category_id, t.type, t.amount, t.tags,
+			       t.transaction_date >= $2::date AS is_current,
+			       CASE WHEN t.transaction_date >= $2::date THEN t.transaction_date
+			            ELSE (t.transaction_date + make_interval(months => $7::int, days => $8::int))::date END AS bucket_date
+			FROM transactions t
+			WHERE t.%s = $1
+			  AND t.deleted_at IS NULL
+			  AND t.transaction_date >= $5::date
+			  AND t.transaction_date <= $3::date
+			  AND (t.transaction_date >= $2::date OR t.transaction_date <= $6::date)
+			  AND ($4::text = '' OR t.type = $4::text)
+		)
+		SELECT %s
+		       COALESCE(SUM(src.amount) FILTER (WHERE src.is_current), 0),
+		       COUNT(*) FILTER (WHERE src.is_current),
+		       COALESCE(SUM(src.amount) FILTER (WHERE NOT src.is_current), 0)
+		FROM src
+		%s
+		%s
+	`, scopeColumn, selectList, strings.Join(joins, "\n\t\t"), groupBy)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spending summary: %w", err)
+	}
+	defer rows.Close()
+
+	buckets := make([]*models.SpendingSummaryBucket, 0)
+	for rows.Next() {
+		var bucket models.SpendingSummaryBucket
+
+		dest := make([]any, 0, len(columns)+3)
+		for _, scan := range scans {
+			dest = append(dest, scan(&bucket)...)
+		}
+		dest = append(dest, &bucket.Total, &bucket.Count, &bucket.PreviousTotal)
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan spending summary bucket: %w", err)
+		}
+
+		buckets = append(buckets, &bucket)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read spending summary: %w", err)
+	}
+
+	totalsQuery := fmt.Sprintf(`
+		SELECT COALESCE(SUM(amount) FILTER (WHERE type = 'income' AND transaction_date >= $2::date), 0),
+		       COALESCE(SUM(amount) FILTER (WHERE type = 'expense' AND transaction_date >= $2::date), 0),
+		       COALESCE(SUM(amount) FILTER (WHERE type = 'income' AND transaction_date < $2::date), 0),
+		       COALESCE(SUM(amount) FILTER (WHERE type = 'expense' AND transaction_date < $2::date), 0)
+		FROM transactions
+		WHERE %s = $1
+		  AND deleted_at IS NULL
+		  AND transaction_date >= $5::date
+		  AND transaction_date <= $3::date
+		  AND (transaction_date >= $2::date OR transaction_date <= $6::date)
+		  AND ($4::text = '' OR type = $4::text)
+	`, scopeColumn)
+
+	summary := &models.SpendingSummary{
+		Buckets:  buckets,
+		DateFrom: input.DateFrom,
+		DateTo:   input.DateTo,
+	}
+	err = r.db.QueryRow(ctx, totalsQuery, scope, input.DateFrom, input.DateTo, input.Type, prev.from, prev.to).Scan(
+		&summary.TotalIncome,
+		&summary.TotalExpense,
+		&summary.PreviousTotalIncome,
+		&summary.PreviousTotalExpense,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spending totals: %w", err)
+	}
+
+	return summary, nil
+}
+
+// previousPeriod is the inclusive range a summary is compared with. Its rows are moved forward
+// by months and days to land in the buckets of the current period.
+type previousPeriod struct {
+	from, to     time.Time
+	months, days int
+}
+
+// comparedPeriod returns the period before the inclusive range that lines up with its time buckets.
+// Month and year buckets are matched by calendar month, so March is compared with February and not
+// with the 31 days before it. Week buckets are shifted by whole weeks, other ranges by their length.
+func comparedPeriod(from, to time.Time, groupBy []string) previousPeriod {
+	unit := ""
+	for _, dim := range groupBy {
+		if _, ok := summaryTimeUnits[dim]; ok {
+			unit = dim
+		}
+	}
+
+	switch unit {
+	case models.GroupByMonth:
+		months := (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month()) + 1
+		return previousPeriod{from: addMonths(from, -months), to: addMonths(to, -months), months: months}
+	case models.GroupByYear:
+		months := (to.Year() - from.Year() + 1) * 12
+		return previousPeriod{from: addMonths(from, -months), to: addMonths(to, -months), months: months}
+	case models.GroupByWeek:
+		days := (periodDays(from, to) + 6) / 7 * 7
+		return previousPeriod{from: from.AddDate(0, 0, -days), to: to.AddDate(0, 0, -days), days: days}
+	default:
+		days := periodDays(from, to)
+		return previousPeriod{from: from.AddDate(0, 0, -days), to: to.AddDate(0, 0, -days), days: days}
+	}
+}
+
+// addMonths shifts the date by whole months. A day missing in the target month becomes its last day,
+// and the last day of a month stays the last day, so a whole month maps onto a whole month.
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+
+	day := t.Day()
+	if t.AddDate(0, 0, 1).Day() == 1 {
+		day = last
+	}
+
+	return time.Date(first.Year(), first.Month(), min(day, last), 0, 0, 0, 0, t.Location())
+}
+
+// periodDays returns the number of days in an inclusive date range
+func periodDays(from, to time.Time) int {
+	return int(to.Sub(from).Hours()/24) + 1
+}
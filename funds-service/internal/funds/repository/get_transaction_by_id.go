@@ -11,11 +11,11 @@ import (
 func (r *FundsRepository) GetTransactionById(ctx context.Context, id int64) (*models.Transaction, error) {
 	query := `
 		SELECT t.id, t.user_uid, t.category_id, t.type, t.amount, t.title, t.description, 
-		       t.transaction_date, t.created_at, t.updated_at,
-		       c.id, c.name, c.type, c.icon, c.created_at
+		       t.transaction_date, t.created_at, t.updated_at, t.tags, t.version, t.ledger_id,
+		       c.id, c.code, c.name, c.type, c.icon, c.created_at
 		FROM transactions t
 		LEFT JOIN categories c ON t.category_id = c.id
-		WHERE t.id = $1
+		WHERE t.id = $1 AND t.deleted_at IS NULL
 	`
 
 	var transaction models.Transaction
@@ -32,7 +32,11 @@ func (r *FundsRepository) GetTransactionById(ctx context.Context, id int64) (*mo
 		&transaction.TransactionDate,
 		&transaction.CreatedAt,
 		&transaction.UpdatedAt,
+		&transaction.Tags,
+		&transaction.Version,
+		&transaction.LedgerID,
 		&category.ID,
+		&category.Code,
 		&category.Name,
 		&category.Type,
 		&category.Icon,
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// getTransactionForUpdateInTx locks the transaction row and returns its full snapshot,
+// including soft-deleted rows. It verifies that the transaction belongs to the user.
+func (r *FundsRepository) getTransactionForUpdateInTx(ctx context.Context, tx pgx.Tx, id int64, userUID string) (*models.Transaction, error) {
+	query := `
+		SELECT id, user_uid, category_id, type, amount, title, description,
+		       transaction_date, created_at, updated_at, tags, version, ledger_id, deleted_at
+		FROM transactions
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	var transaction models.Transaction
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&transaction.ID,
+		&transaction.UserUID,
+		&transaction.CategoryID,
+		&transaction.Type,
+		&transaction.Amount,
+		&transaction.Title,
+		&transaction.Description,
+		&transaction.TransactionDate,
+		&transaction.CreatedAt,
+		&transaction.UpdatedAt,
+		&transaction.Tags,
+		&transaction.Version,
+		&transaction.LedgerID,
+		&transaction.DeletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	// Verify that the transaction belongs to the user
+	if transaction.UserUID != userUID {
+		return nil, ErrTransactionNotOwned
+	}
+
+	return &transaction, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *FundsRepository) GetTransactionHistory(ctx context.Context, id int64, userUID string) ([]*models.TransactionRevision, error) {
+	// Verify that the transaction exists and belongs to the user, deleted ones included
+	var ownerUID string
+	err := r.db.QueryRow(ctx, `SELECT user_uid FROM transactions WHERE id = $1`, id).Scan(&ownerUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if ownerUID != userUID {
+		return nil, ErrTransactionNotOwned
+	}
+
+	query := `
+		SELECT id, transaction_id, changed_by, action, before, after, changed_at
+		FROM transaction_revisions
+		WHERE transaction_id = $1
+		ORDER BY changed_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction history: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := make([]*models.TransactionRevision, 0)
+	for rows.Next() {
+		var revision models.TransactionRevision
+
+		err := rows.Scan(
+			&revision.ID,
+			&revision.TransactionID,
+			&revision.ChangedBy,
+			&revision.Action,
+			&revision.Before,
+			&revision.After,
+			&revision.ChangedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan transaction revision: %w", err)
+		}
+
+		revisions = append(revisions, &revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read transaction history: %w", err)
+	}
+
+	return revisions, nil
+}
@@ -4,31 +4,28 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/postgres"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
 )
 
-func (r *FundsRepository) GetUserTransactions(ctx context.Context, userUID string, limit, offset int32) ([]*models.Transaction, int64, error) {
-	var total int64
-	countQuery := `SELECT COUNT(*) FROM transactions WHERE user_uid = $1`
-	err := r.db.QueryRow(ctx, countQuery, userUID).Scan(&total)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get transactions count: %w", err)
-	}
-
+// GetUserTransactions returns a page of the user's transactions. The total is exact only when
+// it is requested or the page is the last one, otherwise counting stops at a cap.
+func (r *FundsRepository) GetUserTransactions(ctx context.Context, userUID string, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error) {
 	query := `
 		SELECT t.id, t.user_uid, t.category_id, t.type, t.amount, t.title, t.description, 
-		       t.transaction_date, t.created_at, t.updated_at,
-		       c.id, c.name, c.type, c.icon, c.created_at
+		       t.transaction_date, t.created_at, t.updated_at, t.tags, t.version, t.ledger_id,
+		       c.id, c.code, c.name, c.type, c.icon, c.created_at
 		FROM transactions t
 		LEFT JOIN categories c ON t.category_id = c.id
-		WHERE t.user_uid = $1
+		WHERE t.user_uid = $1 AND t.deleted_at IS NULL
 		ORDER BY t.transaction_date DESC, t.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.db.Query(ctx, query, userUID, limit, offset)
+	// One extra row tells whether there is a next page
+	rows, err := r.db.Query(ctx, query, userUID, limit+1, offset)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get transactions: %w", err)
+		return nil, models.PageTotal{}, fmt.Errorf("failed to get transactions: %w", err)
 	}
 	defer rows.Close()
 
@@ -48,19 +45,36 @@ func (r *FundsRepository) GetUserTransactions(ctx context.Context, userUID strin
 			&transaction.TransactionDate,
 			&transaction.CreatedAt,
 			&transaction.UpdatedAt,
+			&transaction.Tags,
+			&transaction.Version,
+			&transaction.LedgerID,
 			&category.ID,
+			&category.Code,
 			&category.Name,
 			&category.Type,
 			&category.Icon,
 			&category.CreatedAt,
 		)
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to scan transaction: %w", err)
+			return nil, models.PageTotal{}, fmt.Errorf("failed to scan transaction: %w", err)
 		}
 
 		transaction.Category = &category
 		transactions = append(transactions, &transaction)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to read transactions: %w", err)
+	}
+
+	countQuery := `SELECT 1 FROM transactions WHERE user_uid = $1 AND deleted_at IS NULL`
+	total, err := postgres.CountPageTotal(ctx, r.db, countQuery, []any{userUID}, limit, offset, len(transactions), exactTotal)
+	if err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to get transactions count: %w", err)
+	}
+	if total.HasMore {
+		transactions = transactions[:limit]
+	}
+
 	return transactions, total, nil
 }
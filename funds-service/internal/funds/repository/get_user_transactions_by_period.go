@@ -5,37 +5,28 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/postgres"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
 )
 
-func (r *FundsRepository) GetUserTransactionsByPeriod(ctx context.Context, userUID string, days int32, limit, offset int32) ([]*models.Transaction, int64, error) {
-	startDate := time.Now().AddDate(0, 0, -int(days))
-
-	var total int64
-	countQuery := `
-		SELECT COUNT(*) 
-		FROM transactions 
-		WHERE user_uid = $1 AND transaction_date >= $2
-	`
-	err := r.db.QueryRow(ctx, countQuery, userUID, startDate).Scan(&total)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get transactions count: %w", err)
-	}
-
+// GetUserTransactionsByPeriod returns a page of the user's transactions dated within the inclusive range.
+// The total is exact only when it is requested or the page is the last one, otherwise counting stops at a cap.
+func (r *FundsRepository) GetUserTransactionsByPeriod(ctx context.Context, userUID string, dateFrom, dateTo time.Time, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error) {
 	query := `
 		SELECT t.id, t.user_uid, t.category_id, t.type, t.amount, t.title, t.description, 
-		       t.transaction_date, t.created_at, t.updated_at,
-		       c.id, c.name, c.type, c.icon, c.created_at
+		       t.transaction_date, t.created_at, t.updated_at, t.tags, t.version, t.ledger_id,
+		       c.id, c.code, c.name, c.type, c.icon, c.created_at
 		FROM transactions t
 		LEFT JOIN categories c ON t.category_id = c.id
-		WHERE t.user_uid = $1 AND t.transaction_date >= $2
+		WHERE t.user_uid = $1 AND t.transaction_date BETWEEN $2::date AND $3::date AND t.deleted_at IS NULL
 		ORDER BY t.transaction_date DESC, t.created_at DESC
-		LIMIT $3 OFFSET $4
+		LIMIT $4 OFFSET $5
 	`
 
-	rows, err := r.db.Query(ctx, query, userUID, startDate, limit, offset)
+	// One extra row tells whether there is a next page
+	rows, err := r.db.Query(ctx, query, userUID, dateFrom, dateTo, limit+1, offset)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get transactions: %w", err)
+		return nil, models.PageTotal{}, fmt.Errorf("failed to get transactions: %w", err)
 	}
 	defer rows.Close()
 
@@ -55,19 +46,40 @@ func (r *FundsRepository) GetUserTransactionsByPeriod(ctx context.Context, userU
 			&transaction.TransactionDate,
 			&transaction.CreatedAt,
 			&transaction.UpdatedAt,
+			&transaction.Tags,
+			&transaction.Version,
+			&transaction.LedgerID,
 			&category.ID,
+			&category.Code,
 			&category.Name,
 			&category.Type,
 			&category.Icon,
 			&category.CreatedAt,
 		)
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to scan transaction: %w", err)
+			return nil, models.PageTotal{}, fmt.Errorf("failed to scan transaction: %w", err)
 		}
 
 		transaction.Category = &category
 		transactions = append(transactions, &transaction)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to read transactions: %w", err)
+	}
+
+	countQuery := `
+		SELECT 1
+		FROM transactions
+		WHERE user_uid = $1 AND transaction_date BETWEEN $2::date AND $3::date AND deleted_at IS NULL
+	`
+	total, err := postgres.CountPageTotal(ctx, r.db, countQuery, []any{userUID, dateFrom, dateTo}, limit, offset, len(transactions), exactTotal)
+	if err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to get transactions count: %w", err)
+	}
+	if total.HasMore {
+		transactions = transactions[:limit]
+	}
+
 	return transactions, total, nil
 }
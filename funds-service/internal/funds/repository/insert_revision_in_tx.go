@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *FundsRepository) insertRevisionInTx(ctx context.Context, tx pgx.Tx, transactionID int64, changedBy, action string, before, after *models.Transaction) error {
+	beforeJSON, err := marshalSnapshot(before)
+	if err != nil {
+		return err
+	}
+
+	afterJSON, err := marshalSnapshot(after)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO transaction_revisions (transaction_id, changed_by, action, before, after)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err = tx.Exec(ctx, query, transactionID, changedBy, action, beforeJSON, afterJSON)
+	if err != nil {
+		return fmt.Errorf("failed to insert transaction revision: %w", err)
+	}
+
+	// Every change of a transaction goes through a revision, so balance snapshots are invalidated here
+	return markSnapshotsStaleInTx(ctx, tx, before, after)
+}
+
+// marshalSnapshot encodes a transaction snapshot for a JSONB column, nil stays NULL
+func marshalSnapshot(t *models.Transaction) ([]byte, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	snapshot := *t
+	snapshot.Category = nil
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transaction snapshot: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// markSnapshotsStaleInTx records that the balance snapshots of the transaction owner have to be
+// rebuilt starting from the earliest date the change touches
+func markSnapshotsStaleInTx(ctx context.Context, tx pgx.Tx, before, after *models.Transaction) error {
+	var (
+		userUID   string
+		staleFrom time.Time
+	)
+	for _, t := range []*models.Transaction{before, after} {
+		if t == nil {
+			continue
+		}
+		userUID = t.UserUID
+		if staleFrom.IsZero() || t.TransactionDate.Before(staleFrom) {
+			staleFrom = t.TransactionDate
+		}
+	}
+	if userUID == "" {
+		return nil
+	}
+
+	query := `
+		INSERT INTO balance_snapshot_stale (user_uid, stale_from)
+		VALUES ($1, $2::date)
+		ON CONFLICT (user_uid) DO UPDATE
+		SET stale_from = LEAST(balance_snapshot_stale.stale_from, EXCLUDED.stale_from)
+	`
+	if _, err := tx.Exec(ctx, query, userUID, staleFrom); err != nil {
+		return fmt.Errorf("failed to mark balance snapshots stale: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package repository
+
+import "strings"
+
+// normalizeTags trims and lowercases tags and drops empty values and duplicates.
+// It never returns nil, so the NOT NULL tags column is always satisfied.
+func normalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+
+	return result
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PurgeDeletedTransactions permanently removes transactions that were moved to the trash
+// before the given moment together with their revisions, attachments and debt payments.
+// The partitioned transactions table cannot be referenced by foreign keys, so there is no cascade.
+func (r *FundsRepository) PurgeDeletedTransactions(ctx context.Context, before time.Time) (int64, error) {
+	query := `
+		WITH purged AS (
+			DELETE FROM transactions WHERE deleted_at IS NOT NULL AND deleted_at < $1
+			RETURNING id
+		),
+		revisions AS (
+			DELETE FROM transaction_revisions WHERE transaction_id IN (SELECT id FROM purged)
+		),
+		attachments AS (
+			DELETE FROM attachments WHERE transaction_id IN (SELECT id FROM purged)
+		),
+		payments AS (
+			DELETE FROM debt_payments WHERE transaction_id IN (SELECT id FROM purged)
+		)
+		SELECT COUNT(*) FROM purged
+	`
+
+	var purged int64
+	if err := r.db.QueryRow(ctx, query, before).Scan(&purged); err != nil {
+		return 0, fmt.Errorf("failed to purge deleted transactions: %w", err)
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// RefreshBalanceSnapshots brings the daily balance snapshots of the user up to date: rebuilds the days
+// changed since the last refresh and extends the series to the current date
+func (r *FundsRepository) RefreshBalanceSnapshots(ctx context.Context, userUID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// The job and history requests may refresh the same user concurrently
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, userUID); err != nil {
+		return fmt.Errorf("failed to lock balance snapshots: %w", err)
+	}
+
+	var staleFrom *time.Time
+	err = tx.QueryRow(ctx, `DELETE FROM balance_snapshot_stale WHERE user_uid = $1 RETURNING stale_from`, userUID).Scan(&staleFrom)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get stale balance snapshots: %w", err)
+	}
+
+	var lastDate *time.Time
+	var upToDate bool
+	err = tx.QueryRow(ctx, `
+		SELECT MAX(snapshot_date), COALESCE(MAX(snapshot_date) >= CURRENT_DATE, FALSE)
+		FROM balance_snapshots
+		WHERE user_uid = $1
+	`, userUID).Scan(&lastDate, &upToDate)
+	if err != nil {
+		return fmt.Errorf("failed to get last balance snapshot: %w", err)
+	}
+
+	// The series is rebuilt from the earliest changed day or extended after the last snapshot
+	from := staleFrom
+	if lastDate != nil && (!upToDate || from != nil) {
+		next := lastDate.AddDate(0, 0, 1)
+		if from == nil || next.Before(*from) {
+			from = &next
+		}
+	}
+	if from == nil {
+		// Nothing changed since the last refresh, or the user has no transactions yet
+		return tx.Commit(ctx)
+	}
+
+	if err := rebuildBalanceSnapshotsInTx(ctx, tx, userUID, *from); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RefreshStaleBalanceSnapshots refreshes the snapshots of every user whose transactions changed
+// or whose series ends before the current date. It returns the number of refreshed users.
+func (r *FundsRepository) RefreshStaleBalanceSnapshots(ctx context.Context) (int, error) {
+	query := `
+		SELECT user_uid::text FROM balance_snapshot_stale
+		UNION
+		SELECT user_uid::text FROM balance_snapshots
+		GROUP BY user_uid
+		HAVING MAX(snapshot_date) < CURRENT_DATE
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get users with stale balance snapshots: %w", err)
+	}
+	userUIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return 0, fmt.Errorf("failed to read users with stale balance snapshots: %w", err)
+	}
+
+	var (
+		refreshed int
+		errs      []error
+	)
+	for _, userUID := range userUIDs {
+		if err := r.RefreshBalanceSnapshots(ctx, userUID); err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", userUID, err))
+			continue
+		}
+		refreshed++
+	}
+
+	return refreshed, errors.Join(errs...)
+}
+
+// rebuildBalanceSnapshotsInTx recomputes the snapshots of the user from the given date up to the
+// current date, or up to the latest transaction when it is dated in the future.
+// The opening balance comes from the snapshot of the previous day when there is one, so the
+// transactions of archived partitions keep counting.
+func rebuildBalanceSnapshotsInTx(ctx context.Context, tx pgx.Tx, userUID string, from time.Time) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM balance_snapshots WHERE user_uid = $1 AND snapshot_date >= $2::date`, userUID, from); err != nil {
+		return fmt.Errorf("failed to delete balance snapshots: %w", err)
+	}
+
+	query := `
+		WITH opening AS (
+			SELECT COALESCE(
+				(SELECT closing_balance FROM balance_snapshots WHERE user_uid = $1 AND snapshot_date = $2::date - 1),
+				(SELECT SUM(CASE WHEN type = 'income' THEN amount ELSE -amount END)
+				 FROM transactions
+				 WHERE user_uid = $1 AND deleted_at IS NULL AND transaction_date < $2::date),
+				0
+			) AS balance
+		),
+		days AS (
+			SELECT d::date AS day
+			FROM generate_series(
+				$2::date,
+				GREATEST(CURRENT_DATE, (SELECT MAX(transaction_date) FROM transactions WHERE user_uid = $1 AND deleted_at IS NULL)),
+				interval '1 day'
+			) AS d
+		),
+		daily AS (
+			SELECT days.day,
+			       COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'income'), 0) AS income,
+			       COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'expense'), 0) AS expense
+			FROM days
+			LEFT JOIN transactions t
+			       ON t.user_uid = $1 AND t.deleted_at IS NULL AND t.transaction_date = days.day
+			GROUP BY days.day
+		),
+		running AS (
+			SELECT day, income, expense,
+			       (SELECT balance FROM opening) + SUM(income - expense) OVER (ORDER BY day) AS closing
+			FROM daily
+		)
+		INSERT INTO balance_snapshots (user_uid, snapshot_date, opening_balance, closing_balance, income, expense, updated_at)
+		SELECT $1, day, closing - income + expense, closing, income, expense, NOW()
+		FROM running
+	`
+	if _, err := tx.Exec(ctx, query, userUID, from); err != nil {
+		return fmt.Errorf("failed to rebuild balance snapshots: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// RestoreTransaction takes the transaction out of the trash and re-applies it to the balance
+func (r *FundsRepository) RestoreTransaction(ctx context.Context, id int64, userUID string) (*models.Transaction, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	deleted, err := r.getTransactionForUpdateInTx(ctx, tx, id, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted.DeletedAt == nil {
+		return nil, ErrTransactionNotDeleted
+	}
+
+	restoreQuery := `
+		UPDATE transactions
+		SET deleted_at = NULL, version = version + 1, updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, user_uid, category_id, type, amount, title, description, transaction_date, created_at, updated_at, tags, version, ledger_id
+	`
+
+	var transaction models.Transaction
+	err = tx.QueryRow(ctx, restoreQuery, id).Scan(
+		&transaction.ID,
+		&transaction.UserUID,
+		&transaction.CategoryID,
+		&transaction.Type,
+		&transaction.Amount,
+		&transaction.Title,
+		&transaction.Description,
+		&transaction.TransactionDate,
+		&transaction.CreatedAt,
+		&transaction.UpdatedAt,
+		&transaction.Tags,
+		&transaction.Version,
+		&transaction.LedgerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore transaction: %w", err)
+	}
+
+	if err := r.updateUserBalanceInTx(ctx, tx, transaction.UserUID, transaction.Type, transaction.Amount, true); err != nil {
+		return nil, fmt.Errorf("failed to re-apply balance: %w", err)
+	}
+
+	if err := r.insertRevisionInTx(ctx, tx, id, userUID, models.RevisionActionRestore, deleted, &transaction); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	category, err := r.GetCategoryById(ctx, transaction.CategoryID, nil)
+	if err == nil {
+		transaction.Category = category
+	}
+
+	return &transaction, nil
+}
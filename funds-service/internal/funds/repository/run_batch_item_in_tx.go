@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// runBatchItemInTx runs fn inside a savepoint, so a failed item does not abort the whole batch transaction
+func runBatchItemInTx(ctx context.Context, tx pgx.Tx, fn func(sp pgx.Tx) error) error {
+	sp, err := tx.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	if err := fn(sp); err != nil {
+		if rbErr := sp.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("failed to roll back savepoint: %w", rbErr)
+		}
+		return err
+	}
+
+	if err := sp.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+
+	return nil
+}
+
+// checkCategoryInTx verifies that the category exists, is not archived and matches the transaction type
+func checkCategoryInTx(ctx context.Context, tx pgx.Tx, categoryID int32, transactionType string) error {
+	var (
+		categoryType string
+		archived     bool
+	)
+	err := tx.QueryRow(ctx, `SELECT type, archived_at IS NOT NULL FROM categories WHERE id = $1`, categoryID).Scan(&categoryType, &archived)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCategoryNotFound
+		}
+		return fmt.Errorf("failed to get category: %w", err)
+	}
+
+	if archived {
+		return ErrCategoryArchived
+	}
+
+	if categoryType != transactionType {
+		return ErrCategoryTypeMismatch
+	}
+
+	return nil
+}
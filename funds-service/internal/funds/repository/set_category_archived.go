@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// SetCategoryArchived archives or restores the category. Archived categories are hidden from
+// category lists and cannot be chosen for new transactions, existing transactions keep them.
+func (r *FundsRepository) SetCategoryArchived(ctx context.Context, id int32, archived bool) (*models.Category, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE categories
+		SET archived_at = CASE WHEN $2::bool THEN COALESCE(archived_at, NOW()) END,
+		    updated_at = NOW()
+		WHERE id = $1
+	`
+	tag, err := tx.Exec(ctx, query, id, archived)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive category: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, ErrCategoryNotFound
+	}
+
+	category, err := getManagedCategoryInTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return category, nil
+}
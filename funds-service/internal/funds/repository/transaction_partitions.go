@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	transactionPartitionPrefix = "transactions_y"
+	transactionPartitionLayout = "2006m01"
+	transactionsDefaultTable   = "transactions_default"
+	transactionsArchiveSchema  = "transactions_archive"
+)
+
+// transactionPartitionName returns the name of the partition holding the month of the given date
+func transactionPartitionName(month time.Time) string {
+	return transactionPartitionPrefix + month.Format(transactionPartitionLayout)
+}
+
+// parseTransactionPartitionName returns the first day of the month of the partition.
+// Tables that do not follow the naming scheme are reported as not ok.
+func parseTransactionPartitionName(name string) (time.Time, bool) {
+	suffix, ok := strings.CutPrefix(name, transactionPartitionPrefix)
+	if !ok {
+		return time.Time{}, false
+	}
+	month, err := time.Parse(transactionPartitionLayout, suffix)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return month, true
+}
+
+// monthStart truncates the date to the first day of its month
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// EnsureTransactionPartitions creates the monthly partitions of the transactions table from the
+// month of the given date for the given number of months ahead. It returns the names of the created partitions.
+func (r *FundsRepository) EnsureTransactionPartitions(ctx context.Context, from time.Time, monthsAhead int) ([]string, error) {
+	start := monthStart(from)
+
+	var created []string
+	for i := 0; i <= monthsAhead; i++ {
+		month := start.AddDate(0, i, 0)
+		name := transactionPartitionName(month)
+
+		var exists bool
+		if err := r.db.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, name).Scan(&exists); err != nil {
+			return created, fmt.Errorf("failed to check partition %s: %w", name, err)
+		}
+		if exists {
+			continue
+		}
+
+		if err := r.createTransactionPartition(ctx, name, month); err != nil {
+			return created, err
+		}
+		created = append(created, name)
+	}
+
+	return created, nil
+}
+
+// createTransactionPartition creates the partition as a standalone table, moves the rows of its
+// month out of the default partition and attaches it. Attaching fails while the default
+// partition still holds rows of the new range.
+func (r *FundsRepository) createTransactionPartition(ctx context.Context, name string, month time.Time) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	table := pgx.Identifier{name}.Sanitize()
+	next := month.AddDate(0, 1, 0)
+
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`CREATE TABLE %s (LIKE transactions INCLUDING DEFAULTS INCLUDING CONSTRAINTS)`, table)); err != nil {
+		return fmt.Errorf("failed to create partition %s: %w", name, err)
+	}
+
+	moveQuery := fmt.Sprintf(`
+		WITH moved AS (
+			DELETE FROM %s
+			WHERE transaction_date >= $1::date AND transaction_date < $2::date
+			RETURNING *
+		)
+		INSERT INTO %s SELECT * FROM moved
+	`, pgx.Identifier{transactionsDefaultTable}.Sanitize(), table)
+	if _, err := tx.Exec(ctx, moveQuery, month, next); err != nil {
+		return fmt.Errorf("failed to move rows into partition %s: %w", name, err)
+	}
+
+	// Partition bounds cannot be passed as parameters, both dates are formatted above
+	attachQuery := fmt.Sprintf(`ALTER TABLE transactions ATTACH PARTITION %s FOR VALUES FROM ('%s') TO ('%s')`,
+		table, month.Format(time.DateOnly), next.Format(time.DateOnly))
+	if _, err := tx.Exec(ctx, attachQuery); err != nil {
+		return fmt.Errorf("failed to attach partition %s: %w", name, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ArchiveTransactionPartitions detaches the monthly partitions that end before the given date and
+// moves them into the archive schema. Archived transactions disappear from every read, while
+// balances and balance snapshots keep their amounts. It returns the names of the archived partitions.
+func (r *FundsRepository) ArchiveTransactionPartitions(ctx context.Context, before time.Time) ([]string, error) {
+	query := `
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'transactions'::regclass
+		ORDER BY c.relname
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction partitions: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transaction partitions: %w", err)
+	}
+
+	cutoff := monthStart(before)
+
+	var archived []string
+	for _, name := range names {
+		month, ok := parseTransactionPartitionName(name)
+		if !ok || month.AddDate(0, 1, 0).After(cutoff) {
+			continue
+		}
+
+		if err := r.archiveTransactionPartition(ctx, name); err != nil {
+			return archived, err
+		}
+		archived = append(archived, name)
+	}
+
+	return archived, nil
+}
+
+func (r *FundsRepository) archiveTransactionPartition(ctx context.Context, name string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	table := pgx.Identifier{name}.Sanitize()
+
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE transactions DETACH PARTITION %s`, table)); err != nil {
+		return fmt.Errorf("failed to detach partition %s: %w", name, err)
+	}
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s SET SCHEMA %s`, table, pgx.Identifier{transactionsArchiveSchema}.Sanitize())); err != nil {
+		return fmt.Errorf("failed to move partition %s to the archive: %w", name, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
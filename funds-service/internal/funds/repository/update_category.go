@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// UpdateCategory changes the default name and icon of the category and upserts its translations.
+// The type is immutable, existing transactions depend on it.
+func (r *FundsRepository) UpdateCategory(ctx context.Context, input models.UpdateCategoryInput) (*models.Category, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE categories
+		SET name = COALESCE($2, name),
+		    icon = COALESCE($3, icon),
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING id
+	`
+
+	var id int32
+	if err := tx.QueryRow(ctx, query, input.ID, input.Name, input.Icon).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	if err := upsertCategoryTranslationsInTx(ctx, tx, id, input.Translations); err != nil {
+		return nil, err
+	}
+
+	category, err := getManagedCategoryInTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return category, nil
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
-	"github.com/jackc/pgx/v5"
 )
 
 func (r *FundsRepository) UpdateTransaction(ctx context.Context, input models.UpdateTransactionInput) (*models.Transaction, error) {
@@ -15,27 +14,24 @@ func (r *FundsRepository) UpdateTransaction(ctx context.Context, input models.Up
 	}
 	defer tx.Rollback(ctx)
 
-	var oldTransaction models.Transaction
-	getQuery := `
-		SELECT user_uid, type, amount 
-		FROM transactions 
-		WHERE id = $1
-	`
-	err = tx.QueryRow(ctx, getQuery, input.ID).Scan(
-		&oldTransaction.UserUID,
-		&oldTransaction.Type,
-		&oldTransaction.Amount,
-	)
+	oldTransaction, err := r.getTransactionForUpdateInTx(ctx, tx, input.ID, input.UserUID)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("transaction not found")
-		}
-		return nil, fmt.Errorf("failed to get old transaction: %w", err)
+		return nil, err
+	}
+
+	if oldTransaction.DeletedAt != nil {
+		return nil, ErrTransactionDeleted
 	}
 
-	// Verify that the transaction belongs to the user
-	if oldTransaction.UserUID != input.UserUID {
-		return nil, fmt.Errorf("transaction does not belong to user")
+	if input.ExpectedVersion != 0 && input.ExpectedVersion != oldTransaction.Version {
+		return nil, ErrVersionMismatch
+	}
+
+	// Transactions keep an archived category, it just cannot be chosen again
+	if input.CategoryID != oldTransaction.CategoryID {
+		if err := checkCategoryInTx(ctx, tx, input.CategoryID, input.Type); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := r.updateUserBalanceInTx(ctx, tx, oldTransaction.UserUID, oldTransaction.Type, oldTransaction.Amount, false); err != nil {
@@ -45,9 +41,9 @@ func (r *FundsRepository) UpdateTransaction(ctx context.Context, input models.Up
 	updateQuery := `
 		UPDATE transactions 
 		SET category_id = $1, type = $2, amount = $3, title = $4, description = $5, 
-		    transaction_date = $6, updated_at = NOW()
-		WHERE id = $7
-		RETURNING id, user_uid, category_id, type, amount, title, description, transaction_date, created_at, updated_at
+		    transaction_date = $6, tags = $7, version = version + 1, updated_at = NOW()
+		WHERE id = $8
+		RETURNING id, user_uid, category_id, type, amount, title, description, transaction_date, created_at, updated_at, tags, version, ledger_id
 	`
 
 	var transaction models.Transaction
@@ -58,6 +54,7 @@ func (r *FundsRepository) UpdateTransaction(ctx context.Context, input models.Up
 		input.Title,
 		input.Description,
 		input.TransactionDate,
+		normalizeTags(input.Tags),
 		input.ID,
 	).Scan(
 		&transaction.ID,
@@ -70,6 +67,9 @@ func (r *FundsRepository) UpdateTransaction(ctx context.Context, input models.Up
 		&transaction.TransactionDate,
 		&transaction.CreatedAt,
 		&transaction.UpdatedAt,
+		&transaction.Tags,
+		&transaction.Version,
+		&transaction.LedgerID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update transaction: %w", err)
@@ -79,14 +79,19 @@ func (r *FundsRepository) UpdateTransaction(ctx context.Context, input models.Up
 		return nil, fmt.Errorf("failed to apply new balance: %w", err)
 	}
 
+	if err := r.insertRevisionInTx(ctx, tx, transaction.ID, input.UserUID, models.RevisionActionUpdate, oldTransaction, &transaction); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	category, err := r.GetCategoryById(ctx, input.CategoryID)
+	category, err := r.GetCategoryById(ctx, input.CategoryID, nil)
 	if err == nil {
 		transaction.Category = category
 	}
+	transaction.Previous = oldTransaction
 
 	return &transaction, nil
 }
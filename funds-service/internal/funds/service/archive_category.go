@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) ArchiveCategory(ctx context.Context, id int32) (*models.Category, error) {
+	return s.repo.SetCategoryArchived(ctx, id, true)
+}
+
+func (s *FundsService) RestoreCategory(ctx context.Context, id int32) (*models.Category, error) {
+	return s.repo.SetCategoryArchived(ctx, id, false)
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) BatchCreateTransactions(ctx context.Context, userUID string, inputs []models.CreateTransactionInput, allOrNothing bool) (*models.BatchResult, error) {
+	result, err := s.repo.BatchCreateTransactions(ctx, userUID, inputs, allOrNothing)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyBatch(ctx, userUID, models.AnalyticsActionTransactionsCreated, result)
+
+	return result, nil
+}
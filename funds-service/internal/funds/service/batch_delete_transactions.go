@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) BatchDeleteTransactions(ctx context.Context, userUID string, ids []int64, allOrNothing bool) (*models.BatchResult, error) {
+	result, err := s.repo.BatchDeleteTransactions(ctx, userUID, ids, allOrNothing)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyBatch(ctx, userUID, models.AnalyticsActionTransactionsDeleted, result)
+
+	return result, nil
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) BatchUpdateTransactions(ctx context.Context, userUID string, patches []models.TransactionPatch, allOrNothing bool) (*models.BatchResult, error) {
+	result, err := s.repo.BatchUpdateTransactions(ctx, userUID, patches, allOrNothing)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyBatch(ctx, userUID, models.AnalyticsActionTransactionsUpdated, result)
+
+	return result, nil
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) CreateCategory(ctx context.Context, input models.CreateCategoryInput) (*models.Category, error) {
+	return s.repo.CreateCategory(ctx, input)
+}
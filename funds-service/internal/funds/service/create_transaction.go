@@ -7,9 +7,12 @@ import (
 )
 
 func (s *FundsService) CreateTransaction(ctx context.Context, input models.CreateTransactionInput) (*models.Transaction, error) {
-	err := s.prod.Produce(ctx, []byte(input.UserUID), []byte("update"))
+	transaction, err := s.repo.CreateTransaction(ctx, input)
 	if err != nil {
 		return nil, err
 	}
-	return s.repo.CreateTransaction(ctx, input)
+
+	s.notifyTransactions(ctx, input.UserUID, models.AnalyticsActionTransactionsCreated, []*models.Transaction{transaction})
+
+	return transaction, nil
 }
@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
 )
 
-func (s *FundsService) DeleteTransaction(ctx context.Context, id int64, userUID string) error {
-	err := s.prod.Produce(ctx, []byte(userUID), []byte("update"))
+func (s *FundsService) DeleteTransaction(ctx context.Context, id int64, userUID string, expectedVersion int64) error {
+	transaction, err := s.repo.DeleteTransaction(ctx, id, userUID, expectedVersion)
 	if err != nil {
 		return err
 	}
-	return s.repo.DeleteTransaction(ctx, id, userUID)
+
+	s.notifyTransactions(ctx, userUID, models.AnalyticsActionTransactionsDeleted, []*models.Transaction{transaction})
+
+	return nil
 }
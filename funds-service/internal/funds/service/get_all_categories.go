@@ -6,6 +6,6 @@ import (
 	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
 )
 
-func (s *FundsService) GetAllCategories(ctx context.Context) ([]*models.Category, error) {
-	return s.repo.GetAllCategories(ctx)
+func (s *FundsService) GetAllCategories(ctx context.Context, locales []string, includeArchived bool) ([]*models.Category, error) {
+	return s.repo.GetAllCategories(ctx, locales, includeArchived)
 }
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// GetBalanceHistory returns the balance time series. The user's snapshots are refreshed first,
+// so the series reflects changes the background job has not processed yet.
+func (s *FundsService) GetBalanceHistory(ctx context.Context, input models.BalanceHistoryInput) (*models.BalanceHistory, error) {
+	if err := s.repo.RefreshBalanceSnapshots(ctx, input.UserUID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetBalanceHistory(ctx, input)
+}
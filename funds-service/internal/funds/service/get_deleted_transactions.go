@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) GetDeletedTransactions(ctx context.Context, userUID string, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error) {
+	return s.repo.GetDeletedTransactions(ctx, userUID, limit, offset, exactTotal)
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) GetSpendingSummary(ctx context.Context, input models.SpendingSummaryInput) (*models.SpendingSummary, error) {
+	summary, err := s.repo.GetSpendingSummary(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range summary.Buckets {
+		b.Delta = b.Total - b.PreviousTotal
+		if b.PreviousTotal > 0 {
+			b.DeltaPercent = b.Delta / b.PreviousTotal * 100
+		}
+	}
+
+	return summary, nil
+}
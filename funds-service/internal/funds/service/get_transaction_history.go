@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) GetTransactionHistory(ctx context.Context, id int64, userUID string) ([]*models.TransactionRevision, error) {
+	return s.repo.GetTransactionHistory(ctx, id, userUID)
+}
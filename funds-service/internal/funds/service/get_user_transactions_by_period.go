@@ -2,10 +2,11 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
 )
 
-func (s *FundsService) GetUserTransactionsByPeriod(ctx context.Context, userUID string, days int32, limit, offset int32) ([]*models.Transaction, int64, error) {
-	return s.repo.GetUserTransactionsByPeriod(ctx, userUID, days, limit, offset)
+func (s *FundsService) GetUserTransactionsByPeriod(ctx context.Context, userUID string, dateFrom, dateTo time.Time, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error) {
+	return s.repo.GetUserTransactionsByPeriod(ctx, userUID, dateFrom, dateTo, limit, offset, exactTotal)
 }
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// notifyBatch emits a single analytics event for a committed batch. The batch is already
+// committed at this point, so a failed event is logged instead of failing the request.
+func (s *FundsService) notifyBatch(ctx context.Context, userUID, action string, result *models.BatchResult) {
+	if !result.Committed {
+		return
+	}
+
+	s.notifyTransactions(ctx, userUID, action, result.Transactions)
+}
+
+// notifyTransactions emits an analytics event with the changed transactions after they are committed.
+// A failed event is logged, the transactions are already saved.
+func (s *FundsService) notifyTransactions(ctx context.Context, userUID, action string, transactions []*models.Transaction) {
+	event := models.AnalyticsEvent{
+		EventID: uuid.New().String(),
+		UserUID: userUID,
+		Action:  action,
+	}
+	if action != models.AnalyticsActionUpdate {
+		for _, t := range transactions {
+			event.Transactions = append(event.Transactions, models.NewTransactionEvent(t))
+		}
+	}
+
+	message, err := json.Marshal(event)
+	if err != nil {
+		log.FromContext(ctx).Errorf("failed to marshal analytics event: %v", err)
+		return
+	}
+
+	if err := s.prod.Produce(ctx, []byte(userUID), message); err != nil {
+		log.FromContext(ctx).Errorf("failed to produce %s event: %v", action, err)
+	}
+}
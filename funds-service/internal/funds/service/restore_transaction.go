@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) RestoreTransaction(ctx context.Context, id int64, userUID string) (*models.Transaction, error) {
+	transaction, err := s.repo.RestoreTransaction(ctx, id, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyTransactions(ctx, userUID, models.AnalyticsActionTransactionsRestored, []*models.Transaction{transaction})
+
+	return transaction, nil
+}
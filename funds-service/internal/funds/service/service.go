@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	producer "github.com/cg-2025-crutch/backend/funds-service/internal/adapters/producers"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/repository"
@@ -12,18 +13,34 @@ type FundsServicer interface {
 	// Transaction methods
 	CreateTransaction(ctx context.Context, input models.CreateTransactionInput) (*models.Transaction, error)
 	GetTransactionById(ctx context.Context, id int64) (*models.Transaction, error)
-	GetUserTransactions(ctx context.Context, userUID string, limit, offset int32) ([]*models.Transaction, int64, error)
-	GetUserTransactionsByPeriod(ctx context.Context, userUID string, days int32, limit, offset int32) ([]*models.Transaction, int64, error)
+	GetUserTransactions(ctx context.Context, userUID string, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error)
+	GetUserTransactionsByPeriod(ctx context.Context, userUID string, dateFrom, dateTo time.Time, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error)
 	UpdateTransaction(ctx context.Context, input models.UpdateTransactionInput) (*models.Transaction, error)
-	DeleteTransaction(ctx context.Context, id int64, userUID string) error
+	DeleteTransaction(ctx context.Context, id int64, userUID string, expectedVersion int64) error
+	RestoreTransaction(ctx context.Context, id int64, userUID string) (*models.Transaction, error)
+	GetDeletedTransactions(ctx context.Context, userUID string, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error)
+	GetTransactionHistory(ctx context.Context, id int64, userUID string) ([]*models.TransactionRevision, error)
+
+	// Batch methods
+	BatchCreateTransactions(ctx context.Context, userUID string, inputs []models.CreateTransactionInput, allOrNothing bool) (*models.BatchResult, error)
+	BatchUpdateTransactions(ctx context.Context, userUID string, patches []models.TransactionPatch, allOrNothing bool) (*models.BatchResult, error)
+	BatchDeleteTransactions(ctx context.Context, userUID string, ids []int64, allOrNothing bool) (*models.BatchResult, error)
 
 	// Category methods
-	GetAllCategories(ctx context.Context) ([]*models.Category, error)
-	GetCategoriesByType(ctx context.Context, categoryType string) ([]*models.Category, error)
-	GetCategoryById(ctx context.Context, id int32) (*models.Category, error)
+	GetAllCategories(ctx context.Context, locales []string, includeArchived bool) ([]*models.Category, error)
+	GetCategoriesByType(ctx context.Context, categoryType string, locales []string) ([]*models.Category, error)
+	GetCategoryById(ctx context.Context, id int32, locales []string) (*models.Category, error)
+	CreateCategory(ctx context.Context, input models.CreateCategoryInput) (*models.Category, error)
+	UpdateCategory(ctx context.Context, input models.UpdateCategoryInput) (*models.Category, error)
+	ArchiveCategory(ctx context.Context, id int32) (*models.Category, error)
+	RestoreCategory(ctx context.Context, id int32) (*models.Category, error)
 
 	// Balance methods
 	GetUserBalance(ctx context.Context, userUID string) (*models.UserBalance, error)
+	GetBalanceHistory(ctx context.Context, input models.BalanceHistoryInput) (*models.BalanceHistory, error)
+
+	// Report methods
+	GetSpendingSummary(ctx context.Context, input models.SpendingSummaryInput) (*models.SpendingSummary, error)
 }
 
 type FundsService struct {
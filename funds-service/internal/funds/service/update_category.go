@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *FundsService) UpdateCategory(ctx context.Context, input models.UpdateCategoryInput) (*models.Category, error) {
+	return s.repo.UpdateCategory(ctx, input)
+}
@@ -7,9 +7,12 @@ import (
 )
 
 func (s *FundsService) UpdateTransaction(ctx context.Context, input models.UpdateTransactionInput) (*models.Transaction, error) {
-	err := s.prod.Produce(ctx, []byte(input.UserUID), []byte("update"))
+	transaction, err := s.repo.UpdateTransaction(ctx, input)
 	if err != nil {
 		return nil, err
 	}
-	return s.repo.UpdateTransaction(ctx, input)
+
+	s.notifyTransactions(ctx, input.UserUID, models.AnalyticsActionTransactionsUpdated, []*models.Transaction{transaction})
+
+	return transaction, nil
 }
@@ -25,10 +25,22 @@ const (
 	FundsService_GetUserTransactionsByPeriod_FullMethodName = "/funds_service.FundsService/GetUserTransactionsByPeriod"
 	FundsService_UpdateTransaction_FullMethodName           = "/funds_service.FundsService/UpdateTransaction"
 	FundsService_DeleteTransaction_FullMethodName           = "/funds_service.FundsService/DeleteTransaction"
+	FundsService_RestoreTransaction_FullMethodName          = "/funds_service.FundsService/RestoreTransaction"
+	FundsService_GetDeletedTransactions_FullMethodName      = "/funds_service.FundsService/GetDeletedTransactions"
+	FundsService_GetTransactionHistory_FullMethodName       = "/funds_service.FundsService/GetTransactionHistory"
+	FundsService_BatchCreateTransactions_FullMethodName     = "/funds_service.FundsService/BatchCreateTransactions"
+	FundsService_BatchUpdateTransactions_FullMethodName     = "/funds_service.FundsService/BatchUpdateTransactions"
+	FundsService_BatchDeleteTransactions_FullMethodName     = "/funds_service.FundsService/BatchDeleteTransactions"
 	FundsService_GetAllCategories_FullMethodName            = "/funds_service.FundsService/GetAllCategories"
 	FundsService_GetCategoriesByType_FullMethodName         = "/funds_service.FundsService/GetCategoriesByType"
 	FundsService_GetCategoryById_FullMethodName             = "/funds_service.FundsService/GetCategoryById"
+	FundsService_CreateCategory_FullMethodName              = "/funds_service.FundsService/CreateCategory"
+	FundsService_UpdateCategory_FullMethodName              = "/funds_service.FundsService/UpdateCategory"
+	FundsService_ArchiveCategory_FullMethodName             = "/funds_service.FundsService/ArchiveCategory"
+	FundsService_RestoreCategory_FullMethodName             = "/funds_service.FundsService/RestoreCategory"
 	FundsService_GetUserBalance_FullMethodName              = "/funds_service.FundsService/GetUserBalance"
+	FundsService_GetBalanceHistory_FullMethodName           = "/funds_service.FundsService/GetBalanceHistory"
+	FundsService_GetSpendingSummary_FullMethodName          = "/funds_service.FundsService/GetSpendingSummary"
 )
 
 // FundsServiceClient is the client API for FundsService service.
@@ -41,10 +53,23 @@ type FundsServiceClient interface {
 	GetUserTransactionsByPeriod(ctx context.Context, in *GetUserTransactionsByPeriodRequest, opts ...grpc.CallOption) (*GetUserTransactionsByPeriodResponse, error)
 	UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*UpdateTransactionResponse, error)
 	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error)
+	RestoreTransaction(ctx context.Context, in *RestoreTransactionRequest, opts ...grpc.CallOption) (*RestoreTransactionResponse, error)
+	GetDeletedTransactions(ctx context.Context, in *GetDeletedTransactionsRequest, opts ...grpc.CallOption) (*GetDeletedTransactionsResponse, error)
+	GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error)
+	BatchCreateTransactions(ctx context.Context, in *BatchCreateTransactionsRequest, opts ...grpc.CallOption) (*BatchCreateTransactionsResponse, error)
+	BatchUpdateTransactions(ctx context.Context, in *BatchUpdateTransactionsRequest, opts ...grpc.CallOption) (*BatchUpdateTransactionsResponse, error)
+	BatchDeleteTransactions(ctx context.Context, in *BatchDeleteTransactionsRequest, opts ...grpc.CallOption) (*BatchDeleteTransactionsResponse, error)
 	GetAllCategories(ctx context.Context, in *GetAllCategoriesRequest, opts ...grpc.CallOption) (*GetAllCategoriesResponse, error)
 	GetCategoriesByType(ctx context.Context, in *GetCategoriesByTypeRequest, opts ...grpc.CallOption) (*GetCategoriesByTypeResponse, error)
 	GetCategoryById(ctx context.Context, in *GetCategoryByIdRequest, opts ...grpc.CallOption) (*GetCategoryByIdResponse, error)
+	// Category management, the gateway restricts it to administrators
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
+	ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*ArchiveCategoryResponse, error)
+	RestoreCategory(ctx context.Context, in *RestoreCategoryRequest, opts ...grpc.CallOption) (*RestoreCategoryResponse, error)
 	GetUserBalance(ctx context.Context, in *GetUserBalanceRequest, opts ...grpc.CallOption) (*GetUserBalanceResponse, error)
+	GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error)
+	GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error)
 }
 
 type fundsServiceClient struct {
@@ -115,6 +140,66 @@ func (c *fundsServiceClient) DeleteTransaction(ctx context.Context, in *DeleteTr
 	return out, nil
 }
 
+func (c *fundsServiceClient) RestoreTransaction(ctx context.Context, in *RestoreTransactionRequest, opts ...grpc.CallOption) (*RestoreTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTransactionResponse)
+	err := c.cc.Invoke(ctx, FundsService_RestoreTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundsServiceClient) GetDeletedTransactions(ctx context.Context, in *GetDeletedTransactionsRequest, opts ...grpc.CallOption) (*GetDeletedTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeletedTransactionsResponse)
+	err := c.cc.Invoke(ctx, FundsService_GetDeletedTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundsServiceClient) GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionHistoryResponse)
+	err := c.cc.Invoke(ctx, FundsService_GetTransactionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundsServiceClient) BatchCreateTransactions(ctx context.Context, in *BatchCreateTransactionsRequest, opts ...grpc.CallOption) (*BatchCreateTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTransactionsResponse)
+	err := c.cc.Invoke(ctx, FundsService_BatchCreateTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundsServiceClient) BatchUpdateTransactions(ctx context.Context, in *BatchUpdateTransactionsRequest, opts ...grpc.CallOption) (*BatchUpdateTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateTransactionsResponse)
+	err := c.cc.Invoke(ctx, FundsService_BatchUpdateTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundsServiceClient) BatchDeleteTransactions(ctx context.Context, in *BatchDeleteTransactionsRequest, opts ...grpc.CallOption) (*BatchDeleteTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteTransactionsResponse)
+	err := c.cc.Invoke(ctx, FundsService_BatchDeleteTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fundsServiceClient) GetAllCategories(ctx context.Context, in *GetAllCategoriesRequest, opts ...grpc.CallOption) (*GetAllCategoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAllCategoriesResponse)
@@ -145,6 +230,46 @@ func (c *fundsServiceClient) GetCategoryById(ctx context.Context, in *GetCategor
 	return out, nil
 }
 
+func (c *fundsServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryResponse)
+	err := c.cc.Invoke(ctx, FundsService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundsServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryResponse)
+	err := c.cc.Invoke(ctx, FundsService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundsServiceClient) ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*ArchiveCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveCategoryResponse)
+	err := c.cc.Invoke(ctx, FundsService_ArchiveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundsServiceClient) RestoreCategory(ctx context.Context, in *RestoreCategoryRequest, opts ...grpc.CallOption) (*RestoreCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCategoryResponse)
+	err := c.cc.Invoke(ctx, FundsService_RestoreCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fundsServiceClient) GetUserBalance(ctx context.Context, in *GetUserBalanceRequest, opts ...grpc.CallOption) (*GetUserBalanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserBalanceResponse)
@@ -155,6 +280,26 @@ func (c *fundsServiceClient) GetUserBalance(ctx context.Context, in *GetUserBala
 	return out, nil
 }
 
+func (c *fundsServiceClient) GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, FundsService_GetBalanceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundsServiceClient) GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpendingSummaryResponse)
+	err := c.cc.Invoke(ctx, FundsService_GetSpendingSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FundsServiceServer is the server API for FundsService service.
 // All implementations must embed UnimplementedFundsServiceServer
 // for forward compatibility.
@@ -165,10 +310,23 @@ type FundsServiceServer interface {
 	GetUserTransactionsByPeriod(context.Context, *GetUserTransactionsByPeriodRequest) (*GetUserTransactionsByPeriodResponse, error)
 	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error)
 	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
+	RestoreTransaction(context.Context, *RestoreTransactionRequest) (*RestoreTransactionResponse, error)
+	GetDeletedTransactions(context.Context, *GetDeletedTransactionsRequest) (*GetDeletedTransactionsResponse, error)
+	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
+	BatchCreateTransactions(context.Context, *BatchCreateTransactionsRequest) (*BatchCreateTransactionsResponse, error)
+	BatchUpdateTransactions(context.Context, *BatchUpdateTransactionsRequest) (*BatchUpdateTransactionsResponse, error)
+	BatchDeleteTransactions(context.Context, *BatchDeleteTransactionsRequest) (*BatchDeleteTransactionsResponse, error)
 	GetAllCategories(context.Context, *GetAllCategoriesRequest) (*GetAllCategoriesResponse, error)
 	GetCategoriesByType(context.Context, *GetCategoriesByTypeRequest) (*GetCategoriesByTypeResponse, error)
 	GetCategoryById(context.Context, *GetCategoryByIdRequest) (*GetCategoryByIdResponse, error)
+	// Category management, the gateway restricts it to administrators
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*ArchiveCategoryResponse, error)
+	RestoreCategory(context.Context, *RestoreCategoryRequest) (*RestoreCategoryResponse, error)
 	GetUserBalance(context.Context, *GetUserBalanceRequest) (*GetUserBalanceResponse, error)
+	GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error)
+	GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error)
 	mustEmbedUnimplementedFundsServiceServer()
 }
 
@@ -197,6 +355,24 @@ func (UnimplementedFundsServiceServer) UpdateTransaction(context.Context, *Updat
 func (UnimplementedFundsServiceServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteTransaction not implemented")
 }
+func (UnimplementedFundsServiceServer) RestoreTransaction(context.Context, *RestoreTransactionRequest) (*RestoreTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreTransaction not implemented")
+}
+func (UnimplementedFundsServiceServer) GetDeletedTransactions(context.Context, *GetDeletedTransactionsRequest) (*GetDeletedTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDeletedTransactions not implemented")
+}
+func (UnimplementedFundsServiceServer) GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionHistory not implemented")
+}
+func (UnimplementedFundsServiceServer) BatchCreateTransactions(context.Context, *BatchCreateTransactionsRequest) (*BatchCreateTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchCreateTransactions not implemented")
+}
+func (UnimplementedFundsServiceServer) BatchUpdateTransactions(context.Context, *BatchUpdateTransactionsRequest) (*BatchUpdateTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateTransactions not implemented")
+}
+func (UnimplementedFundsServiceServer) BatchDeleteTransactions(context.Context, *BatchDeleteTransactionsRequest) (*BatchDeleteTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeleteTransactions not implemented")
+}
 func (UnimplementedFundsServiceServer) GetAllCategories(context.Context, *GetAllCategoriesRequest) (*GetAllCategoriesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAllCategories not implemented")
 }
@@ -206,9 +382,27 @@ func (UnimplementedFundsServiceServer) GetCategoriesByType(context.Context, *Get
 func (UnimplementedFundsServiceServer) GetCategoryById(context.Context, *GetCategoryByIdRequest) (*GetCategoryByIdResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCategoryById not implemented")
 }
+func (UnimplementedFundsServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedFundsServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedFundsServiceServer) ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*ArchiveCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveCategory not implemented")
+}
+func (UnimplementedFundsServiceServer) RestoreCategory(context.Context, *RestoreCategoryRequest) (*RestoreCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreCategory not implemented")
+}
 func (UnimplementedFundsServiceServer) GetUserBalance(context.Context, *GetUserBalanceRequest) (*GetUserBalanceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserBalance not implemented")
 }
+func (UnimplementedFundsServiceServer) GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBalanceHistory not implemented")
+}
+func (UnimplementedFundsServiceServer) GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSpendingSummary not implemented")
+}
 func (UnimplementedFundsServiceServer) mustEmbedUnimplementedFundsServiceServer() {}
 func (UnimplementedFundsServiceServer) testEmbeddedByValue()                      {}
 
@@ -338,6 +532,114 @@ func _FundsService_DeleteTransaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FundsService_RestoreTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).RestoreTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_RestoreTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).RestoreTransaction(ctx, req.(*RestoreTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundsService_GetDeletedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).GetDeletedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_GetDeletedTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).GetDeletedTransactions(ctx, req.(*GetDeletedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundsService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).GetTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_GetTransactionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).GetTransactionHistory(ctx, req.(*GetTransactionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundsService_BatchCreateTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).BatchCreateTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_BatchCreateTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).BatchCreateTransactions(ctx, req.(*BatchCreateTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundsService_BatchUpdateTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).BatchUpdateTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_BatchUpdateTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).BatchUpdateTransactions(ctx, req.(*BatchUpdateTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundsService_BatchDeleteTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).BatchDeleteTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_BatchDeleteTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).BatchDeleteTransactions(ctx, req.(*BatchDeleteTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FundsService_GetAllCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAllCategoriesRequest)
 	if err := dec(in); err != nil {
@@ -392,6 +694,78 @@ func _FundsService_GetCategoryById_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FundsService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundsService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundsService_ArchiveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).ArchiveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_ArchiveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).ArchiveCategory(ctx, req.(*ArchiveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundsService_RestoreCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).RestoreCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_RestoreCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).RestoreCategory(ctx, req.(*RestoreCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FundsService_GetUserBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserBalanceRequest)
 	if err := dec(in); err != nil {
@@ -410,6 +784,42 @@ func _FundsService_GetUserBalance_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FundsService_GetBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).GetBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_GetBalanceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).GetBalanceHistory(ctx, req.(*GetBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundsService_GetSpendingSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundsServiceServer).GetSpendingSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FundsService_GetSpendingSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundsServiceServer).GetSpendingSummary(ctx, req.(*GetSpendingSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FundsService_ServiceDesc is the grpc.ServiceDesc for FundsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -442,21 +852,1234 @@ var FundsService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _FundsService_DeleteTransaction_Handler,
 		},
 		{
-			MethodName: "GetAllCategories",
-			Handler:    _FundsService_GetAllCategories_Handler,
+			MethodName: "RestoreTransaction",
+			Handler:    _FundsService_RestoreTransaction_Handler,
 		},
 		{
-			MethodName: "GetCategoriesByType",
-			Handler:    _FundsService_GetCategoriesByType_Handler,
+			MethodName: "GetDeletedTransactions",
+			Handler:    _FundsService_GetDeletedTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransactionHistory",
+			Handler:    _FundsService_GetTransactionHistory_Handler,
+		},
+		{
+			MethodName: "BatchCreateTransactions",
+			Handler:    _FundsService_BatchCreateTransactions_Handler,
+		},
+		{
+			MethodName: "BatchUpdateTransactions",
+			Handler:    _FundsService_BatchUpdateTransactions_Handler,
+		},
+		{
+			MethodName: "BatchDeleteTransactions",
+			Handler:    _FundsService_BatchDeleteTransactions_Handler,
+		},
+		{
+			MethodName: "GetAllCategories",
+			Handler:    _FundsService_GetAllCategories_Handler,
+		},
+		{
+			MethodName: "GetCategoriesByType",
+			Handler:    _FundsService_GetCategoriesByType_Handler,
 		},
 		{
 			MethodName: "GetCategoryById",
 			Handler:    _FundsService_GetCategoryById_Handler,
 		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _FundsService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _FundsService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "ArchiveCategory",
+			Handler:    _FundsService_ArchiveCategory_Handler,
+		},
+		{
+			MethodName: "RestoreCategory",
+			Handler:    _FundsService_RestoreCategory_Handler,
+		},
 		{
 			MethodName: "GetUserBalance",
 			Handler:    _FundsService_GetUserBalance_Handler,
 		},
+		{
+			MethodName: "GetBalanceHistory",
+			Handler:    _FundsService_GetBalanceHistory_Handler,
+		},
+		{
+			MethodName: "GetSpendingSummary",
+			Handler:    _FundsService_GetSpendingSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "funds_service.proto",
+}
+
+const (
+	AttachmentService_UploadAttachment_FullMethodName          = "/funds_service.AttachmentService/UploadAttachment"
+	AttachmentService_DownloadAttachment_FullMethodName        = "/funds_service.AttachmentService/DownloadAttachment"
+	AttachmentService_GetTransactionAttachments_FullMethodName = "/funds_service.AttachmentService/GetTransactionAttachments"
+	AttachmentService_DeleteAttachment_FullMethodName          = "/funds_service.AttachmentService/DeleteAttachment"
+	AttachmentService_GetAttachmentUsage_FullMethodName        = "/funds_service.AttachmentService/GetAttachmentUsage"
+)
+
+// AttachmentServiceClient is the client API for AttachmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttachmentServiceClient interface {
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	GetTransactionAttachments(ctx context.Context, in *GetTransactionAttachmentsRequest, opts ...grpc.CallOption) (*GetTransactionAttachmentsResponse, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	GetAttachmentUsage(ctx context.Context, in *GetAttachmentUsageRequest, opts ...grpc.CallOption) (*GetAttachmentUsageResponse, error)
+}
+
+type attachmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttachmentServiceClient(cc grpc.ClientConnInterface) AttachmentServiceClient {
+	return &attachmentServiceClient{cc}
+}
+
+func (c *attachmentServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[0], AttachmentService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *attachmentServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[1], AttachmentService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *attachmentServiceClient) GetTransactionAttachments(ctx context.Context, in *GetTransactionAttachmentsRequest, opts ...grpc.CallOption) (*GetTransactionAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionAttachmentsResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_GetTransactionAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) GetAttachmentUsage(ctx context.Context, in *GetAttachmentUsageRequest, opts ...grpc.CallOption) (*GetAttachmentUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttachmentUsageResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_GetAttachmentUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttachmentServiceServer is the server API for AttachmentService service.
+// All implementations must embed UnimplementedAttachmentServiceServer
+// for forward compatibility.
+type AttachmentServiceServer interface {
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	GetTransactionAttachments(context.Context, *GetTransactionAttachmentsRequest) (*GetTransactionAttachmentsResponse, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	GetAttachmentUsage(context.Context, *GetAttachmentUsageRequest) (*GetAttachmentUsageResponse, error)
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+// UnimplementedAttachmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttachmentServiceServer struct{}
+
+func (UnimplementedAttachmentServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) GetTransactionAttachments(context.Context, *GetTransactionAttachmentsRequest) (*GetTransactionAttachmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionAttachments not implemented")
+}
+func (UnimplementedAttachmentServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) GetAttachmentUsage(context.Context, *GetAttachmentUsageRequest) (*GetAttachmentUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAttachmentUsage not implemented")
+}
+func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
+func (UnimplementedAttachmentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttachmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentServiceServer will
+// result in compilation errors.
+type UnsafeAttachmentServiceServer interface {
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+func RegisterAttachmentServiceServer(s grpc.ServiceRegistrar, srv AttachmentServiceServer) {
+	// If the following call panics, it indicates UnimplementedAttachmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttachmentService_ServiceDesc, srv)
+}
+
+func _AttachmentService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttachmentServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _AttachmentService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttachmentServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _AttachmentService_GetTransactionAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).GetTransactionAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_GetTransactionAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).GetTransactionAttachments(ctx, req.(*GetTransactionAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_GetAttachmentUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).GetAttachmentUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_GetAttachmentUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).GetAttachmentUsage(ctx, req.(*GetAttachmentUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttachmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "funds_service.AttachmentService",
+	HandlerType: (*AttachmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTransactionAttachments",
+			Handler:    _AttachmentService_GetTransactionAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _AttachmentService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "GetAttachmentUsage",
+			Handler:    _AttachmentService_GetAttachmentUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _AttachmentService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _AttachmentService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "funds_service.proto",
+}
+
+const (
+	DebtService_CreateDebt_FullMethodName        = "/funds_service.DebtService/CreateDebt"
+	DebtService_GetDebtById_FullMethodName       = "/funds_service.DebtService/GetDebtById"
+	DebtService_GetUserDebts_FullMethodName      = "/funds_service.DebtService/GetUserDebts"
+	DebtService_DeleteDebt_FullMethodName        = "/funds_service.DebtService/DeleteDebt"
+	DebtService_LinkDebtPayment_FullMethodName   = "/funds_service.DebtService/LinkDebtPayment"
+	DebtService_UnlinkDebtPayment_FullMethodName = "/funds_service.DebtService/UnlinkDebtPayment"
+	DebtService_GetDebtSummary_FullMethodName    = "/funds_service.DebtService/GetDebtSummary"
+)
+
+// DebtServiceClient is the client API for DebtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DebtServiceClient interface {
+	CreateDebt(ctx context.Context, in *CreateDebtRequest, opts ...grpc.CallOption) (*CreateDebtResponse, error)
+	GetDebtById(ctx context.Context, in *GetDebtByIdRequest, opts ...grpc.CallOption) (*GetDebtByIdResponse, error)
+	GetUserDebts(ctx context.Context, in *GetUserDebtsRequest, opts ...grpc.CallOption) (*GetUserDebtsResponse, error)
+	DeleteDebt(ctx context.Context, in *DeleteDebtRequest, opts ...grpc.CallOption) (*DeleteDebtResponse, error)
+	LinkDebtPayment(ctx context.Context, in *LinkDebtPaymentRequest, opts ...grpc.CallOption) (*LinkDebtPaymentResponse, error)
+	UnlinkDebtPayment(ctx context.Context, in *UnlinkDebtPaymentRequest, opts ...grpc.CallOption) (*UnlinkDebtPaymentResponse, error)
+	GetDebtSummary(ctx context.Context, in *GetDebtSummaryRequest, opts ...grpc.CallOption) (*GetDebtSummaryResponse, error)
+}
+
+type debtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDebtServiceClient(cc grpc.ClientConnInterface) DebtServiceClient {
+	return &debtServiceClient{cc}
+}
+
+func (c *debtServiceClient) CreateDebt(ctx context.Context, in *CreateDebtRequest, opts ...grpc.CallOption) (*CreateDebtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDebtResponse)
+	err := c.cc.Invoke(ctx, DebtService_CreateDebt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debtServiceClient) GetDebtById(ctx context.Context, in *GetDebtByIdRequest, opts ...grpc.CallOption) (*GetDebtByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDebtByIdResponse)
+	err := c.cc.Invoke(ctx, DebtService_GetDebtById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debtServiceClient) GetUserDebts(ctx context.Context, in *GetUserDebtsRequest, opts ...grpc.CallOption) (*GetUserDebtsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserDebtsResponse)
+	err := c.cc.Invoke(ctx, DebtService_GetUserDebts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debtServiceClient) DeleteDebt(ctx context.Context, in *DeleteDebtRequest, opts ...grpc.CallOption) (*DeleteDebtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDebtResponse)
+	err := c.cc.Invoke(ctx, DebtService_DeleteDebt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debtServiceClient) LinkDebtPayment(ctx context.Context, in *LinkDebtPaymentRequest, opts ...grpc.CallOption) (*LinkDebtPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkDebtPaymentResponse)
+	err := c.cc.Invoke(ctx, DebtService_LinkDebtPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debtServiceClient) UnlinkDebtPayment(ctx context.Context, in *UnlinkDebtPaymentRequest, opts ...grpc.CallOption) (*UnlinkDebtPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkDebtPaymentResponse)
+	err := c.cc.Invoke(ctx, DebtService_UnlinkDebtPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debtServiceClient) GetDebtSummary(ctx context.Context, in *GetDebtSummaryRequest, opts ...grpc.CallOption) (*GetDebtSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDebtSummaryResponse)
+	err := c.cc.Invoke(ctx, DebtService_GetDebtSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebtServiceServer is the server API for DebtService service.
+// All implementations must embed UnimplementedDebtServiceServer
+// for forward compatibility.
+type DebtServiceServer interface {
+	CreateDebt(context.Context, *CreateDebtRequest) (*CreateDebtResponse, error)
+	GetDebtById(context.Context, *GetDebtByIdRequest) (*GetDebtByIdResponse, error)
+	GetUserDebts(context.Context, *GetUserDebtsRequest) (*GetUserDebtsResponse, error)
+	DeleteDebt(context.Context, *DeleteDebtRequest) (*DeleteDebtResponse, error)
+	LinkDebtPayment(context.Context, *LinkDebtPaymentRequest) (*LinkDebtPaymentResponse, error)
+	UnlinkDebtPayment(context.Context, *UnlinkDebtPaymentRequest) (*UnlinkDebtPaymentResponse, error)
+	GetDebtSummary(context.Context, *GetDebtSummaryRequest) (*GetDebtSummaryResponse, error)
+	mustEmbedUnimplementedDebtServiceServer()
+}
+
+// UnimplementedDebtServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDebtServiceServer struct{}
+
+func (UnimplementedDebtServiceServer) CreateDebt(context.Context, *CreateDebtRequest) (*CreateDebtResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDebt not implemented")
+}
+func (UnimplementedDebtServiceServer) GetDebtById(context.Context, *GetDebtByIdRequest) (*GetDebtByIdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDebtById not implemented")
+}
+func (UnimplementedDebtServiceServer) GetUserDebts(context.Context, *GetUserDebtsRequest) (*GetUserDebtsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserDebts not implemented")
+}
+func (UnimplementedDebtServiceServer) DeleteDebt(context.Context, *DeleteDebtRequest) (*DeleteDebtResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDebt not implemented")
+}
+func (UnimplementedDebtServiceServer) LinkDebtPayment(context.Context, *LinkDebtPaymentRequest) (*LinkDebtPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkDebtPayment not implemented")
+}
+func (UnimplementedDebtServiceServer) UnlinkDebtPayment(context.Context, *UnlinkDebtPaymentRequest) (*UnlinkDebtPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkDebtPayment not implemented")
+}
+func (UnimplementedDebtServiceServer) GetDebtSummary(context.Context, *GetDebtSummaryRequest) (*GetDebtSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDebtSummary not implemented")
+}
+func (UnimplementedDebtServiceServer) mustEmbedUnimplementedDebtServiceServer() {}
+func (UnimplementedDebtServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeDebtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DebtServiceServer will
+// result in compilation errors.
+type UnsafeDebtServiceServer interface {
+	mustEmbedUnimplementedDebtServiceServer()
+}
+
+func RegisterDebtServiceServer(s grpc.ServiceRegistrar, srv DebtServiceServer) {
+	// If the following call panics, it indicates UnimplementedDebtServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DebtService_ServiceDesc, srv)
+}
+
+func _DebtService_CreateDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDebtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).CreateDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_CreateDebt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).CreateDebt(ctx, req.(*CreateDebtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebtService_GetDebtById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebtByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).GetDebtById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_GetDebtById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).GetDebtById(ctx, req.(*GetDebtByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebtService_GetUserDebts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDebtsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).GetUserDebts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_GetUserDebts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).GetUserDebts(ctx, req.(*GetUserDebtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebtService_DeleteDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDebtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).DeleteDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_DeleteDebt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).DeleteDebt(ctx, req.(*DeleteDebtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebtService_LinkDebtPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkDebtPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).LinkDebtPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_LinkDebtPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).LinkDebtPayment(ctx, req.(*LinkDebtPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebtService_UnlinkDebtPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkDebtPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).UnlinkDebtPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_UnlinkDebtPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).UnlinkDebtPayment(ctx, req.(*UnlinkDebtPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebtService_GetDebtSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebtSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).GetDebtSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_GetDebtSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).GetDebtSummary(ctx, req.(*GetDebtSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DebtService_ServiceDesc is the grpc.ServiceDesc for DebtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DebtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "funds_service.DebtService",
+	HandlerType: (*DebtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDebt",
+			Handler:    _DebtService_CreateDebt_Handler,
+		},
+		{
+			MethodName: "GetDebtById",
+			Handler:    _DebtService_GetDebtById_Handler,
+		},
+		{
+			MethodName: "GetUserDebts",
+			Handler:    _DebtService_GetUserDebts_Handler,
+		},
+		{
+			MethodName: "DeleteDebt",
+			Handler:    _DebtService_DeleteDebt_Handler,
+		},
+		{
+			MethodName: "LinkDebtPayment",
+			Handler:    _DebtService_LinkDebtPayment_Handler,
+		},
+		{
+			MethodName: "UnlinkDebtPayment",
+			Handler:    _DebtService_UnlinkDebtPayment_Handler,
+		},
+		{
+			MethodName: "GetDebtSummary",
+			Handler:    _DebtService_GetDebtSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "funds_service.proto",
+}
+
+const (
+	LedgerService_CreateLedger_FullMethodName           = "/funds_service.LedgerService/CreateLedger"
+	LedgerService_GetLedgerById_FullMethodName          = "/funds_service.LedgerService/GetLedgerById"
+	LedgerService_GetUserLedgers_FullMethodName         = "/funds_service.LedgerService/GetUserLedgers"
+	LedgerService_DeleteLedger_FullMethodName           = "/funds_service.LedgerService/DeleteLedger"
+	LedgerService_AddLedgerMember_FullMethodName        = "/funds_service.LedgerService/AddLedgerMember"
+	LedgerService_UpdateLedgerMemberRole_FullMethodName = "/funds_service.LedgerService/UpdateLedgerMemberRole"
+	LedgerService_RemoveLedgerMember_FullMethodName     = "/funds_service.LedgerService/RemoveLedgerMember"
+	LedgerService_GetLedgerTransactions_FullMethodName  = "/funds_service.LedgerService/GetLedgerTransactions"
+	LedgerService_GetLedgerBalance_FullMethodName       = "/funds_service.LedgerService/GetLedgerBalance"
+	LedgerService_GetLedgerSettlement_FullMethodName    = "/funds_service.LedgerService/GetLedgerSettlement"
+)
+
+// LedgerServiceClient is the client API for LedgerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LedgerServiceClient interface {
+	CreateLedger(ctx context.Context, in *CreateLedgerRequest, opts ...grpc.CallOption) (*CreateLedgerResponse, error)
+	GetLedgerById(ctx context.Context, in *GetLedgerByIdRequest, opts ...grpc.CallOption) (*GetLedgerByIdResponse, error)
+	GetUserLedgers(ctx context.Context, in *GetUserLedgersRequest, opts ...grpc.CallOption) (*GetUserLedgersResponse, error)
+	DeleteLedger(ctx context.Context, in *DeleteLedgerRequest, opts ...grpc.CallOption) (*DeleteLedgerResponse, error)
+	AddLedgerMember(ctx context.Context, in *AddLedgerMemberRequest, opts ...grpc.CallOption) (*AddLedgerMemberResponse, error)
+	UpdateLedgerMemberRole(ctx context.Context, in *UpdateLedgerMemberRoleRequest, opts ...grpc.CallOption) (*UpdateLedgerMemberRoleResponse, error)
+	RemoveLedgerMember(ctx context.Context, in *RemoveLedgerMemberRequest, opts ...grpc.CallOption) (*RemoveLedgerMemberResponse, error)
+	GetLedgerTransactions(ctx context.Context, in *GetLedgerTransactionsRequest, opts ...grpc.CallOption) (*GetLedgerTransactionsResponse, error)
+	GetLedgerBalance(ctx context.Context, in *GetLedgerBalanceRequest, opts ...grpc.CallOption) (*GetLedgerBalanceResponse, error)
+	GetLedgerSettlement(ctx context.Context, in *GetLedgerSettlementRequest, opts ...grpc.CallOption) (*GetLedgerSettlementResponse, error)
+}
+
+type ledgerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLedgerServiceClient(cc grpc.ClientConnInterface) LedgerServiceClient {
+	return &ledgerServiceClient{cc}
+}
+
+func (c *ledgerServiceClient) CreateLedger(ctx context.Context, in *CreateLedgerRequest, opts ...grpc.CallOption) (*CreateLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLedgerResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetLedgerById(ctx context.Context, in *GetLedgerByIdRequest, opts ...grpc.CallOption) (*GetLedgerByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLedgerByIdResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetLedgerById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetUserLedgers(ctx context.Context, in *GetUserLedgersRequest, opts ...grpc.CallOption) (*GetUserLedgersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserLedgersResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetUserLedgers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteLedger(ctx context.Context, in *DeleteLedgerRequest, opts ...grpc.CallOption) (*DeleteLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLedgerResponse)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) AddLedgerMember(ctx context.Context, in *AddLedgerMemberRequest, opts ...grpc.CallOption) (*AddLedgerMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddLedgerMemberResponse)
+	err := c.cc.Invoke(ctx, LedgerService_AddLedgerMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateLedgerMemberRole(ctx context.Context, in *UpdateLedgerMemberRoleRequest, opts ...grpc.CallOption) (*UpdateLedgerMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLedgerMemberRoleResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateLedgerMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) RemoveLedgerMember(ctx context.Context, in *RemoveLedgerMemberRequest, opts ...grpc.CallOption) (*RemoveLedgerMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveLedgerMemberResponse)
+	err := c.cc.Invoke(ctx, LedgerService_RemoveLedgerMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetLedgerTransactions(ctx context.Context, in *GetLedgerTransactionsRequest, opts ...grpc.CallOption) (*GetLedgerTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLedgerTransactionsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetLedgerTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetLedgerBalance(ctx context.Context, in *GetLedgerBalanceRequest, opts ...grpc.CallOption) (*GetLedgerBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLedgerBalanceResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetLedgerBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetLedgerSettlement(ctx context.Context, in *GetLedgerSettlementRequest, opts ...grpc.CallOption) (*GetLedgerSettlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLedgerSettlementResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetLedgerSettlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LedgerServiceServer is the server API for LedgerService service.
+// All implementations must embed UnimplementedLedgerServiceServer
+// for forward compatibility.
+type LedgerServiceServer interface {
+	CreateLedger(context.Context, *CreateLedgerRequest) (*CreateLedgerResponse, error)
+	GetLedgerById(context.Context, *GetLedgerByIdRequest) (*GetLedgerByIdResponse, error)
+	GetUserLedgers(context.Context, *GetUserLedgersRequest) (*GetUserLedgersResponse, error)
+	DeleteLedger(context.Context, *DeleteLedgerRequest) (*DeleteLedgerResponse, error)
+	AddLedgerMember(context.Context, *AddLedgerMemberRequest) (*AddLedgerMemberResponse, error)
+	UpdateLedgerMemberRole(context.Context, *UpdateLedgerMemberRoleRequest) (*UpdateLedgerMemberRoleResponse, error)
+	RemoveLedgerMember(context.Context, *RemoveLedgerMemberRequest) (*RemoveLedgerMemberResponse, error)
+	GetLedgerTransactions(context.Context, *GetLedgerTransactionsRequest) (*GetLedgerTransactionsResponse, error)
+	GetLedgerBalance(context.Context, *GetLedgerBalanceRequest) (*GetLedgerBalanceResponse, error)
+	GetLedgerSettlement(context.Context, *GetLedgerSettlementRequest) (*GetLedgerSettlementResponse, error)
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+// UnimplementedLedgerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLedgerServiceServer struct{}
+
+func (UnimplementedLedgerServiceServer) CreateLedger(context.Context, *CreateLedgerRequest) (*CreateLedgerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateLedger not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetLedgerById(context.Context, *GetLedgerByIdRequest) (*GetLedgerByIdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLedgerById not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetUserLedgers(context.Context, *GetUserLedgersRequest) (*GetUserLedgersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserLedgers not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteLedger(context.Context, *DeleteLedgerRequest) (*DeleteLedgerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteLedger not implemented")
+}
+func (UnimplementedLedgerServiceServer) AddLedgerMember(context.Context, *AddLedgerMemberRequest) (*AddLedgerMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddLedgerMember not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateLedgerMemberRole(context.Context, *UpdateLedgerMemberRoleRequest) (*UpdateLedgerMemberRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateLedgerMemberRole not implemented")
+}
+func (UnimplementedLedgerServiceServer) RemoveLedgerMember(context.Context, *RemoveLedgerMemberRequest) (*RemoveLedgerMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveLedgerMember not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetLedgerTransactions(context.Context, *GetLedgerTransactionsRequest) (*GetLedgerTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLedgerTransactions not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetLedgerBalance(context.Context, *GetLedgerBalanceRequest) (*GetLedgerBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLedgerBalance not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetLedgerSettlement(context.Context, *GetLedgerSettlementRequest) (*GetLedgerSettlementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLedgerSettlement not implemented")
+}
+func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
+func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeLedgerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LedgerServiceServer will
+// result in compilation errors.
+type UnsafeLedgerServiceServer interface {
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
+	// If the following call panics, it indicates UnimplementedLedgerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LedgerService_ServiceDesc, srv)
+}
+
+func _LedgerService_CreateLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateLedger(ctx, req.(*CreateLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetLedgerById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetLedgerById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetLedgerById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetLedgerById(ctx, req.(*GetLedgerByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetUserLedgers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserLedgersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetUserLedgers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetUserLedgers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetUserLedgers(ctx, req.(*GetUserLedgersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteLedger(ctx, req.(*DeleteLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_AddLedgerMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLedgerMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).AddLedgerMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_AddLedgerMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).AddLedgerMember(ctx, req.(*AddLedgerMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateLedgerMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLedgerMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateLedgerMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateLedgerMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateLedgerMemberRole(ctx, req.(*UpdateLedgerMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_RemoveLedgerMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLedgerMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).RemoveLedgerMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_RemoveLedgerMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).RemoveLedgerMember(ctx, req.(*RemoveLedgerMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetLedgerTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetLedgerTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetLedgerTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetLedgerTransactions(ctx, req.(*GetLedgerTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetLedgerBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetLedgerBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetLedgerBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetLedgerBalance(ctx, req.(*GetLedgerBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetLedgerSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetLedgerSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetLedgerSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetLedgerSettlement(ctx, req.(*GetLedgerSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LedgerService_ServiceDesc is the grpc.ServiceDesc for LedgerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LedgerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "funds_service.LedgerService",
+	HandlerType: (*LedgerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLedger",
+			Handler:    _LedgerService_CreateLedger_Handler,
+		},
+		{
+			MethodName: "GetLedgerById",
+			Handler:    _LedgerService_GetLedgerById_Handler,
+		},
+		{
+			MethodName: "GetUserLedgers",
+			Handler:    _LedgerService_GetUserLedgers_Handler,
+		},
+		{
+			MethodName: "DeleteLedger",
+			Handler:    _LedgerService_DeleteLedger_Handler,
+		},
+		{
+			MethodName: "AddLedgerMember",
+			Handler:    _LedgerService_AddLedgerMember_Handler,
+		},
+		{
+			MethodName: "UpdateLedgerMemberRole",
+			Handler:    _LedgerService_UpdateLedgerMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveLedgerMember",
+			Handler:    _LedgerService_RemoveLedgerMember_Handler,
+		},
+		{
+			MethodName: "GetLedgerTransactions",
+			Handler:    _LedgerService_GetLedgerTransactions_Handler,
+		},
+		{
+			MethodName: "GetLedgerBalance",
+			Handler:    _LedgerService_GetLedgerBalance_Handler,
+		},
+		{
+			MethodName: "GetLedgerSettlement",
+			Handler:    _LedgerService_GetLedgerSettlement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "funds_service.proto",
+}
+
+const (
+	BudgetService_SetBudgets_FullMethodName     = "/funds_service.BudgetService/SetBudgets"
+	BudgetService_GetUserBudgets_FullMethodName = "/funds_service.BudgetService/GetUserBudgets"
+)
+
+// BudgetServiceClient is the client API for BudgetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BudgetServiceClient interface {
+	SetBudgets(ctx context.Context, in *SetBudgetsRequest, opts ...grpc.CallOption) (*SetBudgetsResponse, error)
+	GetUserBudgets(ctx context.Context, in *GetUserBudgetsRequest, opts ...grpc.CallOption) (*GetUserBudgetsResponse, error)
+}
+
+type budgetServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBudgetServiceClient(cc grpc.ClientConnInterface) BudgetServiceClient {
+	return &budgetServiceClient{cc}
+}
+
+func (c *budgetServiceClient) SetBudgets(ctx context.Context, in *SetBudgetsRequest, opts ...grpc.CallOption) (*SetBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBudgetsResponse)
+	err := c.cc.Invoke(ctx, BudgetService_SetBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetUserBudgets(ctx context.Context, in *GetUserBudgetsRequest, opts ...grpc.CallOption) (*GetUserBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserBudgetsResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetUserBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BudgetServiceServer is the server API for BudgetService service.
+// All implementations must embed UnimplementedBudgetServiceServer
+// for forward compatibility.
+type BudgetServiceServer interface {
+	SetBudgets(context.Context, *SetBudgetsRequest) (*SetBudgetsResponse, error)
+	GetUserBudgets(context.Context, *GetUserBudgetsRequest) (*GetUserBudgetsResponse, error)
+	mustEmbedUnimplementedBudgetServiceServer()
+}
+
+// UnimplementedBudgetServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBudgetServiceServer struct{}
+
+func (UnimplementedBudgetServiceServer) SetBudgets(context.Context, *SetBudgetsRequest) (*SetBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetUserBudgets(context.Context, *GetUserBudgetsRequest) (*GetUserBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) mustEmbedUnimplementedBudgetServiceServer() {}
+func (UnimplementedBudgetServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeBudgetServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BudgetServiceServer will
+// result in compilation errors.
+type UnsafeBudgetServiceServer interface {
+	mustEmbedUnimplementedBudgetServiceServer()
+}
+
+func RegisterBudgetServiceServer(s grpc.ServiceRegistrar, srv BudgetServiceServer) {
+	// If the following call panics, it indicates UnimplementedBudgetServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BudgetService_ServiceDesc, srv)
+}
+
+func _BudgetService_SetBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).SetBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_SetBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).SetBudgets(ctx, req.(*SetBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetUserBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetUserBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetUserBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetUserBudgets(ctx, req.(*GetUserBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BudgetService_ServiceDesc is the grpc.ServiceDesc for BudgetService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BudgetService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "funds_service.BudgetService",
+	HandlerType: (*BudgetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetBudgets",
+			Handler:    _BudgetService_SetBudgets_Handler,
+		},
+		{
+			MethodName: "GetUserBudgets",
+			Handler:    _BudgetService_GetUserBudgets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "funds_service.proto",
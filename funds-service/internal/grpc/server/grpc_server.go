@@ -6,11 +6,19 @@ import (
 	"net"
 	"time"
 
+	attachmentshandler "github.com/cg-2025-crutch/backend/funds-service/internal/attachments/handler"
+	attachmentsservice "github.com/cg-2025-crutch/backend/funds-service/internal/attachments/service"
+	budgetshandler "github.com/cg-2025-crutch/backend/funds-service/internal/budgets/handler"
+	budgetsservice "github.com/cg-2025-crutch/backend/funds-service/internal/budgets/service"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/config"
+	debtshandler "github.com/cg-2025-crutch/backend/funds-service/internal/debts/handler"
+	debtsservice "github.com/cg-2025-crutch/backend/funds-service/internal/debts/service"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/handler"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/service"
 	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
+	ledgershandler "github.com/cg-2025-crutch/backend/funds-service/internal/ledgers/handler"
+	ledgersservice "github.com/cg-2025-crutch/backend/funds-service/internal/ledgers/service"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 )
@@ -20,9 +28,9 @@ type GrpcServer struct {
 	host string
 }
 
-func NewGrpcServer(conf config.ServerConfig) *GrpcServer {
+func NewGrpcServer(conf config.ServerConfig, opts ...grpc.ServerOption) *GrpcServer {
 	return &GrpcServer{
-		srv:  grpc.NewServer(),
+		srv:  grpc.NewServer(opts...),
 		host: conf.GRPCHost + ":" + conf.GRPCPort,
 	}
 }
@@ -31,6 +39,22 @@ func (s *GrpcServer) RegisterGRPC(srvc service.FundsServicer) {
 	pb.RegisterFundsServiceServer(s.srv, handler.NewGRPCHandler(srvc))
 }
 
+func (s *GrpcServer) RegisterAttachments(srvc attachmentsservice.AttachmentsServicer) {
+	pb.RegisterAttachmentServiceServer(s.srv, attachmentshandler.NewGRPCHandler(srvc))
+}
+
+func (s *GrpcServer) RegisterDebts(srvc debtsservice.DebtsServicer) {
+	pb.RegisterDebtServiceServer(s.srv, debtshandler.NewGRPCHandler(srvc))
+}
+
+func (s *GrpcServer) RegisterLedgers(srvc ledgersservice.LedgersServicer) {
+	pb.RegisterLedgerServiceServer(s.srv, ledgershandler.NewGRPCHandler(srvc))
+}
+
+func (s *GrpcServer) RegisterBudgets(srvc budgetsservice.BudgetsServicer) {
+	pb.RegisterBudgetServiceServer(s.srv, budgetshandler.NewGRPCHandler(srvc))
+}
+
 func (s *GrpcServer) Run(ctx context.Context) error {
 
 	g, ctx := errgroup.WithContext(ctx)
@@ -0,0 +1,31 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/config"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Store keeps binary objects addressed by a key
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, int64, error) // Returns the blob and its size
+	Delete(ctx context.Context, key string) error
+}
+
+// New creates the store selected by BLOB_BACKEND
+func New(ctx context.Context, cfg config.BlobConfig) (Store, error) {
+	switch cfg.Backend {
+	case "local":
+		return NewLocalStore(cfg.LocalDir)
+	case "s3":
+		return NewS3Store(ctx, cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown blob backend: %s", cfg.Backend)
+	}
+}
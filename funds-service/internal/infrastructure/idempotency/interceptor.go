@@ -0,0 +1,163 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// MetadataKey carries the client supplied idempotency key
+	MetadataKey = "idempotency-key"
+	// ReplayedMetadataKey is set in the response header when the response is a replay
+	ReplayedMetadataKey = "idempotency-replayed"
+
+	maxKeyLength = 255
+)
+
+// UnaryServerInterceptor makes the given methods idempotent for requests that carry an idempotency key.
+// Keys are scoped by the user_uid of the request.
+// A replay with the same payload returns the stored response, a replay with a different payload
+// fails with AlreadyExists and a replay of a request that is still running fails with Aborted.
+func UnaryServerInterceptor(store *Store, methods ...string) grpc.UnaryServerInterceptor {
+	guarded := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		guarded[m] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := guarded[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		key := keyFromContext(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d characters", maxKeyLength)
+		}
+
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		hash, err := requestHash(msg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash request: %v", err)
+		}
+
+		userUID := requestUserUID(msg)
+
+		record, acquired, err := store.Reserve(ctx, userUID, info.FullMethod, key, hash)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+
+		if !acquired {
+			return replay(ctx, record, hash)
+		}
+
+		l := log.FromContext(ctx)
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if relErr := store.Release(context.WithoutCancel(ctx), userUID, info.FullMethod, key); relErr != nil {
+				l.Errorf("idempotency: %v", relErr)
+			}
+			return nil, err
+		}
+
+		data, err := marshalResponse(resp)
+		if err != nil {
+			l.Errorf("idempotency: failed to marshal response: %v", err)
+			return resp, nil
+		}
+
+		// The request has already been applied, so a failure here only loses the ability to replay it
+		if err := store.Complete(context.WithoutCancel(ctx), userUID, info.FullMethod, key, data); err != nil {
+			l.Errorf("idempotency: %v", err)
+		}
+
+		return resp, nil
+	}
+}
+
+func replay(ctx context.Context, record *Record, hash []byte) (any, error) {
+	if !bytes.Equal(record.RequestHash, hash) {
+		return nil, status.Error(codes.AlreadyExists, "idempotency key has already been used with a different request")
+	}
+
+	if record.Response == nil {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is still in progress")
+	}
+
+	var stored anypb.Any
+	if err := proto.Unmarshal(record.Response, &stored); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal stored response: %v", err)
+	}
+
+	resp, err := stored.UnmarshalNew()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal stored response: %v", err)
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ReplayedMetadataKey, "true"))
+
+	return resp, nil
+}
+
+func keyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// requestUserUID returns the user the request is made on behalf of. All guarded requests carry user_uid
+func requestUserUID(msg proto.Message) string {
+	if r, ok := msg.(interface{ GetUserUid() string }); ok {
+		return r.GetUserUid()
+	}
+	return ""
+}
+
+func requestHash(msg proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+func marshalResponse(resp any) ([]byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, status.Error(codes.Internal, "response is not a protobuf message")
+	}
+
+	stored, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(stored)
+}
@@ -0,0 +1,113 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Record is a stored idempotency key. Response is nil while the first request is still being processed.
+type Record struct {
+	RequestHash []byte
+	Response    []byte
+}
+
+type Store struct {
+	db  *pgxpool.Pool
+	ttl time.Duration
+}
+
+func NewStore(db *pgxpool.Pool, ttl time.Duration) *Store {
+	return &Store{
+		db:  db,
+		ttl: ttl,
+	}
+}
+
+// Reserve claims the key for the method. Keys are scoped per user, so different users may use the same key.
+// If the key is already taken and has not expired, the existing record is returned and acquired is false.
+func (s *Store) Reserve(ctx context.Context, userUID, method, key string, requestHash []byte) (*Record, bool, error) {
+	query := `
+		INSERT INTO idempotency_keys (user_uid, method, key, request_hash)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_uid, method, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, response = NULL, created_at = NOW()
+		WHERE idempotency_keys.created_at < $5
+	`
+
+	tag, err := s.db.Exec(ctx, query, userUID, method, key, requestHash, time.Now().Add(-s.ttl))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	if tag.RowsAffected() == 1 {
+		return nil, true, nil
+	}
+
+	var record Record
+	err = s.db.QueryRow(ctx, `SELECT request_hash, response FROM idempotency_keys WHERE user_uid = $1 AND method = $2 AND key = $3`, userUID, method, key).
+		Scan(&record.RequestHash, &record.Response)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The record was released between the two queries, the client may simply retry
+			return &Record{RequestHash: requestHash}, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return &record, false, nil
+}
+
+// Complete stores the response of the request that reserved the key
+func (s *Store) Complete(ctx context.Context, userUID, method, key string, response []byte) error {
+	_, err := s.db.Exec(ctx, `UPDATE idempotency_keys SET response = $4 WHERE user_uid = $1 AND method = $2 AND key = $3`, userUID, method, key, response)
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+
+	return nil
+}
+
+// Release frees the key after a failed request, so that it can be retried with the same key
+func (s *Store) Release(ctx context.Context, userUID, method, key string) error {
+	_, err := s.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE user_uid = $1 AND method = $2 AND key = $3 AND response IS NULL`, userUID, method, key)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes keys older than the replay window
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := s.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE created_at < $1`, time.Now().Add(-s.ttl))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// RunCleanup periodically deletes expired keys until ctx is cancelled
+func (s *Store) RunCleanup(ctx context.Context, interval time.Duration) {
+	l := log.FromContext(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.DeleteExpired(ctx); err != nil {
+				l.Errorf("idempotency cleanup: %v", err)
+			}
+		}
+	}
+}
@@ -40,6 +40,16 @@ func (m *Migrator) Up(parentCtx context.Context, timeout time.Duration) error {
 	return nil
 }
 
+// UpTo applies the migrations up to and including the given version
+func (m *Migrator) UpTo(ctx context.Context, version int64) error {
+	err := goose.UpToContext(ctx, m.db, m.dir, version)
+	if err != nil {
+		return fmt.Errorf("migrator: failed to up to %d: %w", version, err)
+	}
+
+	return nil
+}
+
 func (m *Migrator) Down() error {
 	err := goose.Down(m.db, m.dir)
 	if err != nil {
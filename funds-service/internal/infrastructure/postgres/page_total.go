@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// MaxCountedRows is how many rows past the current page are counted when the exact total is not requested
+const MaxCountedRows = 1000
+
+// Querier is implemented by both the pool and pgx.Tx
+type Querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// CountPageTotal computes the total of a page that was fetched with limit+1 rows.
+// A short page gives the total for free. Otherwise the rows selected by filterQuery are counted,
+// up to MaxCountedRows past the page unless exact is set.
+func CountPageTotal(ctx context.Context, db Querier, filterQuery string, args []any, limit, offset int32, fetched int, exact bool) (models.PageTotal, error) {
+	total := models.PageTotal{HasMore: fetched > int(limit)}
+
+	if !total.HasMore && (fetched > 0 || offset == 0) {
+		total.Count = int64(offset) + int64(fetched)
+		return total, nil
+	}
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM (%s) AS page_rows`, filterQuery)
+	limitRows := int64(offset) + int64(limit) + MaxCountedRows
+	if !exact {
+		query = fmt.Sprintf(`SELECT COUNT(*) FROM (%s LIMIT $%d) AS page_rows`, filterQuery, len(args)+1)
+		args = append(args[:len(args):len(args)], limitRows)
+	}
+
+	if err := db.QueryRow(ctx, query, args...).Scan(&total.Count); err != nil {
+		return models.PageTotal{}, fmt.Errorf("failed to count rows: %w", err)
+	}
+	total.Estimated = !exact && total.Count >= limitRows
+
+	return total, nil
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) AddLedgerMember(ctx context.Context, req *pb.AddLedgerMemberRequest) (*pb.AddLedgerMemberResponse, error) {
+	if req.MemberUid == "" || req.Username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "member_uid and username are required")
+	}
+
+	if !validRole(req.Role) {
+		return nil, status.Errorf(codes.InvalidArgument, "role must be 'editor' or 'viewer'")
+	}
+
+	member, err := h.service.AddLedgerMember(ctx, models.AddLedgerMemberInput{
+		LedgerID:  req.LedgerId,
+		UserUID:   req.UserUid,
+		MemberUID: req.MemberUid,
+		Username:  req.Username,
+		Role:      req.Role,
+	})
+	if err != nil {
+		return nil, ledgerError(err, "failed to add ledger member")
+	}
+
+	return &pb.AddLedgerMemberResponse{
+		Member: h.memberToProto(member),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) CreateLedger(ctx context.Context, req *pb.CreateLedgerRequest) (*pb.CreateLedgerResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if req.UserUid == "" || req.Username == "" || name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_uid, username and name are required")
+	}
+
+	if len(name) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be at most 255 characters")
+	}
+
+	ledger, err := h.service.CreateLedger(ctx, name, req.UserUid, req.Username)
+	if err != nil {
+		return nil, ledgerError(err, "failed to create ledger")
+	}
+
+	return &pb.CreateLedgerResponse{
+		Ledger: h.ledgerToProto(ledger),
+	}, nil
+}
@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) DeleteLedger(ctx context.Context, req *pb.DeleteLedgerRequest) (*pb.DeleteLedgerResponse, error) {
+	if err := h.service.DeleteLedger(ctx, req.Id, req.UserUid); err != nil {
+		return nil, ledgerError(err, "failed to delete ledger")
+	}
+
+	return &pb.DeleteLedgerResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetLedgerBalance(ctx context.Context, req *pb.GetLedgerBalanceRequest) (*pb.GetLedgerBalanceResponse, error) {
+	balance, err := h.service.GetLedgerBalance(ctx, req.LedgerId, req.UserUid)
+	if err != nil {
+		return nil, ledgerError(err, "failed to get ledger balance")
+	}
+
+	members := make([]*pb.LedgerMemberBalance, 0, len(balance.Members))
+	for _, m := range balance.Members {
+		members = append(members, &pb.LedgerMemberBalance{
+			UserUid:  m.UserUID,
+			Username: m.Username,
+			Role:     m.Role,
+			Income:   m.Income,
+			Expense:  m.Expense,
+			Balance:  m.Balance,
+		})
+	}
+
+	return &pb.GetLedgerBalanceResponse{
+		LedgerId:     balance.LedgerID,
+		TotalIncome:  balance.TotalIncome,
+		TotalExpense: balance.TotalExpense,
+		Balance:      balance.Balance,
+		Members:      members,
+	}, nil
+}
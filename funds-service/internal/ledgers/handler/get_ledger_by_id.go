@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetLedgerById(ctx context.Context, req *pb.GetLedgerByIdRequest) (*pb.GetLedgerByIdResponse, error) {
+	ledger, err := h.service.GetLedgerById(ctx, req.Id, req.UserUid)
+	if err != nil {
+		return nil, ledgerError(err, "failed to get ledger")
+	}
+
+	return &pb.GetLedgerByIdResponse{
+		Ledger: h.ledgerToProto(ledger),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) GetLedgerSettlement(ctx context.Context, req *pb.GetLedgerSettlementRequest) (*pb.GetLedgerSettlementResponse, error) {
+	var dateFrom, dateTo *time.Time
+	if req.DateFrom != "" {
+		from, err := time.Parse("2006-01-02", req.DateFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date_from format: %v", err)
+		}
+		dateFrom = &from
+	}
+	if req.DateTo != "" {
+		to, err := time.Parse("2006-01-02", req.DateTo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date_to format: %v", err)
+		}
+		dateTo = &to
+	}
+
+	if dateFrom != nil && dateTo != nil && dateTo.Before(*dateFrom) {
+		return nil, status.Error(codes.InvalidArgument, "date_to must not be before date_from")
+	}
+
+	settlement, err := h.service.GetLedgerSettlement(ctx, req.LedgerId, req.UserUid, dateFrom, dateTo)
+	if err != nil {
+		return nil, ledgerError(err, "failed to get ledger settlement")
+	}
+
+	shares := make([]*pb.SettlementShare, 0, len(settlement.Shares))
+	for _, s := range settlement.Shares {
+		shares = append(shares, &pb.SettlementShare{
+			UserUid:  s.UserUID,
+			Username: s.Username,
+			Paid:     s.Paid,
+			Share:    s.Share,
+			Net:      s.Net,
+		})
+	}
+
+	transfers := make([]*pb.SettlementTransfer, 0, len(settlement.Transfers))
+	for _, t := range settlement.Transfers {
+		transfers = append(transfers, &pb.SettlementTransfer{
+			FromUid:      t.FromUID,
+			FromUsername: t.FromUsername,
+			ToUid:        t.ToUID,
+			ToUsername:   t.ToUsername,
+			Amount:       t.Amount,
+		})
+	}
+
+	return &pb.GetLedgerSettlementResponse{
+		LedgerId:     settlement.LedgerID,
+		DateFrom:     req.DateFrom,
+		DateTo:       req.DateTo,
+		TotalExpense: settlement.TotalExpense,
+		SharePerUser: settlement.SharePerUser,
+		Shares:       shares,
+		Transfers:    transfers,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetLedgerTransactions(ctx context.Context, req *pb.GetLedgerTransactionsRequest) (*pb.GetLedgerTransactionsResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = 50
+	}
+
+	transactions, total, err := h.service.GetLedgerTransactions(ctx, req.LedgerId, req.UserUid, limit, req.Offset, req.ExactTotal)
+	if err != nil {
+		return nil, ledgerError(err, "failed to get ledger transactions")
+	}
+
+	pbTransactions := make([]*pb.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		pbTransactions = append(pbTransactions, h.transactionToProto(t))
+	}
+
+	return &pb.GetLedgerTransactionsResponse{
+		Transactions:   pbTransactions,
+		Total:          total.Count,
+		TotalEstimated: total.Estimated,
+		HasMore:        total.HasMore,
+	}, nil
+}
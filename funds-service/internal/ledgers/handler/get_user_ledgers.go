@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) GetUserLedgers(ctx context.Context, req *pb.GetUserLedgersRequest) (*pb.GetUserLedgersResponse, error) {
+	ledgers, err := h.service.GetUserLedgers(ctx, req.UserUid)
+	if err != nil {
+		return nil, ledgerError(err, "failed to get user ledgers")
+	}
+
+	pbLedgers := make([]*pb.Ledger, 0, len(ledgers))
+	for _, l := range ledgers {
+		pbLedgers = append(pbLedgers, h.ledgerToProto(l))
+	}
+
+	return &pb.GetUserLedgersResponse{
+		Ledgers: pbLedgers,
+	}, nil
+}
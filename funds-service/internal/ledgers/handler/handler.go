@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"errors"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/ledgers/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/ledgers/service"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GRPCHandler struct {
+	pb.UnimplementedLedgerServiceServer
+	service service.LedgersServicer
+}
+
+func NewGRPCHandler(service service.LedgersServicer) *GRPCHandler {
+	return &GRPCHandler{
+		service: service,
+	}
+}
+
+func (h *GRPCHandler) ledgerToProto(l *models.Ledger) *pb.Ledger {
+	ledger := &pb.Ledger{
+		Id:           l.ID,
+		Name:         l.Name,
+		OwnerUid:     l.OwnerUID,
+		Role:         l.Role,
+		MembersCount: l.MembersCount,
+		CreatedAt:    l.CreatedAt.Unix(),
+		UpdatedAt:    l.UpdatedAt.Unix(),
+	}
+
+	for _, m := range l.Members {
+		ledger.Members = append(ledger.Members, h.memberToProto(m))
+	}
+
+	return ledger
+}
+
+func (h *GRPCHandler) memberToProto(m *models.LedgerMember) *pb.LedgerMember {
+	return &pb.LedgerMember{
+		LedgerId: m.LedgerID,
+		UserUid:  m.UserUID,
+		Username: m.Username,
+		Role:     m.Role,
+		JoinedAt: m.JoinedAt.Unix(),
+	}
+}
+
+func (h *GRPCHandler) transactionToProto(t *models.Transaction) *pb.Transaction {
+	transaction := &pb.Transaction{
+		Id:              t.ID,
+		UserUid:         t.UserUID,
+		CategoryId:      t.CategoryID,
+		Type:            t.Type,
+		Amount:          t.Amount,
+		Title:           t.Title,
+		Description:     t.Description,
+		TransactionDate: t.TransactionDate.Format("2006-01-02"),
+		CreatedAt:       t.CreatedAt.Unix(),
+		UpdatedAt:       t.UpdatedAt.Unix(),
+		Tags:            t.Tags,
+		Version:         t.Version,
+	}
+
+	if t.LedgerID != nil {
+		transaction.LedgerId = *t.LedgerID
+	}
+
+	if t.Category != nil {
+		transaction.Category = &pb.Category{
+			Id:        t.Category.ID,
+			Name:      t.Category.Name,
+			Type:      t.Category.Type,
+			Icon:      t.Category.Icon,
+			CreatedAt: t.Category.CreatedAt.Unix(),
+		}
+	}
+
+	return transaction
+}
+
+// validRole reports whether the role can be given to a member, a ledger has exactly one owner
+func validRole(role string) bool {
+	return role == models.LedgerRoleEditor || role == models.LedgerRoleViewer
+}
+
+// ledgerError maps repository errors to gRPC status codes
+func ledgerError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrLedgerNotFound), errors.Is(err, repository.ErrMemberNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrNotLedgerMember), errors.Is(err, repository.ErrNotLedgerOwner):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrMemberAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrOwnerRoleImmutable):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+)
+
+func (h *GRPCHandler) RemoveLedgerMember(ctx context.Context, req *pb.RemoveLedgerMemberRequest) (*pb.RemoveLedgerMemberResponse, error) {
+	if err := h.service.RemoveLedgerMember(ctx, req.LedgerId, req.UserUid, req.MemberUid); err != nil {
+		return nil, ledgerError(err, "failed to remove ledger member")
+	}
+
+	return &pb.RemoveLedgerMemberResponse{
+		Success: true,
+	}, nil
+}
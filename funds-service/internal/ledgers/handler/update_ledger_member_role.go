@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) UpdateLedgerMemberRole(ctx context.Context, req *pb.UpdateLedgerMemberRoleRequest) (*pb.UpdateLedgerMemberRoleResponse, error) {
+	if !validRole(req.Role) {
+		return nil, status.Errorf(codes.InvalidArgument, "role must be 'editor' or 'viewer'")
+	}
+
+	member, err := h.service.UpdateLedgerMemberRole(ctx, req.LedgerId, req.UserUid, req.MemberUid, req.Role)
+	if err != nil {
+		return nil, ledgerError(err, "failed to update ledger member role")
+	}
+
+	return &pb.UpdateLedgerMemberRoleResponse{
+		Member: h.memberToProto(member),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *LedgersRepository) AddLedgerMember(ctx context.Context, input models.AddLedgerMemberInput) (*models.LedgerMember, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM ledgers WHERE id = $1 FOR UPDATE`, input.LedgerID); err != nil {
+		return nil, fmt.Errorf("failed to lock ledger: %w", err)
+	}
+
+	if err := requireOwner(ctx, tx, input.LedgerID, input.UserUID); err != nil {
+		if isAccessError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to check ledger owner: %w", err)
+	}
+
+	member := &models.LedgerMember{
+		LedgerID: input.LedgerID,
+		UserUID:  input.MemberUID,
+		Username: input.Username,
+		Role:     input.Role,
+	}
+
+	query := `
+		INSERT INTO ledger_members (ledger_id, user_uid, username, role)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (ledger_id, user_uid) DO NOTHING
+		RETURNING joined_at
+	`
+	err = tx.QueryRow(ctx, query, input.LedgerID, input.MemberUID, input.Username, input.Role).Scan(&member.JoinedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMemberAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to add ledger member: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE ledgers SET updated_at = NOW() WHERE id = $1`, input.LedgerID); err != nil {
+		return nil, fmt.Errorf("failed to update ledger: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return member, nil
+}
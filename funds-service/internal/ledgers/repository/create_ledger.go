@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// CreateLedger creates the ledger with its creator as the owner
+func (r *LedgersRepository) CreateLedger(ctx context.Context, name, ownerUID, ownerUsername string) (*models.Ledger, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	ledger := &models.Ledger{
+		Name:         name,
+		OwnerUID:     ownerUID,
+		Role:         models.LedgerRoleOwner,
+		MembersCount: 1,
+	}
+
+	query := `
+		INSERT INTO ledgers (name, owner_uid)
+		VALUES ($1, $2)
+		RETURNING id, created_at, updated_at
+	`
+	if err := tx.QueryRow(ctx, query, name, ownerUID).Scan(&ledger.ID, &ledger.CreatedAt, &ledger.UpdatedAt); err != nil {
+		return nil, fmt.Errorf("failed to create ledger: %w", err)
+	}
+
+	owner := &models.LedgerMember{
+		LedgerID: ledger.ID,
+		UserUID:  ownerUID,
+		Username: ownerUsername,
+		Role:     models.LedgerRoleOwner,
+	}
+
+	memberQuery := `
+		INSERT INTO ledger_members (ledger_id, user_uid, username, role)
+		VALUES ($1, $2, $3, $4)
+		RETURNING joined_at
+	`
+	if err := tx.QueryRow(ctx, memberQuery, ledger.ID, ownerUID, ownerUsername, owner.Role).Scan(&owner.JoinedAt); err != nil {
+		return nil, fmt.Errorf("failed to add ledger owner: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	ledger.Members = []*models.LedgerMember{owner}
+
+	return ledger, nil
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeleteLedger removes the ledger, its transactions become personal ones of the members who paid
+func (r *LedgersRepository) DeleteLedger(ctx context.Context, id int64, userUID string) error {
+	if err := requireOwner(ctx, r.db, id, userUID); err != nil {
+		if isAccessError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to check ledger owner: %w", err)
+	}
+
+	if _, err := r.db.Exec(ctx, `DELETE FROM ledgers WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete ledger: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// GetLedgerBalance sums the ledger transactions per member over an optional period.
+// Current members are listed even without transactions, former members only if they have some.
+func (r *LedgersRepository) GetLedgerBalance(ctx context.Context, ledgerID int64, userUID string, dateFrom, dateTo *time.Time) (*models.LedgerBalance, error) {
+	if _, err := getMemberRole(ctx, r.db, ledgerID, userUID); err != nil {
+		if isAccessError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get ledger role: %w", err)
+	}
+
+	query := `
+		WITH totals AS (
+			SELECT user_uid,
+			       COALESCE(SUM(amount) FILTER (WHERE type = 'income'), 0) AS income,
+			       COALESCE(SUM(amount) FILTER (WHERE type = 'expense'), 0) AS expense
+			FROM transactions
+			WHERE ledger_id = $1
+			  AND deleted_at IS NULL
+			  AND ($2::date IS NULL OR transaction_date >= $2::date)
+			  AND ($3::date IS NULL OR transaction_date <= $3::date)
+			GROUP BY user_uid
+		)
+		SELECT COALESCE(m.user_uid, t.user_uid)::text, COALESCE(m.username, ''), COALESCE(m.role, ''),
+		       COALESCE(t.income, 0), COALESCE(t.expense, 0)
+		FROM (SELECT * FROM ledger_members WHERE ledger_id = $1) m
+		FULL JOIN totals t ON t.user_uid = m.user_uid
+		ORDER BY m.joined_at NULLS LAST, 1
+	`
+
+	rows, err := r.db.Query(ctx, query, ledgerID, dateFrom, dateTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger balance: %w", err)
+	}
+	defer rows.Close()
+
+	balance := &models.LedgerBalance{
+		LedgerID: ledgerID,
+		Members:  make([]*models.LedgerMemberBalance, 0),
+	}
+	for rows.Next() {
+		var member models.LedgerMemberBalance
+		if err := rows.Scan(&member.UserUID, &member.Username, &member.Role, &member.Income, &member.Expense); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger member balance: %w", err)
+		}
+
+		member.Balance = member.Income - member.Expense
+		balance.TotalIncome += member.Income
+		balance.TotalExpense += member.Expense
+		balance.Members = append(balance.Members, &member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ledger balance: %w", err)
+	}
+
+	balance.Balance = balance.TotalIncome - balance.TotalExpense
+
+	return balance, nil
+}
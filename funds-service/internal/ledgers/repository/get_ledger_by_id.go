@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// GetLedgerById returns the ledger with its members, it is visible to members only
+func (r *LedgersRepository) GetLedgerById(ctx context.Context, id int64, userUID string) (*models.Ledger, error) {
+	role, err := getMemberRole(ctx, r.db, id, userUID)
+	if err != nil {
+		if isAccessError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get ledger role: %w", err)
+	}
+
+	ledger := &models.Ledger{Role: role}
+
+	query := `SELECT id, name, owner_uid, created_at, updated_at FROM ledgers WHERE id = $1`
+	err = r.db.QueryRow(ctx, query, id).Scan(
+		&ledger.ID,
+		&ledger.Name,
+		&ledger.OwnerUID,
+		&ledger.CreatedAt,
+		&ledger.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger: %w", err)
+	}
+
+	membersQuery := `
+		SELECT ledger_id, user_uid, username, role, joined_at
+		FROM ledger_members
+		WHERE ledger_id = $1
+		ORDER BY joined_at, username
+	`
+	rows, err := r.db.Query(ctx, membersQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger members: %w", err)
+	}
+	defer rows.Close()
+
+	ledger.Members = make([]*models.LedgerMember, 0)
+	for rows.Next() {
+		var member models.LedgerMember
+		if err := rows.Scan(&member.LedgerID, &member.UserUID, &member.Username, &member.Role, &member.JoinedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger member: %w", err)
+		}
+		ledger.Members = append(ledger.Members, &member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ledger members: %w", err)
+	}
+
+	ledger.MembersCount = int32(len(ledger.Members))
+
+	return ledger, nil
+}
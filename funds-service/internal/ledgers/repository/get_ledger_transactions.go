@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/postgres"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// GetLedgerTransactions returns the transactions of all members shared with the ledger.
+// The total is exact only when it is requested or the page is the last one.
+func (r *LedgersRepository) GetLedgerTransactions(ctx context.Context, ledgerID int64, userUID string, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error) {
+	if _, err := getMemberRole(ctx, r.db, ledgerID, userUID); err != nil {
+		if isAccessError(err) {
+			return nil, models.PageTotal{}, err
+		}
+		return nil, models.PageTotal{}, fmt.Errorf("failed to get ledger role: %w", err)
+	}
+
+	query := `
+		SELECT t.id, t.user_uid, t.category_id, t.type, t.amount, t.title, t.description,
+		       t.transaction_date, t.created_at, t.updated_at, t.tags, t.version, t.ledger_id,
+		       c.id, c.code, c.name, c.type, c.icon, c.created_at
+		FROM transactions t
+		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE t.ledger_id = $1 AND t.deleted_at IS NULL
+		ORDER BY t.transaction_date DESC, t.created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	// One extra row tells whether there is a next page
+	rows, err := r.db.Query(ctx, query, ledgerID, limit+1, offset)
+	if err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	defer rows.Close()
+
+	transactions := make([]*models.Transaction, 0)
+	for rows.Next() {
+		var transaction models.Transaction
+		var category models.Category
+
+		err := rows.Scan(
+			&transaction.ID,
+			&transaction.UserUID,
+			&transaction.CategoryID,
+			&transaction.Type,
+			&transaction.Amount,
+			&transaction.Title,
+			&transaction.Description,
+			&transaction.TransactionDate,
+			&transaction.CreatedAt,
+			&transaction.UpdatedAt,
+			&transaction.Tags,
+			&transaction.Version,
+			&transaction.LedgerID,
+			&category.ID,
+			&category.Code,
+			&category.Name,
+			&category.Type,
+			&category.Icon,
+			&category.CreatedAt,
+		)
+		if err != nil {
+			return nil, models.PageTotal{}, fmt.Errorf("failed to scan transaction: %w", err)
+		}
+
+		transaction.Category = &category
+		transactions = append(transactions, &transaction)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to read transactions: %w", err)
+	}
+
+	countQuery := `SELECT 1 FROM transactions WHERE ledger_id = $1 AND deleted_at IS NULL`
+	total, err := postgres.CountPageTotal(ctx, r.db, countQuery, []any{ledgerID}, limit, offset, len(transactions), exactTotal)
+	if err != nil {
+		return nil, models.PageTotal{}, fmt.Errorf("failed to get transactions count: %w", err)
+	}
+	if total.HasMore {
+		transactions = transactions[:limit]
+	}
+
+	return transactions, total, nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (r *LedgersRepository) GetUserLedgers(ctx context.Context, userUID string) ([]*models.Ledger, error) {
+	query := `
+		SELECT l.id, l.name, l.owner_uid, m.role,
+		       (SELECT COUNT(*) FROM ledger_members lm WHERE lm.ledger_id = l.id),
+		       l.created_at, l.updated_at
+		FROM ledgers l
+		JOIN ledger_members m ON m.ledger_id = l.id AND m.user_uid = $1
+		ORDER BY l.created_at, l.id
+	`
+
+	rows, err := r.db.Query(ctx, query, userUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledgers: %w", err)
+	}
+	defer rows.Close()
+
+	ledgers := make([]*models.Ledger, 0)
+	for rows.Next() {
+		var ledger models.Ledger
+		err := rows.Scan(
+			&ledger.ID,
+			&ledger.Name,
+			&ledger.OwnerUID,
+			&ledger.Role,
+			&ledger.MembersCount,
+			&ledger.CreatedAt,
+			&ledger.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ledger: %w", err)
+		}
+		ledgers = append(ledgers, &ledger)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ledgers: %w", err)
+	}
+
+	return ledgers, nil
+}
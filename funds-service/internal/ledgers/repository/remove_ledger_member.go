@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// RemoveLedgerMember removes a member by the owner's request or lets a member leave.
+// Transactions of the member stay in the ledger, they are part of the shared history.
+func (r *LedgersRepository) RemoveLedgerMember(ctx context.Context, ledgerID int64, userUID, memberUID string) error {
+	role, err := getMemberRole(ctx, r.db, ledgerID, userUID)
+	if err != nil {
+		if isAccessError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to get ledger role: %w", err)
+	}
+
+	switch {
+	case memberUID == userUID && role == models.LedgerRoleOwner:
+		return ErrOwnerRoleImmutable
+	case memberUID != userUID && role != models.LedgerRoleOwner:
+		return ErrNotLedgerOwner
+	}
+
+	tag, err := r.db.Exec(ctx, `DELETE FROM ledger_members WHERE ledger_id = $1 AND user_uid = $2`, ledgerID, memberUID)
+	if err != nil {
+		return fmt.Errorf("failed to remove ledger member: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrMemberNotFound
+	}
+
+	return nil
+}
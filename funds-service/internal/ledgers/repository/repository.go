@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrLedgerNotFound      = errors.New("ledger not found")
+	ErrNotLedgerMember     = errors.New("user is not a member of the ledger")
+	ErrNotLedgerOwner      = errors.New("only the ledger owner can do this")
+	ErrMemberNotFound      = errors.New("ledger member not found")
+	ErrMemberAlreadyExists = errors.New("user is already a member of the ledger")
+	ErrOwnerRoleImmutable  = errors.New("the ledger owner cannot be changed or removed")
+)
+
+type LedgersRepositorer interface {
+	CreateLedger(ctx context.Context, name, ownerUID, ownerUsername string) (*models.Ledger, error)
+	GetLedgerById(ctx context.Context, id int64, userUID string) (*models.Ledger, error)
+	GetUserLedgers(ctx context.Context, userUID string) ([]*models.Ledger, error)
+	DeleteLedger(ctx context.Context, id int64, userUID string) error
+	AddLedgerMember(ctx context.Context, input models.AddLedgerMemberInput) (*models.LedgerMember, error)
+	UpdateLedgerMemberRole(ctx context.Context, ledgerID int64, userUID, memberUID, role string) (*models.LedgerMember, error)
+	RemoveLedgerMember(ctx context.Context, ledgerID int64, userUID, memberUID string) error
+	GetLedgerTransactions(ctx context.Context, ledgerID int64, userUID string, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error)
+	GetLedgerBalance(ctx context.Context, ledgerID int64, userUID string, dateFrom, dateTo *time.Time) (*models.LedgerBalance, error)
+}
+
+type LedgersRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) LedgersRepositorer {
+	return &LedgersRepository{db: db}
+}
+
+// querier is implemented by both the pool and pgx.Tx
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// getMemberRole returns the role of the user in the ledger
+func getMemberRole(ctx context.Context, q querier, ledgerID int64, userUID string) (string, error) {
+	query := `
+		SELECT (SELECT role FROM ledger_members WHERE ledger_id = l.id AND user_uid = $2)
+		FROM ledgers l
+		WHERE l.id = $1
+	`
+
+	var role *string
+	if err := q.QueryRow(ctx, query, ledgerID, userUID).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrLedgerNotFound
+		}
+		return "", err
+	}
+
+	if role == nil {
+		return "", ErrNotLedgerMember
+	}
+
+	return *role, nil
+}
+
+// requireOwner verifies that the user owns the ledger
+func requireOwner(ctx context.Context, q querier, ledgerID int64, userUID string) error {
+	role, err := getMemberRole(ctx, q, ledgerID, userUID)
+	if err != nil {
+		return err
+	}
+
+	if role != models.LedgerRoleOwner {
+		return ErrNotLedgerOwner
+	}
+
+	return nil
+}
+
+// isAccessError reports whether the error denies access to the ledger and is returned as is
+func isAccessError(err error) bool {
+	return errors.Is(err, ErrLedgerNotFound) || errors.Is(err, ErrNotLedgerMember) || errors.Is(err, ErrNotLedgerOwner)
+}
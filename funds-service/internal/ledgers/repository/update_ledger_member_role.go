@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *LedgersRepository) UpdateLedgerMemberRole(ctx context.Context, ledgerID int64, userUID, memberUID, role string) (*models.LedgerMember, error) {
+	if err := requireOwner(ctx, r.db, ledgerID, userUID); err != nil {
+		if isAccessError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to check ledger owner: %w", err)
+	}
+
+	if memberUID == userUID {
+		return nil, ErrOwnerRoleImmutable
+	}
+
+	query := `
+		UPDATE ledger_members
+		SET role = $3
+		WHERE ledger_id = $1 AND user_uid = $2
+		RETURNING ledger_id, user_uid, username, role, joined_at
+	`
+
+	var member models.LedgerMember
+	err := r.db.QueryRow(ctx, query, ledgerID, memberUID, role).Scan(
+		&member.LedgerID,
+		&member.UserUID,
+		&member.Username,
+		&member.Role,
+		&member.JoinedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to update ledger member: %w", err)
+	}
+
+	return &member, nil
+}
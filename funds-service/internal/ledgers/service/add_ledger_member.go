@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *LedgersService) AddLedgerMember(ctx context.Context, input models.AddLedgerMemberInput) (*models.LedgerMember, error) {
+	return s.repo.AddLedgerMember(ctx, input)
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *LedgersService) CreateLedger(ctx context.Context, name, ownerUID, ownerUsername string) (*models.Ledger, error) {
+	return s.repo.CreateLedger(ctx, name, ownerUID, ownerUsername)
+}
@@ -0,0 +1,7 @@
+package service
+
+import "context"
+
+func (s *LedgersService) DeleteLedger(ctx context.Context, id int64, userUID string) error {
+	return s.repo.DeleteLedger(ctx, id, userUID)
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *LedgersService) GetLedgerBalance(ctx context.Context, ledgerID int64, userUID string) (*models.LedgerBalance, error) {
+	return s.repo.GetLedgerBalance(ctx, ledgerID, userUID, nil, nil)
+}
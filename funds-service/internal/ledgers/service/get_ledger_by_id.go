@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *LedgersService) GetLedgerById(ctx context.Context, id int64, userUID string) (*models.Ledger, error) {
+	return s.repo.GetLedgerById(ctx, id, userUID)
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+// GetLedgerSettlement splits the ledger expenses of the period equally between the participants
+// and finds the transfers that even out what everyone paid. Participants are the members
+// who can add expenses and everyone who actually paid, including former members and viewers.
+func (s *LedgersService) GetLedgerSettlement(ctx context.Context, ledgerID int64, userUID string, dateFrom, dateTo *time.Time) (*models.LedgerSettlement, error) {
+	balance, err := s.repo.GetLedgerBalance(ctx, ledgerID, userUID, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement := &models.LedgerSettlement{
+		LedgerID:  ledgerID,
+		DateFrom:  dateFrom,
+		DateTo:    dateTo,
+		Shares:    make([]*models.SettlementShare, 0),
+		Transfers: make([]*models.SettlementTransfer, 0),
+	}
+
+	var participants []*models.LedgerMemberBalance
+	for _, member := range balance.Members {
+		if member.Expense > 0 || member.Role == models.LedgerRoleOwner || member.Role == models.LedgerRoleEditor {
+			participants = append(participants, member)
+		}
+	}
+
+	if len(participants) == 0 {
+		return settlement, nil
+	}
+
+	// Amounts are settled in kopecks, so the shares add up to the total exactly
+	var total int64
+	paid := make([]int64, len(participants))
+	for i, p := range participants {
+		paid[i] = toKopecks(p.Expense)
+		total += paid[i]
+	}
+
+	share := total / int64(len(participants))
+	remainder := total % int64(len(participants))
+
+	net := make([]int64, len(participants))
+	for i, p := range participants {
+		owed := share
+		if int64(i) < remainder {
+			owed++
+		}
+		net[i] = paid[i] - owed
+
+		settlement.Shares = append(settlement.Shares, &models.SettlementShare{
+			UserUID:  p.UserUID,
+			Username: p.Username,
+			Paid:     fromKopecks(paid[i]),
+			Share:    fromKopecks(owed),
+			Net:      fromKopecks(net[i]),
+		})
+	}
+
+	settlement.TotalExpense = fromKopecks(total)
+	settlement.SharePerUser = models.RoundMoney(float64(total) / float64(len(participants)) / 100)
+
+	for _, t := range minTransfers(net) {
+		from, to := participants[t.from], participants[t.to]
+		settlement.Transfers = append(settlement.Transfers, &models.SettlementTransfer{
+			FromUID:      from.UserUID,
+			FromUsername: from.Username,
+			ToUID:        to.UserUID,
+			ToUsername:   to.Username,
+			Amount:       fromKopecks(t.amount),
+		})
+	}
+
+	return settlement, nil
+}
+
+type transfer struct {
+	from, to int
+	amount   int64
+}
+
+// minTransfers repeatedly matches the largest debtor with the largest creditor.
+// Every step settles at least one of them, so there are fewer transfers than participants.
+func minTransfers(net []int64) []transfer {
+	var debtors, creditors []int
+	for i, n := range net {
+		switch {
+		case n < 0:
+			debtors = append(debtors, i)
+		case n > 0:
+			creditors = append(creditors, i)
+		}
+	}
+
+	balance := append([]int64(nil), net...)
+	sort.SliceStable(debtors, func(a, b int) bool { return balance[debtors[a]] < balance[debtors[b]] })
+	sort.SliceStable(creditors, func(a, b int) bool { return balance[creditors[a]] > balance[creditors[b]] })
+
+	var transfers []transfer
+	for d, c := 0, 0; d < len(debtors) && c < len(creditors); {
+		debtor, creditor := debtors[d], creditors[c]
+
+		amount := min(-balance[debtor], balance[creditor])
+		transfers = append(transfers, transfer{from: debtor, to: creditor, amount: amount})
+
+		balance[debtor] += amount
+		balance[creditor] -= amount
+		if balance[debtor] == 0 {
+			d++
+		}
+		if balance[creditor] == 0 {
+			c++
+		}
+	}
+
+	return transfers
+}
+
+func toKopecks(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+func fromKopecks(amount int64) float64 {
+	return float64(amount) / 100
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *LedgersService) GetLedgerTransactions(ctx context.Context, ledgerID int64, userUID string, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error) {
+	return s.repo.GetLedgerTransactions(ctx, ledgerID, userUID, limit, offset, exactTotal)
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *LedgersService) GetUserLedgers(ctx context.Context, userUID string) ([]*models.Ledger, error) {
+	return s.repo.GetUserLedgers(ctx, userUID)
+}
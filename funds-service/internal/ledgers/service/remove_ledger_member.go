@@ -0,0 +1,7 @@
+package service
+
+import "context"
+
+func (s *LedgersService) RemoveLedgerMember(ctx context.Context, ledgerID int64, userUID, memberUID string) error {
+	return s.repo.RemoveLedgerMember(ctx, ledgerID, userUID, memberUID)
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/ledgers/repository"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+type LedgersServicer interface {
+	CreateLedger(ctx context.Context, name, ownerUID, ownerUsername string) (*models.Ledger, error)
+	GetLedgerById(ctx context.Context, id int64, userUID string) (*models.Ledger, error)
+	GetUserLedgers(ctx context.Context, userUID string) ([]*models.Ledger, error)
+	DeleteLedger(ctx context.Context, id int64, userUID string) error
+	AddLedgerMember(ctx context.Context, input models.AddLedgerMemberInput) (*models.LedgerMember, error)
+	UpdateLedgerMemberRole(ctx context.Context, ledgerID int64, userUID, memberUID, role string) (*models.LedgerMember, error)
+	RemoveLedgerMember(ctx context.Context, ledgerID int64, userUID, memberUID string) error
+	GetLedgerTransactions(ctx context.Context, ledgerID int64, userUID string, limit, offset int32, exactTotal bool) ([]*models.Transaction, models.PageTotal, error)
+	GetLedgerBalance(ctx context.Context, ledgerID int64, userUID string) (*models.LedgerBalance, error)
+	GetLedgerSettlement(ctx context.Context, ledgerID int64, userUID string, dateFrom, dateTo *time.Time) (*models.LedgerSettlement, error)
+}
+
+type LedgersService struct {
+	repo repository.LedgersRepositorer
+}
+
+func NewService(repo repository.LedgersRepositorer) LedgersServicer {
+	return &LedgersService{
+		repo: repo,
+	}
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cg-2025-crutch/backend/funds-service/internal/models"
+)
+
+func (s *LedgersService) UpdateLedgerMemberRole(ctx context.Context, ledgerID int64, userUID, memberUID, role string) (*models.LedgerMember, error) {
+	return s.repo.UpdateLedgerMemberRole(ctx, ledgerID, userUID, memberUID, role)
+}
@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+// Analytics event actions
+const (
+	AnalyticsActionUpdate               = "update"
+	AnalyticsActionTransactionsCreated  = "transactions_created"
+	AnalyticsActionTransactionsUpdated  = "transactions_updated"
+	AnalyticsActionTransactionsDeleted  = "transactions_deleted"
+	AnalyticsActionTransactionsRestored = "transactions_restored"
+)
+
+// AnalyticsEvent is sent to analytics-service when the transactions of a user change.
+// The changed transactions are attached for per-transaction analysis and for updating
+// spending aggregates: updated ones carry their values before the update, deleted ones
+// carry the values they had when deleted. EventID is unique per event, so analytics-service can
+// skip an event it has already applied when Kafka delivers it again.
+type AnalyticsEvent struct {
+	EventID      string             `json:"event_id"`
+	UserUID      string             `json:"user_uid"`
+	Action       string             `json:"action"`
+	Transactions []TransactionEvent `json:"transactions,omitempty"`
+}
+
+// TransactionEvent is the part of a transaction analytics-service needs
+type TransactionEvent struct {
+	ID              int64   `json:"id"`
+	CategoryID      int32   `json:"category_id"`
+	Type            string  `json:"type"`
+	Amount          float64 `json:"amount"`
+	Title           string  `json:"title"`
+	TransactionDate string  `json:"transaction_date"` // YYYY-MM-DD
+	CreatedAt       int64   `json:"created_at"`
+	LedgerID        int64   `json:"ledger_id,omitempty"`
+
+	// Previous holds the values before the update, set for updated transactions only
+	Previous *TransactionEvent `json:"previous,omitempty"`
+}
+
+// NewTransactionEvent converts a transaction for an analytics event
+func NewTransactionEvent(t *Transaction) TransactionEvent {
+	event := TransactionEvent{
+		ID:              t.ID,
+		CategoryID:      t.CategoryID,
+		Type:            t.Type,
+		Amount:          t.Amount,
+		Title:           t.Title,
+		TransactionDate: t.TransactionDate.Format(time.DateOnly),
+		CreatedAt:       t.CreatedAt.Unix(),
+	}
+	if t.LedgerID != nil {
+		event.LedgerID = *t.LedgerID
+	}
+	if t.Previous != nil {
+		previous := NewTransactionEvent(t.Previous)
+		event.Previous = &previous
+	}
+	return event
+}
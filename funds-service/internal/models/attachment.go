@@ -0,0 +1,43 @@
+package models
+
+import (
+	"io"
+	"time"
+)
+
+type Attachment struct {
+	ID            int64     `json:"id" db:"id"`
+	TransactionID int64     `json:"transaction_id" db:"transaction_id"`
+	UserUID       string    `json:"user_uid" db:"user_uid"`
+	FileName      string    `json:"file_name" db:"file_name"`
+	ContentType   string    `json:"content_type" db:"content_type"`
+	Size          int64     `json:"size" db:"size_bytes"`
+	StorageKey    string    `json:"-" db:"storage_key"`     // Blob key of the original file
+	ThumbnailKey  *string   `json:"-" db:"thumbnail_key"`   // Blob key of the JPEG thumbnail, nil for non-images
+	Checksum      string    `json:"checksum" db:"checksum"` // Hex encoded SHA-256 of the content
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
+
+type UploadAttachmentInput struct {
+	TransactionID int64
+	UserUID       string
+	FileName      string
+	ContentType   string // Declared by the client, the sniffed type takes precedence
+	Content       io.Reader
+}
+
+// AttachmentContent is an open attachment blob, the caller must close Body
+type AttachmentContent struct {
+	FileName    string
+	ContentType string
+	Size        int64
+	Body        io.ReadCloser
+}
+
+type AttachmentUsage struct {
+	UsedBytes           int64
+	QuotaBytes          int64
+	Count               int64
+	MaxFileSize         int64
+	AllowedContentTypes []string
+}
@@ -12,3 +12,27 @@ type UserBalance struct {
 	LastTransactionAt *time.Time `json:"last_transaction_at,omitempty" db:"last_transaction_at"`
 	UpdatedAt         time.Time  `json:"updated_at" db:"updated_at"`
 }
+
+type BalanceHistoryInput struct {
+	UserUID     string       `json:"user_uid"`
+	DateFrom    time.Time    `json:"date_from"`
+	DateTo      time.Time    `json:"date_to"`     // Inclusive
+	Granularity string       `json:"granularity"` // day, week, month or year
+	WeekStart   time.Weekday `json:"week_start"`  // First day of week buckets
+}
+
+// BalanceHistoryPoint is a bucket of the balance time series
+type BalanceHistoryPoint struct {
+	Period         time.Time `json:"period"` // Start of the bucket
+	OpeningBalance float64   `json:"opening_balance"`
+	ClosingBalance float64   `json:"closing_balance"`
+	Income         float64   `json:"income"`
+	Expense        float64   `json:"expense"`
+}
+
+type BalanceHistory struct {
+	Points      []*BalanceHistoryPoint `json:"points"`
+	Granularity string                 `json:"granularity"`
+	DateFrom    time.Time              `json:"date_from"`
+	DateTo      time.Time              `json:"date_to"`
+}
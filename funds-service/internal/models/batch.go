@@ -0,0 +1,24 @@
+package models
+
+// MaxBatchSize limits the number of items in a single batch request
+const MaxBatchSize = 500
+
+// TransactionPatch describes a partial update applied by BatchUpdateTransactions
+type TransactionPatch struct {
+	ID              int64   `json:"id"`
+	CategoryID      *int32  `json:"category_id,omitempty"` // Must have the same type as the transaction
+	Title           *string `json:"title,omitempty"`
+	ShiftDays       int32   `json:"shift_days"` // Moves transaction_date by the given number of days
+	ExpectedVersion int64   `json:"expected_version"`
+}
+
+type BatchItemError struct {
+	Index int   `json:"index"` // Position of the item in the batch
+	Err   error `json:"-"`
+}
+
+type BatchResult struct {
+	Transactions []*Transaction   `json:"transactions"` // Created, updated or deleted transactions
+	Errors       []BatchItemError `json:"errors"`
+	Committed    bool             `json:"committed"` // False when nothing was applied
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// Budget sources
+const (
+	BudgetSourceManual = "manual"
+	BudgetSourcePlan   = "plan" // Created by accepting an analytics budget plan
+)
+
+// Budget is a spending limit of an expense category for a calendar month
+type Budget struct {
+	ID           int64     `json:"id" db:"id"`
+	UserUID      string    `json:"user_uid" db:"user_uid"`
+	CategoryID   int32     `json:"category_id" db:"category_id"`
+	CategoryCode string    `json:"category_code" db:"-"`
+	CategoryName string    `json:"category_name" db:"-"`
+	Month        time.Time `json:"month" db:"month"` // First day of the month
+	Amount       float64   `json:"amount" db:"amount"`
+	Source       string    `json:"source" db:"source"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type BudgetItem struct {
+	CategoryID int32   `json:"category_id"`
+	Amount     float64 `json:"amount"`
+}
+
+type SetBudgetsInput struct {
+	UserUID string
+	Month   time.Time // First day of the month
+	Items   []BudgetItem
+	Replace bool // Delete the other budgets of the month
+	// ReplaceSource limits the budgets that are deleted or overwritten to the ones with this source,
+	// empty allows all of them
+	ReplaceSource string
+	Source        string
+}
+
+// SetBudgetsResult holds all budgets of the month and the ones from the input that were left unchanged,
+// because they have another source than SetBudgetsInput.ReplaceSource
+type SetBudgetsResult struct {
+	Budgets []*Budget
+	Kept    []*Budget
+}
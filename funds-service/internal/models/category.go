@@ -2,10 +2,32 @@ package models
 
 import "time"
 
+// DefaultLocale is the locale of category names stored in the categories table
+const DefaultLocale = "ru"
+
 type Category struct {
-	ID        int32     `json:"id" db:"id"`
-	Name      string    `json:"name" db:"name"`
-	Type      string    `json:"type" db:"type"` // income or expense
-	Icon      string    `json:"icon" db:"icon"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	ID           int32             `json:"id" db:"id"`
+	Code         string            `json:"code" db:"code"` // Stable identifier, does not depend on the locale
+	Name         string            `json:"name" db:"name"`
+	Type         string            `json:"type" db:"type"` // income or expense
+	Icon         string            `json:"icon" db:"icon"`
+	CreatedAt    time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at" db:"updated_at"`
+	ArchivedAt   *time.Time        `json:"archived_at,omitempty" db:"archived_at"`
+	Translations map[string]string `json:"translations,omitempty"` // Locale to name, only for category management
+}
+
+type CreateCategoryInput struct {
+	Code         string            `json:"code"`
+	Name         string            `json:"name"` // Name in the default locale
+	Type         string            `json:"type"`
+	Icon         string            `json:"icon"`
+	Translations map[string]string `json:"translations"`
+}
+
+type UpdateCategoryInput struct {
+	ID           int32             `json:"id"`
+	Name         *string           `json:"name"`
+	Icon         *string           `json:"icon"`
+	Translations map[string]string `json:"translations"` // Upserted, an empty name removes the translation
 }
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// Debt kinds
+const (
+	DebtKindLoan       = "loan"
+	DebtKindCreditCard = "credit_card"
+	DebtKindBorrowed   = "borrowed" // Money borrowed from a person
+	DebtKindLent       = "lent"     // Money lent to a person, the user is the creditor
+)
+
+// Debt statuses
+const (
+	DebtStatusActive  = "active"
+	DebtStatusPaidOff = "paid_off"
+)
+
+type Debt struct {
+	ID             int64      `json:"id" db:"id"`
+	UserUID        string     `json:"user_uid" db:"user_uid"`
+	Kind           string     `json:"kind" db:"kind"`
+	Title          string     `json:"title" db:"title"`
+	Counterparty   string     `json:"counterparty" db:"counterparty"`
+	Principal      float64    `json:"principal" db:"principal"`
+	InterestRate   float64    `json:"interest_rate" db:"interest_rate"` // Annual, percent
+	TermMonths     int32      `json:"term_months" db:"term_months"`
+	StartDate      time.Time  `json:"start_date" db:"start_date"`
+	MonthlyPayment float64    `json:"monthly_payment" db:"monthly_payment"`
+	PaidPrincipal  float64    `json:"paid_principal" db:"-"` // Sum over payments of live transactions
+	PaidInterest   float64    `json:"paid_interest" db:"-"`
+	PaymentsCount  int32      `json:"payments_count" db:"-"`
+	LastPaymentAt  *time.Time `json:"last_payment_at,omitempty" db:"-"`
+	PayoffDate     *time.Time `json:"payoff_date,omitempty" db:"-"` // Projected by the service
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// RemainingBalance returns the principal that is still to be paid
+func (d *Debt) RemainingBalance() float64 {
+	return max(0, d.Principal-d.PaidPrincipal)
+}
+
+func (d *Debt) Status() string {
+	if d.RemainingBalance() < 0.005 {
+		return DebtStatusPaidOff
+	}
+	return DebtStatusActive
+}
+
+// IsLiability reports whether the user owes the money, as opposed to money lent to others
+func (d *Debt) IsLiability() bool {
+	return d.Kind != DebtKindLent
+}
+
+// MonthlyRate returns the interest rate of one monthly period as a fraction
+func (d *Debt) MonthlyRate() float64 {
+	return d.InterestRate / 100 / 12
+}
+
+// SplitPayment splits a payment into interest accrued on the remaining balance
+// over one period and principal. Overpayments beyond the balance are not credited.
+func (d *Debt) SplitPayment(amount float64) (principal, interest float64) {
+	remaining := d.RemainingBalance()
+
+	interest = min(amount, RoundMoney(remaining*d.MonthlyRate()))
+	principal = min(RoundMoney(amount-interest), remaining)
+
+	return principal, interest
+}
+
+// RoundMoney rounds an amount to kopecks
+func RoundMoney(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+type DebtPayment struct {
+	ID             int64     `json:"id" db:"id"`
+	DebtID         int64     `json:"debt_id" db:"debt_id"`
+	TransactionID  int64     `json:"transaction_id" db:"transaction_id"`
+	Amount         float64   `json:"amount" db:"amount"` // Amount of the linked transaction
+	PrincipalPart  float64   `json:"principal_part" db:"principal_part"`
+	InterestPart   float64   `json:"interest_part" db:"interest_part"`
+	RemainingAfter float64   `json:"remaining_after" db:"remaining_after"`
+	PaidAt         time.Time `json:"paid_at" db:"transaction_date"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
+
+type AmortizationEntry struct {
+	Number    int32     `json:"number"`
+	Date      time.Time `json:"date"`
+	Payment   float64   `json:"payment"`
+	Principal float64   `json:"principal"`
+	Interest  float64   `json:"interest"`
+	Remaining float64   `json:"remaining"`
+}
+
+type CreateDebtInput struct {
+	UserUID        string
+	Kind           string
+	Title          string
+	Counterparty   string
+	Principal      float64
+	InterestRate   float64
+	TermMonths     int32
+	StartDate      time.Time
+	MonthlyPayment float64 // Zero means the annuity payment for debts with a term
+}
+
+type DebtDetails struct {
+	Debt     *Debt
+	Payments []*DebtPayment
+	Schedule []*AmortizationEntry
+}
+
+type DebtSummary struct {
+	TotalOwed       float64
+	TotalLent       float64
+	MonthlyPayments float64
+	ActiveCount     int32
+	DebtFreeDate    *time.Time
+}
@@ -0,0 +1,86 @@
+package models
+
+import "time"
+
+// Ledger member roles
+const (
+	LedgerRoleOwner  = "owner"
+	LedgerRoleEditor = "editor" // Adds own transactions to the ledger
+	LedgerRoleViewer = "viewer" // Only reads the ledger
+)
+
+// Ledger is a budget shared by several users. Its transactions stay attributed
+// to the member who paid, the ledger only groups them.
+type Ledger struct {
+	ID           int64           `json:"id" db:"id"`
+	Name         string          `json:"name" db:"name"`
+	OwnerUID     string          `json:"owner_uid" db:"owner_uid"`
+	Role         string          `json:"role" db:"-"` // Role of the requesting user
+	MembersCount int32           `json:"members_count" db:"-"`
+	Members      []*LedgerMember `json:"members,omitempty" db:"-"`
+	CreatedAt    time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+type LedgerMember struct {
+	LedgerID int64     `json:"ledger_id" db:"ledger_id"`
+	UserUID  string    `json:"user_uid" db:"user_uid"`
+	Username string    `json:"username" db:"username"`
+	Role     string    `json:"role" db:"role"`
+	JoinedAt time.Time `json:"joined_at" db:"joined_at"`
+}
+
+type AddLedgerMemberInput struct {
+	LedgerID  int64  `json:"ledger_id"`
+	UserUID   string `json:"user_uid"` // The owner who adds the member
+	MemberUID string `json:"member_uid"`
+	Username  string `json:"username"` // Resolved from user-service by the caller
+	Role      string `json:"role"`
+}
+
+// LedgerMemberBalance holds the totals of one member's ledger transactions.
+// Role is empty for former members whose transactions remain in the ledger.
+type LedgerMemberBalance struct {
+	UserUID  string  `json:"user_uid"`
+	Username string  `json:"username"`
+	Role     string  `json:"role"`
+	Income   float64 `json:"income"`
+	Expense  float64 `json:"expense"`
+	Balance  float64 `json:"balance"`
+}
+
+type LedgerBalance struct {
+	LedgerID     int64                  `json:"ledger_id"`
+	TotalIncome  float64                `json:"total_income"`
+	TotalExpense float64                `json:"total_expense"`
+	Balance      float64                `json:"balance"`
+	Members      []*LedgerMemberBalance `json:"members"`
+}
+
+// SettlementShare is the part of the shared expenses a participant paid and owes
+type SettlementShare struct {
+	UserUID  string  `json:"user_uid"`
+	Username string  `json:"username"`
+	Paid     float64 `json:"paid"`
+	Share    float64 `json:"share"`
+	Net      float64 `json:"net"` // Positive when the others owe the participant
+}
+
+type SettlementTransfer struct {
+	FromUID      string  `json:"from_uid"`
+	FromUsername string  `json:"from_username"`
+	ToUID        string  `json:"to_uid"`
+	ToUsername   string  `json:"to_username"`
+	Amount       float64 `json:"amount"`
+}
+
+// LedgerSettlement tells who owes whom so that everyone bears an equal share of the expenses
+type LedgerSettlement struct {
+	LedgerID     int64                 `json:"ledger_id"`
+	DateFrom     *time.Time            `json:"date_from,omitempty"`
+	DateTo       *time.Time            `json:"date_to,omitempty"`
+	TotalExpense float64               `json:"total_expense"`
+	SharePerUser float64               `json:"share_per_user"`
+	Shares       []*SettlementShare    `json:"shares"`
+	Transfers    []*SettlementTransfer `json:"transfers"`
+}
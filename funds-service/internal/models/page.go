@@ -0,0 +1,8 @@
+package models
+
+// PageTotal describes how many items match a paginated list
+type PageTotal struct {
+	Count     int64
+	Estimated bool // Count is a lower bound, counting stopped at a cap
+	HasMore   bool // There are items after the returned page
+}
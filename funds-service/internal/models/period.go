@@ -0,0 +1,138 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Period kinds
+const (
+	PeriodDays        = "days"         // Rolling window of the last N days
+	PeriodWeek        = "week"         // Calendar week starting on the user's week start
+	PeriodMonth       = "month"        // Calendar month
+	PeriodQuarter     = "quarter"      // Calendar quarter
+	PeriodSalaryMonth = "salary_month" // Month starting on the user's salary day
+	PeriodCustom      = "custom"       // Explicit date range
+)
+
+var ErrInvalidPeriod = errors.New("invalid period")
+
+// Calendar holds the user's preferences the periods are evaluated with
+type Calendar struct {
+	Location  *time.Location
+	WeekStart time.Weekday
+	SalaryDay int // 0 - not set, salary months then match calendar months
+}
+
+// NewCalendar builds a calendar from the user's profile: an IANA time zone name,
+// an ISO weekday the week starts on (1 - Monday, 7 - Sunday) and the salary day.
+// Unset values fall back to UTC, Monday and calendar months.
+func NewCalendar(timezone string, weekStart, salaryDay int32) (Calendar, error) {
+	cal := Calendar{
+		Location:  time.UTC,
+		WeekStart: time.Monday,
+		SalaryDay: int(salaryDay),
+	}
+
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return Calendar{}, fmt.Errorf("%w: unknown timezone %s", ErrInvalidPeriod, timezone)
+		}
+		cal.Location = loc
+	}
+
+	switch {
+	case weekStart == 0:
+	case weekStart >= 1 && weekStart <= 7:
+		cal.WeekStart = time.Weekday(weekStart % 7)
+	default:
+		return Calendar{}, fmt.Errorf("%w: week_start must be between 1 and 7", ErrInvalidPeriod)
+	}
+
+	if salaryDay < 0 || salaryDay > 31 {
+		return Calendar{}, fmt.Errorf("%w: salary_day must be between 0 and 31", ErrInvalidPeriod)
+	}
+
+	return cal, nil
+}
+
+// Today returns the current date in the user's time zone. Dates are represented
+// as midnight UTC, the same way transaction dates are parsed.
+func (c Calendar) Today(now time.Time) time.Time {
+	y, m, d := now.In(c.Location).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// PeriodSelector describes a period relative to the current date
+type PeriodSelector struct {
+	Kind     string
+	Offset   int // 0 - the current period, -1 - the previous one and so on
+	Days     int // PeriodDays only
+	DateFrom time.Time
+	DateTo   time.Time // PeriodCustom only, inclusive
+}
+
+// Resolve returns the inclusive date range of the period in the user's calendar
+func (p PeriodSelector) Resolve(cal Calendar, now time.Time) (from, to time.Time, err error) {
+	today := cal.Today(now)
+
+	switch p.Kind {
+	case PeriodDays:
+		if p.Days <= 0 {
+			return from, to, fmt.Errorf("%w: days must be positive", ErrInvalidPeriod)
+		}
+		// The window includes today and the N days before it, previous windows do not overlap it
+		to = today.AddDate(0, 0, p.Offset*(p.Days+1))
+		from = to.AddDate(0, 0, -p.Days)
+	case PeriodWeek:
+		shift := (int(today.Weekday()) - int(cal.WeekStart) + 7) % 7
+		from = today.AddDate(0, 0, 7*p.Offset-shift)
+		to = from.AddDate(0, 0, 6)
+	case PeriodMonth:
+		from = time.Date(today.Year(), today.Month()+time.Month(p.Offset), 1, 0, 0, 0, 0, time.UTC)
+		to = from.AddDate(0, 1, -1)
+	case PeriodQuarter:
+		first := time.Month((int(today.Month())-1)/3*3 + 1)
+		from = time.Date(today.Year(), first+time.Month(3*p.Offset), 1, 0, 0, 0, 0, time.UTC)
+		to = from.AddDate(0, 3, -1)
+	case PeriodSalaryMonth:
+		from, to = salaryMonth(today, cal.SalaryDay, p.Offset)
+	case PeriodCustom:
+		if p.DateFrom.IsZero() || p.DateTo.IsZero() {
+			return from, to, fmt.Errorf("%w: date_from and date_to are required for a custom period", ErrInvalidPeriod)
+		}
+		from, to = p.DateFrom, p.DateTo
+	default:
+		return from, to, fmt.Errorf("%w: unsupported kind %q", ErrInvalidPeriod, p.Kind)
+	}
+
+	if to.Before(from) {
+		return from, to, fmt.Errorf("%w: date_to must not be before date_from", ErrInvalidPeriod)
+	}
+
+	return from, to, nil
+}
+
+// salaryMonth returns the month that starts on the salary day and contains today, shifted by offset months.
+// In short months the salary day is moved to the last day of the month.
+func salaryMonth(today time.Time, salaryDay, offset int) (from, to time.Time) {
+	if salaryDay <= 0 {
+		salaryDay = 1
+	}
+
+	anchor := func(year int, month time.Month) time.Time {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		return time.Date(year, month, min(salaryDay, last), 0, 0, 0, 0, time.UTC)
+	}
+
+	// time.Date normalizes months out of range, so the month is shifted freely
+	year, month := today.Year(), today.Month()
+	if today.Before(anchor(year, month)) {
+		month--
+	}
+	month += time.Month(offset)
+
+	return anchor(year, month), anchor(year, month+1).AddDate(0, 0, -1)
+}
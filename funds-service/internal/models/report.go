@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// Group-by dimensions supported by the spending summary
+const (
+	GroupByCategory = "category"
+	GroupByType     = "type"
+	GroupByDay      = "day"
+	GroupByWeek     = "week"
+	GroupByMonth    = "month"
+	GroupByYear     = "year"
+	GroupByTag      = "tag"
+	GroupByMember   = "member" // Member who made the transactions, useful for ledgers
+)
+
+type SpendingSummaryInput struct {
+	UserUID         string       `json:"user_uid"`
+	DateFrom        time.Time    `json:"date_from"`
+	DateTo          time.Time    `json:"date_to"` // Inclusive
+	GroupBy         []string     `json:"group_by"`
+	Type            string       `json:"type"` // Optional filter: income or expense
+	ComparePrevious bool         `json:"compare_previous"`
+	LedgerID        *int64       `json:"ledger_id"`  // Summarizes the ledger instead of the user's transactions
+	WeekStart       time.Weekday `json:"week_start"` // First day of week buckets
+}
+
+type SpendingSummaryBucket struct {
+	CategoryID    int32      `json:"category_id,omitempty"`
+	CategoryCode  string     `json:"category_code,omitempty"`
+	CategoryName  string     `json:"category_name,omitempty"`
+	Type          string     `json:"type,omitempty"`
+	Period        *time.Time `json:"period,omitempty"` // Start of the day/week/month/year bucket
+	Tag           string     `json:"tag,omitempty"`
+	UserUID       string     `json:"user_uid,omitempty"`
+	Total         float64    `json:"total"`
+	Count         int64      `json:"count"`
+	PreviousTotal float64    `json:"previous_total"`
+	Delta         float64    `json:"delta"`
+	DeltaPercent  float64    `json:"delta_percent"`
+}
+
+type SpendingSummary struct {
+	Buckets              []*SpendingSummaryBucket `json:"buckets"`
+	TotalIncome          float64                  `json:"total_income"`
+	TotalExpense         float64                  `json:"total_expense"`
+	PreviousTotalIncome  float64                  `json:"previous_total_income"`
+	PreviousTotalExpense float64                  `json:"previous_total_expense"`
+	DateFrom             time.Time                `json:"date_from"`
+	DateTo               time.Time                `json:"date_to"`
+}
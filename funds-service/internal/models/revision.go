@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Revision actions
+const (
+	RevisionActionCreate  = "create"
+	RevisionActionUpdate  = "update"
+	RevisionActionDelete  = "delete"
+	RevisionActionRestore = "restore"
+)
+
+type TransactionRevision struct {
+	ID            int64        `json:"id" db:"id"`
+	TransactionID int64        `json:"transaction_id" db:"transaction_id"`
+	ChangedBy     string       `json:"changed_by" db:"changed_by"`
+	Action        string       `json:"action" db:"action"`
+	Before        *Transaction `json:"before,omitempty" db:"before"` // Snapshot before the change, nil for create
+	After         *Transaction `json:"after,omitempty" db:"after"`   // Snapshot after the change
+	ChangedAt     time.Time    `json:"changed_at" db:"changed_at"`
+}
@@ -5,17 +5,24 @@ import (
 )
 
 type Transaction struct {
-	ID              int64     `json:"id" db:"id"`
-	UserUID         string    `json:"user_uid" db:"user_uid"`
-	CategoryID      int32     `json:"category_id" db:"category_id"`
-	Type            string    `json:"type" db:"type"` // income or expense
-	Amount          float64   `json:"amount" db:"amount"`
-	Title           string    `json:"title" db:"title"`
-	Description     string    `json:"description" db:"description"`
-	TransactionDate time.Time `json:"transaction_date" db:"transaction_date"`
-	CreatedAt       time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
-	Category        *Category `json:"category,omitempty" db:"-"` // Populated by join
+	ID              int64      `json:"id" db:"id"`
+	UserUID         string     `json:"user_uid" db:"user_uid"`
+	CategoryID      int32      `json:"category_id" db:"category_id"`
+	Type            string     `json:"type" db:"type"` // income or expense
+	Amount          float64    `json:"amount" db:"amount"`
+	Title           string     `json:"title" db:"title"`
+	Description     string     `json:"description" db:"description"`
+	TransactionDate time.Time  `json:"transaction_date" db:"transaction_date"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+	Tags            []string   `json:"tags" db:"tags"`
+	Version         int64      `json:"version" db:"version"`
+	LedgerID        *int64     `json:"ledger_id,omitempty" db:"ledger_id"` // Set for transactions shared with a ledger
+	DeletedAt       *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	Category        *Category  `json:"category,omitempty" db:"-"` // Populated by join
+
+	// Previous is the snapshot before an update, it is sent to analytics-service
+	Previous *Transaction `json:"-" db:"-"`
 }
 
 type CreateTransactionInput struct {
@@ -26,6 +33,8 @@ type CreateTransactionInput struct {
 	Title           string    `json:"title"`
 	Description     string    `json:"description"`
 	TransactionDate time.Time `json:"transaction_date"`
+	Tags            []string  `json:"tags"`
+	LedgerID        *int64    `json:"ledger_id"` // Requires the owner or editor role in the ledger
 }
 
 type UpdateTransactionInput struct {
@@ -37,4 +46,6 @@ type UpdateTransactionInput struct {
 	Title           string    `json:"title"`
 	Description     string    `json:"description"`
 	TransactionDate time.Time `json:"transaction_date"`
+	Tags            []string  `json:"tags"`
+	ExpectedVersion int64     `json:"expected_version"` // 0 skips the version check
 }
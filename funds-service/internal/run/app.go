@@ -7,14 +7,27 @@ import (
 	"os/signal"
 	"syscall"
 
+	attachmentsrepository "github.com/cg-2025-crutch/backend/funds-service/internal/attachments/repository"
+	attachmentsservice "github.com/cg-2025-crutch/backend/funds-service/internal/attachments/service"
+	budgetsrepository "github.com/cg-2025-crutch/backend/funds-service/internal/budgets/repository"
+	budgetsservice "github.com/cg-2025-crutch/backend/funds-service/internal/budgets/service"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/config"
+	debtsrepository "github.com/cg-2025-crutch/backend/funds-service/internal/debts/repository"
+	debtsservice "github.com/cg-2025-crutch/backend/funds-service/internal/debts/service"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/jobs"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/repository"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/funds/service"
+	pb "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/gen"
 	grpcserver "github.com/cg-2025-crutch/backend/funds-service/internal/grpc/server"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/blob"
+	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/idempotency"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/kafka"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/log"
 	"github.com/cg-2025-crutch/backend/funds-service/internal/infrastructure/postgres"
+	ledgersrepository "github.com/cg-2025-crutch/backend/funds-service/internal/ledgers/repository"
+	ledgersservice "github.com/cg-2025-crutch/backend/funds-service/internal/ledgers/service"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 var defaultLevel = zap.NewAtomicLevelAt(zap.InfoLevel)
@@ -52,17 +65,61 @@ func Run(mainCtx context.Context) error {
 		return fmt.Errorf("appRun: %w", err)
 	}
 
+	blobStore, err := blob.New(ctx, conf.Blob)
+	if err != nil {
+		return fmt.Errorf("failed to init blob store: %w", err)
+	}
+
 	repo := repository.NewRepository(db.Pool)
 	svc := service.NewService(repo, producer)
 
-	grpcServer := grpcserver.NewGrpcServer(conf.Server)
+	attachmentsRepo := attachmentsrepository.NewRepository(db.Pool)
+	attachmentsSvc := attachmentsservice.NewService(attachmentsRepo, blobStore, conf.Attachments)
+
+	debtsRepo := debtsrepository.NewRepository(db.Pool)
+	debtsSvc := debtsservice.NewService(debtsRepo, producer)
+
+	ledgersRepo := ledgersrepository.NewRepository(db.Pool)
+	ledgersSvc := ledgersservice.NewService(ledgersRepo)
+
+	budgetsRepo := budgetsrepository.NewRepository(db.Pool)
+	budgetsSvc := budgetsservice.NewService(budgetsRepo)
+
+	purgeJob := jobs.NewPurgeDeletedJob(repo, attachmentsSvc, conf.Trash)
+	go purgeJob.Run(ctx)
+
+	snapshotsJob := jobs.NewBalanceSnapshotsJob(repo, conf.Snapshots)
+	go snapshotsJob.Run(ctx)
+
+	partitionsJob := jobs.NewTransactionPartitionsJob(repo, conf.Partitions)
+	go partitionsJob.Run(ctx)
+
+	idempotencyStore := idempotency.NewStore(db.Pool, conf.Idempotency.TTL)
+	go idempotencyStore.RunCleanup(ctx, conf.Idempotency.CleanupInterval)
+
+	grpcServer := grpcserver.NewGrpcServer(conf.Server, grpc.UnaryInterceptor(
+		idempotency.UnaryServerInterceptor(idempotencyStore,
+			pb.FundsService_CreateTransaction_FullMethodName,
+			pb.FundsService_UpdateTransaction_FullMethodName,
+			pb.FundsService_DeleteTransaction_FullMethodName,
+			pb.FundsService_BatchCreateTransactions_FullMethodName,
+			pb.FundsService_BatchUpdateTransactions_FullMethodName,
+			pb.FundsService_BatchDeleteTransactions_FullMethodName,
+			pb.DebtService_CreateDebt_FullMethodName,
+			pb.DebtService_LinkDebtPayment_FullMethodName,
+			pb.LedgerService_CreateLedger_FullMethodName,
+			pb.BudgetService_SetBudgets_FullMethodName,
+		),
+	))
 
 	grpcServer.RegisterGRPC(svc)
+	grpcServer.RegisterAttachments(attachmentsSvc)
+	grpcServer.RegisterDebts(debtsSvc)
+	grpcServer.RegisterLedgers(ledgersSvc)
+	grpcServer.RegisterBudgets(budgetsSvc)
 
 	if err = grpcServer.Run(ctx); err != nil {
 		return fmt.Errorf("appRun: %w", err)
 	}
 	return nil
-
-	return nil
 }
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+CREATE INDEX idx_transactions_tags ON transactions USING GIN (tags);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_tags;
+ALTER TABLE transactions DROP COLUMN IF EXISTS tags;
@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN deleted_at TIMESTAMPTZ;
+CREATE INDEX idx_transactions_user_deleted ON transactions(user_uid, deleted_at DESC) WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE transaction_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    changed_by UUID NOT NULL,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('create', 'update', 'delete', 'restore')),
+    before JSONB,
+    after JSONB,
+    changed_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_transaction_revisions_transaction ON transaction_revisions(transaction_id, changed_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS transaction_revisions;
+DROP INDEX IF EXISTS idx_transactions_user_deleted;
+ALTER TABLE transactions DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE transactions DROP COLUMN IF EXISTS version;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE attachments (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    storage_key TEXT NOT NULL,
+    thumbnail_key TEXT,
+    checksum CHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_attachments_transaction ON attachments(transaction_id, created_at);
+CREATE INDEX idx_attachments_user ON attachments(user_uid);
+
+-- +goose Down
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE debts (
+    id BIGSERIAL PRIMARY KEY,
+    user_uid UUID NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('loan', 'credit_card', 'borrowed', 'lent')),
+    title VARCHAR(255) NOT NULL,
+    counterparty VARCHAR(255) NOT NULL DEFAULT '',
+    principal DECIMAL(15, 2) NOT NULL CHECK (principal > 0),
+    interest_rate DECIMAL(7, 4) NOT NULL DEFAULT 0 CHECK (interest_rate >= 0),
+    term_months INTEGER NOT NULL DEFAULT 0 CHECK (term_months >= 0),
+    start_date DATE NOT NULL,
+    monthly_payment DECIMAL(15, 2) NOT NULL DEFAULT 0 CHECK (monthly_payment >= 0),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_debts_user ON debts(user_uid);
+
+-- A transaction pays at most one debt. Payments of soft-deleted transactions are ignored,
+-- purged transactions take their payments with them.
+CREATE TABLE debt_payments (
+    id BIGSERIAL PRIMARY KEY,
+    debt_id BIGINT NOT NULL REFERENCES debts(id) ON DELETE CASCADE,
+    transaction_id BIGINT NOT NULL UNIQUE REFERENCES transactions(id) ON DELETE CASCADE,
+    principal_part DECIMAL(15, 2) NOT NULL,
+    interest_part DECIMAL(15, 2) NOT NULL,
+    remaining_after DECIMAL(15, 2) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_debt_payments_debt ON debt_payments(debt_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS debt_payments;
+DROP TABLE IF EXISTS debts;
@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE ledgers (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    owner_uid UUID NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TABLE ledger_members (
+    ledger_id BIGINT NOT NULL REFERENCES ledgers(id) ON DELETE CASCADE,
+    user_uid UUID NOT NULL,
+    username VARCHAR(255) NOT NULL,
+    role VARCHAR(10) NOT NULL CHECK (role IN ('owner', 'editor', 'viewer')),
+    joined_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (ledger_id, user_uid)
+);
+
+CREATE INDEX idx_ledger_members_user ON ledger_members(user_uid);
+
+-- Transactions stay attributed to the member in user_uid, ledger_id shares them with the ledger.
+-- Deleting a ledger turns its transactions back into personal ones of their members.
+ALTER TABLE transactions ADD COLUMN ledger_id BIGINT REFERENCES ledgers(id) ON DELETE SET NULL;
+CREATE INDEX idx_transactions_ledger_date ON transactions(ledger_id, transaction_date DESC) WHERE ledger_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_ledger_date;
+ALTER TABLE transactions DROP COLUMN IF EXISTS ledger_id;
+DROP TABLE IF EXISTS ledger_members;
+DROP TABLE IF EXISTS ledgers;
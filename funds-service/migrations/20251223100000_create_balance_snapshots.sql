@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE balance_snapshots (
+    user_uid UUID NOT NULL,
+    snapshot_date DATE NOT NULL,
+    opening_balance DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    closing_balance DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    income DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    expense DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (user_uid, snapshot_date)
+);
+
+-- Users whose snapshots starting from stale_from no longer match their transactions
+CREATE TABLE balance_snapshot_stale (
+    user_uid UUID PRIMARY KEY,
+    stale_from DATE NOT NULL
+);
+
+-- Existing transactions are backfilled by the snapshot job
+INSERT INTO balance_snapshot_stale (user_uid, stale_from)
+SELECT user_uid, MIN(transaction_date)
+FROM transactions
+WHERE deleted_at IS NULL
+GROUP BY user_uid;
+
+-- +goose Down
+DROP TABLE IF EXISTS balance_snapshot_stale;
+DROP TABLE IF EXISTS balance_snapshots;
@@ -0,0 +1,82 @@
+-- +goose Up
+ALTER TABLE categories
+    ADD COLUMN code VARCHAR(50),
+    ADD COLUMN archived_at TIMESTAMPTZ,
+    ADD COLUMN updated_at TIMESTAMPTZ DEFAULT NOW();
+
+-- Stable codes of the seeded categories, analytics ranges are keyed by them
+UPDATE categories c
+SET code = seed.code
+FROM (VALUES
+    ('Зарплата', 'income', 'salary'),
+    ('Фриланс', 'income', 'freelance'),
+    ('Инвестиции', 'income', 'investments'),
+    ('Подарки', 'income', 'gifts_received'),
+    ('Продажа', 'income', 'sales'),
+    ('Другое', 'income', 'other_income'),
+    ('Продукты', 'expense', 'groceries'),
+    ('Выплаты по кредитам', 'expense', 'loan_payments'),
+    ('Транспорт', 'expense', 'transport'),
+    ('Жилье', 'expense', 'housing'),
+    ('Коммунальные услуги', 'expense', 'utilities'),
+    ('Здоровье', 'expense', 'health'),
+    ('Развлечения', 'expense', 'entertainment'),
+    ('Образование', 'expense', 'education'),
+    ('Одежда', 'expense', 'clothing'),
+    ('Кафе и рестораны', 'expense', 'restaurants'),
+    ('Путешествия', 'expense', 'travel'),
+    ('Спорт', 'expense', 'sports'),
+    ('Связь', 'expense', 'communication'),
+    ('Подарки', 'expense', 'gifts'),
+    ('Другое', 'expense', 'other')
+) AS seed(name, type, code)
+WHERE c.name = seed.name AND c.type = seed.type;
+
+-- Categories added by hand get a code derived from the id
+UPDATE categories SET code = 'category_' || id WHERE code IS NULL;
+
+ALTER TABLE categories ALTER COLUMN code SET NOT NULL;
+ALTER TABLE categories ADD CONSTRAINT categories_code_key UNIQUE (code);
+
+-- Names in other locales, categories.name holds the name in the default (Russian) locale
+CREATE TABLE category_translations (
+    category_id INT NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    PRIMARY KEY (category_id, locale)
+);
+
+INSERT INTO category_translations (category_id, locale, name)
+SELECT c.id, 'en', tr.name
+FROM categories c
+JOIN (VALUES
+    ('salary', 'Salary'),
+    ('freelance', 'Freelance'),
+    ('investments', 'Investments'),
+    ('gifts_received', 'Gifts'),
+    ('sales', 'Sales'),
+    ('other_income', 'Other'),
+    ('groceries', 'Groceries'),
+    ('loan_payments', 'Loan payments'),
+    ('transport', 'Transport'),
+    ('housing', 'Housing'),
+    ('utilities', 'Utilities'),
+    ('health', 'Health'),
+    ('entertainment', 'Entertainment'),
+    ('education', 'Education'),
+    ('clothing', 'Clothing'),
+    ('restaurants', 'Cafes and restaurants'),
+    ('travel', 'Travel'),
+    ('sports', 'Sports'),
+    ('communication', 'Mobile and internet'),
+    ('gifts', 'Gifts'),
+    ('other', 'Other')
+) AS tr(code, name) ON tr.code = c.code;
+
+-- +goose Down
+DROP TABLE IF EXISTS category_translations;
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_code_key;
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS code;
@@ -0,0 +1,138 @@
+-- +goose Up
+-- Transactions are range-partitioned by the month of transaction_date. Rows outside of the
+-- existing partitions go to transactions_default, the partitions job moves them out when it
+-- creates the partition for their month. Old partitions are detached into the archive schema.
+--
+-- The rows are copied inside the migration transaction, the table is locked until it commits.
+-- Large installations should run this migration in a maintenance window.
+
+-- The primary key of a partitioned table has to include the partition key, so tables
+-- referencing transactions by id lose their foreign keys. Purging deletes their rows explicitly.
+ALTER TABLE transaction_revisions DROP CONSTRAINT IF EXISTS transaction_revisions_transaction_id_fkey;
+ALTER TABLE attachments DROP CONSTRAINT IF EXISTS attachments_transaction_id_fkey;
+ALTER TABLE debt_payments DROP CONSTRAINT IF EXISTS debt_payments_transaction_id_fkey;
+
+ALTER TABLE transactions RENAME TO transactions_unpartitioned;
+ALTER INDEX transactions_pkey RENAME TO transactions_unpartitioned_pkey;
+ALTER SEQUENCE transactions_id_seq OWNED BY NONE;
+
+CREATE TABLE transactions (
+    id BIGINT NOT NULL DEFAULT nextval('transactions_id_seq'),
+    user_uid UUID NOT NULL,
+    category_id INTEGER NOT NULL REFERENCES categories(id),
+    type VARCHAR(10) NOT NULL CHECK (type IN ('income', 'expense')),
+    amount DECIMAL(15, 2) NOT NULL CHECK (amount > 0),
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    transaction_date DATE NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    deleted_at TIMESTAMPTZ,
+    version BIGINT NOT NULL DEFAULT 1,
+    ledger_id BIGINT REFERENCES ledgers(id) ON DELETE SET NULL,
+    PRIMARY KEY (id, transaction_date)
+) PARTITION BY RANGE (transaction_date);
+
+CREATE TABLE transactions_default PARTITION OF transactions DEFAULT;
+
+-- Monthly partitions from the earliest transaction, at most ten years back, up to three months ahead
+-- +goose StatementBegin
+DO $$
+DECLARE
+    part_start DATE;
+BEGIN
+    FOR part_start IN
+        SELECT generate_series(
+            GREATEST(
+                date_trunc('month', COALESCE((SELECT MIN(transaction_date) FROM transactions_unpartitioned), CURRENT_DATE)),
+                date_trunc('month', CURRENT_DATE) - interval '10 years'
+            ),
+            date_trunc('month', CURRENT_DATE) + interval '3 months',
+            interval '1 month'
+        )::date
+    LOOP
+        EXECUTE format(
+            'CREATE TABLE %I PARTITION OF transactions FOR VALUES FROM (%L) TO (%L)',
+            'transactions_' || to_char(part_start, '"y"YYYY"m"MM'),
+            part_start,
+            (part_start + interval '1 month')::date
+        );
+    END LOOP;
+END $$;
+-- +goose StatementEnd
+
+INSERT INTO transactions (id, user_uid, category_id, type, amount, title, description, transaction_date,
+                          created_at, updated_at, tags, deleted_at, version, ledger_id)
+SELECT id, user_uid, category_id, type, amount, title, description, transaction_date,
+       created_at, updated_at, tags, deleted_at, version, ledger_id
+FROM transactions_unpartitioned;
+
+DROP TABLE transactions_unpartitioned;
+ALTER SEQUENCE transactions_id_seq OWNED BY transactions.id;
+
+-- Date range filters are served by partition pruning, idx_transactions_date is not recreated
+CREATE INDEX idx_transactions_user_date ON transactions(user_uid, transaction_date DESC);
+CREATE INDEX idx_transactions_user_category ON transactions(user_uid, category_id);
+CREATE INDEX idx_transactions_user_type_date ON transactions(user_uid, type, transaction_date);
+CREATE INDEX idx_transactions_tags ON transactions USING GIN (tags);
+CREATE INDEX idx_transactions_user_deleted ON transactions(user_uid, deleted_at DESC) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_transactions_ledger_date ON transactions(ledger_id, transaction_date DESC) WHERE ledger_id IS NOT NULL;
+
+CREATE SCHEMA IF NOT EXISTS transactions_archive;
+
+ANALYZE transactions;
+
+-- +goose Down
+-- Partitions detached into the archive schema are not restored, the schema is kept when it is not empty
+ALTER TABLE transactions RENAME TO transactions_partitioned;
+ALTER INDEX transactions_pkey RENAME TO transactions_partitioned_pkey;
+ALTER SEQUENCE transactions_id_seq OWNED BY NONE;
+
+CREATE TABLE transactions (
+    id BIGINT PRIMARY KEY DEFAULT nextval('transactions_id_seq'),
+    user_uid UUID NOT NULL,
+    category_id INTEGER NOT NULL REFERENCES categories(id),
+    type VARCHAR(10) NOT NULL CHECK (type IN ('income', 'expense')),
+    amount DECIMAL(15, 2) NOT NULL CHECK (amount > 0),
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    transaction_date DATE NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    deleted_at TIMESTAMPTZ,
+    version BIGINT NOT NULL DEFAULT 1,
+    ledger_id BIGINT REFERENCES ledgers(id) ON DELETE SET NULL
+);
+
+INSERT INTO transactions (id, user_uid, category_id, type, amount, title, description, transaction_date,
+                          created_at, updated_at, tags, deleted_at, version, ledger_id)
+SELECT id, user_uid, category_id, type, amount, title, description, transaction_date,
+       created_at, updated_at, tags, deleted_at, version, ledger_id
+FROM transactions_partitioned;
+
+DROP TABLE transactions_partitioned;
+ALTER SEQUENCE transactions_id_seq OWNED BY transactions.id;
+
+CREATE INDEX idx_transactions_user_date ON transactions(user_uid, transaction_date DESC);
+CREATE INDEX idx_transactions_user_category ON transactions(user_uid, category_id);
+CREATE INDEX idx_transactions_user_type_date ON transactions(user_uid, type, transaction_date);
+CREATE INDEX idx_transactions_date ON transactions(transaction_date);
+CREATE INDEX idx_transactions_tags ON transactions USING GIN (tags);
+CREATE INDEX idx_transactions_user_deleted ON transactions(user_uid, deleted_at DESC) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_transactions_ledger_date ON transactions(ledger_id, transaction_date DESC) WHERE ledger_id IS NOT NULL;
+
+-- Rows of archived transactions cannot be referenced anymore
+DELETE FROM transaction_revisions r WHERE NOT EXISTS (SELECT 1 FROM transactions t WHERE t.id = r.transaction_id);
+DELETE FROM attachments a WHERE NOT EXISTS (SELECT 1 FROM transactions t WHERE t.id = a.transaction_id);
+DELETE FROM debt_payments p WHERE NOT EXISTS (SELECT 1 FROM transactions t WHERE t.id = p.transaction_id);
+
+ALTER TABLE transaction_revisions ADD CONSTRAINT transaction_revisions_transaction_id_fkey
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE;
+ALTER TABLE attachments ADD CONSTRAINT attachments_transaction_id_fkey
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE;
+ALTER TABLE debt_payments ADD CONSTRAINT debt_payments_transaction_id_fkey
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE;
+
+DROP SCHEMA IF EXISTS transactions_archive;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Monthly spending limits per expense category. A budget belongs to the calendar month
+-- that starts at month, one budget per category and month.
+CREATE TABLE budgets (
+    id BIGSERIAL PRIMARY KEY,
+    user_uid UUID NOT NULL,
+    category_id INT NOT NULL REFERENCES categories(id),
+    month DATE NOT NULL CHECK (EXTRACT(DAY FROM month) = 1),
+    amount DECIMAL(15, 2) NOT NULL CHECK (amount >= 0),
+    source VARCHAR(10) NOT NULL DEFAULT 'manual' CHECK (source IN ('manual', 'plan')),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (user_uid, month, category_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS budgets;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Keys are chosen by clients, so they are unique only within one user
+ALTER TABLE idempotency_keys ADD COLUMN user_uid VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (user_uid, method, key);
+
+-- +goose Down
+-- Keys of different users may collide without the user scope, the replay window is dropped
+DELETE FROM idempotency_keys;
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys DROP COLUMN user_uid;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (method, key);
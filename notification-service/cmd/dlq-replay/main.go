@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os/signal"
+	"syscall"
+
+	"github.com/cg-2025-crutch/backend/notification-service/internal/run"
+)
+
+// dlq-replay sends failed notifications from the dead-letter topics back to the original topics,
+// e.g. after an outage of the push service
+func main() {
+	topic := flag.String("topic", "", "dead-letter topic to replay, e.g. webpush.dlq; all topics if empty")
+	limit := flag.Int("limit", 0, "maximum number of messages to replay; 0 replays all of them")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	replayed, err := run.ReplayDeadLetters(ctx, *topic, *limit)
+	fmt.Printf("replayed %d messages\n", replayed)
+	if err != nil {
+		log.Fatalf("replay stopped with error: %v", err)
+	}
+}
@@ -2,10 +2,14 @@ package consumers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/IBM/sarama"
+	"github.com/cg-2025-crutch/backend/notification-service/internal/infrastructure/kafka"
 	"github.com/cg-2025-crutch/backend/notification-service/internal/infrastructure/log"
 	"github.com/cg-2025-crutch/backend/notification-service/internal/models"
+	"github.com/cg-2025-crutch/backend/notification-service/internal/notifications/repository"
 	"github.com/cg-2025-crutch/backend/notification-service/internal/notifications/service"
 	"github.com/mailru/easyjson"
 )
@@ -20,57 +24,33 @@ func NewNotificationConsumer(notifService *service.NotificationService) *Notific
 	}
 }
 
-func (cons *NotificationConsumer) Setup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (cons *NotificationConsumer) Cleanup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (cons *NotificationConsumer) ConsumeClaim(s sarama.ConsumerGroupSession, c sarama.ConsumerGroupClaim) error {
-	l := log.FromContext(s.Context())
-
-	for {
-		select {
-		case msg, ok := <-c.Messages():
-			if !ok {
-				l.Info("Consumer channel closed")
-				return nil
-			}
-
-			l.Infof("Message received: topic=%s, partition=%d, offset=%d",
-				msg.Topic, msg.Partition, msg.Offset)
-
-			var kafkaMsg models.KafkaNotificationMessage
-			if err := easyjson.Unmarshal(msg.Value, &kafkaMsg); err != nil {
-				l.Errorf("Failed to unmarshal Kafka message: %v", err)
-				// Mark message as processed even if it fails to avoid blocking
-				s.MarkMessage(msg, "")
-				s.Commit()
-				continue
-			}
-
-			l.Infof("Processing notification for user: %s, title: %s",
-				kafkaMsg.UserUID, kafkaMsg.Notification.Title)
-
-			if err := cons.service.SendNotification(s.Context(), kafkaMsg.UserUID, kafkaMsg.Notification); err != nil {
-				l.Errorf("Failed to send notification: %v", err)
-				s.MarkMessage(msg, "")
-				s.Commit()
-				continue
-			}
-
-			l.Infof("Successfully sent notification to user: %s", kafkaMsg.UserUID)
+// Process sends the notification from the message. A returned error makes the message retried,
+// errors that a retry will not fix are marked permanent and go straight to the DLQ
+func (cons *NotificationConsumer) Process(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	l := log.FromContext(ctx)
 
-			s.MarkMessage(msg, "")
-			s.Commit()
+	var kafkaMsg models.KafkaNotificationMessage
+	if err := easyjson.Unmarshal(msg.Value, &kafkaMsg); err != nil {
+		return kafka.Permanent(fmt.Errorf("failed to unmarshal Kafka message: %w", err))
+	}
 
-		case <-s.Context().Done():
-			l.Info("Consumer context done")
-			return nil
-		}
+	l.Infof("Processing notification for user: %s, title: %s",
+		kafkaMsg.UserUID, kafkaMsg.Notification.Title)
+
+	err := cons.service.SendNotification(ctx, kafkaMsg.UserUID, kafkaMsg.Notification)
+	switch {
+	case errors.Is(err, repository.ErrSubscriptionNotFound), errors.Is(err, service.ErrSubscriptionExpired):
+		// The user is not subscribed to push notifications, there is nobody to deliver to
+		l.Infof("Skipping notification for user %s: %v", kafkaMsg.UserUID, err)
+		return nil
+	case errors.Is(err, service.ErrInvalidSubscription):
+		return kafka.Permanent(err)
+	case err != nil:
+		return err
 	}
+
+	l.Infof("Successfully sent notification to user: %s", kafkaMsg.UserUID)
+	return nil
 }
 
 func StartConsuming(ctx context.Context, consumer sarama.ConsumerGroup, topics []string, handler sarama.ConsumerGroupHandler) {
@@ -39,6 +39,16 @@ type KafkaConfig struct {
 	ConsTopic    []string      `env:"KAFKA_CONS_TOPIC" env-required:"true"`
 	ProdTopic    string        `env:"KAFKA_PROD_TOPIC" env-required:"true"`
 	ConnDeadline time.Duration `env:"KAFKA_CONN_DEADLINE" envDefault:"10s"`
+	Retry        KafkaRetryConfig
+}
+
+// KafkaRetryConfig: Attempts in-place attempts with backoff from Backoff to MaxBackoff,
+// then one <topic>.retry.N topic per delay in Delays, then <topic>.dlq
+type KafkaRetryConfig struct {
+	Attempts   int             `env:"KAFKA_RETRY_ATTEMPTS" envDefault:"3"`
+	Backoff    time.Duration   `env:"KAFKA_RETRY_BACKOFF" envDefault:"500ms"`
+	MaxBackoff time.Duration   `env:"KAFKA_RETRY_MAX_BACKOFF" envDefault:"10s"`
+	Delays     []time.Duration `env:"KAFKA_RETRY_DELAYS" envDefault:"1m,10m" envSeparator:","`
 }
 
 type NotificationsConfig struct {
@@ -0,0 +1,184 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/IBM/sarama"
+)
+
+// ErrUnknownDLQTopic is returned when replaying from a topic that is not one of the service's DLQs
+var ErrUnknownDLQTopic = errors.New("unknown dead-letter topic")
+
+// DeadLetters replays messages from the service's DLQ topics to their original topics
+type DeadLetters struct {
+	brokers []string
+	group   string
+	topics  []string
+}
+
+// NewDeadLetters creates a replayer for the DLQs of topics consumed by consumerGroup.
+// Progress is kept in a separate group, so every message is replayed once
+func NewDeadLetters(brokers []string, consumerGroup string, topics []string) *DeadLetters {
+	dlqTopics := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		dlqTopics = append(dlqTopics, DLQTopic(topic))
+	}
+
+	return &DeadLetters{
+		brokers: brokers,
+		group:   consumerGroup + "-dlq-replay",
+		topics:  dlqTopics,
+	}
+}
+
+// Replay replays up to limit messages from dlqTopic, or from all of the service's DLQs if dlqTopic is empty.
+// A zero limit replays everything accumulated so far. Returns the number of replayed messages
+func (d *DeadLetters) Replay(ctx context.Context, dlqTopic string, limit int) (int, error) {
+	topics := d.topics
+	if dlqTopic != "" {
+		if !slices.Contains(d.topics, dlqTopic) {
+			return 0, fmt.Errorf("%w: %s", ErrUnknownDLQTopic, dlqTopic)
+		}
+		topics = []string{dlqTopic}
+	}
+
+	cfg := sarama.NewConfig()
+	cfg.Version = sarama.V2_3_0_0
+	cfg.Producer.Return.Successes = true
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	cfg.Consumer.Offsets.AutoCommit.Enable = false
+	cfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	client, err := sarama.NewClient(d.brokers, cfg)
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to Kafka: %w", err)
+	}
+	defer client.Close()
+
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create producer: %w", err)
+	}
+	defer producer.Close()
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	offsets, err := sarama.NewOffsetManagerFromClient(d.group, client)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create offset manager: %w", err)
+	}
+	defer offsets.Close()
+
+	replayed := 0
+	for _, topic := range topics {
+		partitions, err := client.Partitions(topic)
+		if err != nil {
+			return replayed, fmt.Errorf("failed to get partitions of %s: %w", topic, err)
+		}
+
+		for _, partition := range partitions {
+			if limit > 0 && replayed >= limit {
+				return replayed, nil
+			}
+
+			left := 0
+			if limit > 0 {
+				left = limit - replayed
+			}
+			n, err := replayPartition(ctx, client, consumer, producer, offsets, topic, partition, left)
+			replayed += n
+			if err != nil {
+				return replayed, err
+			}
+		}
+	}
+
+	return replayed, nil
+}
+
+// replayPartition replays the messages accumulated in the partition by the time of the call and stores the progress
+func replayPartition(ctx context.Context, client sarama.Client, consumer sarama.Consumer, producer sarama.SyncProducer,
+	offsets sarama.OffsetManager, topic string, partition int32, limit int) (int, error) {
+	pom, err := offsets.ManagePartition(topic, partition)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get replay progress of %s/%d: %w", topic, partition, err)
+	}
+	defer pom.Close()
+
+	oldest, err := client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get offsets of %s/%d: %w", topic, partition, err)
+	}
+	newest, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get offsets of %s/%d: %w", topic, partition, err)
+	}
+
+	// Messages removed by retention are skipped
+	next, _ := pom.NextOffset()
+	next = max(next, oldest)
+	if next >= newest {
+		return 0, nil
+	}
+
+	pc, err := consumer.ConsumePartition(topic, partition, next)
+	if err != nil {
+		return 0, fmt.Errorf("failed to consume %s/%d: %w", topic, partition, err)
+	}
+	defer pc.Close()
+
+	replayed := 0
+	defer offsets.Commit()
+	for next < newest && (limit == 0 || replayed < limit) {
+		select {
+		case <-ctx.Done():
+			return replayed, ctx.Err()
+		case msg := <-pc.Messages():
+			out, err := replayMessage(msg)
+			if err != nil {
+				return replayed, err
+			}
+			if _, _, err := producer.SendMessage(out); err != nil {
+				return replayed, fmt.Errorf("failed to replay message %s/%d/%d: %w", topic, partition, msg.Offset, err)
+			}
+
+			next = msg.Offset + 1
+			pom.MarkOffset(next, "")
+			replayed++
+		}
+	}
+
+	return replayed, nil
+}
+
+// replayMessage sends the message back to its original topic without the retry headers, so it goes
+// through all attempts again, and increments the DLQ replay counter
+func replayMessage(msg *sarama.ConsumerMessage) (*sarama.ProducerMessage, error) {
+	topic, ok := header(msg, HeaderOriginalTopic)
+	if !ok {
+		return nil, fmt.Errorf("message %s/%d/%d has no %s header", msg.Topic, msg.Partition, msg.Offset, HeaderOriginalTopic)
+	}
+
+	replays, _ := headerInt(msg, HeaderDLQReplays)
+	out := &sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: []sarama.RecordHeader{recordHeader(HeaderDLQReplays, strconv.FormatInt(replays+1, 10))},
+	}
+	for _, h := range msg.Headers {
+		if _, own := retryHeaders[string(h.Key)]; !own && string(h.Key) != HeaderDLQReplays {
+			out.Headers = append(out.Headers, *h)
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,295 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/cg-2025-crutch/backend/notification-service/internal/infrastructure/log"
+)
+
+// Headers set on a failed message when it is moved to a retry topic or to the DLQ
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderRetryStage        = "x-retry-stage"      // Retry topic number the message was moved to
+	HeaderNotBefore         = "x-retry-not-before" // Unix ms before which the message is not processed
+	HeaderAttempts          = "x-attempts"         // How many times processing has been attempted
+	HeaderError             = "x-error"            // Error of the last attempt
+	HeaderFailedAt          = "x-failed-at"        // Time of the last attempt, RFC 3339
+	HeaderDLQReplays        = "x-dlq-replays"      // How many times the message was replayed from the DLQ
+)
+
+// retryHeaders are set by the handler itself and are not copied from the incoming message
+var retryHeaders = map[string]struct{}{
+	HeaderOriginalTopic:     {},
+	HeaderOriginalPartition: {},
+	HeaderOriginalOffset:    {},
+	HeaderRetryStage:        {},
+	HeaderNotBefore:         {},
+	HeaderAttempts:          {},
+	HeaderError:             {},
+	HeaderFailedAt:          {},
+}
+
+// RetryConfig defines how processing is retried: first Attempts in-place attempts with exponential backoff
+// from Backoff to MaxBackoff, then one retry topic per delay in Delays, then the DLQ
+type RetryConfig struct {
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	Delays     []time.Duration
+}
+
+// RetryTopic returns the retry topic of the given stage, stages start at 1
+func RetryTopic(topic string, stage int) string {
+	return fmt.Sprintf("%s.retry.%d", topic, stage)
+}
+
+// DLQTopic returns the dead-letter topic
+func DLQTopic(topic string) string {
+	return topic + ".dlq"
+}
+
+// Topics returns the topics together with their retry topics to subscribe to
+func (c RetryConfig) Topics(topics []string) []string {
+	result := make([]string, 0, len(topics)*(len(c.Delays)+1))
+	for _, topic := range topics {
+		result = append(result, topic)
+		for stage := 1; stage <= len(c.Delays); stage++ {
+			result = append(result, RetryTopic(topic, stage))
+		}
+	}
+	return result
+}
+
+func (c RetryConfig) backoff(attempt int) time.Duration {
+	d := c.Backoff
+	for i := 1; i < attempt && d < c.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, c.MaxBackoff)
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks an error that a retry will not fix, e.g. a malformed message.
+// Such a message goes straight to the DLQ
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// IsPermanent reports whether the error is marked as permanent
+func IsPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent)
+}
+
+// Processor processes a single message. An error means processing should be retried
+type Processor func(ctx context.Context, msg *sarama.ConsumerMessage) error
+
+// RetryingHandler is a consumer group handler that marks a message only after it has been processed
+// or moved to a retry topic or the DLQ
+type RetryingHandler struct {
+	process  Processor
+	producer sarama.SyncProducer
+	cfg      RetryConfig
+}
+
+// NewRetryingHandler creates a retrying handler. The producer is used to move messages to retry topics and the DLQ
+func NewRetryingHandler(process Processor, producer sarama.SyncProducer, cfg RetryConfig) *RetryingHandler {
+	return &RetryingHandler{
+		process:  process,
+		producer: producer,
+		cfg:      cfg,
+	}
+}
+
+func (h *RetryingHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *RetryingHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *RetryingHandler) ConsumeClaim(s sarama.ConsumerGroupSession, c sarama.ConsumerGroupClaim) error {
+	l := log.FromContext(s.Context())
+
+	for {
+		select {
+		case msg, ok := <-c.Messages():
+			if !ok {
+				l.Info("Consumer channel closed")
+				return nil
+			}
+
+			l.Infof("Message received: topic=%s, partition=%d, offset=%d, key=%s",
+				msg.Topic, msg.Partition, msg.Offset, string(msg.Key))
+
+			if err := h.handle(s.Context(), msg); err != nil {
+				// The session ended before the message was processed or moved, it will be delivered again
+				l.Infof("Message topic=%s, partition=%d, offset=%d left unprocessed: %v", msg.Topic, msg.Partition, msg.Offset, err)
+				return nil
+			}
+
+			s.MarkMessage(msg, "")
+			s.Commit()
+
+		case <-s.Context().Done():
+			l.Info("Consumer context done")
+			return nil
+		}
+	}
+}
+
+// handle processes the message with in-place retries and moves it on if they all fail.
+// An error is returned only if the context ends first
+func (h *RetryingHandler) handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	l := log.FromContext(ctx)
+
+	// Messages in a retry topic share the same delay and are ordered by send time, so waiting for
+	// the first one does not hold back the next ones longer than their own delay
+	if notBefore, ok := headerInt(msg, HeaderNotBefore); ok {
+		if err := sleep(ctx, time.Until(time.UnixMilli(notBefore))); err != nil {
+			return err
+		}
+	}
+
+	attempts, _ := headerInt(msg, HeaderAttempts)
+	var err error
+	for i := 1; i <= max(1, h.cfg.Attempts); i++ {
+		if i > 1 {
+			if err := sleep(ctx, h.cfg.backoff(i-1)); err != nil {
+				return err
+			}
+		}
+
+		attempts++
+		if err = h.process(ctx, msg); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		l.Warnf("Failed to process message topic=%s, partition=%d, offset=%d, attempt %d: %v",
+			msg.Topic, msg.Partition, msg.Offset, attempts, err)
+		if IsPermanent(err) {
+			break
+		}
+	}
+
+	return h.forward(ctx, msg, attempts, err)
+}
+
+// forward moves the message to the next retry topic or to the DLQ. The message must not be marked
+// until it is moved, so sending is retried until it succeeds or the context ends
+func (h *RetryingHandler) forward(ctx context.Context, msg *sarama.ConsumerMessage, attempts int64, cause error) error {
+	l := log.FromContext(ctx)
+
+	originalTopic := msg.Topic
+	if topic, ok := header(msg, HeaderOriginalTopic); ok {
+		originalTopic = topic
+	}
+	stage, _ := headerInt(msg, HeaderRetryStage)
+
+	out := &sarama.ProducerMessage{
+		Topic: DLQTopic(originalTopic),
+		Key:   sarama.ByteEncoder(msg.Key),
+		Value: sarama.ByteEncoder(msg.Value),
+	}
+	if !IsPermanent(cause) && int(stage) < len(h.cfg.Delays) {
+		stage++
+		out.Topic = RetryTopic(originalTopic, int(stage))
+		out.Headers = append(out.Headers, recordHeader(HeaderNotBefore, strconv.FormatInt(time.Now().Add(h.cfg.Delays[stage-1]).UnixMilli(), 10)))
+	}
+
+	out.Headers = append(out.Headers,
+		recordHeader(HeaderOriginalTopic, originalTopic),
+		recordHeader(HeaderRetryStage, strconv.FormatInt(stage, 10)),
+		recordHeader(HeaderAttempts, strconv.FormatInt(attempts, 10)),
+		recordHeader(HeaderError, cause.Error()),
+		recordHeader(HeaderFailedAt, time.Now().UTC().Format(time.RFC3339)),
+	)
+	// The position in the original topic is recorded on the first failure
+	partition, ok := header(msg, HeaderOriginalPartition)
+	if !ok {
+		partition = strconv.FormatInt(int64(msg.Partition), 10)
+	}
+	offset, ok := header(msg, HeaderOriginalOffset)
+	if !ok {
+		offset = strconv.FormatInt(msg.Offset, 10)
+	}
+	out.Headers = append(out.Headers,
+		recordHeader(HeaderOriginalPartition, partition),
+		recordHeader(HeaderOriginalOffset, offset),
+	)
+	for _, rh := range msg.Headers {
+		if _, own := retryHeaders[string(rh.Key)]; !own {
+			out.Headers = append(out.Headers, *rh)
+		}
+	}
+
+	for i := 1; ; i++ {
+		_, _, err := h.producer.SendMessage(out)
+		if err == nil {
+			l.Warnf("Message topic=%s, partition=%d, offset=%d moved to %s after %d attempts",
+				msg.Topic, msg.Partition, msg.Offset, out.Topic, attempts)
+			return nil
+		}
+
+		l.Errorf("Failed to move message to %s: %v", out.Topic, err)
+		if err := sleep(ctx, h.cfg.backoff(i)); err != nil {
+			return err
+		}
+	}
+}
+
+func header(msg *sarama.ConsumerMessage, key string) (string, bool) {
+	for _, h := range msg.Headers {
+		if string(h.Key) == key {
+			return string(h.Value), true
+		}
+	}
+	return "", false
+}
+
+func headerInt(msg *sarama.ConsumerMessage, key string) (int64, bool) {
+	value, ok := header(msg, key)
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	return n, err == nil
+}
+
+func recordHeader(key, value string) sarama.RecordHeader {
+	return sarama.RecordHeader{Key: []byte(key), Value: []byte(value)}
+}
+
+// sleep waits for d or until the context ends
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
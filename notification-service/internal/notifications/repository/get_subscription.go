@@ -6,11 +6,15 @@ import (
 	"fmt"
 
 	"github.com/cg-2025-crutch/backend/notification-service/internal/models"
+	"github.com/redis/rueidis"
 )
 
 func (r *RedisRepo) GetSubscription(ctx context.Context, userUID string) (*models.StoredSubscription, error) {
 	cmd := r.client.B().Get().Key(userUID).Build()
 	result, err := r.client.Do(ctx, cmd).ToString()
+	if rueidis.IsRedisNil(err) {
+		return nil, ErrSubscriptionNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get subscription from redis: %w", err)
 	}
@@ -2,11 +2,15 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cg-2025-crutch/backend/notification-service/internal/models"
 	"github.com/redis/rueidis"
 )
 
+// ErrSubscriptionNotFound is returned when the user has no stored push subscription
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
 type RedisReporer interface {
 	InsertSubscription(ctx context.Context, userUID string, sub models.StoredSubscription) error
 	GetSubscription(ctx context.Context, userUID string) (*models.StoredSubscription, error)
@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/SherClockHolmes/webpush-go"
 	"github.com/cg-2025-crutch/backend/notification-service/internal/infrastructure/log"
@@ -11,6 +13,13 @@ import (
 	"github.com/mailru/easyjson"
 )
 
+var (
+	// ErrSubscriptionExpired is returned when the push service reports that the subscription no longer exists
+	ErrSubscriptionExpired = errors.New("subscription expired")
+	// ErrInvalidSubscription is returned when the stored subscription keys are malformed, resending will not help
+	ErrInvalidSubscription = errors.New("invalid subscription")
+)
+
 func (s *NotificationService) SendNotification(ctx context.Context, userUID string, not models.Notification) error {
 	l := log.FromContext(ctx)
 
@@ -45,12 +54,12 @@ func (s *NotificationService) sendToSubscriber(ctx context.Context, sb models.St
 
 	if _, err := base64.RawURLEncoding.DecodeString(sb.P256dh); err != nil {
 		l.Errorf("Invalid p256dh key (not valid base64url): %v", err)
-		return fmt.Errorf("invalid p256dh key: %w", err)
+		return fmt.Errorf("%w: invalid p256dh key: %v", ErrInvalidSubscription, err)
 	}
 
 	if _, err := base64.RawURLEncoding.DecodeString(sb.Auth); err != nil {
 		l.Errorf("Invalid auth key (not valid base64url): %v", err)
-		return fmt.Errorf("invalid auth key: %w", err)
+		return fmt.Errorf("%w: invalid auth key: %v", ErrInvalidSubscription, err)
 	}
 
 	sub := &webpush.Subscription{
@@ -75,6 +84,13 @@ func (s *NotificationService) sendToSubscriber(ctx context.Context, sb models.St
 	}
 	defer resp.Body.Close()
 
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrSubscriptionExpired
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return fmt.Errorf("push service responded with status %d", resp.StatusCode)
+	}
+
 	l.Infof("Notification sent successfully: status=%d", resp.StatusCode)
 
 	return nil
@@ -20,6 +20,8 @@ import (
 
 var defaultLevel = zap.NewAtomicLevelAt(zap.InfoLevel)
 
+const consumerGroup = "notification-consumer"
+
 func Run(mainCtx context.Context) error {
 	ctx, cancel := signal.NotifyContext(mainCtx, syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
@@ -42,16 +44,25 @@ func Run(mainCtx context.Context) error {
 
 	notificationService := service.NewNotificationService(conf.Notif, repo)
 
-	kafkaConsumer, err := kafka.InitKafkaConsumer(ctx, "notification-consumer", conf.Kafka)
+	// Failed messages are moved to the retry topics and the DLQ with this producer
+	retryProducer, err := kafka.NewSyncProducer(ctx, "notification-retry-producer", conf.Kafka)
+	if err != nil {
+		logger.Fatal("failed to initialize Kafka retry producer", zap.Error(err))
+	}
+	defer retryProducer.Close()
+
+	kafkaConsumer, err := kafka.InitKafkaConsumer(ctx, consumerGroup, conf.Kafka)
 	if err != nil {
 		logger.Fatal("failed to initialize Kafka consumer", zap.Error(err))
 	}
 	defer kafkaConsumer.Close()
 
-	consumerHandler := consumers.NewNotificationConsumer(notificationService)
+	retryCfg := retryConfig(conf.Kafka.Retry)
+	consumerHandler := kafka.NewRetryingHandler(consumers.NewNotificationConsumer(notificationService).Process, retryProducer, retryCfg)
+	topics := retryCfg.Topics(conf.Kafka.ConsTopic)
 
-	consumers.StartConsuming(ctx, kafkaConsumer, conf.Kafka.ConsTopic, consumerHandler)
-	logger.Infof("Kafka consumer started, listening to topics: %v", conf.Kafka.ConsTopic)
+	consumers.StartConsuming(ctx, kafkaConsumer, topics, consumerHandler)
+	logger.Infof("Kafka consumer started, listening to topics: %v", topics)
 
 	grpcServer := server.NewGrpcServer(conf.Server)
 
@@ -62,3 +73,12 @@ func Run(mainCtx context.Context) error {
 	}
 	return nil
 }
+
+func retryConfig(conf config.KafkaRetryConfig) kafka.RetryConfig {
+	return kafka.RetryConfig{
+		Attempts:   conf.Attempts,
+		Backoff:    conf.Backoff,
+		MaxBackoff: conf.MaxBackoff,
+		Delays:     conf.Delays,
+	}
+}
@@ -0,0 +1,20 @@
+package run
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cg-2025-crutch/backend/notification-service/internal/config"
+	"github.com/cg-2025-crutch/backend/notification-service/internal/infrastructure/kafka"
+)
+
+// ReplayDeadLetters sends up to limit messages from the notification DLQ topics back to their original
+// topics, an empty dlqTopic replays from all of them. Returns the number of replayed messages
+func ReplayDeadLetters(ctx context.Context, dlqTopic string, limit int) (int, error) {
+	conf, err := config.New()
+	if err != nil {
+		return 0, fmt.Errorf("failed to import config: %w", err)
+	}
+
+	return kafka.NewDeadLetters(conf.Kafka.Brokers, consumerGroup, conf.Kafka.ConsTopic).Replay(ctx, dlqTopic, limit)
+}
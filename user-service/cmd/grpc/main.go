@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	_ "time/tzdata" // The runtime image has no zoneinfo, user time zones are validated against the embedded one
 
 	"github.com/cg-2025-crutch/backend/user-service/internal/run"
 )
@@ -9,10 +9,11 @@ import (
 )
 
 type AppConfig struct {
-	Server   ServerConfig
-	Logger   LoggerConfig
-	Postgres PostgresConfig
-	JWT      JWTConfig
+	Server      ServerConfig
+	Logger      LoggerConfig
+	Postgres    PostgresConfig
+	JWT         JWTConfig
+	Idempotency IdempotencyConfig
 }
 
 type ServerConfig struct {
@@ -43,6 +44,11 @@ type JWTConfig struct {
 	RefreshTokenTTL time.Duration `env:"JWT_REFRESH_TOKEN_TTL" envDefault:"168h"`
 }
 
+type IdempotencyConfig struct {
+	TTL             time.Duration `env:"IDEMPOTENCY_TTL" envDefault:"24h"`
+	CleanupInterval time.Duration `env:"IDEMPOTENCY_CLEANUP_INTERVAL" envDefault:"1h"`
+}
+
 func New() (AppConfig, error) {
 	var cfg AppConfig
 	_ = godotenv.Load(".env")
@@ -20,10 +20,10 @@ type GrpcServer struct {
 	host string
 }
 
-func NewGrpcServer(conf config.ServerConfig) *GrpcServer {
+func NewGrpcServer(conf config.ServerConfig, opts ...grpc.ServerOption) *GrpcServer {
 
 	return &GrpcServer{
-		srv:  grpc.NewServer(),
+		srv:  grpc.NewServer(opts...),
 		host: conf.GRPCHost + ":" + conf.GRPCPort,
 	}
 }
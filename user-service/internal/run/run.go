@@ -9,13 +9,16 @@ import (
 	"syscall"
 
 	"github.com/cg-2025-crutch/backend/user-service/internal/config"
+	pb "github.com/cg-2025-crutch/backend/user-service/internal/grpc/gen"
 	"github.com/cg-2025-crutch/backend/user-service/internal/grpc/server"
+	"github.com/cg-2025-crutch/backend/user-service/internal/infrastructure/idempotency"
 	"github.com/cg-2025-crutch/backend/user-service/internal/infrastructure/jwt"
 	"github.com/cg-2025-crutch/backend/user-service/internal/infrastructure/log"
 	"github.com/cg-2025-crutch/backend/user-service/internal/infrastructure/postgres"
 	"github.com/cg-2025-crutch/backend/user-service/internal/users/repository"
 	"github.com/cg-2025-crutch/backend/user-service/internal/users/service"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 var defaultLevel = zap.NewAtomicLevelAt(zap.InfoLevel)
@@ -55,7 +58,14 @@ func Run(mainCtx context.Context) error {
 
 	userService := service.NewUserService(userRepo, jwtManager)
 
-	grpcServer := server.NewGrpcServer(conf.Server)
+	idempotencyStore := idempotency.NewStore(pg.Pool, conf.Idempotency.TTL, conf.JWT.SecretKey)
+	go idempotencyStore.RunCleanup(ctx, conf.Idempotency.CleanupInterval)
+
+	grpcServer := server.NewGrpcServer(conf.Server, grpc.UnaryInterceptor(
+		idempotency.UnaryServerInterceptor(idempotencyStore,
+			pb.UserService_CreateUser_FullMethodName,
+		),
+	))
 
 	grpcServer.RegisterGRPC(userService)
 
@@ -23,6 +23,13 @@ func (h *GRPCHandler) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		return nil, status.Error(codes.InvalidArgument, "password is required")
 	}
 
+	if err := validateCalendar(req.Timezone, req.WeekStart, req.SalaryDay); err != nil {
+		return nil, err
+	}
+	if err := validateIncomeSource(req.IncomeSource); err != nil {
+		return nil, err
+	}
+
 	dto := models.CreateUserDTO{
 		Username:     req.Username,
 		Password:     req.Password,
@@ -31,6 +38,19 @@ func (h *GRPCHandler) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		Age:          req.Age,
 		Salary:       req.Salary,
 		WorkSphereID: req.WorkSphereId,
+		Timezone:     req.Timezone,
+		WeekStart:    req.WeekStart,
+		SalaryDay:    req.SalaryDay,
+		IncomeSource: req.IncomeSource,
+	}
+	if dto.Timezone == "" {
+		dto.Timezone = models.DefaultTimezone
+	}
+	if dto.WeekStart == 0 {
+		dto.WeekStart = models.DefaultWeekStart
+	}
+	if dto.IncomeSource == "" {
+		dto.IncomeSource = models.IncomeSourceProfile
 	}
 
 	user, err := h.service.CreateUser(ctx, dto)
@@ -1,9 +1,13 @@
 package handler
 
 import (
+	"time"
+
 	pb "github.com/cg-2025-crutch/backend/user-service/internal/grpc/gen"
 	"github.com/cg-2025-crutch/backend/user-service/internal/users/models"
 	"github.com/cg-2025-crutch/backend/user-service/internal/users/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GRPCHandler struct {
@@ -26,5 +30,36 @@ func (h *GRPCHandler) modelToProto(user *models.User) *pb.User {
 		Age:          user.Age,
 		Salary:       user.Salary,
 		WorkSphereId: user.WorkSphereID,
+		Timezone:     user.Timezone,
+		WeekStart:    user.WeekStart,
+		SalaryDay:    user.SalaryDay,
+		IncomeSource: user.IncomeSource,
+	}
+}
+
+// validateCalendar checks the calendar preferences, unset values are skipped
+func validateCalendar(timezone string, weekStart, salaryDay int32) error {
+	if timezone != "" {
+		// "Local" would mean the server's zone, only IANA names are stored
+		if _, err := time.LoadLocation(timezone); err != nil || timezone == "Local" {
+			return status.Errorf(codes.InvalidArgument, "unknown timezone: %s", timezone)
+		}
+	}
+	if weekStart < 0 || weekStart > 7 {
+		return status.Error(codes.InvalidArgument, "week_start must be an ISO weekday from 1 (Monday) to 7 (Sunday)")
+	}
+	if salaryDay < 0 || salaryDay > 31 {
+		return status.Error(codes.InvalidArgument, "salary_day must be between 1 and 31, or 0 if not set")
+	}
+	return nil
+}
+
+// validateIncomeSource checks the income source, an empty value is skipped
+func validateIncomeSource(source string) error {
+	switch source {
+	case "", models.IncomeSourceProfile, models.IncomeSourceTransactions:
+		return nil
+	default:
+		return status.Errorf(codes.InvalidArgument, "income_source must be %q or %q", models.IncomeSourceProfile, models.IncomeSourceTransactions)
 	}
 }
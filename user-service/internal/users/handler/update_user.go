@@ -27,6 +27,16 @@ func (h *GRPCHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
 	}
 
+	if err := validateCalendar(req.GetTimezone(), req.GetWeekStart(), req.GetSalaryDay()); err != nil {
+		return nil, err
+	}
+	if req.WeekStart != nil && *req.WeekStart == 0 {
+		return nil, status.Error(codes.InvalidArgument, "week_start must be an ISO weekday from 1 (Monday) to 7 (Sunday)")
+	}
+	if err := validateIncomeSource(req.GetIncomeSource()); err != nil {
+		return nil, err
+	}
+
 	dto := models.UpdateUserDTO{
 		UID:          uid,
 		Username:     req.Username,
@@ -35,6 +45,18 @@ func (h *GRPCHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		Age:          req.Age,
 		Salary:       req.Salary,
 		WorkSphereID: req.WorkSphereId,
+		Timezone:     req.Timezone,
+		WeekStart:    req.WeekStart,
+		SalaryDay:    req.SalaryDay,
+		IncomeSource: req.IncomeSource,
+	}
+	if dto.Timezone != nil && *dto.Timezone == "" {
+		timezone := models.DefaultTimezone
+		dto.Timezone = &timezone
+	}
+	if dto.IncomeSource != nil && *dto.IncomeSource == "" {
+		source := models.IncomeSourceProfile
+		dto.IncomeSource = &source
 	}
 
 	user, err := h.service.UpdateUser(ctx, dto)
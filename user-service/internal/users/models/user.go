@@ -4,6 +4,18 @@ import (
 	"github.com/google/uuid"
 )
 
+// Calendar defaults for users who have not set their preferences
+const (
+	DefaultTimezone  = "UTC"
+	DefaultWeekStart = 1 // Monday
+)
+
+// Income sources analytics can base recommendations on
+const (
+	IncomeSourceProfile      = "profile"      // Salary from the profile
+	IncomeSourceTransactions = "transactions" // Average of the income transactions
+)
+
 type User struct {
 	UID          uuid.UUID `db:"uid"`
 	Username     string    `db:"username"`
@@ -13,6 +25,10 @@ type User struct {
 	Age          int32     `db:"age"`
 	Salary       float64   `db:"salary"`
 	WorkSphereID int64     `db:"work_sphere"`
+	Timezone     string    `db:"timezone"`   // IANA name, e.g. Europe/Moscow
+	WeekStart    int32     `db:"week_start"` // ISO weekday: 1 - Monday, 7 - Sunday
+	SalaryDay    int32     `db:"salary_day"` // Day of month the salary arrives, 0 - not set
+	IncomeSource string    `db:"income_source"`
 }
 
 type CreateUserDTO struct {
@@ -23,6 +39,10 @@ type CreateUserDTO struct {
 	Age          int32
 	Salary       float64
 	WorkSphereID int64
+	Timezone     string
+	WeekStart    int32
+	SalaryDay    int32
+	IncomeSource string
 }
 
 type UpdateUserDTO struct {
@@ -33,4 +53,8 @@ type UpdateUserDTO struct {
 	Age          int32
 	Salary       float64
 	WorkSphereID int64
+	Timezone     *string // Calendar preferences are kept when not set
+	WeekStart    *int32
+	SalaryDay    *int32
+	IncomeSource *string
 }
@@ -21,9 +21,10 @@ func (r *postgresRepository) CreateUser(ctx context.Context, dto models.CreateUs
 	uid := uuid.New()
 
 	query := `
-		INSERT INTO users (uid, username, password, first_name, second_name, age, salary, work_sphere)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING uid, username, password, first_name, second_name, age, salary, work_sphere
+		INSERT INTO users (uid, username, password, first_name, second_name, age, salary, work_sphere,
+		                   timezone, week_start, salary_day, income_source)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING uid, username, password, first_name, second_name, age, salary, work_sphere, timezone, week_start, salary_day, income_source
 	`
 
 	user := &models.User{}
@@ -36,6 +37,10 @@ func (r *postgresRepository) CreateUser(ctx context.Context, dto models.CreateUs
 		dto.Age,
 		dto.Salary,
 		dto.WorkSphereID,
+		dto.Timezone,
+		dto.WeekStart,
+		dto.SalaryDay,
+		dto.IncomeSource,
 	).Scan(
 		&user.UID,
 		&user.Username,
@@ -45,6 +50,10 @@ func (r *postgresRepository) CreateUser(ctx context.Context, dto models.CreateUs
 		&user.Age,
 		&user.Salary,
 		&user.WorkSphereID,
+		&user.Timezone,
+		&user.WeekStart,
+		&user.SalaryDay,
+		&user.IncomeSource,
 	)
 
 	if err != nil {
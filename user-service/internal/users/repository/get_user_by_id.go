@@ -12,7 +12,7 @@ import (
 
 func (r *postgresRepository) GetUserByID(ctx context.Context, uid uuid.UUID) (*models.User, error) {
 	query := `
-		SELECT uid, username, password, first_name, second_name, age, salary, work_sphere
+		SELECT uid, username, password, first_name, second_name, age, salary, work_sphere, timezone, week_start, salary_day, income_source
 		FROM users
 		WHERE uid = $1
 	`
@@ -27,6 +27,10 @@ func (r *postgresRepository) GetUserByID(ctx context.Context, uid uuid.UUID) (*m
 		&user.Age,
 		&user.Salary,
 		&user.WorkSphereID,
+		&user.Timezone,
+		&user.WeekStart,
+		&user.SalaryDay,
+		&user.IncomeSource,
 	)
 
 	if err != nil {
@@ -11,7 +11,7 @@ import (
 
 func (r *postgresRepository) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
 	query := `
-		SELECT uid, username, password, first_name, second_name, age, salary, work_sphere
+		SELECT uid, username, password, first_name, second_name, age, salary, work_sphere, timezone, week_start, salary_day, income_source
 		FROM users
 		WHERE username = $1
 	`
@@ -26,6 +26,10 @@ func (r *postgresRepository) GetUserByUsername(ctx context.Context, username str
 		&user.Age,
 		&user.Salary,
 		&user.WorkSphereID,
+		&user.Timezone,
+		&user.WeekStart,
+		&user.SalaryDay,
+		&user.IncomeSource,
 	)
 
 	if err != nil {
@@ -12,9 +12,13 @@ import (
 func (r *postgresRepository) UpdateUser(ctx context.Context, dto models.UpdateUserDTO) (*models.User, error) {
 	query := `
 		UPDATE users
-		SET username = $2, first_name = $3, second_name = $4, age = $5, salary = $6, work_sphere = $7
+		SET username = $2, first_name = $3, second_name = $4, age = $5, salary = $6, work_sphere = $7,
+		    timezone = COALESCE($8, timezone),
+		    week_start = COALESCE($9, week_start),
+		    salary_day = COALESCE($10, salary_day),
+		    income_source = COALESCE($11, income_source)
 		WHERE uid = $1
-		RETURNING uid, username, password, first_name, second_name, age, salary, work_sphere
+		RETURNING uid, username, password, first_name, second_name, age, salary, work_sphere, timezone, week_start, salary_day, income_source
 	`
 
 	user := &models.User{}
@@ -26,6 +30,10 @@ func (r *postgresRepository) UpdateUser(ctx context.Context, dto models.UpdateUs
 		dto.Age,
 		dto.Salary,
 		dto.WorkSphereID,
+		dto.Timezone,
+		dto.WeekStart,
+		dto.SalaryDay,
+		dto.IncomeSource,
 	).Scan(
 		&user.UID,
 		&user.Username,
@@ -35,6 +43,10 @@ func (r *postgresRepository) UpdateUser(ctx context.Context, dto models.UpdateUs
 		&user.Age,
 		&user.Salary,
 		&user.WorkSphereID,
+		&user.Timezone,
+		&user.WeekStart,
+		&user.SalaryDay,
+		&user.IncomeSource,
 	)
 
 	if err != nil {
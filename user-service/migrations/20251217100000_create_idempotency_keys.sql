@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE idempotency_keys (
+    method VARCHAR(255) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    request_hash BYTEA NOT NULL,
+    response BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (method, key)
+);
+
+CREATE INDEX idx_idempotency_keys_created ON idempotency_keys(created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
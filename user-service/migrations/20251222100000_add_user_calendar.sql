@@ -0,0 +1,12 @@
+-- +goose Up
+-- Calendar preferences: periods like "this month" are evaluated in the user's time zone
+ALTER TABLE users
+    ADD COLUMN timezone TEXT NOT NULL DEFAULT 'UTC',
+    ADD COLUMN week_start SMALLINT NOT NULL DEFAULT 1 CHECK (week_start BETWEEN 1 AND 7),
+    ADD COLUMN salary_day SMALLINT NOT NULL DEFAULT 0 CHECK (salary_day BETWEEN 0 AND 31);
+
+-- +goose Down
+ALTER TABLE users
+    DROP COLUMN IF EXISTS timezone,
+    DROP COLUMN IF EXISTS week_start,
+    DROP COLUMN IF EXISTS salary_day;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Income analytics is based on: the salary from the profile or the actual income transactions
+ALTER TABLE users
+    ADD COLUMN income_source TEXT NOT NULL DEFAULT 'profile' CHECK (income_source IN ('profile', 'transactions'));
+
+-- +goose Down
+ALTER TABLE users
+    DROP COLUMN IF EXISTS income_source;
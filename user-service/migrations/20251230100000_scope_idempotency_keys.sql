@@ -0,0 +1,12 @@
+-- +goose Up
+-- Keys are chosen by clients, so they are unique only within a scope: the username being registered
+ALTER TABLE idempotency_keys ADD COLUMN scope TEXT NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (scope, method, key);
+
+-- +goose Down
+-- Keys of different clients may collide without the scope, the replay window is dropped
+DELETE FROM idempotency_keys;
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys DROP COLUMN scope;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (method, key);